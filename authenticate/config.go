@@ -7,6 +7,7 @@ import (
 
 	"github.com/pomerium/pomerium/authenticate/events"
 	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	identitypb "github.com/pomerium/pomerium/pkg/grpc/identity"
 	"github.com/pomerium/pomerium/pkg/identity"
 )
@@ -14,6 +15,7 @@ import (
 type authenticateConfig struct {
 	getIdentityProvider func(ctx context.Context, tracerProvider oteltrace.TracerProvider, options *config.Options, idpID string) (identity.Authenticator, error)
 	profileTrimFn       func(*identitypb.Profile)
+	additionalRecordsFn func(context.Context, *identitypb.Profile) ([]*databroker.Record, error)
 	authEventFn         events.AuthEventFn
 }
 
@@ -43,6 +45,15 @@ func WithProfileTrimFn(profileTrimFn func(*identitypb.Profile)) Option {
 	}
 }
 
+// WithAdditionalRecordsFn sets the additionalRecordsFn function in the config.
+// The returned databroker records are sent to the proxy along with the
+// identity profile when using the stateless authenticate flow.
+func WithAdditionalRecordsFn(additionalRecordsFn func(context.Context, *identitypb.Profile) ([]*databroker.Record, error)) Option {
+	return func(cfg *authenticateConfig) {
+		cfg.additionalRecordsFn = additionalRecordsFn
+	}
+}
+
 // WithOnAuthenticationEventHook sets the authEventFn function in the config
 func WithOnAuthenticationEventHook(fn events.AuthEventFn) Option {
 	return func(cfg *authenticateConfig) {
@@ -49,6 +49,8 @@ func (a *Authenticate) Mount(r *mux.Router) {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if r.URL.Path == endpoints.PathPomeriumVerifyAccessToken ||
 				r.URL.Path == endpoints.PathPomeriumVerifyIdentityToken ||
+				r.URL.Path == endpoints.PathPomeriumBackChannelLogout || // called by the identity provider
+				strings.HasPrefix(r.URL.Path, endpoints.PathPomeriumSCIM+"/") || // called by the identity provider
 				r.URL.Path == endpoints.PathAuthenticateCallback { // protected by separate CSRF token
 				r = csrf.UnsafeSkipCheck(r)
 			}
@@ -95,6 +97,8 @@ func (a *Authenticate) mountDashboard(r *mux.Router) {
 	sr.Path("/" + endpoints.SubPathSignedOut).Handler(httputil.HandlerFunc(a.signedOut)).Methods(http.MethodGet)
 	sr.Path("/" + endpoints.SubPathVerifyAccessToken).Handler(httputil.HandlerFunc(a.verifyAccessToken)).Methods(http.MethodPost)
 	sr.Path("/" + endpoints.SubPathVerifyIdentityToken).Handler(httputil.HandlerFunc(a.verifyIdentityToken)).Methods(http.MethodPost)
+	sr.Path("/" + endpoints.SubPathBackChannelLogout).Handler(httputil.HandlerFunc(a.backChannelLogout)).Methods(http.MethodPost)
+	sr.PathPrefix("/" + endpoints.SubPathSCIM + "/").HandlerFunc(a.serveSCIM)
 
 	// routes that need a session:
 	sr = sr.NewRoute().Subrouter()
@@ -146,9 +150,16 @@ func (a *Authenticate) VerifySession(next http.Handler) http.Handler {
 				Msg("authenticate: couldn't verify session")
 			span.AddEvent("couldn't verify session",
 				oteltrace.WithAttributes(attribute.String("error", err.Error())))
+			if errors.Is(err, authenticateflow.ErrAssuranceLevelNotMet) {
+				return a.stepUpOrFail(w, r, err)
+			}
 			return a.reauthenticateOrFail(w, r, err)
 		}
 
+		if _, err := r.Cookie(stepUpCookieName); err == nil {
+			clearStepUpCookie(w)
+		}
+
 		next.ServeHTTP(w, r.WithContext(ctx))
 		return nil
 	})
@@ -189,8 +200,9 @@ func (a *Authenticate) SignOut(w http.ResponseWriter, r *http.Request) error {
 			return err
 		}
 
+		redirectCookieName := a.options.Load().GetCookiePrefix().CookieName(urlutil.QueryRedirectURI)
 		handlers.SignOutConfirm(handlers.SignOutConfirmData{
-			URL:             urlutil.SignOutURL(r, authenticateURL, a.state.Load().sharedKey),
+			URL:             urlutil.SignOutURL(r, authenticateURL, a.state.Load().sharedKey, redirectCookieName),
 			BrandingOptions: a.options.Load().BrandingOptions,
 		}).ServeHTTP(w, r)
 		return nil
@@ -305,7 +317,7 @@ func (a *Authenticate) reauthenticateOrFail(w http.ResponseWriter, r *http.Reque
 	b = append(b, enc...)
 	encodedState := base64.URLEncoding.EncodeToString(b)
 
-	err = authenticator.SignIn(w, r, encodedState)
+	err = authenticator.SignIn(w, a.withStepUpAuthCodeOptions(r), encodedState)
 	if err != nil {
 		return httputil.NewError(http.StatusInternalServerError,
 			fmt.Errorf("failed to sign in: %w", err))
@@ -424,6 +436,13 @@ Or contact your administrator.
 		nh.Audience = append(nh.Audience, nextRedirectURL.Hostname())
 	}
 
+	if state.claimsMapper != nil {
+		claims.Claims, err = state.claimsMapper.MapClaims(ctx, idpID, claims.Claims)
+		if err != nil {
+			return nil, fmt.Errorf("error mapping identity provider claims: %w", err)
+		}
+	}
+
 	// save the session and access token to the databroker/cookie store
 	if err := state.flow.PersistSession(ctx, w, &nh, claims, accessToken); err != nil {
 		return nil, fmt.Errorf("failed saving new session: %w", err)
@@ -467,6 +486,7 @@ func (a *Authenticate) userInfo(w http.ResponseWriter, r *http.Request) error {
 		cookie := options.NewCookie()
 		cookie.Name = urlutil.QueryRedirectURI
 		cookie.Value = redirectURI
+		httputil.ApplyCookiePrefix(cookie, options.GetCookiePrefix())
 
 		http.SetCookie(w, cookie)
 		http.Redirect(w, r, u.String(), http.StatusFound)
@@ -0,0 +1,72 @@
+package authenticate
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+// backChannelLogout handles an OpenID Connect back-channel logout request. The
+// identity provider posts a signed logout token identifying the user (sub)
+// and/or the identity provider session (sid) and every matching Pomerium
+// session is revoked. Each logout token (jti) is only accepted once.
+//
+// https://openid.net/specs/openid-connect-backchannel-1_0.html#BCRequest
+func (a *Authenticate) backChannelLogout(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Cache-Control", "no-cache, no-store")
+
+	rawLogoutToken := r.FormValue("logout_token")
+	if rawLogoutToken == "" {
+		return httputil.NewError(http.StatusBadRequest, errors.New("missing logout_token"))
+	}
+
+	authenticator, err := a.cfg.getIdentityProvider(a.backgroundCtx, a.tracerProvider, a.options.Load(), a.getIdentityProviderIDForRequest(r))
+	if err != nil {
+		return err
+	}
+
+	claims, err := authenticator.VerifyLogoutToken(r.Context(), rawLogoutToken)
+	if err != nil {
+		log.Ctx(r.Context()).Info().
+			Err(err).
+			Str("idp", authenticator.Name()).
+			Msg("authenticate: logout token failed verification")
+		return httputil.NewError(http.StatusBadRequest, err)
+	}
+
+	// logout tokens may only be used once
+	iss, _ := claims["iss"].(string)
+	jti, _ := claims["jti"].(string)
+	err = a.state.Load().flow.RecordLogoutToken(r.Context(), iss, jti)
+	if errors.Is(err, session.ErrLogoutTokenReplayed) {
+		log.Ctx(r.Context()).Info().
+			Str("idp", authenticator.Name()).
+			Msg("authenticate: logout token was replayed")
+		return httputil.NewError(http.StatusBadRequest, err)
+	} else if err != nil {
+		return err
+	}
+
+	var keys []string
+	if sid, _ := claims["sid"].(string); sid != "" {
+		keys = append(keys, session.IndexKeyForSID(iss, sid))
+	} else if sub, _ := claims["sub"].(string); sub != "" {
+		keys = append(keys, session.IndexKeyForSubject(iss, sub))
+	}
+
+	revoked, err := a.state.Load().flow.RevokeSessionsByIndex(r.Context(), keys...)
+	if err != nil {
+		return err
+	}
+
+	log.Ctx(r.Context()).Info().
+		Str("idp", authenticator.Name()).
+		Strs("session-ids", revoked).
+		Msg("authenticate: back-channel logout")
+
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -36,6 +38,7 @@ import (
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	configproto "github.com/pomerium/pomerium/pkg/grpc/config"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/identity"
 	"github.com/pomerium/pomerium/pkg/identity/oidc"
 )
@@ -304,6 +307,78 @@ func TestAuthenticate_SignOutDoesNotRequireSession(t *testing.T) {
 	assert.Contains(t, string(body), `"page":"SignOutConfirm"`)
 }
 
+func TestAuthenticate_BackChannelLogout(t *testing.T) {
+	t.Parallel()
+
+	serve := func(provider identity.MockProvider, form url.Values, logoutTokens ...string) (*stubFlow, *httptest.ResponseRecorder) {
+		f := &stubFlow{logoutTokens: logoutTokens}
+		a := &Authenticate{
+			cfg: getAuthenticateConfig(WithGetIdentityProvider(func(_ context.Context, _ oteltrace.TracerProvider, _ *config.Options, _ string) (identity.Authenticator, error) {
+				return provider, nil
+			})),
+		}
+		a.state.Store(&authenticateState{
+			cookieSecret:  cryptutil.NewKey(),
+			sessionStore:  &mstore.Store{LoadError: errors.New("no session")},
+			sharedEncoder: mock.Encoder{},
+			flow:          f,
+		})
+		a.options.Store(new(config.Options))
+		r := httptest.NewRequest(http.MethodPost, "/.pomerium/backchannel-logout", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		a.Handler().ServeHTTP(w, r)
+		return f, w
+	}
+
+	t.Run("sid", func(t *testing.T) {
+		t.Parallel()
+
+		f, w := serve(identity.MockProvider{VerifyLogoutTokenResponse: map[string]any{
+			"iss": "https://idp.example.com",
+			"sub": "USER",
+			"sid": "SESSION",
+		}}, url.Values{"logout_token": {"TOKEN"}})
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, []string{session.IndexKeyForSID("https://idp.example.com", "SESSION")}, f.revokedIndexKeys)
+	})
+	t.Run("sub", func(t *testing.T) {
+		t.Parallel()
+
+		f, w := serve(identity.MockProvider{VerifyLogoutTokenResponse: map[string]any{
+			"iss": "https://idp.example.com",
+			"sub": "USER",
+		}}, url.Values{"logout_token": {"TOKEN"}})
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, []string{session.IndexKeyForSubject("https://idp.example.com", "USER")}, f.revokedIndexKeys)
+	})
+	t.Run("replayed token", func(t *testing.T) {
+		t.Parallel()
+
+		f, w := serve(identity.MockProvider{VerifyLogoutTokenResponse: map[string]any{
+			"iss": "https://idp.example.com",
+			"sub": "USER",
+			"jti": "JTI",
+		}}, url.Values{"logout_token": {"TOKEN"}}, "https://idp.example.com|JTI")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Empty(t, f.revokedIndexKeys)
+	})
+	t.Run("missing token", func(t *testing.T) {
+		t.Parallel()
+
+		f, w := serve(identity.MockProvider{}, url.Values{})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Empty(t, f.revokedIndexKeys)
+	})
+	t.Run("invalid token", func(t *testing.T) {
+		t.Parallel()
+
+		f, w := serve(identity.MockProvider{VerifyLogoutTokenError: oidc.ErrInvalidLogoutToken}, url.Values{"logout_token": {"TOKEN"}})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Empty(t, f.revokedIndexKeys)
+	})
+}
+
 func TestAuthenticate_OAuthCallback(t *testing.T) {
 	t.Parallel()
 
@@ -792,6 +867,8 @@ func (m mockDataBrokerServiceClient) Put(ctx context.Context, in *databroker.Put
 // stubFlow is a stub implementation of the flow interface.
 type stubFlow struct {
 	verifySignatureErr error
+	revokedIndexKeys   []string
+	logoutTokens       []string
 }
 
 func (f *stubFlow) VerifyAuthenticateSignature(*http.Request) error {
@@ -818,6 +895,20 @@ func (*stubFlow) RevokeSession(
 	return ""
 }
 
+func (f *stubFlow) RevokeSessionsByIndex(_ context.Context, keys ...string) ([]string, error) {
+	f.revokedIndexKeys = append(f.revokedIndexKeys, keys...)
+	return nil, nil
+}
+
+func (f *stubFlow) RecordLogoutToken(_ context.Context, issuer, jti string) error {
+	key := issuer + "|" + jti
+	if slices.Contains(f.logoutTokens, key) {
+		return session.ErrLogoutTokenReplayed
+	}
+	f.logoutTokens = append(f.logoutTokens, key)
+	return nil
+}
+
 func (*stubFlow) GetUserInfoData(*http.Request, *sessions.Handle) handlers.UserInfoData {
 	return handlers.UserInfoData{}
 }
@@ -0,0 +1,61 @@
+package authenticate
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	googlegrpc "google.golang.org/grpc"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/scim"
+	"github.com/pomerium/pomerium/pkg/endpoints"
+	"github.com/pomerium/pomerium/pkg/grpc"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// newSCIMHandler returns the handler for the SCIM provisioning endpoints, or
+// nil if SCIM provisioning isn't enabled.
+func newSCIMHandler(
+	ctx context.Context,
+	tracerProvider oteltrace.TracerProvider,
+	cfg *config.Config,
+	outboundGrpcConn *grpc.CachedOutboundGRPClientConn,
+) (http.Handler, error) {
+	bearerToken, err := cfg.Options.GetSCIMBearerToken()
+	if err != nil {
+		return nil, err
+	} else if bearerToken == "" {
+		return nil, nil
+	}
+
+	sharedKey, err := cfg.Options.GetSharedKey()
+	if err != nil {
+		return nil, err
+	}
+
+	dataBrokerConn, err := outboundGrpcConn.Get(ctx, &grpc.OutboundOptions{
+		OutboundPort:   cfg.OutboundPort,
+		InstallationID: cfg.Options.InstallationID,
+		ServiceName:    cfg.Options.Services,
+		SignedJWTKey:   sharedKey,
+	}, googlegrpc.WithStatsHandler(otelgrpc.NewClientHandler(otelgrpc.WithTracerProvider(tracerProvider))))
+	if err != nil {
+		return nil, err
+	}
+
+	return scim.New(endpoints.PathPomeriumSCIM,
+		databroker.NewDataBrokerServiceClient(dataBrokerConn),
+		bearerToken).HandlerFunc(), nil
+}
+
+// serveSCIM handles a SCIM provisioning request.
+func (a *Authenticate) serveSCIM(w http.ResponseWriter, r *http.Request) {
+	h := a.state.Load().scim
+	if h == nil {
+		http.NotFound(w, r)
+		return
+	}
+	h.ServeHTTP(w, r)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/pomerium/pomerium/internal/encoding"
 	"github.com/pomerium/pomerium/internal/encoding/jws"
 	"github.com/pomerium/pomerium/internal/handlers"
+	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/sessions"
 	"github.com/pomerium/pomerium/internal/sessions/cookie"
 	"github.com/pomerium/pomerium/internal/urlutil"
@@ -31,6 +32,8 @@ type flow interface {
 	PersistSession(ctx context.Context, w http.ResponseWriter, h *sessions.Handle, claims identity.SessionClaims, accessToken *oauth2.Token) error
 	VerifySession(ctx context.Context, r *http.Request, h *sessions.Handle) error
 	RevokeSession(ctx context.Context, r *http.Request, authenticator identity.Authenticator, h *sessions.Handle) string
+	RevokeSessionsByIndex(ctx context.Context, keys ...string) ([]string, error)
+	RecordLogoutToken(ctx context.Context, issuer, jti string) error
 	GetUserInfoData(r *http.Request, h *sessions.Handle) handlers.UserInfoData
 	LogAuthenticateEvent(r *http.Request)
 	GetIdentityProviderIDForURLValues(url.Values) string
@@ -57,6 +60,13 @@ type authenticateState struct {
 	csrf *csrfCookieValidation
 
 	jwk *jose.JSONWebKeySet
+
+	// claimsMapper transforms identity provider claims before they are
+	// persisted, if configured
+	claimsMapper identity.ClaimsMapper
+
+	// scim handles SCIM provisioning requests, if enabled
+	scim http.Handler
 }
 
 func newAuthenticateStateFromConfig(
@@ -115,12 +125,15 @@ func newAuthenticateStateFromConfig(
 
 	cookieStore, err := cookie.NewStore(func() cookie.Options {
 		return cookie.Options{
-			Name:     cfg.Options.CookieName + "_authenticate",
-			Domain:   cfg.Options.CookieDomain,
-			Secure:   true,
-			HTTPOnly: cfg.Options.CookieHTTPOnly,
-			Expire:   cfg.Options.CookieExpire,
-			SameSite: cfg.Options.GetCookieSameSite(),
+			Name:        cfg.Options.CookieName + "_authenticate",
+			Domain:      cfg.Options.CookieDomain,
+			Secure:      true,
+			HTTPOnly:    cfg.Options.CookieHTTPOnly,
+			Expire:      cfg.Options.CookieExpire,
+			SameSite:    cfg.Options.GetCookieSameSite(),
+			Compression: httputil.CookieCompression(cfg.Options.CookieCompression),
+			Prefix:      cfg.Options.GetCookiePrefix(),
+			Partitioned: cfg.Options.CookiePartitioned,
 		}
 	}, state.sharedEncoder)
 	if err != nil {
@@ -129,7 +142,7 @@ func newAuthenticateStateFromConfig(
 
 	state.csrf = newCSRFCookieValidation(
 		state.cookieSecret,
-		fmt.Sprintf("%s_csrf", cfg.Options.CookieName),
+		cfg.Options.GetCookiePrefix().CookieName(fmt.Sprintf("%s_csrf", cfg.Options.CookieName)),
 		cfg.Options.GetCSRFSameSite(),
 	)
 
@@ -149,6 +162,16 @@ func newAuthenticateStateFromConfig(
 		}
 	}
 
+	state.claimsMapper, err = cfg.GetClaimsMapper()
+	if err != nil {
+		return nil, err
+	}
+
+	state.scim, err = newSCIMHandler(ctx, tracerProvider, cfg, outboundGrpcConn)
+	if err != nil {
+		return nil, err
+	}
+
 	if cfg.Options.UseStatelessAuthenticateFlow() {
 		state.flow, err = authenticateflow.NewStateless(ctx,
 			tracerProvider,
@@ -156,6 +179,7 @@ func newAuthenticateStateFromConfig(
 			cookieStore,
 			authenticateConfig.getIdentityProvider,
 			authenticateConfig.profileTrimFn,
+			authenticateConfig.additionalRecordsFn,
 			authenticateConfig.authEventFn,
 			outboundGrpcConn,
 		)
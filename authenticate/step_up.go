@@ -0,0 +1,58 @@
+package authenticate
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/identity/oauth"
+)
+
+// stepUpCookieName is the name of the cookie set while the user signs in
+// again to meet a route's required assurance level.
+const stepUpCookieName = "_pomerium_step_up"
+
+const stepUpCookieMaxAge = 5 * time.Minute
+
+// stepUpOrFail asks the user to sign in again to meet the assurance level
+// requested for the sign in. If the user already signed in again without
+// meeting it, the request fails instead of repeating the sign in.
+func (a *Authenticate) stepUpOrFail(w http.ResponseWriter, r *http.Request, err error) error {
+	if _, cookieErr := r.Cookie(stepUpCookieName); cookieErr == nil {
+		clearStepUpCookie(w)
+		return httputil.NewError(http.StatusForbidden, err)
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     stepUpCookieName,
+		Value:    r.FormValue(urlutil.QueryAssuranceLevel),
+		Path:     "/",
+		MaxAge:   int(stepUpCookieMaxAge.Seconds()),
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return a.reauthenticateOrFail(w, r, err)
+}
+
+// withStepUpAuthCodeOptions adds the identity provider parameters for the
+// assurance level requested for the sign in, if any, to the request context.
+func (a *Authenticate) withStepUpAuthCodeOptions(r *http.Request) *http.Request {
+	level := r.FormValue(urlutil.QueryAssuranceLevel)
+	if level == "" {
+		return r
+	}
+	return r.WithContext(oauth.WithAuthCodeOptions(r.Context(), a.options.Load().GetStepUpAuthCodeOptions(level)))
+}
+
+func clearStepUpCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     stepUpCookieName,
+		Path:     "/",
+		MaxAge:   -1,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
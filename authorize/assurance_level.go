@@ -0,0 +1,18 @@
+package authorize
+
+import (
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+// isAssuranceLevelSatisfied returns true if the user signed in with the
+// assurance level required by the route. Service accounts never meet a
+// required assurance level.
+func isAssuranceLevelSatisfied(policy *config.Policy, s sessionOrServiceAccount) bool {
+	if policy == nil || policy.RequiredAssuranceLevel == "" {
+		return true
+	}
+
+	sess, ok := s.(*session.Session)
+	return ok && config.IsAssuranceLevelSatisfied(policy.RequiredAssuranceLevel, sess.GetAssuranceLevel())
+}
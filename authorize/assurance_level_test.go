@@ -0,0 +1,26 @@
+package authorize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+)
+
+func TestIsAssuranceLevelSatisfied(t *testing.T) {
+	t.Parallel()
+
+	mfa := &config.Policy{RequiredAssuranceLevel: config.AssuranceLevelMFA}
+	withMFA := &session.Session{Id: "s1"}
+	withMFA.SetAssuranceLevel(config.AssuranceLevelMFA)
+
+	assert.True(t, isAssuranceLevelSatisfied(nil, nil))
+	assert.True(t, isAssuranceLevelSatisfied(&config.Policy{}, &session.Session{Id: "s1"}))
+	assert.True(t, isAssuranceLevelSatisfied(mfa, withMFA))
+	assert.False(t, isAssuranceLevelSatisfied(mfa, &session.Session{Id: "s1"}))
+	assert.False(t, isAssuranceLevelSatisfied(mfa, &user.ServiceAccount{Id: "sa1"}))
+	assert.False(t, isAssuranceLevelSatisfied(mfa, nil))
+}
@@ -21,7 +21,10 @@ import (
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpc"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
 	"github.com/pomerium/pomerium/pkg/ssh"
+	"github.com/pomerium/pomerium/pkg/storage"
 	"github.com/pomerium/pomerium/pkg/telemetry/trace"
 )
 
@@ -72,6 +75,17 @@ func (a *Authorize) GetDataBrokerServiceClient() databroker.DataBrokerServiceCli
 	return a.state.Load().dataBrokerClient
 }
 
+// WaitForSync waits for the synced data used by the authorize service to
+// complete its first sync with the databroker.
+func (a *Authorize) WaitForSync(ctx context.Context) error {
+	for recordType, q := range a.state.Load().syncQueriers {
+		if err := storage.WaitForSync(ctx, q); err != nil {
+			return fmt.Errorf("authorize: error waiting for %s to sync: %w", recordType, err)
+		}
+	}
+	return nil
+}
+
 // Run runs the authorize service.
 func (a *Authorize) Run(ctx context.Context) error {
 	eg, ctx := errgroup.WithContext(ctx)
@@ -82,6 +96,16 @@ func (a *Authorize) Run(ctx context.Context) error {
 		a.accessTracker.Run(ctx)
 		return nil
 	})
+	eg.Go(func() error {
+		// drop revoked sessions from the cache as soon as they're revoked
+		return storage.NewCacheInvalidator(ctx, "authorize/sessions",
+			session.RevocationRecordType,
+			grpcutil.GetTypeURL(new(session.Session)),
+			a.GetDataBrokerServiceClient,
+			a.withQuerierForCheckRequest,
+			databroker.WithSyncerTracerProvider(a.tracerProvider),
+		).Run(ctx)
+	})
 	return eg.Wait()
 }
 
@@ -149,6 +173,8 @@ func newPolicyEvaluator(
 		evaluator.WithClientCA(clientCA),
 		evaluator.WithAddDefaultClientCertificateRule(addDefaultClientCertificateRule),
 		evaluator.WithClientCRL(clientCRL),
+		evaluator.WithClientOCSPCheck(opts.DownstreamMTLS.OCSPCheck),
+		evaluator.WithClientOCSPFailClosed(opts.DownstreamMTLS.OCSPFailClosed),
 		evaluator.WithClientCertConstraints(clientCertConstraints),
 		evaluator.WithSigningKey(signingKey),
 		evaluator.WithAuthenticateURL(authenticateURL.String()),
@@ -19,10 +19,12 @@ import (
 	"github.com/tniswong/go.rfcx/rfc7231"
 	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/pomerium/pomerium/authorize/checkrequest"
 	"github.com/pomerium/pomerium/authorize/evaluator"
 	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/config/envoyconfig"
 	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/jsonrpc"
 	"github.com/pomerium/pomerium/internal/log"
@@ -77,7 +79,9 @@ func (a *Authorize) handleResultAllowed(
 	_ *envoy_service_auth_v3.CheckRequest,
 	result *evaluator.Result,
 ) (*envoy_service_auth_v3.CheckResponse, error) {
-	return a.okResponse(result.Headers, result.HeadersToRemove), nil
+	res := a.okResponse(result.Headers, result.HeadersToRemove)
+	setResponseHeaderClaims(res, result.ResponseHeaderClaims)
+	return res, nil
 }
 
 func (a *Authorize) handleResultDenied(
@@ -135,6 +139,23 @@ func (a *Authorize) okResponse(headersToSet http.Header, headersToRemove []strin
 	}
 }
 
+// setResponseHeaderClaims stores the claims referenced by response headers in
+// the ext_authz dynamic metadata, where envoy reads them when adding the
+// response headers.
+func setResponseHeaderClaims(res *envoy_service_auth_v3.CheckResponse, claims map[string]string) {
+	if len(claims) == 0 {
+		return
+	}
+
+	fields := make(map[string]*structpb.Value, len(claims))
+	for k, v := range claims {
+		fields[k] = structpb.NewStringValue(v)
+	}
+	res.DynamicMetadata = &structpb.Struct{Fields: map[string]*structpb.Value{
+		envoyconfig.ResponseHeaderClaimsMetadataKey: structpb.NewStructValue(&structpb.Struct{Fields: fields}),
+	}}
+}
+
 func deniedResponseForMCP(
 	ctx context.Context,
 	id jsonrpc.ID,
@@ -215,6 +236,13 @@ func (a *Authorize) deniedResponse(
 	case isGRPCWebRequest(in):
 		return deniedResponseForGRPCWeb(code, reason, headers), nil
 	default:
+		if body, ok := renderCustomErrorPage(ctx, code, reason); ok {
+			respBody = body
+			headers.Set("Content-Type", "text/html; charset=UTF-8")
+			headers.Set(httputil.HeaderPomeriumResponse, "true")
+			break
+		}
+
 		// create a http response writer recorder
 		w := httptest.NewRecorder()
 		r := getHTTPRequestFromCheckRequest(in)
@@ -277,6 +305,12 @@ func (a *Authorize) requireLoginResponse(
 		signInURLQuery = url.Values{}
 		signInURLQuery.Add("pomerium_traceparent", id)
 	}
+	if request.Policy != nil && request.Policy.RequiredAssuranceLevel != "" {
+		if signInURLQuery == nil {
+			signInURLQuery = url.Values{}
+		}
+		signInURLQuery.Set(urlutil.QueryAssuranceLevel, request.Policy.RequiredAssuranceLevel)
+	}
 	var additionalHosts []string
 	if request.Policy != nil {
 		additionalHosts = request.Policy.DependsOn
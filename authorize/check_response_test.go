@@ -18,6 +18,7 @@ import (
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/jsonrpc"
 	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
 	hpke_handlers "github.com/pomerium/pomerium/pkg/hpke/handlers"
 	"github.com/pomerium/pomerium/pkg/policy/criteria"
 	"github.com/pomerium/pomerium/pkg/telemetry/requestid"
@@ -574,6 +575,48 @@ func TestAuthorize_deniedResponse(t *testing.T) {
 			}
 		}`, res)
 	})
+
+	t.Run("custom error page", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		ctx = requestid.WithValue(ctx, "REQUESTID")
+		ctx = withErrorPages(ctx, &config.Policy{
+			Name: "App",
+			ErrorPages: map[string]string{
+				"4xx": "{{.RouteName}}: {{.Status}} {{.StatusText}} for {{.Email}} ({{.RequestID}})",
+			},
+		}, &user.User{Email: "user@example.com"})
+
+		res, err := a.deniedResponse(ctx, &envoy_service_auth_v3.CheckRequest{}, http.StatusForbidden, "Forbidden", nil)
+		assert.NoError(t, err)
+		testutil.AssertProtoJSONEqual(t, `{
+			"deniedResponse": {
+				"body": "App: 403 Forbidden for user@example.com (REQUESTID)",
+				"headers": [
+					{
+						"appendAction": "OVERWRITE_IF_EXISTS_OR_ADD",
+						"header": { "key": "Content-Type", "value": "text/html; charset=UTF-8" }
+					},
+					{
+						"appendAction": "OVERWRITE_IF_EXISTS_OR_ADD",
+						"header": { "key": "X-Pomerium-Intercepted-Response", "value": "true" }
+					}
+				],
+				"status": {
+					"code": "Forbidden"
+				}
+			},
+			"status": {
+				"code": 7,
+				"message": "Access Denied"
+			}
+		}`, res)
+
+		res, err = a.deniedResponse(ctx, &envoy_service_auth_v3.CheckRequest{}, http.StatusInternalServerError, "ERROR", nil)
+		assert.NoError(t, err)
+		assert.Contains(t, res.GetDeniedResponse().GetBody(), "<!DOCTYPE html>",
+			"should use the default page for statuses without a custom page")
+	})
 }
 
 func mustParseWeightedURLs(t *testing.T, urls ...string) []config.WeightedURL {
@@ -0,0 +1,58 @@
+package authorize
+
+import (
+	"cmp"
+	"context"
+	"strconv"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/telemetry/requestid"
+)
+
+type errorPagesContextKey struct{}
+
+type errorPagesContext struct {
+	policy *config.Policy
+	email  string
+}
+
+// withErrorPages adds the route's custom error pages to the context, so that
+// denied responses can use them.
+func withErrorPages(ctx context.Context, policy *config.Policy, u *user.User) context.Context {
+	if policy == nil || len(policy.ErrorPages) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, errorPagesContextKey{}, &errorPagesContext{
+		policy: policy,
+		email:  u.GetEmail(),
+	})
+}
+
+// renderCustomErrorPage renders the custom error page for the given status
+// code, if the route in the context has one.
+func renderCustomErrorPage(ctx context.Context, code int32, reason string) ([]byte, bool) {
+	v, ok := ctx.Value(errorPagesContextKey{}).(*errorPagesContext)
+	if !ok {
+		return nil, false
+	}
+
+	key, ok := v.policy.GetErrorPageKey(int(code))
+	if !ok {
+		return nil, false
+	}
+
+	body, err := v.policy.RenderErrorPage(key, config.ErrorPageData{
+		Status:     strconv.Itoa(int(code)),
+		StatusText: reason,
+		RequestID:  requestid.FromContext(ctx),
+		RouteName:  cmp.Or(v.policy.Name, v.policy.From),
+		Email:      v.email,
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("error rendering custom error page, using the default")
+		return nil, false
+	}
+	return body, true
+}
@@ -10,6 +10,8 @@ type evaluatorConfig struct {
 	Policies                                          []*config.Policy `hash:"-"`
 	ClientCA                                          []byte
 	ClientCRL                                         []byte
+	ClientOCSPCheck                                   bool
+	ClientOCSPFailClosed                              bool
 	AddDefaultClientCertificateRule                   bool
 	ClientCertConstraints                             ClientCertConstraints
 	SigningKey                                        []byte
@@ -58,6 +60,21 @@ func WithClientCRL(clientCRL []byte) Option {
 	}
 }
 
+// WithClientOCSPCheck sets whether to check client certificates with OCSP.
+func WithClientOCSPCheck(clientOCSPCheck bool) Option {
+	return func(cfg *evaluatorConfig) {
+		cfg.ClientOCSPCheck = clientOCSPCheck
+	}
+}
+
+// WithClientOCSPFailClosed sets whether OCSP responder errors are treated as
+// revocation.
+func WithClientOCSPFailClosed(clientOCSPFailClosed bool) Option {
+	return func(cfg *evaluatorConfig) {
+		cfg.ClientOCSPFailClosed = clientOCSPFailClosed
+	}
+}
+
 // WithAddDefaultClientCertificateRule sets whether to add a default
 // invalid_client_certificate deny rule to all policies.
 func WithAddDefaultClientCertificateRule(addDefaultClientCertificateRule bool) Option {
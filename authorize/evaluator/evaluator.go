@@ -145,12 +145,13 @@ type RequestSession struct {
 
 // Result is the result of evaluation.
 type Result struct {
-	Allow               RuleResult
-	Deny                RuleResult
-	Headers             http.Header
-	HeadersToRemove     []string
-	Traces              []contextutil.PolicyEvaluationTrace
-	AdditionalLogFields map[log.AuthorizeLogField]any
+	Allow                RuleResult
+	Deny                 RuleResult
+	Headers              http.Header
+	HeadersToRemove      []string
+	ResponseHeaderClaims map[string]string
+	Traces               []contextutil.PolicyEvaluationTrace
+	AdditionalLogFields  map[log.AuthorizeLogField]any
 }
 
 func (r *Result) HasReason(reason criteria.Reason) bool {
@@ -167,6 +168,8 @@ type Evaluator struct {
 	headersEvaluators     *HeadersEvaluator
 	clientCA              []byte
 	clientCRL             []byte
+	clientOCSPCheck       bool
+	clientOCSPFailClosed  bool
 	clientCertConstraints ClientCertConstraints
 
 	cfgCacheKey uint64
@@ -200,6 +203,8 @@ func New(
 		store:                 store,
 		clientCA:              cfg.ClientCA,
 		clientCRL:             cfg.ClientCRL,
+		clientOCSPCheck:       cfg.ClientOCSPCheck,
+		clientOCSPFailClosed:  cfg.ClientOCSPFailClosed,
 		clientCertConstraints: cfg.ClientCertConstraints,
 		cfgCacheKey:           cfg.cacheKey(),
 	}
@@ -321,12 +326,13 @@ func (e *Evaluator) Evaluate(ctx context.Context, req *Request) (*Result, error)
 	e.evaluationDuration.Record(ctx, time.Since(start).Milliseconds())
 
 	res := &Result{
-		Allow:               policyOutput.Allow,
-		Deny:                policyOutput.Deny,
-		Headers:             headersOutput.Headers,
-		HeadersToRemove:     headersOutput.HeadersToRemove,
-		Traces:              policyOutput.Traces,
-		AdditionalLogFields: headersOutput.AdditionalLogFields,
+		Allow:                policyOutput.Allow,
+		Deny:                 policyOutput.Deny,
+		Headers:              headersOutput.Headers,
+		HeadersToRemove:      headersOutput.HeadersToRemove,
+		ResponseHeaderClaims: headersOutput.ResponseHeaderClaims,
+		Traces:               policyOutput.Traces,
+		AdditionalLogFields:  headersOutput.AdditionalLogFields,
 	}
 	return res, nil
 }
@@ -338,6 +344,7 @@ var internalPathsNeedingLogin = set.From([]string{
 	endpoints.PathPomeriumWebAuthn,
 	endpoints.PathPomeriumRoutes,
 	endpoints.PathPomeriumAPIRoutes,
+	endpoints.PathPomeriumAPIToken,
 	endpoints.PathPomeriumMCPAuthorize,
 	endpoints.PathPomeriumMCPConnect,
 	endpoints.PathPomeriumMCPRoutes,
@@ -381,12 +388,25 @@ func (e *Evaluator) evaluatePolicy(ctx context.Context, req *Request) (*PolicyRe
 		return nil, err
 	}
 
+	clientCRL, err := e.getClientCRL(req.Policy)
+	if err != nil {
+		return nil, err
+	}
+
 	isValidClientCertificate, err := isValidClientCertificate(
-		clientCA, string(e.clientCRL), req.HTTP.ClientCertificate, e.clientCertConstraints)
+		clientCA, clientCRL, req.HTTP.ClientCertificate, e.clientCertConstraints)
 	if err != nil {
 		return nil, fmt.Errorf("authorize: error validating client certificate: %w", err)
 	}
 
+	if isValidClientCertificate && e.getClientOCSPCheck(req.Policy) {
+		revoked, err := isClientCertificateRevokedOCSP(ctx, clientCA, req.HTTP.ClientCertificate, e.clientOCSPFailClosed)
+		if err != nil {
+			return nil, fmt.Errorf("authorize: error checking client certificate revocation: %w", err)
+		}
+		isValidClientCertificate = !revoked
+	}
+
 	return policyEvaluator.Evaluate(ctx, &PolicyRequest{
 		HTTP:                     req.HTTP,
 		SSH:                      req.SSH,
@@ -419,6 +439,31 @@ func (e *Evaluator) getClientCA(policy *config.Policy) (string, error) {
 	return string(e.clientCA), nil
 }
 
+// getClientCRL returns the global client CRLs along with any CRLs for the
+// policy.
+func (e *Evaluator) getClientCRL(policy *config.Policy) (string, error) {
+	if policy == nil || policy.TLSDownstreamClientCRL == "" {
+		return string(e.clientCRL), nil
+	}
+
+	bs, err := base64.StdEncoding.DecodeString(policy.TLSDownstreamClientCRL)
+	if err != nil {
+		return "", err
+	}
+	crl := string(e.clientCRL)
+	if crl != "" && !strings.HasSuffix(crl, "\n") {
+		crl += "\n"
+	}
+	return crl + string(bs), nil
+}
+
+func (e *Evaluator) getClientOCSPCheck(policy *config.Policy) bool {
+	if policy != nil && policy.TLSDownstreamClientOCSPCheck != nil {
+		return *policy.TLSDownstreamClientOCSPCheck
+	}
+	return e.clientOCSPCheck
+}
+
 func updateStore(store *store.Store, cfg *evaluatorConfig) error {
 	jwk, err := getJWK(cfg)
 	if err != nil {
@@ -16,9 +16,12 @@ import (
 
 // HeadersResponse is the output from the headers.rego script.
 type HeadersResponse struct {
-	Headers             http.Header
-	HeadersToRemove     []string
-	AdditionalLogFields map[log.AuthorizeLogField]any
+	Headers         http.Header
+	HeadersToRemove []string
+	// ResponseHeaderClaims are the claims referenced by the route's response
+	// headers, keyed by claim name.
+	ResponseHeaderClaims map[string]string
+	AdditionalLogFields  map[log.AuthorizeLogField]any
 }
 
 // A HeadersEvaluator evaluates the headers.rego script.
@@ -93,6 +93,37 @@ func (e *headersEvaluatorEvaluation) fillJWTClaimHeaders(ctx context.Context) er
 	return nil
 }
 
+// fillResponseHeaderClaims looks up the claims referenced by the route's
+// response headers. Envoy reads them from the ext_authz dynamic metadata.
+func (e *headersEvaluatorEvaluation) fillResponseHeaderClaims(ctx context.Context) error {
+	names := e.request.Policy.GetResponseHeaderClaims()
+	if len(names) == 0 {
+		return nil
+	}
+
+	claims, err := e.getJWTPayload(ctx)
+	if err != nil {
+		return err
+	}
+
+	s, _ := e.getSessionOrServiceAccount(ctx)
+	u := e.getUser(ctx)
+
+	e.response.ResponseHeaderClaims = make(map[string]string, len(names))
+	for _, name := range names {
+		if claim, ok := claims[name]; ok {
+			e.response.ResponseHeaderClaims[name] = getHeaderStringValue(claim)
+		} else if vs, ok := getClaimStringSlice(s, name); ok {
+			e.response.ResponseHeaderClaims[name] = strings.Join(vs, ",")
+		} else if vs, ok := getClaimStringSlice(u, name); ok {
+			e.response.ResponseHeaderClaims[name] = strings.Join(vs, ",")
+		} else {
+			e.response.ResponseHeaderClaims[name] = ""
+		}
+	}
+	return nil
+}
+
 func (e *headersEvaluatorEvaluation) fillMCPHeaders(ctx context.Context) (err error) {
 	if e.request == nil || e.request.Policy == nil || e.request.Policy.MCP == nil || e.request.Session.ID == "" {
 		return nil
@@ -179,6 +210,21 @@ func (e *headersEvaluatorEvaluation) fillRoutingKeyHeaders() {
 	}
 }
 
+// fillRateLimitKeyHeader sets the key envoy uses to rate limit requests to the
+// route. Authenticated requests are limited per user, other requests per
+// client IP address.
+func (e *headersEvaluatorEvaluation) fillRateLimitKeyHeader(ctx context.Context) {
+	if e.request.Policy == nil || e.request.Policy.RateLimit == nil {
+		return
+	}
+
+	if userID := e.getJWTPayloadUser(ctx); userID != "" {
+		e.response.Headers.Set(httputil.HeaderPomeriumRateLimitKey, "user:"+userID)
+	} else {
+		e.response.Headers.Set(httputil.HeaderPomeriumRateLimitKey, "ip:"+e.request.HTTP.IP)
+	}
+}
+
 func (e *headersEvaluatorEvaluation) fillSetRequestHeaders(ctx context.Context) {
 	if e.request.Policy == nil {
 		return
@@ -211,6 +257,9 @@ func (e *headersEvaluatorEvaluation) fillHeaders(ctx context.Context) error {
 	if err := e.fillJWTClaimHeaders(ctx); err != nil {
 		return err
 	}
+	if err := e.fillResponseHeaderClaims(ctx); err != nil {
+		return err
+	}
 	err := e.fillMCPHeaders(ctx)
 	if err != nil {
 		return err
@@ -218,6 +267,7 @@ func (e *headersEvaluatorEvaluation) fillHeaders(ctx context.Context) error {
 	e.fillKubernetesHeaders(ctx)
 	e.fillGoogleCloudServerlessHeaders(ctx)
 	e.fillRoutingKeyHeaders()
+	e.fillRateLimitKeyHeader(ctx)
 	e.fillSetRequestHeaders(ctx)
 	return nil
 }
@@ -480,9 +530,50 @@ func (e *headersEvaluatorEvaluation) getJWTPayload(ctx context.Context) (map[str
 			e.cachedJWTPayload[claimKey] = strings.Join(vs, ",")
 		}
 	}
+
+	if e.request.Policy != nil {
+		for claimKey, source := range e.request.Policy.JWTCustomClaims {
+			recordType, sourceClaimKey, err := config.ParseJWTCustomClaimSource(source)
+			if err != nil {
+				return nil, err
+			}
+
+			var msg hasGetClaims
+			switch recordType {
+			case config.JWTCustomClaimSourceSession:
+				msg = s
+			case config.JWTCustomClaimSourceUser:
+				msg = u
+			}
+			if vs, ok := getClaimStringSlice(msg, sourceClaimKey); ok {
+				e.cachedJWTPayload[claimKey] = strings.Join(vs, ",")
+			}
+		}
+	}
 	return e.cachedJWTPayload, nil
 }
 
+// getSignedJWTPayload returns the JWT payload limited to the claims allowed by
+// the route.
+func (e *headersEvaluatorEvaluation) getSignedJWTPayload(ctx context.Context) (map[string]any, error) {
+	jwtPayload, err := e.getJWTPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.request.Policy == nil || len(e.request.Policy.JWTClaimsAllowlist) == 0 {
+		return jwtPayload, nil
+	}
+
+	filtered := make(map[string]any, len(jwtPayload))
+	for claimKey, claim := range jwtPayload {
+		if e.request.Policy.IsJWTClaimAllowed(claimKey) {
+			filtered[claimKey] = claim
+		}
+	}
+	return filtered, nil
+}
+
 func (e *headersEvaluatorEvaluation) getSignedJWT(ctx context.Context) string {
 	if e.gotSignedJWT {
 		return e.cachedSignedJWT
@@ -509,7 +600,7 @@ func (e *headersEvaluatorEvaluation) getSignedJWT(ctx context.Context) string {
 		return ""
 	}
 
-	jwtPayload, err := e.getJWTPayload(ctx)
+	jwtPayload, err := e.getSignedJWTPayload(ctx)
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("authorize/header-evaluator: error creating JWT payload")
 		return ""
@@ -6,8 +6,10 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"math"
 	"net/http"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -205,6 +207,52 @@ func TestHeadersEvaluator(t *testing.T) {
 		assert.Equal(t, []any{}, decoded["groups"])
 	})
 
+	t.Run("jwt claims per route", func(t *testing.T) {
+		t.Parallel()
+
+		output, err := eval(t,
+			[]protoreflect.ProtoMessage{
+				&session.Session{Id: "s1", UserId: "u1", Claims: map[string]*structpb.ListValue{
+					"name": {Values: []*structpb.Value{
+						structpb.NewStringValue("User Name"),
+					}},
+					"amr": {Values: []*structpb.Value{
+						structpb.NewStringValue("pwd"),
+						structpb.NewStringValue("mfa"),
+					}},
+				}},
+				&user.User{Id: "u1", Email: "user@example.com", Claims: map[string]*structpb.ListValue{
+					"department": {Values: []*structpb.Value{
+						structpb.NewStringValue("engineering"),
+					}},
+				}},
+			},
+			&Request{
+				HTTP: RequestHTTP{
+					Hostname: "from.example.com",
+				},
+				Policy: &config.Policy{
+					JWTClaimsAllowlist: []string{"email"},
+					JWTCustomClaims: map[string]string{
+						"dept": "user.department",
+						"amr":  "session.amr",
+					},
+				},
+				Session: RequestSession{ID: "s1"},
+			})
+		require.NoError(t, err)
+
+		claims := decodeJWTAssertion(t, output.Headers)
+		assert.ElementsMatch(t, []string{"iss", "aud", "jti", "iat", "exp", "sub", "email", "dept", "amr"},
+			slices.Collect(maps.Keys(claims)))
+		assert.Equal(t, "user@example.com", claims["email"])
+		assert.Equal(t, "engineering", claims["dept"])
+		assert.Equal(t, "pwd,mfa", claims["amr"])
+
+		// claim headers are not affected by the allowlist
+		assert.Equal(t, "User Name", output.Headers.Get("X-Pomerium-Claim-Name"))
+	})
+
 	t.Run("set_request_headers", func(t *testing.T) {
 		output, err := eval(t,
 			[]proto.Message{
@@ -370,6 +418,40 @@ func TestHeadersEvaluator(t *testing.T) {
 		assert.Equal(t, "e8bc163c82eee18733288c7d4ac636db3a6deb013ef2d37b68322be20edc45cc", output.Headers.Get("X-Pomerium-Routing-Key"))
 	})
 
+	t.Run("rate limit key", func(t *testing.T) {
+		t.Parallel()
+
+		output, err := eval(t,
+			[]protoreflect.ProtoMessage{
+				&session.Session{Id: "s1", UserId: "u1"},
+			},
+			&Request{
+				Session: RequestSession{ID: "s1"},
+			})
+		require.NoError(t, err)
+		assert.Empty(t, output.Headers.Get("X-Pomerium-Rate-Limit-Key"))
+
+		output, err = eval(t,
+			[]protoreflect.ProtoMessage{
+				&session.Session{Id: "s1", UserId: "u1"},
+			},
+			&Request{
+				Policy:  &config.Policy{RateLimit: &config.RateLimit{RequestsPerSecond: 10}},
+				Session: RequestSession{ID: "s1"},
+			})
+		require.NoError(t, err)
+		assert.Equal(t, "user:u1", output.Headers.Get("X-Pomerium-Rate-Limit-Key"))
+
+		output, err = eval(t,
+			[]protoreflect.ProtoMessage{},
+			&Request{
+				Policy: &config.Policy{RateLimit: &config.RateLimit{RequestsPerSecond: 10}},
+				HTTP:   RequestHTTP{IP: "192.0.2.1"},
+			})
+		require.NoError(t, err)
+		assert.Equal(t, "ip:192.0.2.1", output.Headers.Get("X-Pomerium-Rate-Limit-Key"))
+	})
+
 	t.Run("jwt payload email", func(t *testing.T) {
 		t.Parallel()
 
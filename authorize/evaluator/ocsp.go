@@ -0,0 +1,136 @@
+package evaluator
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+const (
+	ocspRequestTimeout  = 5 * time.Second
+	ocspMaxResponseSize = 1 << 20
+	// responses are cached for at most ocspMaxCacheDuration, so that
+	// revocations are noticed promptly
+	ocspMaxCacheDuration = 5 * time.Minute
+	// responder errors are cached for ocspErrorCacheDuration, to avoid
+	// delaying every request while a responder is unavailable
+	ocspErrorCacheDuration = time.Minute
+)
+
+type ocspCacheEntry struct {
+	revoked bool
+	// failed is set when the responder couldn't be queried
+	failed  bool
+	expires time.Time
+}
+
+var (
+	ocspCache, _ = lru.New[string, ocspCacheEntry](1000)
+	ocspClient   = &http.Client{Timeout: ocspRequestTimeout}
+)
+
+// isClientCertificateRevokedOCSP checks the revocation status of a client
+// certificate with the OCSP responder listed in the certificate. The
+// certificate must already have been verified. Responder errors are logged
+// and only treated as revocation when failClosed is set.
+func isClientCertificateRevokedOCSP(ctx context.Context, ca string, certInfo ClientCertificateInfo, failClosed bool) (bool, error) {
+	if ca == "" || certInfo.Leaf == "" {
+		return false, nil
+	}
+
+	cert, err := parseCertificate(certInfo.Leaf)
+	if err != nil {
+		return false, err
+	}
+	if len(cert.OCSPServer) == 0 {
+		return false, nil
+	}
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM([]byte(ca))
+	intermediates := x509.NewCertPool()
+	intermediates.AppendCertsFromPEM([]byte(certInfo.Intermediates))
+
+	// see verifyClientCertificate
+	cert.UnhandledCriticalExtensions = slices.DeleteFunc(cert.UnhandledCriticalExtensions,
+		func(oid asn1.ObjectIdentifier) bool { return oid.Equal(oidSubjectAltName) })
+	chains, err := cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil || len(chains[0]) < 2 {
+		return false, nil
+	}
+	issuer := chains[0][1]
+
+	cacheKey := string(issuer.RawSubjectPublicKeyInfo) + "|" + cert.SerialNumber.String()
+	entry, ok := ocspCache.Get(cacheKey)
+	if !ok || !time.Now().Before(entry.expires) {
+		entry, err = queryOCSP(ctx, cert, issuer)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("responder", cert.OCSPServer[0]).Bool("fail-closed", failClosed).
+				Msg("authorize: failed to check client certificate revocation status")
+			entry = ocspCacheEntry{failed: true, expires: time.Now().Add(ocspErrorCacheDuration)}
+		}
+		ocspCache.Add(cacheKey, entry)
+	}
+
+	return entry.revoked || (entry.failed && failClosed), nil
+}
+
+func queryOCSP(ctx context.Context, cert, issuer *x509.Certificate) (ocspCacheEntry, error) {
+	reqData, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return ocspCacheEntry{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ocspRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cert.OCSPServer[0], bytes.NewReader(reqData))
+	if err != nil {
+		return ocspCacheEntry{}, err
+	}
+	req.Header.Set("Content-Type", "application/ocsp-request")
+
+	res, err := ocspClient.Do(req)
+	if err != nil {
+		return ocspCacheEntry{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return ocspCacheEntry{}, fmt.Errorf("unexpected status code from ocsp responder: %d", res.StatusCode)
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(res.Body, ocspMaxResponseSize))
+	if err != nil {
+		return ocspCacheEntry{}, err
+	}
+
+	resp, err := ocsp.ParseResponseForCert(raw, cert, issuer)
+	if err != nil {
+		return ocspCacheEntry{}, fmt.Errorf("invalid ocsp response: %w", err)
+	}
+
+	entry := ocspCacheEntry{
+		revoked: resp.Status == ocsp.Revoked,
+		expires: time.Now().Add(ocspMaxCacheDuration),
+	}
+	if !resp.NextUpdate.IsZero() && resp.NextUpdate.Before(entry.expires) {
+		entry.expires = resp.NextUpdate
+	}
+	return entry, nil
+}
@@ -0,0 +1,131 @@
+package evaluator
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+)
+
+func Test_isClientCertificateRevokedOCSP(t *testing.T) {
+	t.Parallel()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "OCSP Test CA"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}))
+
+	revokedSerial := big.NewInt(100)
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path == "/unavailable" {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		reqData, _ := io.ReadAll(r.Body)
+		ocspReq, err := ocsp.ParseRequest(reqData)
+		if !assert.NoError(t, err) {
+			return
+		}
+		status := ocsp.Good
+		if ocspReq.SerialNumber.Cmp(revokedSerial) == 0 {
+			status = ocsp.Revoked
+		}
+		data, err := ocsp.CreateResponse(caCert, caCert, ocsp.Response{
+			Status:       status,
+			SerialNumber: ocspReq.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+			RevokedAt:    time.Now().Add(-time.Minute),
+		}, caKey)
+		if !assert.NoError(t, err) {
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(srv.Close)
+
+	newClientCert := func(serial int64, ocspServer string) ClientCertificateInfo {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		tpl := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "client"},
+			NotBefore:    time.Now().Add(-time.Minute),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		if ocspServer != "" {
+			tpl.OCSPServer = []string{ocspServer}
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tpl, caCert, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		return ClientCertificateInfo{
+			Leaf: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		}
+	}
+
+	revoked, err := isClientCertificateRevokedOCSP(t.Context(), caPEM, newClientCert(101, srv.URL), false)
+	assert.NoError(t, err)
+	assert.False(t, revoked, "good certificate")
+
+	revoked, err = isClientCertificateRevokedOCSP(t.Context(), caPEM, newClientCert(100, srv.URL), false)
+	assert.NoError(t, err)
+	assert.True(t, revoked, "revoked certificate")
+
+	before := requests.Load()
+	revoked, err = isClientCertificateRevokedOCSP(t.Context(), caPEM, newClientCert(100, srv.URL), false)
+	assert.NoError(t, err)
+	assert.True(t, revoked, "cached revoked certificate")
+	assert.Equal(t, before, requests.Load(), "should use the cached response")
+
+	revoked, err = isClientCertificateRevokedOCSP(t.Context(), caPEM, newClientCert(102, ""), false)
+	assert.NoError(t, err)
+	assert.False(t, revoked, "no ocsp responder")
+
+	revoked, err = isClientCertificateRevokedOCSP(t.Context(), caPEM, newClientCert(103, srv.URL+"/unavailable"), false)
+	assert.NoError(t, err)
+	assert.False(t, revoked, "responder errors are not revocation")
+
+	before = requests.Load()
+	revoked, err = isClientCertificateRevokedOCSP(t.Context(), caPEM, newClientCert(104, srv.URL+"/unavailable"), true)
+	assert.NoError(t, err)
+	assert.True(t, revoked, "responder errors are revocation when failing closed")
+	assert.Equal(t, before+1, requests.Load())
+	revoked, err = isClientCertificateRevokedOCSP(t.Context(), caPEM, newClientCert(104, srv.URL+"/unavailable"), true)
+	assert.NoError(t, err)
+	assert.True(t, revoked, "cached responder errors are revocation when failing closed")
+	assert.Equal(t, before+1, requests.Load(), "should use the cached error")
+
+	revoked, err = isClientCertificateRevokedOCSP(t.Context(), "", newClientCert(100, srv.URL), false)
+	assert.NoError(t, err)
+	assert.False(t, revoked, "no client CA")
+}
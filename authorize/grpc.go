@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	"go.opentelemetry.io/otel/attribute"
@@ -72,6 +73,7 @@ func (a *Authorize) Check(ctx context.Context, in *envoy_service_auth_v3.CheckRe
 		req.Session.ID = s.GetId()
 		u, _ = a.getDataBrokerUser(ctx, s.GetUserId()) // ignore any missing user error
 	}
+	ctx = withErrorPages(ctx, req.Policy, u)
 
 	// For MCP routes that only require authentication (not full authorization),
 	// if we have a valid session, allow the request without running policy evaluation
@@ -94,6 +96,14 @@ func (a *Authorize) Check(ctx context.Context, in *envoy_service_auth_v3.CheckRe
 		return nil, err
 	}
 
+	// if the user is allowed, but signed in with a lower assurance level than
+	// the route requires, ask the user to sign in again
+	if res.Allow.Value && !res.Deny.Value && !isAssuranceLevelSatisfied(req.Policy, s) {
+		resp, err := a.requireLoginResponse(ctx, in, req)
+		a.logAuthorizeCheck(ctx, req, res, s, u)
+		return resp, err
+	}
+
 	// if show error details is enabled, attach the policy evaluation traces
 	if req.Policy != nil && req.Policy.ShowErrorDetails {
 		ctx = contextutil.WithPolicyEvaluationTraces(ctx, res.Traces)
@@ -103,6 +113,7 @@ func (a *Authorize) Check(ctx context.Context, in *envoy_service_auth_v3.CheckRe
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Str("request-id", requestID).Msg("grpc check ext_authz_error")
 	}
+	setUpgradedConnectionDeadline(resp, hreq, s, a.currentConfig.Load().Options.UpgradedConnectionDrainWindow, time.Now())
 	a.logAuthorizeCheck(ctx, req, res, s, u)
 	return resp, err
 }
@@ -130,6 +141,15 @@ func (a *Authorize) loadSession(
 		return nil, nil
 	}
 
+	var routeID string
+	if req.Policy != nil {
+		routeID, _ = req.Policy.RouteID()
+	}
+	if err := h.ValidateForRoute(routeID); err != nil {
+		log.Ctx(ctx).Info().Err(err).Str("request-id", requestID).Msg("ignoring session handle which is not valid for this route")
+		return nil, nil
+	}
+
 	s, err = a.getDataBrokerSessionOrServiceAccount(ctx, h.ID, h.DatabrokerRecordVersion)
 	if status.Code(err) == codes.Unavailable {
 		log.Ctx(ctx).Debug().Str("request-id", requestID).Err(err).Msg("temporary error checking authorization: data broker unavailable")
@@ -244,7 +264,7 @@ func (a *Authorize) getMatchingPolicy(routeID string) *config.Policy {
 
 func (a *Authorize) withQuerierForCheckRequest(ctx context.Context) context.Context {
 	state := a.state.Load()
-	q := storage.NewQuerier(state.dataBrokerClient)
+	q := state.dataBrokerQuerier
 	// if sync queriers are enabled, use those
 	if len(state.syncQueriers) > 0 {
 		m := map[string]storage.Querier{}
@@ -4,7 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"path/filepath"
+	"sync"
+	"time"
 
+	"github.com/cockroachdb/pebble/v2"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	oteltrace "go.opentelemetry.io/otel/trace"
 	googlegrpc "google.golang.org/grpc"
@@ -14,12 +18,16 @@ import (
 	"github.com/pomerium/pomerium/authorize/internal/store"
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/authenticateflow"
+	"github.com/pomerium/pomerium/internal/fileutil"
+	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/mcp"
+	"github.com/pomerium/pomerium/internal/sessions/serverside"
 	"github.com/pomerium/pomerium/pkg/grpc"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/grpc/user"
 	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/pebbleutil"
 	"github.com/pomerium/pomerium/pkg/storage"
 )
 
@@ -27,11 +35,31 @@ type authenticateFlow interface {
 	AuthenticateSignInURL(ctx context.Context, queryParams url.Values, redirectURL *url.URL, idpID string, additionalLoginHosts []string) (string, error)
 }
 
+// dataBrokerNotFoundTTL is how long queries which return no records are
+// cached, to avoid repeatedly querying the databroker for a missing session.
+const dataBrokerNotFoundTTL = 5 * time.Second
+
+// getSyncQuerierCacheDB opens the database used to persist synced records
+// across restarts. It's shared by every sync querier in the process.
+var getSyncQuerierCacheDB = sync.OnceValues(func() (*pebble.DB, error) {
+	return pebbleutil.Open(filepath.Join(fileutil.CacheDir(), "authorize", "sync"), nil)
+})
+
+func newSyncQuerier(ctx context.Context, client databroker.DataBrokerServiceClient, recordType string) storage.Querier {
+	db, err := getSyncQuerierCacheDB()
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("authorize: error opening sync querier cache, records will not be cached")
+		return storage.NewSyncQuerier(client, recordType)
+	}
+	return storage.NewSyncQuerier(client, recordType, storage.WithSyncQuerierCache(db, []byte(recordType+"/")))
+}
+
 type authorizeState struct {
 	sharedKey                  []byte
 	evaluator                  *evaluator.Evaluator
 	dataBrokerClientConnection *googlegrpc.ClientConn
 	dataBrokerClient           databroker.DataBrokerServiceClient
+	dataBrokerQuerier          storage.Querier
 	sessionStore               *config.SessionStore
 	idpTokenSessionCreator     config.IncomingIDPTokenSessionCreator
 	authenticateFlow           authenticateFlow
@@ -69,6 +97,15 @@ func newAuthorizeStateFromConfig(
 		evaluatorOptions = append(evaluatorOptions, evaluator.WithMCPAccessTokenProvider(mcp))
 	}
 
+	if len(cfg.DownstreamMTLSCRLs) > 0 {
+		// include the CRLs downloaded from crl_urls
+		clientCRL, err := cfg.GetDownstreamMTLSCRL()
+		if err != nil {
+			return nil, fmt.Errorf("authorize: invalid client CRL: %w", err)
+		}
+		evaluatorOptions = append(evaluatorOptions, evaluator.WithClientCRL(clientCRL))
+	}
+
 	state.evaluator, err = newPolicyEvaluator(ctx, cfg.Options, store, previousEvaluator, evaluatorOptions...)
 	if err != nil {
 		return nil, fmt.Errorf("authorize: failed to update policy with options: %w", err)
@@ -95,11 +132,8 @@ func newAuthorizeStateFromConfig(
 	}
 	state.dataBrokerClientConnection = cc
 	state.dataBrokerClient = databroker.NewDataBrokerServiceClient(cc)
+	state.dataBrokerQuerier = storage.NewNegativeCachingQuerier(storage.NewQuerier(state.dataBrokerClient), dataBrokerNotFoundTTL)
 
-	state.sessionStore, err = config.NewSessionStore(cfg.Options)
-	if err != nil {
-		return nil, fmt.Errorf("authorize: invalid session store: %w", err)
-	}
 	state.idpTokenSessionCreator = config.NewIncomingIDPTokenSessionCreator(
 		tracerProvider,
 		func(ctx context.Context, recordType, recordID string) (*databroker.Record, error) {
@@ -118,7 +152,7 @@ func newAuthorizeStateFromConfig(
 	)
 
 	if cfg.Options.UseStatelessAuthenticateFlow() {
-		state.authenticateFlow, err = authenticateflow.NewStateless(ctx, tracerProvider, cfg, nil, nil, nil, nil, outboundGrpcConn)
+		state.authenticateFlow, err = authenticateflow.NewStateless(ctx, tracerProvider, cfg, nil, nil, nil, nil, nil, outboundGrpcConn)
 	} else {
 		state.authenticateFlow, err = authenticateflow.NewStateful(ctx, tracerProvider, cfg, nil, outboundGrpcConn)
 	}
@@ -145,10 +179,21 @@ func newAuthorizeStateFromConfig(
 			directory.UserRecordType,
 		} {
 			if _, ok := state.syncQueriers[recordType]; !ok {
-				state.syncQueriers[recordType] = storage.NewSyncQuerier(state.dataBrokerClient, recordType)
+				state.syncQueriers[recordType] = newSyncQuerier(ctx, state.dataBrokerClient, recordType)
 			}
 		}
 	}
+	if cfg.Options.UseServerSideSessions() {
+		if _, ok := state.syncQueriers[serverside.RecordType]; !ok {
+			state.syncQueriers[serverside.RecordType] = newSyncQuerier(ctx, state.dataBrokerClient, serverside.RecordType)
+		}
+	}
+
+	state.sessionStore, err = config.NewSessionStore(cfg.Options,
+		config.WithSessionStoreDataBroker(state.dataBrokerClient, state.syncQueriers[serverside.RecordType]))
+	if err != nil {
+		return nil, fmt.Errorf("authorize: invalid session store: %w", err)
+	}
 
 	return state, nil
 }
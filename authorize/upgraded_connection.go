@@ -0,0 +1,45 @@
+package authorize
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+)
+
+// setUpgradedConnectionDeadline limits how long an upgraded connection, like a
+// WebSocket, may outlive its session. The client is told when the session
+// expires, and envoy ends the stream once the drain window after the expiry
+// has passed.
+func setUpgradedConnectionDeadline(
+	res *envoy_service_auth_v3.CheckResponse,
+	hreq *http.Request,
+	s sessionOrServiceAccount,
+	drainWindow *time.Duration,
+	now time.Time,
+) {
+	ok := res.GetOkResponse()
+	if ok == nil || drainWindow == nil || !isUpgradeRequest(hreq) {
+		return
+	}
+
+	expiring, isExpiring := s.(interface{ GetExpiresAt() *timestamppb.Timestamp })
+	if !isExpiring || expiring.GetExpiresAt() == nil {
+		return
+	}
+	expiresAt := expiring.GetExpiresAt().AsTime()
+
+	duration := max(expiresAt.Add(*drainWindow).Sub(now), time.Millisecond)
+	ok.Headers = append(ok.Headers,
+		mkHeader(httputil.HeaderEnvoyUpstreamStreamDurationMS, strconv.FormatInt(duration.Milliseconds(), 10)))
+	ok.ResponseHeadersToAdd = append(ok.ResponseHeadersToAdd,
+		mkHeader(httputil.HeaderPomeriumSessionExpiresAt, expiresAt.UTC().Format(time.RFC3339)))
+}
+
+func isUpgradeRequest(hreq *http.Request) bool {
+	return hreq.Method == http.MethodConnect || hreq.Header.Get("Upgrade") != ""
+}
@@ -0,0 +1,62 @@
+package authorize
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestSetUpgradedConnectionDeadline(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := &session.Session{ExpiresAt: timestamppb.New(now.Add(time.Hour))}
+	drainWindow := time.Minute
+	websocket := &http.Request{Method: http.MethodGet, Header: http.Header{"Upgrade": {"websocket"}}}
+
+	t.Run("websocket", func(t *testing.T) {
+		t.Parallel()
+
+		res := (&Authorize{}).okResponse(nil, nil)
+		setUpgradedConnectionDeadline(res, websocket, s, &drainWindow, now)
+		testutil.AssertProtoJSONEqual(t, `{
+			"headers": [{
+				"appendAction": "OVERWRITE_IF_EXISTS_OR_ADD",
+				"header": { "key": "x-envoy-upstream-stream-duration-ms", "value": "3660000" }
+			}],
+			"responseHeadersToAdd": [{
+				"appendAction": "OVERWRITE_IF_EXISTS_OR_ADD",
+				"header": { "key": "x-pomerium-session-expires-at", "value": "2025-01-01T13:00:00Z" }
+			}]
+		}`, res.GetOkResponse())
+	})
+	t.Run("no drain window", func(t *testing.T) {
+		t.Parallel()
+
+		res := (&Authorize{}).okResponse(nil, nil)
+		setUpgradedConnectionDeadline(res, websocket, s, nil, now)
+		assert.Empty(t, res.GetOkResponse().GetHeaders())
+		assert.Empty(t, res.GetOkResponse().GetResponseHeadersToAdd())
+	})
+	t.Run("not upgraded", func(t *testing.T) {
+		t.Parallel()
+
+		res := (&Authorize{}).okResponse(nil, nil)
+		setUpgradedConnectionDeadline(res, &http.Request{Method: http.MethodGet, Header: http.Header{}}, s, &drainWindow, now)
+		assert.Empty(t, res.GetOkResponse().GetHeaders())
+		assert.Empty(t, res.GetOkResponse().GetResponseHeadersToAdd())
+	})
+	t.Run("no session", func(t *testing.T) {
+		t.Parallel()
+
+		res := (&Authorize{}).okResponse(nil, nil)
+		setUpgradedConnectionDeadline(res, websocket, nil, &drainWindow, now)
+		assert.Empty(t, res.GetOkResponse().GetHeaders())
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/pomerium/pomerium/pkg/contextutil"
 	"github.com/pomerium/pomerium/pkg/envoy/files"
 	"github.com/pomerium/pomerium/pkg/health"
+	"github.com/pomerium/pomerium/pkg/storage/file"
 	"github.com/pomerium/pomerium/pkg/telemetry/trace"
 )
 
@@ -33,6 +34,7 @@ func main() {
 	}
 	root.AddCommand(zero_cmd.BuildRootCmd())
 	root.AddCommand(health.BuildHealthCommand())
+	root.AddCommand(file.BuildDatabrokerCommand())
 	root.PersistentFlags().StringVar(&configFile, "config", "", "Specify configuration file location")
 	log.SetLevel(zerolog.InfoLevel)
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 )
 
@@ -46,7 +47,9 @@ type AutocertOptions struct {
 	MustStaple bool `mapstructure:"autocert_must_staple" yaml:"autocert_must_staple,omitempty"`
 
 	// Folder specifies the location to store, and load autocert managed
-	// TLS certificates.
+	// TLS certificates. Besides a local directory, s3://, gs:// and
+	// databroker:// locations are supported. The databroker location shares
+	// certificates between replicas without a shared file system.
 	// defaults to $XDG_DATA_HOME/pomerium
 	Folder string `mapstructure:"autocert_dir" yaml:"autocert_dir,omitempty"`
 
@@ -55,8 +58,55 @@ type AutocertOptions struct {
 
 	// TrustedCAFile points to a file that contains the certificate (bundle) to trust when communicating with an ACME CA.
 	TrustedCAFile string `mapstructure:"autocert_trusted_ca_file" yaml:"autocert_trusted_ca_file,omitempty"`
+
+	// TrustedCAOnly restricts the roots trusted when communicating with an ACME CA to the
+	// certificate(s) in TrustedCA or TrustedCAFile, instead of appending them to the system roots.
+	// This is useful in air-gapped environments that run an internal ACME server.
+	TrustedCAOnly bool `mapstructure:"autocert_trusted_ca_only" yaml:"autocert_trusted_ca_only,omitempty"`
+
+	// ProxyURL is the URL of a proxy to use when communicating with an ACME CA or a DNS provider.
+	// If not set, the proxy is taken from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+	ProxyURL string `mapstructure:"autocert_proxy_url" yaml:"autocert_proxy_url,omitempty"`
+
+	// ChallengeTrustedCA is the base64-encoded certificate (bundle) to trust, in addition to the system
+	// roots, when communicating with the DNS provider while solving challenges. It is separate from
+	// TrustedCA, which only applies to the ACME CA.
+	ChallengeTrustedCA string `mapstructure:"autocert_challenge_trusted_ca" yaml:"autocert_challenge_trusted_ca,omitempty"`
+
+	// ChallengeTrustedCAFile points to a file that contains the certificate (bundle) to trust, in addition
+	// to the system roots, when communicating with the DNS provider while solving challenges.
+	ChallengeTrustedCAFile string `mapstructure:"autocert_challenge_trusted_ca_file" yaml:"autocert_challenge_trusted_ca_file,omitempty"`
+
+	// EventCommand is the path to a command that is executed whenever a certificate is obtained,
+	// renewed or fails to be obtained or renewed. The event is passed via environment variables.
+	EventCommand string `mapstructure:"autocert_event_command" yaml:"autocert_event_command,omitempty"`
+
+	// EventWebhookURL is a URL that certificate events are POSTed to as JSON.
+	EventWebhookURL string `mapstructure:"autocert_event_webhook_url" yaml:"autocert_event_webhook_url,omitempty"`
+
+	// EventDataBroker stores the latest certificate event for each domain as a databroker record,
+	// so that databroker syncers can react to certificate events.
+	EventDataBroker bool `mapstructure:"autocert_event_databroker" yaml:"autocert_event_databroker,omitempty"`
+
+	// DNSProvider enables the ACME DNS-01 challenge using the given DNS
+	// provider. This allows certificates to be issued for wildcard routes.
+	// One of "route53", "cloudflare" or "rfc2136".
+	DNSProvider string `mapstructure:"autocert_dns_provider" yaml:"autocert_dns_provider,omitempty"`
+
+	// DNSProviderOptions are the provider specific settings for DNSProvider:
+	//   - route53: hosted_zone_id, access_key_id, secret_access_key
+	//   - cloudflare: api_token (required), zone_id
+	//   - rfc2136: server (required), tsig_key_name, tsig_secret, tsig_algorithm
+	DNSProviderOptions map[string]string `mapstructure:"autocert_dns_provider_options" yaml:"autocert_dns_provider_options,omitempty"`
 }
 
+// DNS providers for the ACME DNS-01 challenge.
+const (
+	AutocertDNSProviderRoute53    = "route53"
+	AutocertDNSProviderCloudflare = "cloudflare"
+	AutocertDNSProviderRFC2136    = "rfc2136"
+)
+
 // Validate ensures the Options fields are valid, and hydrated.
 func (o *AutocertOptions) Validate() error {
 	// validate ACME EAB settings
@@ -84,6 +134,9 @@ func (o *AutocertOptions) Validate() error {
 			return fmt.Errorf("config: getting trusted certificate pool: %w", err)
 		}
 	}
+	if o.TrustedCAOnly && o.TrustedCA == "" && o.TrustedCAFile == "" {
+		return errors.New("config: Autocert Trusted CA or Trusted CA File required when Trusted CA Only is set")
+	}
 	if o.TrustedCAFile != "" {
 		if _, err := os.ReadFile(o.TrustedCAFile); err != nil {
 			return fmt.Errorf("config: bad trusted certificate (bundle) file: %w", err)
@@ -93,5 +146,56 @@ func (o *AutocertOptions) Validate() error {
 		}
 	}
 
+	// validate the proxy
+	if o.ProxyURL != "" {
+		u, err := urlutil.ParseAndValidateURL(o.ProxyURL)
+		if err != nil {
+			return fmt.Errorf("config: bad autocert proxy url: %w", err)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return fmt.Errorf("config: bad autocert proxy url: unsupported scheme %q", u.Scheme)
+		}
+	}
+
+	// validate x509 roots to trust for challenges
+	if o.ChallengeTrustedCA != "" && o.ChallengeTrustedCAFile != "" {
+		return errors.New("config: providing both Autocert Challenge Trusted CA and Challenge Trusted CA File is not supported")
+	}
+	if o.ChallengeTrustedCA != "" || o.ChallengeTrustedCAFile != "" {
+		if _, err := cryptutil.GetCertPool(o.ChallengeTrustedCA, o.ChallengeTrustedCAFile); err != nil {
+			return fmt.Errorf("config: getting challenge trusted certificate pool: %w", err)
+		}
+	}
+
+	// validate the dns provider
+	switch o.DNSProvider {
+	case "", AutocertDNSProviderRoute53:
+	case AutocertDNSProviderCloudflare:
+		if o.DNSProviderOptions["api_token"] == "" {
+			return errors.New("config: autocert dns provider cloudflare requires api_token")
+		}
+	case AutocertDNSProviderRFC2136:
+		if o.DNSProviderOptions["server"] == "" {
+			return errors.New("config: autocert dns provider rfc2136 requires server")
+		}
+		if (o.DNSProviderOptions["tsig_key_name"] == "") != (o.DNSProviderOptions["tsig_secret"] == "") {
+			return errors.New("config: autocert dns provider rfc2136 requires both tsig_key_name and tsig_secret")
+		}
+	default:
+		return fmt.Errorf("config: unknown autocert dns provider %q", o.DNSProvider)
+	}
+	if o.DNSProvider == "" && len(o.DNSProviderOptions) > 0 {
+		return errors.New("config: autocert dns provider options require autocert_dns_provider")
+	}
+
+	// validate event hooks
+	if o.EventWebhookURL != "" {
+		if _, err := urlutil.ParseAndValidateURL(o.EventWebhookURL); err != nil {
+			return fmt.Errorf("config: bad autocert event webhook url: %w", err)
+		}
+	}
+
 	return nil
 }
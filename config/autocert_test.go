@@ -57,6 +57,14 @@ func TestAutocertOptions_Validate(t *testing.T) {
 		Folder        string
 		TrustedCA     string
 		TrustedCAFile string
+		TrustedCAOnly bool
+
+		ProxyURL               string
+		ChallengeTrustedCA     string
+		ChallengeTrustedCAFile string
+
+		DNSProvider        string
+		DNSProviderOptions map[string]string
 	}
 	type test struct {
 		fields  fields
@@ -103,6 +111,15 @@ func TestAutocertOptions_Validate(t *testing.T) {
 				cleanup: func() { os.Remove(f.Name()) },
 			}
 		},
+		"ok/trusted-ca-only": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					TrustedCA:     base64.StdEncoding.EncodeToString(certPEM),
+					TrustedCAOnly: true,
+				},
+				wantErr: false,
+			}
+		},
 		"fail/missing-eab-key": func(_ *testing.T) test {
 			return test{
 				fields: fields{
@@ -150,6 +167,117 @@ func TestAutocertOptions_Validate(t *testing.T) {
 				wantErr: true,
 			}
 		},
+		"fail/trusted-ca-only-missing-ca": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					TrustedCAOnly: true,
+				},
+				wantErr: true,
+			}
+		},
+		"ok/dns-provider-route53": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					DNSProvider: "route53",
+				},
+				wantErr: false,
+			}
+		},
+		"ok/dns-provider-cloudflare": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					DNSProvider:        "cloudflare",
+					DNSProviderOptions: map[string]string{"api_token": "TOKEN"},
+				},
+				wantErr: false,
+			}
+		},
+		"ok/dns-provider-rfc2136": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					DNSProvider: "rfc2136",
+					DNSProviderOptions: map[string]string{
+						"server":        "ns1.example.com:53",
+						"tsig_key_name": "acme",
+						"tsig_secret":   "c2VjcmV0",
+					},
+				},
+				wantErr: false,
+			}
+		},
+		"fail/dns-provider-unknown": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					DNSProvider: "bind",
+				},
+				wantErr: true,
+			}
+		},
+		"fail/dns-provider-cloudflare-missing-token": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					DNSProvider: "cloudflare",
+				},
+				wantErr: true,
+			}
+		},
+		"fail/dns-provider-rfc2136-partial-tsig": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					DNSProvider:        "rfc2136",
+					DNSProviderOptions: map[string]string{"server": "ns1.example.com:53", "tsig_key_name": "acme"},
+				},
+				wantErr: true,
+			}
+		},
+		"fail/dns-provider-options-without-provider": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					DNSProviderOptions: map[string]string{"api_token": "TOKEN"},
+				},
+				wantErr: true,
+			}
+		},
+		"ok/proxy-url": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					ProxyURL: "http://proxy.example.com:3128",
+				},
+				wantErr: false,
+			}
+		},
+		"fail/proxy-url-scheme": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					ProxyURL: "ftp://proxy.example.com",
+				},
+				wantErr: true,
+			}
+		},
+		"ok/challenge-trusted-ca": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					ChallengeTrustedCA: base64.StdEncoding.EncodeToString(certPEM),
+				},
+				wantErr: false,
+			}
+		},
+		"fail/challenge-trusted-ca-invalid": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					ChallengeTrustedCA: ">invalid-base-64-data<",
+				},
+				wantErr: true,
+			}
+		},
+		"fail/challenge-trusted-ca-missing-file": func(_ *testing.T) test {
+			return test{
+				fields: fields{
+					ChallengeTrustedCAFile: "some-non-existing-file",
+				},
+				wantErr: true,
+			}
+		},
 		"fail/trusted-ca-missing-file": func(_ *testing.T) test {
 			return test{
 				fields: fields{
@@ -173,6 +301,14 @@ func TestAutocertOptions_Validate(t *testing.T) {
 				Folder:        tc.fields.Folder,
 				TrustedCA:     tc.fields.TrustedCA,
 				TrustedCAFile: tc.fields.TrustedCAFile,
+				TrustedCAOnly: tc.fields.TrustedCAOnly,
+
+				ProxyURL:               tc.fields.ProxyURL,
+				ChallengeTrustedCA:     tc.fields.ChallengeTrustedCA,
+				ChallengeTrustedCAFile: tc.fields.ChallengeTrustedCAFile,
+
+				DNSProvider:        tc.fields.DNSProvider,
+				DNSProviderOptions: tc.fields.DNSProviderOptions,
 			}
 			if err := o.Validate(); (err != nil) != tc.wantErr {
 				t.Errorf("AutocertOptions.Validate() error = %v, wantErr %v", err, tc.wantErr)
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/identity"
+)
+
+// DefaultClaimsMapperTimeout is the default timeout for external claims
+// mappers.
+const DefaultClaimsMapperTimeout = 10 * time.Second
+
+// ClaimsMapperOptions configures a claims mapper used to transform identity
+// provider claims before they are stored in session and user records. Exactly
+// one of Name or URL must be set:
+//
+//   - Name references a Go claims mapper registered with
+//     identity.RegisterClaimsMapper.
+//   - URL is an external HTTP endpoint which receives an
+//     identity.HTTPClaimsMapperRequest and returns an
+//     identity.HTTPClaimsMapperResponse.
+type ClaimsMapperOptions struct {
+	Name    string        `mapstructure:"name" yaml:"name,omitempty" json:"name,omitempty"`
+	URL     string        `mapstructure:"url" yaml:"url,omitempty" json:"url,omitempty"`
+	Timeout time.Duration `mapstructure:"timeout" yaml:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+func (o ClaimsMapperOptions) validate() error {
+	switch {
+	case o.Name != "" && o.URL != "":
+		return errors.New("config: idp_claims_mappers: only one of name or url may be set")
+	case o.Name != "":
+		if _, ok := identity.GetClaimsMapper(o.Name); !ok {
+			return fmt.Errorf("config: idp_claims_mappers: unknown claims mapper %q", o.Name)
+		}
+	case o.URL != "":
+		if _, err := urlutil.ParseAndValidateURL(o.URL); err != nil {
+			return fmt.Errorf("config: idp_claims_mappers: bad url %s : %w", o.URL, err)
+		}
+	default:
+		return errors.New("config: idp_claims_mappers: one of name or url is required")
+	}
+	if o.Timeout < 0 {
+		return fmt.Errorf("config: idp_claims_mappers: invalid timeout: %s", o.Timeout)
+	}
+	return nil
+}
+
+// GetClaimsMapper returns the claims mapper built from the configured
+// idp_claims_mappers. If no claims mappers are configured nil is returned.
+func (cfg *Config) GetClaimsMapper() (identity.ClaimsMapper, error) {
+	if cfg == nil || cfg.Options == nil || len(cfg.Options.IDPClaimsMappers) == 0 {
+		return nil, nil
+	}
+
+	mappers := make([]identity.ClaimsMapper, 0, len(cfg.Options.IDPClaimsMappers))
+	for _, o := range cfg.Options.IDPClaimsMappers {
+		if err := o.validate(); err != nil {
+			return nil, err
+		}
+
+		if o.Name != "" {
+			mapper, _ := identity.GetClaimsMapper(o.Name)
+			mappers = append(mappers, mapper)
+			continue
+		}
+
+		transport, err := GetTLSClientTransport(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("get tls client config: %w", err)
+		}
+		timeout := o.Timeout
+		if timeout == 0 {
+			timeout = DefaultClaimsMapperTimeout
+		}
+		mappers = append(mappers, identity.NewHTTPClaimsMapper(o.URL, &http.Client{
+			Transport: otelhttp.NewTransport(transport),
+			Timeout:   timeout,
+		}))
+	}
+	return identity.ChainClaimsMappers(mappers...), nil
+}
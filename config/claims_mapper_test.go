@@ -0,0 +1,62 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/identity"
+)
+
+func init() {
+	identity.RegisterClaimsMapper("test-roles", identity.ClaimsMapperFunc(func(_ context.Context, _ string, claims identity.Claims) (identity.Claims, error) {
+		claims["roles"] = []any{"admin"}
+		return claims, nil
+	}))
+}
+
+func TestClaimsMapperOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		in     ClaimsMapperOptions
+		expect string
+	}{
+		{"name", ClaimsMapperOptions{Name: "test-roles"}, ""},
+		{"url", ClaimsMapperOptions{URL: "https://mapper.example.com/map"}, ""},
+		{"empty", ClaimsMapperOptions{}, "config: idp_claims_mappers: one of name or url is required"},
+		{"both", ClaimsMapperOptions{Name: "test-roles", URL: "https://mapper.example.com"}, "config: idp_claims_mappers: only one of name or url may be set"},
+		{"unknown name", ClaimsMapperOptions{Name: "missing"}, `config: idp_claims_mappers: unknown claims mapper "missing"`},
+		{"bad url", ClaimsMapperOptions{URL: "mapper"}, "config: idp_claims_mappers: bad url mapper"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.in.validate()
+			if tc.expect == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expect)
+			}
+		})
+	}
+}
+
+func TestConfig_GetClaimsMapper(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{Options: NewDefaultOptions()}
+	mapper, err := cfg.GetClaimsMapper()
+	require.NoError(t, err)
+	assert.Nil(t, mapper)
+
+	cfg.Options.IDPClaimsMappers = []ClaimsMapperOptions{{Name: "test-roles"}}
+	mapper, err = cfg.GetClaimsMapper()
+	require.NoError(t, err)
+	claims, err := mapper.MapClaims(t.Context(), "IDP1", identity.Claims{"sub": "U1"})
+	require.NoError(t, err)
+	assert.Equal(t, identity.Claims{"sub": "U1", "roles": []any{"admin"}}, claims)
+}
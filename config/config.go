@@ -30,6 +30,14 @@ type Config struct {
 	AutoCertificates []tls.Certificate
 	EnvoyVersion     string
 
+	// DownstreamMTLSCRLs are the certificate revocation lists downloaded from
+	// the downstream mTLS crl_urls, keyed by URL.
+	DownstreamMTLSCRLs map[string][]byte
+
+	// OCSPStaples are fetched OCSP staples for the configured certificates,
+	// keyed by OCSPStapleKey.
+	OCSPStaples map[string][]byte
+
 	// DerivedCertificates are TLS certificates derived from the shared secret
 	DerivedCertificates []tls.Certificate
 	// DerivedCAPEM is a PEM-encoded certificate authority
@@ -74,6 +82,9 @@ func (cfg *Config) Clone() *Config {
 		Options:          newOptions,
 		AutoCertificates: cfg.AutoCertificates,
 		EnvoyVersion:     cfg.EnvoyVersion,
+		OCSPStaples:      cfg.OCSPStaples,
+
+		DownstreamMTLSCRLs: cfg.DownstreamMTLSCRLs,
 
 		GRPCPort:        cfg.GRPCPort,
 		HTTPPort:        cfg.HTTPPort,
@@ -124,7 +135,7 @@ func (cfg *Config) AllCertificates() ([]tls.Certificate, error) {
 	}
 
 	var certs []tls.Certificate
-	certs = append(certs, optionCertificates...)
+	certs = append(certs, withOCSPStaples(optionCertificates, cfg.OCSPStaples)...)
 	certs = append(certs, cfg.AutoCertificates...)
 	certs = append(certs, cfg.DerivedCertificates...)
 	return certs, nil
@@ -214,12 +214,9 @@ func NewFileWatcherSource(ctx context.Context, underlying Source) *FileWatcherSo
 	}
 	context.AfterFunc(ctx, func() { src.watcher.Close() })
 
-	ch := src.watcher.Bind()
-	go func() {
-		for range ch {
-			src.onFileChange(ctx)
-		}
-	}()
+	src.watcher.OnChange(func(_ context.Context, changes []fileutil.FileChange) {
+		src.onFileChange(ctx, changes)
+	})
 	underlying.OnConfigChange(ctx, func(ctx context.Context, cfg *Config) {
 		src.onConfigChange(ctx, cfg)
 	})
@@ -254,10 +251,20 @@ func (src *FileWatcherSource) onConfigChange(ctx context.Context, cfg *Config) {
 	src.Trigger(ctx, src.cfg)
 }
 
-func (src *FileWatcherSource) onFileChange(ctx context.Context) {
+func (src *FileWatcherSource) onFileChange(ctx context.Context, changes []fileutil.FileChange) {
 	src.mu.Lock()
 	defer src.mu.Unlock()
 
+	// the watched paths may be from a previous config, so ignore any changes
+	// to files that are no longer referenced
+	filePaths := getAllConfigFilePaths(src.cfg)
+	if len(slices.Filter(changes, func(change fileutil.FileChange) bool {
+		return slices.Contains(filePaths, change.Path)
+	})) == 0 {
+		log.Ctx(ctx).Debug().Msg("config/filewatchersource: ignoring change to unrelated files")
+		return
+	}
+
 	hash := getAllConfigFilePathsHash(src.cfg)
 
 	if hash == src.hash {
@@ -299,6 +306,7 @@ func getAllConfigFilePaths(cfg *Config) []string {
 		cfg.Options.MetricsCertificateKeyFile,
 		cfg.Options.MetricsClientCAFile,
 		cfg.Options.PolicyFile,
+		cfg.Options.SCIMBearerTokenFile,
 		cfg.Options.SharedSecretFile,
 		cfg.Options.SigningKeyFile,
 	}
@@ -314,6 +322,7 @@ func getAllConfigFilePaths(cfg *Config) []string {
 			policy.TLSClientKeyFile,
 			policy.TLSCustomCAFile,
 			policy.TLSDownstreamClientCAFile,
+			policy.TLSDownstreamClientCRLFile,
 		)
 	}
 
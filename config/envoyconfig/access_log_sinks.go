@@ -0,0 +1,164 @@
+package envoyconfig
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+
+	envoy_config_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_access_loggers_file_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	envoy_extensions_access_loggers_grpc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	envoy_extensions_access_loggers_open_telemetry_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
+	otlp_common_v1 "go.opentelemetry.io/proto/otlp/common/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/hashutil"
+)
+
+const defaultAccessLogSinkLogName = "pomerium-access-log"
+
+func getAccessLogSinkClusterID(target string) string {
+	return fmt.Sprintf("pomerium-access-log-%d", hashutil.MustHash(target))
+}
+
+// buildAccessLogSinks builds the access logs for the configured access log
+// sinks.
+func buildAccessLogSinks(options *config.Options) []*envoy_config_accesslog_v3.AccessLog {
+	var accessLogs []*envoy_config_accesslog_v3.AccessLog
+	for i := range options.AccessLogSinks {
+		sink := &options.AccessLogSinks[i]
+		switch sink.Type {
+		case config.AccessLogSinkTypeFile:
+			accessLogs = append(accessLogs, buildFileAccessLogSink(sink))
+		case config.AccessLogSinkTypeGRPC:
+			accessLogs = append(accessLogs, buildGRPCAccessLogSink(sink))
+		case config.AccessLogSinkTypeOpenTelemetry:
+			accessLogs = append(accessLogs, buildOpenTelemetryAccessLogSink(sink))
+		}
+	}
+	return accessLogs
+}
+
+func buildFileAccessLogSink(sink *config.AccessLogSink) *envoy_config_accesslog_v3.AccessLog {
+	fal := &envoy_extensions_access_loggers_file_v3.FileAccessLog{
+		Path: sink.Path,
+	}
+	switch {
+	case len(sink.JSONFormat) > 0:
+		fields := make(map[string]*structpb.Value, len(sink.JSONFormat))
+		for k, v := range sink.JSONFormat {
+			fields[k] = structpb.NewStringValue(v)
+		}
+		fal.AccessLogFormat = &envoy_extensions_access_loggers_file_v3.FileAccessLog_LogFormat{
+			LogFormat: &envoy_config_core_v3.SubstitutionFormatString{
+				Format: &envoy_config_core_v3.SubstitutionFormatString_JsonFormat{
+					JsonFormat: &structpb.Struct{Fields: fields},
+				},
+			},
+		}
+	case sink.TextFormat != "":
+		fal.AccessLogFormat = &envoy_extensions_access_loggers_file_v3.FileAccessLog_LogFormat{
+			LogFormat: &envoy_config_core_v3.SubstitutionFormatString{
+				Format: &envoy_config_core_v3.SubstitutionFormatString_TextFormatSource{
+					TextFormatSource: &envoy_config_core_v3.DataSource{
+						Specifier: &envoy_config_core_v3.DataSource_InlineString{
+							InlineString: sink.TextFormat,
+						},
+					},
+				},
+			},
+		}
+	}
+	return &envoy_config_accesslog_v3.AccessLog{
+		Name:       "envoy.access_loggers.file",
+		ConfigType: &envoy_config_accesslog_v3.AccessLog_TypedConfig{TypedConfig: marshalAny(fal)},
+	}
+}
+
+func buildGRPCAccessLogSink(sink *config.AccessLogSink) *envoy_config_accesslog_v3.AccessLog {
+	return &envoy_config_accesslog_v3.AccessLog{
+		Name: "envoy.access_loggers.http_grpc",
+		ConfigType: &envoy_config_accesslog_v3.AccessLog_TypedConfig{
+			TypedConfig: marshalAny(&envoy_extensions_access_loggers_grpc_v3.HttpGrpcAccessLogConfig{
+				CommonConfig: buildAccessLogSinkCommonConfig(sink),
+			}),
+		},
+	}
+}
+
+func buildOpenTelemetryAccessLogSink(sink *config.AccessLogSink) *envoy_config_accesslog_v3.AccessLog {
+	otelConfig := &envoy_extensions_access_loggers_open_telemetry_v3.OpenTelemetryAccessLogConfig{
+		CommonConfig: buildAccessLogSinkCommonConfig(sink),
+	}
+	if sink.TextFormat != "" {
+		otelConfig.Body = &otlp_common_v1.AnyValue{
+			Value: &otlp_common_v1.AnyValue_StringValue{StringValue: sink.TextFormat},
+		}
+	}
+	if len(sink.JSONFormat) > 0 {
+		otelConfig.Attributes = &otlp_common_v1.KeyValueList{}
+		for _, k := range slices.Sorted(maps.Keys(sink.JSONFormat)) {
+			otelConfig.Attributes.Values = append(otelConfig.Attributes.Values, &otlp_common_v1.KeyValue{
+				Key: k,
+				Value: &otlp_common_v1.AnyValue{
+					Value: &otlp_common_v1.AnyValue_StringValue{StringValue: sink.JSONFormat[k]},
+				},
+			})
+		}
+	}
+	return &envoy_config_accesslog_v3.AccessLog{
+		Name:       "envoy.access_loggers.open_telemetry",
+		ConfigType: &envoy_config_accesslog_v3.AccessLog_TypedConfig{TypedConfig: marshalAny(otelConfig)},
+	}
+}
+
+func buildAccessLogSinkCommonConfig(sink *config.AccessLogSink) *envoy_extensions_access_loggers_grpc_v3.CommonGrpcAccessLogConfig {
+	logName := sink.LogName
+	if logName == "" {
+		logName = defaultAccessLogSinkLogName
+	}
+	return &envoy_extensions_access_loggers_grpc_v3.CommonGrpcAccessLogConfig{
+		LogName: logName,
+		GrpcService: &envoy_config_core_v3.GrpcService{
+			TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+					ClusterName: getAccessLogSinkClusterID(sink.Target),
+				},
+			},
+		},
+		TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+	}
+}
+
+// buildAccessLogSinkClusters builds a cluster for each grpc or otel access
+// log sink target.
+func (b *Builder) buildAccessLogSinkClusters(ctx context.Context, cfg *config.Config) ([]*envoy_config_cluster_v3.Cluster, error) {
+	var clusters []*envoy_config_cluster_v3.Cluster
+	seen := map[string]bool{}
+	for _, sink := range cfg.Options.AccessLogSinks {
+		if sink.Type != config.AccessLogSinkTypeGRPC && sink.Type != config.AccessLogSinkTypeOpenTelemetry {
+			continue
+		}
+		name := getAccessLogSinkClusterID(sink.Target)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		target, err := url.Parse(sink.Target)
+		if err != nil {
+			return nil, fmt.Errorf("invalid access log sink target: %w", err)
+		}
+		cluster, err := b.buildInternalCluster(ctx, cfg, name, []*url.URL{target}, upstreamProtocolHTTP2, Keepalive(false))
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters, nil
+}
@@ -0,0 +1,106 @@
+package envoyconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/config/envoyconfig/filemgr"
+	"github.com/pomerium/pomerium/internal/testutil"
+)
+
+func TestBuildAccessLogSinks(t *testing.T) {
+	t.Parallel()
+
+	options := config.NewDefaultOptions()
+	options.AccessLogSinks = []config.AccessLogSink{
+		{Type: config.AccessLogSinkTypeFile, Path: "/dev/stdout", TextFormat: "%RESPONSE_CODE%\n"},
+		{Type: config.AccessLogSinkTypeFile, Path: "/var/log/access.log", JSONFormat: map[string]string{"status": "%RESPONSE_CODE%"}},
+		{Type: config.AccessLogSinkTypeGRPC, Target: "http://als.example.com:9000", LogName: "als"},
+		{
+			Type:       config.AccessLogSinkTypeOpenTelemetry,
+			Target:     "http://otel.example.com:4317",
+			TextFormat: "%REQ(:PATH)%",
+			JSONFormat: map[string]string{"status": "%RESPONSE_CODE%", "authority": "%REQ(:AUTHORITY)%"},
+		},
+	}
+	alsClusterID := getAccessLogSinkClusterID("http://als.example.com:9000")
+	otelClusterID := getAccessLogSinkClusterID("http://otel.example.com:4317")
+
+	testutil.AssertProtoJSONEqual(t, `[
+		{
+			"name": "envoy.access_loggers.file",
+			"typedConfig": {
+				"@type": "type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog",
+				"path": "/dev/stdout",
+				"logFormat": { "textFormatSource": { "inlineString": "%RESPONSE_CODE%\n" } }
+			}
+		},
+		{
+			"name": "envoy.access_loggers.file",
+			"typedConfig": {
+				"@type": "type.googleapis.com/envoy.extensions.access_loggers.file.v3.FileAccessLog",
+				"path": "/var/log/access.log",
+				"logFormat": { "jsonFormat": { "status": "%RESPONSE_CODE%" } }
+			}
+		},
+		{
+			"name": "envoy.access_loggers.http_grpc",
+			"typedConfig": {
+				"@type": "type.googleapis.com/envoy.extensions.access_loggers.grpc.v3.HttpGrpcAccessLogConfig",
+				"commonConfig": {
+					"logName": "als",
+					"grpcService": { "envoyGrpc": { "clusterName": "`+alsClusterID+`" } },
+					"transportApiVersion": "V3"
+				}
+			}
+		},
+		{
+			"name": "envoy.access_loggers.open_telemetry",
+			"typedConfig": {
+				"@type": "type.googleapis.com/envoy.extensions.access_loggers.open_telemetry.v3.OpenTelemetryAccessLogConfig",
+				"commonConfig": {
+					"logName": "pomerium-access-log",
+					"grpcService": { "envoyGrpc": { "clusterName": "`+otelClusterID+`" } },
+					"transportApiVersion": "V3"
+				},
+				"body": { "stringValue": "%REQ(:PATH)%" },
+				"attributes": {
+					"values": [
+						{ "key": "authority", "value": { "stringValue": "%REQ(:AUTHORITY)%" } },
+						{ "key": "status", "value": { "stringValue": "%RESPONSE_CODE%" } }
+					]
+				}
+			}
+		}
+	]`, buildAccessLogSinks(options))
+
+	b := New("local-grpc", "local-http", "local-debug", "local-metrics", filemgr.NewManager(), nil, true)
+	clusters, err := b.buildAccessLogSinkClusters(t.Context(), &config.Config{Options: options})
+	require.NoError(t, err)
+	require.Len(t, clusters, 2)
+	assert.Equal(t, alsClusterID, clusters[0].GetName())
+	assert.Equal(t, otelClusterID, clusters[1].GetName())
+}
+
+func TestBuildAccessLogs(t *testing.T) {
+	t.Parallel()
+
+	options := config.NewDefaultOptions()
+	options.AccessLogSinks = []config.AccessLogSink{
+		{Type: config.AccessLogSinkTypeFile, Path: "/dev/stdout"},
+	}
+
+	options.LogLevel = config.LogLevelInfo
+	accessLogs := buildAccessLogs(options)
+	require.Len(t, accessLogs, 2)
+	assert.Equal(t, "envoy.access_loggers.http_grpc", accessLogs[0].GetName())
+	assert.Equal(t, "envoy.access_loggers.file", accessLogs[1].GetName())
+
+	options.LogLevel = config.LogLevelError
+	accessLogs = buildAccessLogs(options)
+	require.Len(t, accessLogs, 1, "sinks should be kept when the pomerium access log is disabled")
+	assert.Equal(t, "envoy.access_loggers.file", accessLogs[0].GetName())
+}
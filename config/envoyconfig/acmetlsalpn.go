@@ -47,7 +47,7 @@ func (b *Builder) buildACMETLSALPNFilterChain() *envoy_config_listener_v3.Filter
 			ApplicationProtocols: []string{acmeTLSALPNApplicationProtocol},
 		},
 		Filters: []*envoy_config_listener_v3.Filter{
-			TCPProxyFilter(acmeTLSALPNClusterName),
+			TCPProxyFilter("acme_tls_alpn", acmeTLSALPNClusterName),
 		},
 	}
 }
@@ -121,6 +121,12 @@ func (b *Builder) BuildClusters(ctx context.Context, cfg *config.Config) ([]*env
 		envoyAdminCluster,
 	}
 
+	accessLogSinkClusters, err := b.buildAccessLogSinkClusters(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	clusters = append(clusters, accessLogSinkClusters...)
+
 	if config.IsProxy(cfg.Options.Services) {
 		for policy := range cfg.Options.GetAllPolicies() {
 			if len(policy.To) > 0 {
@@ -130,6 +136,13 @@ func (b *Builder) BuildClusters(ctx context.Context, cfg *config.Config) ([]*env
 				}
 				clusters = append(clusters, cluster)
 			}
+			if policy.ExternalProcessing != nil {
+				cluster, err := b.buildExtProcCluster(ctx, cfg, policy)
+				if err != nil {
+					return nil, fmt.Errorf("policy %q: %w", policy.String(), err)
+				}
+				clusters = append(clusters, cluster)
+			}
 		}
 	}
 
@@ -223,10 +236,23 @@ func (b *Builder) buildPolicyCluster(ctx context.Context, cfg *config.Config, po
 		return nil, err
 	}
 	cluster.CircuitBreakers = buildRouteCircuitBreakers(cfg, policy)
+	if outlierDetection := buildRouteOutlierDetection(policy); outlierDetection != nil {
+		cluster.OutlierDetection = outlierDetection
+	}
 
 	return cluster, nil
 }
 
+// buildExtProcCluster builds the cluster for a policy's external processing
+// service.
+func (b *Builder) buildExtProcCluster(ctx context.Context, cfg *config.Config, policy *config.Policy) (*envoy_config_cluster_v3.Cluster, error) {
+	target, err := policy.ExternalProcessing.GetTargetURL()
+	if err != nil {
+		return nil, fmt.Errorf("invalid external processing target: %w", err)
+	}
+	return b.buildInternalCluster(ctx, cfg, getExtProcClusterID(policy), []*url.URL{target}, upstreamProtocolHTTP2, Keepalive(false))
+}
+
 func (b *Builder) buildPolicyEndpoints(
 	ctx context.Context,
 	cfg *config.Config,
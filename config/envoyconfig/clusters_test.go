@@ -1367,4 +1367,67 @@ func Test_buildPolicyCluster(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "alt-stat-name", cluster.AltStatName)
 	})
+	t.Run("outlier detection", func(t *testing.T) {
+		t.Parallel()
+		cluster, err := b.buildPolicyCluster(t.Context(), &config.Config{Options: config.NewDefaultOptions()}, &config.Policy{
+			From: "https://from.example.com",
+			To:   mustParseWeightedURLs(t, "https://example.com"),
+			CircuitBreakerThresholds: &config.CircuitBreakerThresholds{
+				MaxPendingRequests: null.Uint32From(10),
+			},
+			OutlierDetection: &config.OutlierDetection{
+				ConsecutiveGatewayFailure: null.Uint32From(3),
+				BaseEjectionTime:          ptr(time.Minute),
+				MaxEjectionPercent:        null.Uint32From(50),
+			},
+		})
+		require.NoError(t, err)
+		testutil.AssertProtoJSONEqual(t, `{
+			"consecutiveGatewayFailure": 3,
+			"enforcingConsecutiveGatewayFailure": 100,
+			"baseEjectionTime": "60s",
+			"maxEjectionPercent": 50
+		}`, cluster.OutlierDetection)
+		testutil.AssertProtoJSONEqual(t, `{
+			"thresholds": [{ "maxPendingRequests": 10 }]
+		}`, cluster.CircuitBreakers)
+	})
+	t.Run("no outlier detection", func(t *testing.T) {
+		t.Parallel()
+		cluster, err := b.buildPolicyCluster(t.Context(), &config.Config{Options: config.NewDefaultOptions()}, &config.Policy{
+			From: "https://from.example.com",
+			To:   mustParseWeightedURLs(t, "https://example.com"),
+		})
+		require.NoError(t, err)
+		assert.Nil(t, cluster.OutlierDetection)
+	})
+}
+
+func Test_buildExtProcCluster(t *testing.T) {
+	t.Parallel()
+
+	b := New("local-grpc", "local-http", "local-debug", "local-metrics", filemgr.NewManager(), nil, true)
+	policy := &config.Policy{
+		From:               "https://from.example.com",
+		To:                 mustParseWeightedURLs(t, "https://example.com"),
+		ExternalProcessing: &config.ExternalProcessing{Target: "http://scanner.example.com:9000"},
+	}
+	cluster, err := b.buildExtProcCluster(t.Context(), &config.Config{Options: config.NewDefaultOptions()}, policy)
+	require.NoError(t, err)
+	assert.Equal(t, getClusterID(policy)+"-ext-proc", cluster.Name)
+	testutil.AssertProtoJSONEqual(t, `{
+		"clusterName": "`+cluster.Name+`",
+		"endpoints": [{
+			"lbEndpoints": [{
+				"endpoint": {
+					"address": {
+						"socketAddress": { "address": "scanner.example.com", "portValue": 9000 }
+					},
+					"hostname": "scanner.example.com"
+				},
+				"loadBalancingWeight": 1
+			}]
+		}]
+	}`, cluster.LoadAssignment)
+	assert.Contains(t, cluster.TypedExtensionProtocolOptions, "envoy.extensions.upstreams.http.v3.HttpProtocolOptions")
 }
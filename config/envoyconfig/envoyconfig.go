@@ -83,7 +83,7 @@ func buildAccessLogs(options *config.Options) []*envoy_config_accesslog_v3.Acces
 	case config.LogLevelTrace, config.LogLevelDebug, config.LogLevelInfo:
 	default:
 		// don't log access requests for levels > info
-		return nil
+		return buildAccessLogSinks(options)
 	}
 
 	var additionalRequestHeaders []string
@@ -107,10 +107,10 @@ func buildAccessLogs(options *config.Options) []*envoy_config_accesslog_v3.Acces
 		},
 		AdditionalRequestHeadersToLog: additionalRequestHeaders,
 	})
-	return []*envoy_config_accesslog_v3.AccessLog{{
+	return append([]*envoy_config_accesslog_v3.AccessLog{{
 		Name:       "envoy.access_loggers.http_grpc",
 		ConfigType: &envoy_config_accesslog_v3.AccessLog_TypedConfig{TypedConfig: tc},
-	}}
+	}}, buildAccessLogSinks(options)...)
 }
 
 func buildTCPAddress(hostport string, defaultPort uint32) *envoy_config_core_v3.Address {
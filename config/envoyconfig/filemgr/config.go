@@ -2,12 +2,20 @@ package filemgr
 
 import (
 	"path/filepath"
+	"time"
 
 	"github.com/pomerium/pomerium/internal/fileutil"
 )
 
+const (
+	defaultMaxCacheSize = 64 * 1024 * 1024
+	defaultMaxFileAge   = time.Hour
+)
+
 type config struct {
-	cacheDir string
+	cacheDir     string
+	maxCacheSize int64
+	maxFileAge   time.Duration
 }
 
 // An Option updates the config.
@@ -20,9 +28,28 @@ func WithCacheDir(cacheDir string) Option {
 	}
 }
 
+// WithMaxCacheSize returns an Option that sets the maximum size of the cache
+// in bytes. When the cache exceeds this size, the least recently used files
+// which aren't referenced are removed. A size of 0 disables the limit.
+func WithMaxCacheSize(maxCacheSize int64) Option {
+	return func(cfg *config) {
+		cfg.maxCacheSize = maxCacheSize
+	}
+}
+
+// WithMaxFileAge returns an Option that sets how long a file which isn't
+// referenced is kept after it was last used. An age of 0 disables the limit.
+func WithMaxFileAge(maxFileAge time.Duration) Option {
+	return func(cfg *config) {
+		cfg.maxFileAge = maxFileAge
+	}
+}
+
 func newConfig(options ...Option) *config {
 	cfg := new(config)
 	WithCacheDir(filepath.Join(fileutil.CacheDir(), "envoy", "files"))(cfg)
+	WithMaxCacheSize(defaultMaxCacheSize)(cfg)
+	WithMaxFileAge(defaultMaxFileAge)(cfg)
 	for _, o := range options {
 		o(cfg)
 	}
@@ -2,35 +2,100 @@
 package filemgr
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/zeebo/xxh3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/pomerium/pomerium/internal/fileutil"
 	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/protoutil"
 )
 
+// racyModTimeWindow is how recently a file may have been modified, relative
+// to when it was hashed, for its hash to not be trusted on the next use. A
+// file modified again within the resolution of its modification time would
+// otherwise look unchanged.
+const racyModTimeWindow = 2 * time.Second
+
+type cacheEntry struct {
+	size     int64
+	lastUsed time.Time
+}
+
+// A fileHashEntry records the hash of a source file, so that the file doesn't
+// have to be read again while its size and modification time are unchanged.
+type fileHashEntry struct {
+	size     int64
+	modTime  time.Time
+	hashedAt time.Time
+	hash     uint64
+}
+
 // A Manager manages files for envoy.
 type Manager struct {
 	cfg *config
+	now func() time.Time
 
 	initOnce sync.Once
 	initErr  error
+
+	mu         sync.Mutex
+	entries    map[string]*cacheEntry
+	referenced map[string]struct{}
+	fileHashes map[string]fileHashEntry
 }
 
 // NewManager creates a new Manager.
 func NewManager(options ...Option) *Manager {
 	cfg := newConfig(options...)
 	return &Manager{
-		cfg: cfg,
+		cfg:        cfg,
+		now:        time.Now,
+		entries:    map[string]*cacheEntry{},
+		referenced: map[string]struct{}{},
+		fileHashes: map[string]fileHashEntry{},
 	}
 }
 
 func (mgr *Manager) init() {
 	mgr.initOnce.Do(func() {
 		mgr.initErr = os.MkdirAll(mgr.cfg.cacheDir, 0o700)
+		if mgr.initErr != nil {
+			return
+		}
+
+		// track any files left over from a previous run so they count
+		// towards the cache size and can be evicted
+		des, err := os.ReadDir(mgr.cfg.cacheDir)
+		if err != nil {
+			log.Error().Err(err).Msg("filemgr: error reading cache directory")
+			return
+		}
+
+		mgr.mu.Lock()
+		for _, de := range des {
+			fi, err := de.Info()
+			if err != nil || !fi.Mode().IsRegular() {
+				continue
+			}
+			filePath := filepath.Join(mgr.cfg.cacheDir, de.Name())
+			if _, ok := mgr.entries[filePath]; !ok {
+				mgr.entries[filePath] = &cacheEntry{size: fi.Size(), lastUsed: fi.ModTime()}
+			}
+		}
+		mgr.mu.Unlock()
 	})
 }
 
@@ -45,6 +110,11 @@ func (mgr *Manager) BytesDataSource(fileName string, data []byte) *envoy_config_
 	fileName = GetFileNameWithBytesHash(fileName, data)
 	filePath := filepath.Join(mgr.cfg.cacheDir, fileName)
 
+	// the lock is held while writing so that the file can't be evicted
+	// between being written and being tracked
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
 		err = fileutil.WriteFileAtomically(filePath, data, 0o600)
 		if err != nil {
@@ -56,11 +126,167 @@ func (mgr *Manager) BytesDataSource(fileName string, data []byte) *envoy_config_
 		return inlineBytes(data)
 	}
 
+	mgr.entries[filePath] = &cacheEntry{size: int64(len(data)), lastUsed: mgr.now()}
+
 	return inlineFilename(filePath)
 }
 
+// DataSource returns an envoy config data source for the given source. The
+// data is streamed into the cache directory while it's being hashed, so
+// large sources are never fully buffered in memory.
+func (mgr *Manager) DataSource(src Source) (*envoy_config_core_v3.DataSource, error) {
+	mgr.init()
+	if mgr.initErr != nil {
+		return nil, fmt.Errorf("filemgr: error creating cache directory: %w", mgr.initErr)
+	}
+
+	r, err := src.Open()
+	if err != nil {
+		return nil, fmt.Errorf("filemgr: error opening source: %w", err)
+	}
+	defer r.Close()
+
+	// the final file name depends on the hash of the data, so the data is
+	// written to a temporary file first
+	f, err := os.CreateTemp(mgr.cfg.cacheDir, src.Name()+".*.tmp")
+	if err != nil {
+		return nil, fmt.Errorf("filemgr: error creating cache file: %w", err)
+	}
+	tmpPath := f.Name()
+
+	h := xxh3.New()
+	size, err := io.Copy(io.MultiWriter(f, h), r)
+	if err == nil {
+		err = f.Sync()
+	}
+	if err == nil {
+		err = f.Chmod(0o600)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return nil, fmt.Errorf("filemgr: error writing cache file: %w", err)
+	}
+
+	filePath := filepath.Join(mgr.cfg.cacheDir, getFileNameWithHash(src.Name(), h.Sum64()))
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	if _, err := os.Stat(filePath); err == nil {
+		// the cache file already exists, so the temporary file isn't needed
+		_ = os.Remove(tmpPath)
+	} else if err := os.Rename(tmpPath, filePath); err != nil {
+		_ = os.Remove(tmpPath)
+		return nil, fmt.Errorf("filemgr: error renaming cache file: %w", err)
+	}
+
+	mgr.entries[filePath] = &cacheEntry{size: size, lastUsed: mgr.now()}
+
+	return inlineFilename(filePath), nil
+}
+
+// GetReferencedFiles returns the paths of the cache files referenced by the
+// given messages. Messages embedded in Any fields are searched as well.
+func (mgr *Manager) GetReferencedFiles(msgs ...proto.Message) ([]string, error) {
+	prefix := mgr.cfg.cacheDir + string(filepath.Separator)
+
+	var filePaths []string
+	for _, msg := range msgs {
+		_, err := protoutil.Transform(msg, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) (protoreflect.Value, error) {
+			if fd.Kind() == protoreflect.StringKind && strings.HasPrefix(v.String(), prefix) {
+				filePaths = append(filePaths, v.String())
+			}
+			return v, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	slices.Sort(filePaths)
+	return slices.Compact(filePaths), nil
+}
+
+// SetReferencedFiles sets the cache files referenced by the active envoy
+// configuration and evicts files which are no longer referenced. Files which
+// aren't referenced are removed once they haven't been used for the max file
+// age, or, least recently used first, when the cache exceeds the max cache
+// size. Referenced files are never removed.
+func (mgr *Manager) SetReferencedFiles(filePaths []string) {
+	mgr.init()
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	mgr.referenced = make(map[string]struct{}, len(filePaths))
+	for _, filePath := range filePaths {
+		mgr.referenced[filePath] = struct{}{}
+	}
+
+	mgr.evictLocked()
+}
+
+func (mgr *Manager) evictLocked() {
+	now := mgr.now()
+
+	var candidates []string
+	var totalSize int64
+	for filePath, entry := range mgr.entries {
+		if _, ok := mgr.referenced[filePath]; !ok {
+			if mgr.cfg.maxFileAge > 0 && now.Sub(entry.lastUsed) > mgr.cfg.maxFileAge {
+				mgr.removeLocked(filePath)
+				continue
+			}
+			candidates = append(candidates, filePath)
+		}
+		totalSize += entry.size
+	}
+
+	if mgr.cfg.maxCacheSize <= 0 || totalSize <= mgr.cfg.maxCacheSize {
+		return
+	}
+
+	slices.SortFunc(candidates, func(x, y string) int {
+		return mgr.entries[x].lastUsed.Compare(mgr.entries[y].lastUsed)
+	})
+	for _, filePath := range candidates {
+		if totalSize <= mgr.cfg.maxCacheSize {
+			break
+		}
+		size := mgr.entries[filePath].size
+		if mgr.removeLocked(filePath) {
+			totalSize -= size
+		}
+	}
+	if totalSize > mgr.cfg.maxCacheSize {
+		log.Logger().Warn().
+			Int64("size", totalSize).
+			Int64("max-size", mgr.cfg.maxCacheSize).
+			Msg("filemgr: cache exceeds the maximum size, but all remaining files are referenced")
+	}
+}
+
+func (mgr *Manager) removeLocked(filePath string) bool {
+	err := os.Remove(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		log.Error().Err(err).Str("file", filePath).Msg("filemgr: error removing cache file")
+		return false
+	}
+	delete(mgr.entries, filePath)
+	log.Debug().Str("file", filePath).Msg("filemgr: removed cache file")
+	return true
+}
+
 // ClearCache clears the file cache.
 func (mgr *Manager) ClearCache() {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	clear(mgr.entries)
+	clear(mgr.fileHashes)
+
 	if _, err := os.Stat(mgr.cfg.cacheDir); os.IsNotExist(err) {
 		return
 	}
@@ -79,13 +305,82 @@ func (mgr *Manager) ClearCache() {
 	}
 }
 
-// FileDataSource returns an envoy config data source based on a file.
+// FileDataSource returns an envoy config data source based on a file. The file
+// is only copied to the cache directory if its contents aren't cached already,
+// and it's only read again once its size or modification time changes.
 func (mgr *Manager) FileDataSource(filePath string) *envoy_config_core_v3.DataSource {
-	data, err := os.ReadFile(filePath)
+	mgr.init()
+	if mgr.initErr != nil {
+		log.Error().Err(mgr.initErr).Msg("filemgr: error creating cache directory, falling back to the original file")
+		return inlineFilename(filePath)
+	}
+
+	fi, err := os.Stat(filePath)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Error().Err(err).Msg("filemgr: error reading file, falling back to the original file")
+		}
+		return inlineFilename(filePath)
+	}
+
+	hash, err := mgr.getFileHash(filePath, fi)
+	if err != nil {
+		log.Error().Err(err).Msg("filemgr: error reading file, falling back to the original file")
+		return inlineFilename(filePath)
+	}
+
+	cachePath := filepath.Join(mgr.cfg.cacheDir, getFileNameWithHash(filepath.Base(filePath), hash))
+	mgr.mu.Lock()
+	if _, err := os.Stat(cachePath); err == nil {
+		mgr.entries[cachePath] = &cacheEntry{size: fi.Size(), lastUsed: mgr.now()}
+		mgr.mu.Unlock()
+		return inlineFilename(cachePath)
+	}
+	mgr.mu.Unlock()
+
+	ds, err := mgr.DataSource(NewFileSource(filePath))
 	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Error().Err(err).Msg("filemgr: error copying file to cache, falling back to the original file")
+		}
 		return inlineFilename(filePath)
 	}
-	return mgr.BytesDataSource(filepath.Base(filePath), data)
+	return ds
+}
+
+// getFileHash returns the hash of the file's contents. The file is only read
+// if it changed since it was last hashed.
+func (mgr *Manager) getFileHash(filePath string, fi fs.FileInfo) (uint64, error) {
+	mgr.mu.Lock()
+	entry, ok := mgr.fileHashes[filePath]
+	mgr.mu.Unlock()
+	if ok && entry.size == fi.Size() && entry.modTime.Equal(fi.ModTime()) &&
+		fi.ModTime().Before(entry.hashedAt.Add(-racyModTimeWindow)) {
+		return entry.hash, nil
+	}
+
+	hashedAt := time.Now()
+	f, err := os.Open(filePath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	h := xxh3.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return 0, err
+	}
+
+	mgr.mu.Lock()
+	mgr.fileHashes[filePath] = fileHashEntry{
+		size:     fi.Size(),
+		modTime:  fi.ModTime(),
+		hashedAt: hashedAt,
+		hash:     h.Sum64(),
+	}
+	mgr.mu.Unlock()
+
+	return h.Sum64(), nil
 }
 
 func inlineBytes(data []byte) *envoy_config_core_v3.DataSource {
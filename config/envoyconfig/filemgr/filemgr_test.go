@@ -1,12 +1,20 @@
 package filemgr
 
 import (
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_extensions_transport_sockets_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/protoutil"
 )
 
 func Test(t *testing.T) {
@@ -49,4 +57,192 @@ func Test(t *testing.T) {
 
 		mgr.ClearCache()
 	})
+
+	t.Run("unchanged file", func(t *testing.T) {
+		tmpFilePath := filepath.Join(t.TempDir(), "test.txt")
+		modTime := time.Now().Add(-time.Hour)
+		require.NoError(t, os.WriteFile(tmpFilePath, []byte("TEST1"), 0o600))
+		require.NoError(t, os.Chtimes(tmpFilePath, modTime, modTime))
+
+		mgr := NewManager(WithCacheDir(dir))
+		ds1 := mgr.FileDataSource(tmpFilePath)
+
+		// the same size and modification time, so the file isn't read again
+		require.NoError(t, os.WriteFile(tmpFilePath, []byte("TEST2"), 0o600))
+		require.NoError(t, os.Chtimes(tmpFilePath, modTime, modTime))
+		assert.Equal(t, ds1, mgr.FileDataSource(tmpFilePath),
+			"should not read a file whose size and modification time are unchanged")
+
+		require.NoError(t, os.Chtimes(tmpFilePath, time.Now(), time.Now()))
+		assert.Equal(t, filepath.Join(dir, "test-33343439385257475847375443.txt"),
+			mgr.FileDataSource(tmpFilePath).GetFilename(),
+			"should read the file again once it's modified")
+
+		mgr.ClearCache()
+	})
+}
+
+type readerSource struct {
+	name string
+	size int64
+}
+
+func (src readerSource) Name() string {
+	return src.name
+}
+
+func (src readerSource) Open() (io.ReadCloser, error) {
+	return io.NopCloser(io.LimitReader(zeroReader{}, src.size)), nil
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func TestDataSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bytes", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		mgr := NewManager(WithCacheDir(dir))
+		ds, err := mgr.DataSource(NewBytesSource("test.txt", []byte{1, 2, 3, 4, 5}))
+		require.NoError(t, err)
+		assert.Equal(t, mgr.BytesDataSource("test.txt", []byte{1, 2, 3, 4, 5}), ds,
+			"should use the same file name as BytesDataSource")
+
+		ds, err = mgr.DataSource(NewBytesSource("test.txt", []byte{1, 2, 3, 4, 5}))
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "test-31443434314d425355414b4539.txt"), ds.GetFilename())
+
+		des, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, des, 1, "should remove temporary files")
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		t.Parallel()
+
+		const size = 32 * 1024 * 1024
+		mgr := NewManager(WithCacheDir(t.TempDir()))
+		ds, err := mgr.DataSource(readerSource{name: "large.pem", size: size})
+		require.NoError(t, err)
+
+		fi, err := os.Stat(ds.GetFilename())
+		require.NoError(t, err)
+		assert.Equal(t, int64(size), fi.Size())
+		assert.Equal(t, GetFileNameWithBytesHash("large.pem", make([]byte, size)),
+			filepath.Base(ds.GetFilename()))
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		mgr := NewManager(WithCacheDir(t.TempDir()))
+		_, err := mgr.DataSource(NewFileSource(filepath.Join(t.TempDir(), "missing.pem")))
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+}
+
+func TestEviction(t *testing.T) {
+	t.Parallel()
+
+	exists := func(ds *envoy_config_core_v3.DataSource) bool {
+		_, err := os.Stat(ds.GetFilename())
+		return err == nil
+	}
+
+	t.Run("max file age", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mgr := NewManager(WithCacheDir(t.TempDir()), WithMaxFileAge(time.Hour), WithMaxCacheSize(0))
+		mgr.now = func() time.Time { return now }
+
+		ds1 := mgr.BytesDataSource("test1.txt", []byte("TEST1"))
+		ds2 := mgr.BytesDataSource("test2.txt", []byte("TEST2"))
+		ds3 := mgr.BytesDataSource("test3.txt", []byte("TEST3"))
+
+		now = now.Add(30 * time.Minute)
+		mgr.BytesDataSource("test3.txt", []byte("TEST3"))
+
+		now = now.Add(31 * time.Minute)
+		mgr.SetReferencedFiles([]string{ds1.GetFilename()})
+		assert.True(t, exists(ds1), "should keep referenced files")
+		assert.False(t, exists(ds2), "should remove expired files")
+		assert.True(t, exists(ds3), "should keep recently used files")
+	})
+
+	t.Run("max cache size", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mgr := NewManager(WithCacheDir(t.TempDir()), WithMaxFileAge(0), WithMaxCacheSize(10))
+		mgr.now = func() time.Time { return now }
+
+		var dss []*envoy_config_core_v3.DataSource
+		for _, name := range []string{"test1.txt", "test2.txt", "test3.txt", "test4.txt"} {
+			dss = append(dss, mgr.BytesDataSource(name, []byte(name[:5])))
+			now = now.Add(time.Minute)
+		}
+
+		mgr.SetReferencedFiles([]string{dss[0].GetFilename()})
+		assert.True(t, exists(dss[0]), "should keep referenced files")
+		assert.False(t, exists(dss[1]), "should remove the least recently used file")
+		assert.False(t, exists(dss[2]), "should remove the least recently used file")
+		assert.True(t, exists(dss[3]), "should keep files within the max cache size")
+
+		mgr.SetReferencedFiles(nil)
+		assert.False(t, exists(dss[0]), "should remove files no longer referenced")
+		assert.True(t, exists(dss[3]), "should keep files within the max cache size")
+	})
+
+	t.Run("existing files", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "old.txt"), []byte("OLD"), 0o600))
+		old := time.Now().Add(-2 * time.Hour)
+		require.NoError(t, os.Chtimes(filepath.Join(dir, "old.txt"), old, old))
+
+		mgr := NewManager(WithCacheDir(dir), WithMaxFileAge(time.Hour))
+		mgr.SetReferencedFiles(nil)
+		assert.NoFileExists(t, filepath.Join(dir, "old.txt"))
+	})
+}
+
+func TestGetReferencedFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	mgr := NewManager(WithCacheDir(dir))
+	ds1 := mgr.BytesDataSource("ca.pem", []byte("CA"))
+	ds2 := mgr.BytesDataSource("crl.pem", []byte("CRL"))
+
+	cluster := &envoy_config_cluster_v3.Cluster{
+		Name: "example",
+		TransportSocket: &envoy_config_core_v3.TransportSocket{
+			Name: "tls",
+			ConfigType: &envoy_config_core_v3.TransportSocket_TypedConfig{
+				TypedConfig: protoutil.NewAny(&envoy_extensions_transport_sockets_tls_v3.UpstreamTlsContext{
+					CommonTlsContext: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext{
+						ValidationContextType: &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext_ValidationContext{
+							ValidationContext: &envoy_extensions_transport_sockets_tls_v3.CertificateValidationContext{
+								TrustedCa: ds1,
+								Crl:       ds2,
+							},
+						},
+					},
+				}),
+			},
+		},
+	}
+
+	filePaths, err := mgr.GetReferencedFiles(cluster, cluster, &envoy_config_cluster_v3.Cluster{Name: filepath.Join(t.TempDir(), "other.txt")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{ds1.GetFilename(), ds2.GetFilename()}, filePaths)
 }
@@ -11,7 +11,10 @@ import (
 // GetFileNameWithBytesHash constructs a filename using a base filename and a hash of
 // the data. For example: GetFileNameWithBytesHash("example.txt", []byte{...}) ==> "example-abcd1234.txt"
 func GetFileNameWithBytesHash(base string, data []byte) string {
-	h := xxh3.Hash(data)
+	return getFileNameWithHash(base, xxh3.Hash(data))
+}
+
+func getFileNameWithHash(base string, h uint64) string {
 	he := base36.Encode(h)
 	ext := filepath.Ext(base)
 	return fmt.Sprintf("%s-%x%s", base[:len(base)-len(ext)], he, ext)
@@ -0,0 +1,53 @@
+package filemgr
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// A Source is a source of data for a file managed by the Manager. Sources are
+// read as a stream, so the data never has to be held in memory all at once.
+type Source interface {
+	// Name returns the base file name, which is combined with a hash of the
+	// data to build the name of the cache file.
+	Name() string
+	// Open opens the data for reading.
+	Open() (io.ReadCloser, error)
+}
+
+type bytesSource struct {
+	name string
+	data []byte
+}
+
+// NewBytesSource creates a new Source from bytes.
+func NewBytesSource(name string, data []byte) Source {
+	return bytesSource{name: name, data: data}
+}
+
+func (src bytesSource) Name() string {
+	return src.name
+}
+
+func (src bytesSource) Open() (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(src.data)), nil
+}
+
+type fileSource struct {
+	path string
+}
+
+// NewFileSource creates a new Source from a file.
+func NewFileSource(filePath string) Source {
+	return fileSource{path: filePath}
+}
+
+func (src fileSource) Name() string {
+	return filepath.Base(src.path)
+}
+
+func (src fileSource) Open() (io.ReadCloser, error) {
+	return os.Open(src.path)
+}
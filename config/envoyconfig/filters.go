@@ -3,8 +3,13 @@ package envoyconfig
 import (
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_extensions_filters_http_connect_grpc_bridge_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/connect_grpc_bridge/v3"
+	envoy_extensions_filters_http_cors_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
 	envoy_extensions_filters_http_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoy_extensions_filters_http_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	envoy_extensions_filters_http_grpc_web_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_web/v3"
 	envoy_extensions_filters_http_header_mutation_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	envoy_extensions_filters_http_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
 	envoy_extensions_filters_http_lua_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
 	envoy_extensions_filters_http_router_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
 	envoy_extensions_filters_listener_proxy_protocol_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/proxy_protocol/v3"
@@ -17,6 +22,29 @@ import (
 	"github.com/pomerium/pomerium/pkg/protoutil"
 )
 
+// ConnectGRPCBridgeFilter creates a Connect to gRPC bridge HTTP filter. The
+// filter is disabled unless it is enabled by a route's per-filter config.
+func ConnectGRPCBridgeFilter() *envoy_extensions_filters_network_http_connection_manager.HttpFilter {
+	return &envoy_extensions_filters_network_http_connection_manager.HttpFilter{
+		Name: PerFilterConfigConnectGRPCBridgeName,
+		ConfigType: &envoy_extensions_filters_network_http_connection_manager.HttpFilter_TypedConfig{
+			TypedConfig: protoutil.NewAny(&envoy_extensions_filters_http_connect_grpc_bridge_v3.FilterConfig{}),
+		},
+		Disabled: true,
+	}
+}
+
+// CORSFilter creates a CORS HTTP filter. The filter only handles requests for
+// routes with a CORS policy in their per-filter config.
+func CORSFilter() *envoy_extensions_filters_network_http_connection_manager.HttpFilter {
+	return &envoy_extensions_filters_network_http_connection_manager.HttpFilter{
+		Name: PerFilterConfigCORSName,
+		ConfigType: &envoy_extensions_filters_network_http_connection_manager.HttpFilter_TypedConfig{
+			TypedConfig: protoutil.NewAny(&envoy_extensions_filters_http_cors_v3.Cors{}),
+		},
+	}
+}
+
 // ExtAuthzFilter creates an ext authz filter.
 func ExtAuthzFilter(grpcClientTimeout *durationpb.Duration) *envoy_extensions_filters_network_http_connection_manager.HttpFilter {
 	return &envoy_extensions_filters_network_http_connection_manager.HttpFilter{
@@ -43,6 +71,31 @@ func ExtAuthzFilter(grpcClientTimeout *durationpb.Duration) *envoy_extensions_fi
 	}
 }
 
+// ExtProcFilter creates an external processing HTTP filter. The filter is
+// disabled unless it is enabled by a route's per-filter config, which also
+// sets the processing service.
+func ExtProcFilter() *envoy_extensions_filters_network_http_connection_manager.HttpFilter {
+	return &envoy_extensions_filters_network_http_connection_manager.HttpFilter{
+		Name: PerFilterConfigExtProcName,
+		ConfigType: &envoy_extensions_filters_network_http_connection_manager.HttpFilter_TypedConfig{
+			TypedConfig: protoutil.NewAny(&envoy_extensions_filters_http_ext_proc_v3.ExternalProcessor{}),
+		},
+		Disabled: true,
+	}
+}
+
+// GRPCWebFilter creates a gRPC-Web HTTP filter. The filter is disabled unless
+// it is enabled by a route's per-filter config.
+func GRPCWebFilter() *envoy_extensions_filters_network_http_connection_manager.HttpFilter {
+	return &envoy_extensions_filters_network_http_connection_manager.HttpFilter{
+		Name: PerFilterConfigGRPCWebName,
+		ConfigType: &envoy_extensions_filters_network_http_connection_manager.HttpFilter_TypedConfig{
+			TypedConfig: protoutil.NewAny(&envoy_extensions_filters_http_grpc_web_v3.GrpcWeb{}),
+		},
+		Disabled: true,
+	}
+}
+
 // HTTPConnectionManagerFilter creates a new HTTP connection manager filter.
 func (b *Builder) HTTPConnectionManagerFilter(
 	httpConnectionManager *envoy_extensions_filters_network_http_connection_manager.HttpConnectionManager,
@@ -66,6 +119,19 @@ func HTTPHeaderMutationsFilter(mutation *envoy_extensions_filters_http_header_mu
 	}
 }
 
+// LocalRateLimitFilter creates a local rate limit HTTP filter. The filter is
+// disabled unless it is enabled by a route's per-filter config.
+func LocalRateLimitFilter() *envoy_extensions_filters_network_http_connection_manager.HttpFilter {
+	return &envoy_extensions_filters_network_http_connection_manager.HttpFilter{
+		Name: PerFilterConfigLocalRateLimitName,
+		ConfigType: &envoy_extensions_filters_network_http_connection_manager.HttpFilter_TypedConfig{
+			TypedConfig: protoutil.NewAny(&envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit{
+				StatPrefix: localRateLimitStatPrefix,
+			}),
+		},
+	}
+}
+
 // HTTPRouterFilter creates a new HTTP router filter.
 func HTTPRouterFilter() *envoy_extensions_filters_network_http_connection_manager.HttpFilter {
 	return &envoy_extensions_filters_network_http_connection_manager.HttpFilter{
@@ -103,12 +169,12 @@ func ProxyProtocolFilter() *envoy_config_listener_v3.ListenerFilter {
 }
 
 // TCPProxyFilter creates a new TCP Proxy filter.
-func TCPProxyFilter(clusterName string) *envoy_config_listener_v3.Filter {
+func TCPProxyFilter(statPrefix, clusterName string) *envoy_config_listener_v3.Filter {
 	return &envoy_config_listener_v3.Filter{
 		Name: "tcp_proxy",
 		ConfigType: &envoy_config_listener_v3.Filter_TypedConfig{
 			TypedConfig: protoutil.NewAny(&envoy_extensions_filters_network_tcp_proxy_v3.TcpProxy{
-				StatPrefix: "acme_tls_alpn",
+				StatPrefix: statPrefix,
 				ClusterSpecifier: &envoy_extensions_filters_network_tcp_proxy_v3.TcpProxy_Cluster{
 					Cluster: clusterName,
 				},
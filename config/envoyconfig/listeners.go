@@ -83,6 +83,14 @@ func (b *Builder) BuildListeners(
 		}
 	}
 
+	if shouldStartTCPListeners(cfg.Options) {
+		lis, err := buildTCPListeners(cfg)
+		if err != nil {
+			return nil, err
+		}
+		listeners = append(listeners, lis...)
+	}
+
 	li, err := b.buildOutboundListener(cfg)
 	if err != nil {
 		return nil, err
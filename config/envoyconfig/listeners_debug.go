@@ -34,6 +34,21 @@ func (b *Builder) buildDebugHTTPConnectionManagerFilter() *envoy_config_listener
 		Name:    "debug",
 		Domains: []string{"*"},
 		Routes: []*envoy_config_route_v3.Route{
+			{
+				// the portal uses the envoy clusters to show route health
+				Name: "envoy-clusters",
+				Match: &envoy_config_route_v3.RouteMatch{
+					PathSpecifier: &envoy_config_route_v3.RouteMatch_Path{Path: "/envoy/clusters"},
+				},
+				Action: &envoy_config_route_v3.Route_Route{
+					Route: &envoy_config_route_v3.RouteAction{
+						ClusterSpecifier: &envoy_config_route_v3.RouteAction_Cluster{
+							Cluster: envoyAdminClusterName,
+						},
+						PrefixRewrite: "/clusters",
+					},
+				},
+			},
 			{
 				Name: "debug",
 				Match: &envoy_config_route_v3.RouteMatch{
@@ -0,0 +1,41 @@
+package envoyconfig
+
+import (
+	"testing"
+
+	envoy_http_connection_manager "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/testutil"
+)
+
+func Test_buildDebugHTTPConnectionManagerFilter(t *testing.T) {
+	t.Parallel()
+
+	b := New("local-grpc", "local-http", "local-debug", "local-metrics", nil, nil, true)
+	filter := b.buildDebugHTTPConnectionManagerFilter()
+
+	var hcm envoy_http_connection_manager.HttpConnectionManager
+	require.NoError(t, filter.GetTypedConfig().UnmarshalTo(&hcm))
+	testutil.AssertProtoJSONEqual(t, `[
+		{
+			"match": {
+				"path": "/envoy/clusters"
+			},
+			"name": "envoy-clusters",
+			"route": {
+				"cluster": "pomerium-envoy-admin",
+				"prefixRewrite": "/clusters"
+			}
+		},
+		{
+			"match": {
+				"prefix": "/"
+			},
+			"name": "debug",
+			"route": {
+				"cluster": "pomerium-control-plane-debug"
+			}
+		}
+	]`, hcm.GetRouteConfig().GetVirtualHosts()[0].GetRoutes())
+}
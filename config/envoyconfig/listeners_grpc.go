@@ -15,7 +15,7 @@ import (
 )
 
 func (b *Builder) buildGRPCListener(ctx context.Context, cfg *config.Config) (*envoy_config_listener_v3.Listener, error) {
-	filter := b.buildGRPCHTTPConnectionManagerFilter()
+	filter := b.buildGRPCHTTPConnectionManagerFilter(cfg)
 
 	filterChain := envoy_config_listener_v3.FilterChain{
 		Filters: []*envoy_config_listener_v3.Filter{filter},
@@ -58,7 +58,7 @@ func (b *Builder) buildGRPCListener(ctx context.Context, cfg *config.Config) (*e
 	return li, nil
 }
 
-func (b *Builder) buildGRPCHTTPConnectionManagerFilter() *envoy_config_listener_v3.Filter {
+func (b *Builder) buildGRPCHTTPConnectionManagerFilter(cfg *config.Config) *envoy_config_listener_v3.Filter {
 	allow := []string{
 		"envoy.service.auth.v3.Authorization",
 		"databroker.CheckpointService",
@@ -68,6 +68,9 @@ func (b *Builder) buildGRPCHTTPConnectionManagerFilter() *envoy_config_listener_
 		"grpc.reflection.v1.ServerReflection",
 		"grpc.reflection.v1alpha.ServerReflection",
 	}
+	if cfg.Options.IsRuntimeFlagSet(config.RuntimeFlagDatabrokerDebugService) {
+		allow = append(allow, "databroker.DebugService")
+	}
 	routes := make([]*envoy_config_route_v3.Route, 0, len(allow))
 	for _, svc := range allow {
 		routes = append(routes, &envoy_config_route_v3.Route{
@@ -154,6 +154,8 @@ func (b *Builder) buildMainHTTPConnectionManagerFilter(
 	}
 
 	filters := []*envoy_extensions_filters_network_http_connection_manager.HttpFilter{
+		// answer cors preflight requests before they are authorized
+		CORSFilter(),
 		LuaFilter(luascripts.RemoveImpersonateHeaders),
 		LuaFilter(luascripts.SetClientCertificateMetadata),
 		ExtAuthzFilter(grpcClientTimeout),
@@ -161,6 +163,10 @@ func (b *Builder) buildMainHTTPConnectionManagerFilter(
 		LuaFilter(luascripts.CleanUpstream),
 		LuaFilter(luascripts.RewriteHeaders),
 		LuaFilter(luascripts.LocalReplyType),
+		LocalRateLimitFilter(),
+		GRPCWebFilter(),
+		ConnectGRPCBridgeFilter(),
+		ExtProcFilter(),
 	}
 	// if we support http3 and this is the non-quic listener, add an alt-svc header indicating h3 is available
 	if !useQUIC && cfg.Options.CodecType == config.CodecTypeHTTP3 {
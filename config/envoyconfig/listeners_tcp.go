@@ -0,0 +1,89 @@
+package envoyconfig
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+
+	envoy_config_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+type tcpListenerRoute struct {
+	serverName string
+	clusterID  string
+}
+
+// buildTCPListeners builds a listener for each port that TCP routes accept
+// raw TCP connections on. Routes sharing a port are selected by TLS SNI.
+func buildTCPListeners(cfg *config.Config) ([]*envoy_config_listener_v3.Listener, error) {
+	routesByPort := map[uint32][]tcpListenerRoute{}
+	for policy := range cfg.Options.GetAllPolicies() {
+		if !policy.IsTCP() || policy.TCPListenPorts == "" {
+			continue
+		}
+		ports, err := policy.GetTCPListenPorts()
+		if err != nil {
+			return nil, fmt.Errorf("policy %q: invalid tcp_listen_ports: %w", policy.String(), err)
+		}
+		from, err := url.Parse(policy.From)
+		if err != nil {
+			return nil, fmt.Errorf("policy %q: %w", policy.String(), err)
+		}
+		for _, port := range ports {
+			routesByPort[port] = append(routesByPort[port], tcpListenerRoute{
+				serverName: from.Hostname(),
+				clusterID:  getClusterID(policy),
+			})
+		}
+	}
+
+	host, _, err := net.SplitHostPort(cfg.Options.Addr)
+	if err != nil {
+		host = ""
+	}
+
+	var listeners []*envoy_config_listener_v3.Listener
+	for _, port := range slices.Sorted(maps.Keys(routesByPort)) {
+		routes := routesByPort[port]
+		name := fmt.Sprintf("tcp-ingress-%d", port)
+		li := newTCPListener(name, name, buildTCPAddress(net.JoinHostPort(host, strconv.FormatUint(uint64(port), 10)), port))
+
+		// a single route accepts every connection on the port, so it also
+		// works for upstreams which don't use TLS
+		if len(routes) == 1 {
+			li.FilterChains = []*envoy_config_listener_v3.FilterChain{{
+				Filters: []*envoy_config_listener_v3.Filter{TCPProxyFilter(name, routes[0].clusterID)},
+			}}
+			listeners = append(listeners, li)
+			continue
+		}
+
+		slices.SortFunc(routes, func(a, b tcpListenerRoute) int {
+			return cmp.Compare(a.serverName, b.serverName)
+		})
+		li.ListenerFilters = []*envoy_config_listener_v3.ListenerFilter{TLSInspectorFilter()}
+		for i, route := range routes {
+			if i > 0 && routes[i-1].serverName == route.serverName {
+				return nil, fmt.Errorf("multiple tcp routes for %s listen on port %d", route.serverName, port)
+			}
+			li.FilterChains = append(li.FilterChains, &envoy_config_listener_v3.FilterChain{
+				FilterChainMatch: &envoy_config_listener_v3.FilterChainMatch{
+					ServerNames: []string{route.serverName},
+				},
+				Filters: []*envoy_config_listener_v3.Filter{TCPProxyFilter(name, route.clusterID)},
+			})
+		}
+		listeners = append(listeners, li)
+	}
+	return listeners, nil
+}
+
+func shouldStartTCPListeners(options *config.Options) bool {
+	return config.IsProxy(options.Services)
+}
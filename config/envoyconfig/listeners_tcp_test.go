@@ -0,0 +1,105 @@
+package envoyconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/testutil"
+)
+
+func TestBuildTCPListeners(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no listen ports", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{Options: config.NewDefaultOptions()}
+		cfg.Options.Policies = []config.Policy{
+			{From: "tcp+https://db.example.com:5432", To: mustParseWeightedURLs(t, "tcp://db:5432")},
+		}
+		lis, err := buildTCPListeners(cfg)
+		require.NoError(t, err)
+		assert.Empty(t, lis)
+	})
+	t.Run("port range", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{Options: config.NewDefaultOptions()}
+		cfg.Options.Addr = "127.0.0.1:443"
+		cfg.Options.Policies = []config.Policy{{
+			From:                             "tcp+https://db.example.com:9000",
+			To:                               mustParseWeightedURLs(t, "tcp://db:9000"),
+			TCPListenPorts:                   "9000-9001",
+			AllowPublicUnauthenticatedAccess: true,
+		}}
+		lis, err := buildTCPListeners(cfg)
+		require.NoError(t, err)
+		require.Len(t, lis, 2)
+		for _, li := range lis {
+			assert.NoError(t, li.ValidateAll())
+			assert.Empty(t, li.GetListenerFilters())
+			require.Len(t, li.GetFilterChains(), 1)
+			assert.Nil(t, li.GetFilterChains()[0].GetFilterChainMatch())
+		}
+		assert.Equal(t, "tcp-ingress-9000", lis[0].GetName())
+		testutil.AssertProtoJSONEqual(t, `{
+			"socketAddress": { "address": "127.0.0.1", "portValue": 9001 }
+		}`, lis[1].GetAddress())
+	})
+	t.Run("sni", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{Options: config.NewDefaultOptions()}
+		cfg.Options.Policies = []config.Policy{
+			{
+				From:                             "tcp+https://db2.example.com:5432",
+				To:                               mustParseWeightedURLs(t, "tcp://db2:5432"),
+				TCPListenPorts:                   "5432",
+				AllowPublicUnauthenticatedAccess: true,
+			},
+			{
+				From:                             "tcp+https://db1.example.com:5432",
+				To:                               mustParseWeightedURLs(t, "tcp://db1:5432"),
+				TCPListenPorts:                   "5432",
+				AllowPublicUnauthenticatedAccess: true,
+			},
+		}
+		lis, err := buildTCPListeners(cfg)
+		require.NoError(t, err)
+		require.Len(t, lis, 1)
+		assert.NoError(t, lis[0].ValidateAll())
+		testutil.AssertProtoJSONEqual(t, `[{
+			"name": "tls_inspector",
+			"typedConfig": {
+				"@type": "type.googleapis.com/envoy.extensions.filters.listener.tls_inspector.v3.TlsInspector"
+			}
+		}]`, lis[0].GetListenerFilters())
+		require.Len(t, lis[0].GetFilterChains(), 2)
+		assert.Equal(t, []string{"db1.example.com"}, lis[0].GetFilterChains()[0].GetFilterChainMatch().GetServerNames())
+		assert.Equal(t, []string{"db2.example.com"}, lis[0].GetFilterChains()[1].GetFilterChainMatch().GetServerNames())
+	})
+	t.Run("duplicate server name", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{Options: config.NewDefaultOptions()}
+		cfg.Options.Policies = []config.Policy{
+			{
+				From:                             "tcp+https://db.example.com:5432",
+				To:                               mustParseWeightedURLs(t, "tcp://db1:5432"),
+				TCPListenPorts:                   "5432",
+				AllowPublicUnauthenticatedAccess: true,
+			},
+			{
+				From:                             "tcp+https://db.example.com:5433",
+				To:                               mustParseWeightedURLs(t, "tcp://db2:5432"),
+				TCPListenPorts:                   "5432",
+				AllowPublicUnauthenticatedAccess: true,
+			},
+		}
+		_, err := buildTCPListeners(cfg)
+		assert.Error(t, err)
+	})
+}
@@ -167,6 +167,12 @@ func (b *Builder) BuildLocalReplyConfig(
 	}
 	allMappers = append(allMappers, grpcMappers...)
 
+	errorPageMappers, err := b.buildLocalReplyMappersForErrorPages(options, responseFlagFilter, headers)
+	if err != nil {
+		return nil, err
+	}
+	allMappers = append(allMappers, errorPageMappers...)
+
 	// add the final fallback HTML error handler
 	allMappers = append(allMappers, &envoy_http_connection_manager.ResponseMapper{
 		Filter: &envoy_config_accesslog_v3.AccessLogFilter{
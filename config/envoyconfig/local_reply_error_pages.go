@@ -0,0 +1,137 @@
+package envoyconfig
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+
+	envoy_config_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_http_connection_manager "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+// placeholders for values which are only known to envoy when the local reply
+// is sent
+const (
+	errorPageStatusPlaceholder     = "__pomerium_status__"
+	errorPageStatusTextPlaceholder = "__pomerium_status_text__"
+	errorPageRequestIDPlaceholder  = "__pomerium_request_id__"
+)
+
+// buildLocalReplyMappersForErrorPages builds local reply mappers which use the
+// custom error pages of routes. The route of a request is identified by the
+// metadata set by the local-reply-type lua script.
+func (b *Builder) buildLocalReplyMappersForErrorPages(
+	options *config.Options,
+	responseFlagFilter *envoy_config_accesslog_v3.AccessLogFilter_ResponseFlagFilter,
+	headers []*envoy_config_core_v3.HeaderValueOption,
+) ([]*envoy_http_connection_manager.ResponseMapper, error) {
+	var mappers []*envoy_http_connection_manager.ResponseMapper
+	for policy := range options.GetAllPolicies() {
+		if len(policy.ErrorPages) == 0 {
+			continue
+		}
+
+		routeID, err := policy.RouteID()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range policy.GetErrorPageKeys() {
+			body, err := renderLocalReplyErrorPage(policy, key)
+			if err != nil {
+				return nil, err
+			}
+
+			filters := []*envoy_config_accesslog_v3.AccessLogFilter{
+				{FilterSpecifier: responseFlagFilter},
+				{FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_MetadataFilter{
+					MetadataFilter: &envoy_config_accesslog_v3.MetadataFilter{
+						Matcher: buildErrorPagesRouteIDMatcher(routeID),
+					},
+				}},
+			}
+			filters = append(filters, buildErrorPageStatusCodeFilters(key)...)
+
+			mappers = append(mappers, &envoy_http_connection_manager.ResponseMapper{
+				Filter: &envoy_config_accesslog_v3.AccessLogFilter{
+					FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_AndFilter{
+						AndFilter: &envoy_config_accesslog_v3.AndFilter{Filters: filters},
+					},
+				},
+				BodyFormatOverride: &envoy_config_core_v3.SubstitutionFormatString{
+					ContentType: "text/html; charset=UTF-8",
+					Format: &envoy_config_core_v3.SubstitutionFormatString_TextFormatSource{
+						TextFormatSource: &envoy_config_core_v3.DataSource{
+							Specifier: &envoy_config_core_v3.DataSource_InlineBytes{
+								InlineBytes: body,
+							},
+						},
+					},
+				},
+				HeadersToAdd: headers,
+			})
+		}
+	}
+	return mappers, nil
+}
+
+// renderLocalReplyErrorPage renders an error page as an envoy substitution
+// format string. The user's email is not available to envoy, so it is left
+// empty.
+func renderLocalReplyErrorPage(policy *config.Policy, key string) ([]byte, error) {
+	body, err := policy.RenderErrorPage(key, config.ErrorPageData{
+		Status:     errorPageStatusPlaceholder,
+		StatusText: errorPageStatusTextPlaceholder,
+		RequestID:  errorPageRequestIDPlaceholder,
+		RouteName:  cmp.Or(policy.Name, policy.From),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error rendering error page for local reply: %w", err)
+	}
+
+	// escape any % signs, as envoy will interpret the page as a substitution
+	// format string, and then replace the placeholders
+	return []byte(strings.NewReplacer(
+		"%", "%%",
+		errorPageStatusPlaceholder, "%RESPONSE_CODE%",
+		errorPageStatusTextPlaceholder, "%RESPONSE_CODE_DETAILS%",
+		errorPageRequestIDPlaceholder, "%STREAM_ID%",
+	).Replace(string(body))), nil
+}
+
+// buildErrorPageStatusCodeFilters returns filters matching the status code
+// (403) or status class (5xx) of an error_pages key.
+func buildErrorPageStatusCodeFilters(key string) []*envoy_config_accesslog_v3.AccessLogFilter {
+	if class, ok := strings.CutSuffix(key, "xx"); ok {
+		n, _ := strconv.Atoi(class)
+		return []*envoy_config_accesslog_v3.AccessLogFilter{
+			buildStatusCodeFilter(envoy_config_accesslog_v3.ComparisonFilter_GE, uint32(n*100)),
+			buildStatusCodeFilter(envoy_config_accesslog_v3.ComparisonFilter_LE, uint32(n*100+99)),
+		}
+	}
+
+	n, _ := strconv.Atoi(key)
+	return []*envoy_config_accesslog_v3.AccessLogFilter{
+		buildStatusCodeFilter(envoy_config_accesslog_v3.ComparisonFilter_EQ, uint32(n)),
+	}
+}
+
+func buildStatusCodeFilter(op envoy_config_accesslog_v3.ComparisonFilter_Op, value uint32) *envoy_config_accesslog_v3.AccessLogFilter {
+	return &envoy_config_accesslog_v3.AccessLogFilter{
+		FilterSpecifier: &envoy_config_accesslog_v3.AccessLogFilter_StatusCodeFilter{
+			StatusCodeFilter: &envoy_config_accesslog_v3.StatusCodeFilter{
+				Comparison: &envoy_config_accesslog_v3.ComparisonFilter{
+					Op: op,
+					Value: &envoy_config_core_v3.RuntimeUInt32{
+						DefaultValue: value,
+						RuntimeKey:   "pomerium.error_pages.status_code",
+					},
+				},
+			},
+		},
+	}
+}
@@ -92,6 +92,37 @@ func Test_BuildLocalReplyConfig(t *testing.T) {
 `, tmpl)
 }
 
+func Test_BuildLocalReplyConfig_ErrorPages(t *testing.T) {
+	t.Parallel()
+
+	b := envoyconfig.Builder{}
+	opts := config.NewDefaultOptions()
+	opts.Policies = []config.Policy{{
+		ID:   "route-1",
+		Name: "App",
+		From: "https://from.example.com",
+		ErrorPages: map[string]string{
+			"5xx": `<p style="width:100%">{{.RouteName}} {{.Status}} {{.RequestID}}</p>`,
+			"503": `<p>{{.RouteName}} is unavailable</p>`,
+		},
+	}}
+	lrc, err := b.BuildLocalReplyConfig(opts)
+	require.NoError(t, err)
+
+	var bodies []string
+	for _, m := range lrc.Mappers {
+		filters := m.GetFilter().GetAndFilter().GetFilters()
+		if len(filters) < 2 || filters[1].GetMetadataFilter().GetMatcher().GetPath()[0].GetKey() != "pomerium_error_pages_route_id" {
+			continue
+		}
+		bodies = append(bodies, string(m.GetBodyFormatOverride().GetTextFormatSource().GetInlineBytes()))
+	}
+	assert.Equal(t, []string{
+		`<p>App is unavailable</p>`,
+		`<p style="width:100%%">App %RESPONSE_CODE% %STREAM_ID%</p>`,
+	}, bodies, "should use exact status codes before status classes")
+}
+
 func TestLocalReply(t *testing.T) {
 	env := testenv.New(t)
 
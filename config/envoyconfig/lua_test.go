@@ -76,7 +76,7 @@ func TestLuaLocalReplyContentType(t *testing.T) {
 			"content-type": tc.contentType,
 		}
 		dynamicMetadata := map[string]map[string]any{}
-		handle := newLuaRequestHandle(L, headers, dynamicMetadata)
+		handle := newLuaResponseHandle(L, headers, map[string]any{}, dynamicMetadata)
 
 		err = L.CallByParam(lua.P{
 			Fn:      L.GetGlobal("envoy_on_request"),
@@ -90,6 +90,30 @@ func TestLuaLocalReplyContentType(t *testing.T) {
 			},
 		}, dynamicMetadata)
 	}
+
+	t.Run("error pages", func(t *testing.T) {
+		headers := map[string]string{
+			"accept": "text/html",
+		}
+		metadata := map[string]any{
+			"error_pages_route_id": "route-1",
+		}
+		dynamicMetadata := map[string]map[string]any{}
+		handle := newLuaResponseHandle(L, headers, metadata, dynamicMetadata)
+
+		err = L.CallByParam(lua.P{
+			Fn:      L.GetGlobal("envoy_on_request"),
+			NRet:    0,
+			Protect: true,
+		}, handle)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]map[string]any{
+			"envoy.filters.http.lua": {
+				"pomerium_local_reply_type":     "html",
+				"pomerium_error_pages_route_id": "route-1",
+			},
+		}, dynamicMetadata)
+	})
 }
 
 func TestLuaRewriteHeaders(t *testing.T) {
@@ -149,22 +173,6 @@ func newLua(t *testing.T) *lua.LState {
 	return L
 }
 
-func newLuaRequestHandle(L *lua.LState,
-	headers map[string]string,
-	dynamicMetadata map[string]map[string]any,
-) lua.LValue {
-	return newLuaType(L, map[string]lua.LGFunction{
-		"headers": func(L *lua.LState) int {
-			L.Push(newLuaHeaders(L, headers))
-			return 1
-		},
-		"streamInfo": func(L *lua.LState) int {
-			L.Push(newLuaStreamInfo(L, dynamicMetadata))
-			return 1
-		},
-	})
-}
-
 func newLuaResponseHandle(L *lua.LState,
 	headers map[string]string,
 	metadata map[string]any,
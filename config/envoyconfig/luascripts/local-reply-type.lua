@@ -1,6 +1,7 @@
 -- This filter interprets the accept header of an incoming request and attempts to map it to
 -- a metadata value of either "html", "json", "grpc" or "plain". This metadata value is used to format
--- local replies in a format the client expects.
+-- local replies in a format the client expects. For routes with custom error pages it also records the
+-- route id, so that the route's error pages can be used for local replies.
 
 local function has_prefix(str, prefix)
     return str ~= nil and str:sub(1, #prefix) == prefix
@@ -58,6 +59,11 @@ function envoy_on_request(request_handle)
     local headers = request_handle:headers()
     local dynamic_meta = request_handle:streamInfo():dynamicMetadata()
 
+    local error_pages_route_id = request_handle:metadata():get("error_pages_route_id")
+    if error_pages_route_id ~= nil then
+        dynamic_meta:set("envoy.filters.http.lua", "pomerium_error_pages_route_id", error_pages_route_id)
+    end
+
     local content_type = headers:get("content-type")
     if content_type ~= nil and has_prefix(content_type, "application/grpc") then
         dynamic_meta:set("envoy.filters.http.lua", "pomerium_local_reply_type", "grpc")
@@ -0,0 +1,38 @@
+package envoyconfig
+
+import (
+	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+// buildRouteOutlierDetection builds the outlier detection for a route's
+// cluster, or nil if the route doesn't configure outlier detection.
+func buildRouteOutlierDetection(policy *config.Policy) *envoy_config_cluster_v3.OutlierDetection {
+	src := policy.OutlierDetection
+	if src == nil {
+		return nil
+	}
+
+	dst := new(envoy_config_cluster_v3.OutlierDetection)
+	if src.Consecutive5xx.IsSet() {
+		dst.Consecutive_5Xx = wrapperspb.UInt32(src.Consecutive5xx.Uint32)
+	}
+	if src.ConsecutiveGatewayFailure.IsSet() {
+		dst.ConsecutiveGatewayFailure = wrapperspb.UInt32(src.ConsecutiveGatewayFailure.Uint32)
+		// envoy doesn't enforce gateway failure ejections by default
+		dst.EnforcingConsecutiveGatewayFailure = wrapperspb.UInt32(100)
+	}
+	if src.Interval != nil {
+		dst.Interval = durationpb.New(*src.Interval)
+	}
+	if src.BaseEjectionTime != nil {
+		dst.BaseEjectionTime = durationpb.New(*src.BaseEjectionTime)
+	}
+	if src.MaxEjectionPercent.IsSet() {
+		dst.MaxEjectionPercent = wrapperspb.UInt32(src.MaxEjectionPercent.Uint32)
+	}
+	return dst
+}
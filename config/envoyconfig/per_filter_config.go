@@ -2,14 +2,58 @@ package envoyconfig
 
 import (
 	"strconv"
+	"time"
 
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_common_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	envoy_extensions_filters_http_cors_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
 	envoy_extensions_filters_http_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoy_extensions_filters_http_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	envoy_extensions_filters_http_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/pomerium/pomerium/config"
 )
 
 // PerFilterConfigExtAuthzName is the name of the ext authz filter to apply config to
 const PerFilterConfigExtAuthzName = "envoy.filters.http.ext_authz"
 
+// PerFilterConfigLocalRateLimitName is the name of the local rate limit filter to apply config to
+const PerFilterConfigLocalRateLimitName = "envoy.filters.http.local_ratelimit"
+
+// PerFilterConfigGRPCWebName is the name of the gRPC-Web filter to apply config to
+const PerFilterConfigGRPCWebName = "envoy.filters.http.grpc_web"
+
+// PerFilterConfigConnectGRPCBridgeName is the name of the Connect to gRPC bridge filter to apply config to
+const PerFilterConfigConnectGRPCBridgeName = "envoy.filters.http.connect_grpc_bridge"
+
+// PerFilterConfigCORSName is the name of the CORS filter to apply config to
+const PerFilterConfigCORSName = "envoy.filters.http.cors"
+
+// PerFilterConfigExtProcName is the name of the external processing filter to apply config to
+const PerFilterConfigExtProcName = "envoy.filters.http.ext_proc"
+
+// headers used by gRPC-Web and Connect clients
+const (
+	grpcWebCORSAllowMethods  = "GET,POST,OPTIONS"
+	grpcWebCORSAllowHeaders  = "authorization,content-type,x-grpc-web,x-user-agent,grpc-timeout,connect-protocol-version,connect-timeout-ms"
+	grpcWebCORSExposeHeaders = "grpc-status,grpc-message,grpc-status-details-bin"
+	grpcWebCORSMaxAge        = "7200"
+)
+
+const (
+	localRateLimitStatPrefix    = "pomerium_rate_limit"
+	localRateLimitDescriptorKey = "pomerium_rate_limit_key"
+	// localRateLimitMaxDynamicDescriptors limits the number of users and IP
+	// addresses tracked per route. The least recently used are evicted first.
+	localRateLimitMaxDynamicDescriptors = 10000
+)
+
 // PerFilterConfigExtAuthzContextExtensions returns a per-filter config for ext authz that disables ext-authz.
 func PerFilterConfigExtAuthzContextExtensions(authzContextExtensions map[string]string) *anypb.Any {
 	return marshalAny(&envoy_extensions_filters_http_ext_authz_v3.ExtAuthzPerRoute{
@@ -76,3 +120,115 @@ func ExtAuthzContextExtensionsRouteChecksum(extAuthzContextExtensions map[string
 	v, _ := strconv.ParseUint(extAuthzContextExtensions["route_checksum"], 10, 64)
 	return v
 }
+
+// PerFilterConfigEnabled returns a per-filter config that enables a filter
+// which is disabled by default.
+func PerFilterConfigEnabled() *anypb.Any {
+	return marshalAny(&envoy_config_route_v3.FilterConfig{})
+}
+
+// PerFilterConfigGRPCWebCORS returns a per-filter config for the CORS filter
+// that allows gRPC-Web and Connect requests from the allowed origins.
+func PerFilterConfigGRPCWebCORS(grpcWeb *config.GRPCWeb) *anypb.Any {
+	var origins []*envoy_type_matcher_v3.StringMatcher
+	for _, origin := range grpcWeb.AllowedOrigins {
+		origins = append(origins, &envoy_type_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: origin},
+		})
+	}
+	return marshalAny(&envoy_extensions_filters_http_cors_v3.CorsPolicy{
+		AllowOriginStringMatch: origins,
+		AllowMethods:           grpcWebCORSAllowMethods,
+		AllowHeaders:           grpcWebCORSAllowHeaders,
+		ExposeHeaders:          grpcWebCORSExposeHeaders,
+		MaxAge:                 grpcWebCORSMaxAge,
+		AllowCredentials:       wrapperspb.Bool(true),
+	})
+}
+
+// PerFilterConfigExtProc returns a per-filter config for the external
+// processing filter that enables it and sends requests to the given cluster.
+func PerFilterConfigExtProc(clusterID string, ep *config.ExternalProcessing) *anypb.Any {
+	grpcService := &envoy_config_core_v3.GrpcService{
+		TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+			EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+				ClusterName: clusterID,
+			},
+		},
+	}
+	if ep.Timeout != nil {
+		grpcService.Timeout = durationpb.New(*ep.Timeout)
+	}
+	return marshalAny(&envoy_config_route_v3.FilterConfig{
+		Config: marshalAny(&envoy_extensions_filters_http_ext_proc_v3.ExtProcPerRoute{
+			Override: &envoy_extensions_filters_http_ext_proc_v3.ExtProcPerRoute_Overrides{
+				Overrides: &envoy_extensions_filters_http_ext_proc_v3.ExtProcOverrides{
+					GrpcService:      grpcService,
+					ProcessingMode:   buildExtProcProcessingMode(ep.ProcessingMode),
+					FailureModeAllow: wrapperspb.Bool(ep.FailureModeAllow),
+				},
+			},
+		}),
+	})
+}
+
+func buildExtProcProcessingMode(mode config.ExternalProcessingMode) *envoy_extensions_filters_http_ext_proc_v3.ProcessingMode {
+	headerMode := func(s string) envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_HeaderSendMode {
+		switch s {
+		case config.ExternalProcessingHeaderModeSend:
+			return envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_SEND
+		case config.ExternalProcessingHeaderModeSkip:
+			return envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_SKIP
+		default:
+			return envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_DEFAULT
+		}
+	}
+	bodyMode := func(s string) envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_BodySendMode {
+		switch s {
+		case config.ExternalProcessingBodyModeStreamed:
+			return envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_STREAMED
+		case config.ExternalProcessingBodyModeBuffered:
+			return envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_BUFFERED
+		case config.ExternalProcessingBodyModeBufferedPartial:
+			return envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_BUFFERED_PARTIAL
+		default:
+			return envoy_extensions_filters_http_ext_proc_v3.ProcessingMode_NONE
+		}
+	}
+	return &envoy_extensions_filters_http_ext_proc_v3.ProcessingMode{
+		RequestHeaderMode:  headerMode(mode.RequestHeaders),
+		ResponseHeaderMode: headerMode(mode.ResponseHeaders),
+		RequestBodyMode:    bodyMode(mode.RequestBody),
+		ResponseBodyMode:   bodyMode(mode.ResponseBody),
+	}
+}
+
+// PerFilterConfigLocalRateLimit returns a per-filter config for the local rate
+// limit filter that enables it for a route. Each rate limit key, set by
+// authorize, gets its own token bucket.
+func PerFilterConfigLocalRateLimit(rateLimit *config.RateLimit) *anypb.Any {
+	tokenBucket := &envoy_type_v3.TokenBucket{
+		MaxTokens:     rateLimit.GetBurst(),
+		TokensPerFill: wrapperspb.UInt32(rateLimit.RequestsPerSecond),
+		FillInterval:  durationpb.New(time.Second),
+	}
+	enabled := &envoy_config_core_v3.RuntimeFractionalPercent{
+		DefaultValue: &envoy_type_v3.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+		},
+	}
+	return marshalAny(&envoy_extensions_filters_http_local_ratelimit_v3.LocalRateLimit{
+		StatPrefix:     localRateLimitStatPrefix,
+		TokenBucket:    tokenBucket,
+		FilterEnabled:  enabled,
+		FilterEnforced: enabled,
+		Descriptors: []*envoy_extensions_common_ratelimit_v3.LocalRateLimitDescriptor{{
+			// an empty value matches every key
+			Entries:     []*envoy_extensions_common_ratelimit_v3.RateLimitDescriptor_Entry{{Key: localRateLimitDescriptorKey}},
+			TokenBucket: tokenBucket,
+		}},
+		AlwaysConsumeDefaultTokenBucket: wrapperspb.Bool(false),
+		MaxDynamicDescriptors:           wrapperspb.UInt32(localRateLimitMaxDynamicDescriptors),
+	})
+}
@@ -0,0 +1,39 @@
+package envoyconfig
+
+import (
+	"slices"
+	"strings"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+const retryOnRetriableStatusCodes = "retriable-status-codes"
+
+func buildRouteRetryPolicy(policy *config.Policy) *envoy_config_route_v3.RetryPolicy {
+	src := policy.RetryPolicy
+	if src == nil {
+		return nil
+	}
+
+	retryOn := slices.Clone(src.RetryOn)
+	// retriable status codes are ignored by envoy without this condition
+	if len(src.RetriableStatusCodes) > 0 && !slices.Contains(retryOn, retryOnRetriableStatusCodes) {
+		retryOn = append(retryOn, retryOnRetriableStatusCodes)
+	}
+
+	dst := &envoy_config_route_v3.RetryPolicy{
+		RetryOn:              strings.Join(retryOn, ","),
+		RetriableStatusCodes: src.RetriableStatusCodes,
+	}
+	if src.NumRetries.IsSet() {
+		dst.NumRetries = wrapperspb.UInt32(src.NumRetries.Uint32)
+	}
+	if src.PerTryTimeout != nil {
+		dst.PerTryTimeout = durationpb.New(*src.PerTryTimeout)
+	}
+	return dst
+}
@@ -170,6 +170,12 @@ func (b *Builder) buildControlPlanePrefixRoute(
 	return r
 }
 
+// getExtProcClusterID returns the ID of the cluster for a policy's external
+// processing service.
+func getExtProcClusterID(policy *config.Policy) string {
+	return getClusterID(policy) + "-ext-proc"
+}
+
 // getClusterID returns a cluster ID
 var getClusterID = func(policy *config.Policy) string {
 	prefix := getClusterStatsName(policy)
@@ -287,6 +293,11 @@ func (b *Builder) buildRouteForPolicyAndMatch(
 
 	routeChecksum := policy.Checksum()
 
+	responseHeadersToAdd, responseHeadersToRemove, err := getResponseHeaders(cfg.Options, policy)
+	if err != nil {
+		return nil, err
+	}
+
 	route := &envoy_config_route_v3.Route{
 		Name:  name,
 		Match: match,
@@ -294,9 +305,10 @@ func (b *Builder) buildRouteForPolicyAndMatch(
 			Operation: "ingress: ${method} ${host}${path}",
 			Propagate: wrapperspb.Bool(false),
 		},
-		Metadata:               &envoy_config_core_v3.Metadata{},
-		RequestHeadersToRemove: getRequestHeadersToRemove(cfg.Options, policy),
-		ResponseHeadersToAdd:   toEnvoyHeaders(cfg.Options.GetSetResponseHeadersForPolicy(policy)),
+		Metadata:                &envoy_config_core_v3.Metadata{},
+		RequestHeadersToRemove:  getRequestHeadersToRemove(cfg.Options, policy),
+		ResponseHeadersToAdd:    responseHeadersToAdd,
+		ResponseHeadersToRemove: responseHeadersToRemove,
 	}
 	if policy.Redirect != nil {
 		action, err := b.buildPolicyRouteRedirectAction(policy.Redirect)
@@ -318,6 +330,9 @@ func (b *Builder) buildRouteForPolicyAndMatch(
 	luaMetadata := map[string]*structpb.Value{
 		"rewrite_response_headers": getRewriteHeadersMetadata(policy.RewriteResponseHeaders),
 	}
+	if len(policy.ErrorPages) > 0 {
+		luaMetadata["error_pages_route_id"] = structpb.NewStringValue(routeID)
+	}
 
 	// disable authentication entirely when the proxy is fronting authenticate
 	isFrontingAuthenticate, err := isProxyFrontingAuthenticate(cfg.Options, fromURL.Hostname())
@@ -337,9 +352,25 @@ func (b *Builder) buildRouteForPolicyAndMatch(
 		route.TypedPerFilterConfig = map[string]*anypb.Any{
 			PerFilterConfigExtAuthzName: extAuthzCfg,
 		}
+		if policy.RateLimit != nil {
+			route.TypedPerFilterConfig[PerFilterConfigLocalRateLimitName] = PerFilterConfigLocalRateLimit(policy.RateLimit)
+		}
+		if policy.GRPCWeb != nil {
+			route.TypedPerFilterConfig[PerFilterConfigGRPCWebName] = PerFilterConfigEnabled()
+			if policy.GRPCWeb.Connect {
+				route.TypedPerFilterConfig[PerFilterConfigConnectGRPCBridgeName] = PerFilterConfigEnabled()
+			}
+			if len(policy.GRPCWeb.AllowedOrigins) > 0 {
+				route.TypedPerFilterConfig[PerFilterConfigCORSName] = PerFilterConfigGRPCWebCORS(policy.GRPCWeb)
+			}
+		}
+		if policy.ExternalProcessing != nil {
+			route.TypedPerFilterConfig[PerFilterConfigExtProcName] = PerFilterConfigExtProc(
+				getExtProcClusterID(policy), policy.ExternalProcessing)
+		}
 		luaMetadata["remove_pomerium_cookie"] = &structpb.Value{
 			Kind: &structpb.Value_StringValue{
-				StringValue: cfg.Options.CookieName,
+				StringValue: cfg.Options.GetCookieName(),
 			},
 		}
 		luaMetadata["remove_pomerium_authorization"] = &structpb.Value{
@@ -494,7 +525,22 @@ func (b *Builder) buildPolicyRouteRouteAction(options *config.Options, policy *c
 			},
 		},
 	}
+	if policy.RateLimit != nil {
+		// rate limit by the rate limit key, which is added by authorize.
+		action.RateLimits = []*envoy_config_route_v3.RateLimit{{
+			Actions: []*envoy_config_route_v3.RateLimit_Action{{
+				ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_RequestHeaders_{
+					RequestHeaders: &envoy_config_route_v3.RateLimit_Action_RequestHeaders{
+						HeaderName:    httputil.HeaderPomeriumRateLimitKey,
+						DescriptorKey: localRateLimitDescriptorKey,
+					},
+				},
+			}},
+		}}
+	}
+	action.RetryPolicy = buildRouteRetryPolicy(policy)
 	setHostRewriteOptions(policy, action)
+	action.MaxStreamDuration = getRouteMaxStreamDuration(options, policy)
 
 	return action, nil
 }
@@ -577,6 +623,9 @@ func getRequestHeadersToRemove(options *config.Options, policy *config.Policy) [
 			requestHeadersToRemove = append(requestHeadersToRemove, headerName)
 		}
 	}
+	if policy.RateLimit != nil {
+		requestHeadersToRemove = append(requestHeadersToRemove, httputil.HeaderPomeriumRateLimitKey)
+	}
 	// remove these headers to prevent a user from re-proxying requests through the control plane
 	requestHeadersToRemove = append(requestHeadersToRemove,
 		httputil.HeaderPomeriumReproxyPolicy,
@@ -608,6 +657,22 @@ func getRouteIdleTimeout(policy *config.Policy) *durationpb.Duration {
 	return idleTimeout
 }
 
+// getRouteMaxStreamDuration limits how long upgraded connections may stay open
+// when the upgraded connection drain window is set. Authorize shortens the limit
+// of each connection to when its session expires, so this is only an upper bound
+// for connections which would outlive the longest possible session.
+func getRouteMaxStreamDuration(options *config.Options, policy *config.Policy) *envoy_config_route_v3.RouteAction_MaxStreamDuration {
+	if options.UpgradedConnectionDrainWindow == nil || policy.AllowPublicUnauthenticatedAccess {
+		return nil
+	}
+	if !policy.AllowWebsockets && !policy.AllowSPDY && !policy.IsTCP() && !policy.IsUDP() {
+		return nil
+	}
+	return &envoy_config_route_v3.RouteAction_MaxStreamDuration{
+		MaxStreamDuration: durationpb.New(options.CookieExpire + *options.UpgradedConnectionDrainWindow),
+	}
+}
+
 func shouldDisableStreamIdleTimeout(policy *config.Policy) bool {
 	return policy.AllowWebsockets ||
 		policy.IsTCP() ||
@@ -0,0 +1,100 @@
+package envoyconfig
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/headertemplate"
+)
+
+// ResponseHeaderClaimsMetadataKey is the key of the ext_authz dynamic
+// metadata containing the claims referenced by response headers.
+const ResponseHeaderClaimsMetadataKey = "response_header_claims"
+
+// getResponseHeaders returns the response headers to add and remove for a
+// route. The global and route set_response_headers are applied first,
+// followed by the route's response header rules in order.
+func getResponseHeaders(options *config.Options, policy *config.Policy) (
+	toAdd []*envoy_config_core_v3.HeaderValueOption,
+	toRemove []string,
+	err error,
+) {
+	toAdd = toEnvoyHeaders(options.GetSetResponseHeadersForPolicy(policy))
+
+	for _, h := range policy.ResponseHeaders {
+		switch h.Action {
+		case config.ResponseHeaderActionSet:
+			value, err := renderResponseHeaderValue(policy, h.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+			toAdd = append(removeEnvoyHeader(toAdd, h.Name), mkEnvoyHeader(h.Name, value))
+		case config.ResponseHeaderActionAppend:
+			value, err := renderResponseHeaderValue(policy, h.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+			toAdd = append(toAdd, &envoy_config_core_v3.HeaderValueOption{
+				Header: &envoy_config_core_v3.HeaderValue{
+					Key:   h.Name,
+					Value: value,
+				},
+				AppendAction: envoy_config_core_v3.HeaderValueOption_APPEND_IF_EXISTS_OR_ADD,
+			})
+		case config.ResponseHeaderActionRemove:
+			toAdd = removeEnvoyHeader(toAdd, h.Name)
+			toRemove = append(toRemove, h.Name)
+		}
+	}
+
+	sort.Strings(toRemove)
+	return toAdd, toRemove, nil
+}
+
+// renderResponseHeaderValue converts a response header value template into an
+// envoy header value. Route variables are replaced with their values and
+// claim variables are read from the dynamic metadata set by authorize.
+func renderResponseHeaderValue(policy *config.Policy, src string) (string, error) {
+	var err error
+	dst := headertemplate.Render(escapeEnvoyHeaderValue(src), func(ref []string) string {
+		v, e := config.ParseResponseHeaderVariable(ref)
+		if e != nil {
+			err = e
+			return ""
+		}
+
+		if v.Claim != "" {
+			return "%DYNAMIC_METADATA(" + PerFilterConfigExtAuthzName + ":" +
+				ResponseHeaderClaimsMetadataKey + ":" + v.Claim + ")%"
+		}
+
+		value, e := policy.GetResponseHeaderRouteField(v.RouteField)
+		if e != nil {
+			err = e
+			return ""
+		}
+		return escapeEnvoyHeaderValue(value)
+	})
+	return dst, err
+}
+
+// escapeEnvoyHeaderValue escapes text so envoy does not interpret it as a
+// command operator.
+func escapeEnvoyHeaderValue(s string) string {
+	return strings.ReplaceAll(s, "%", "%%")
+}
+
+func removeEnvoyHeader(headers []*envoy_config_core_v3.HeaderValueOption, name string) []*envoy_config_core_v3.HeaderValueOption {
+	name = http.CanonicalHeaderKey(name)
+	filtered := make([]*envoy_config_core_v3.HeaderValueOption, 0, len(headers))
+	for _, h := range headers {
+		if http.CanonicalHeaderKey(h.GetHeader().GetKey()) != name {
+			filtered = append(filtered, h)
+		}
+	}
+	return filtered
+}
@@ -11,6 +11,7 @@ import (
 	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v9"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/pomerium/pomerium/config"
@@ -312,6 +313,23 @@ func TestTimeouts(t *testing.T) {
 	}
 }
 
+func TestRouteMaxStreamDuration(t *testing.T) {
+	t.Parallel()
+
+	options := &config.Options{CookieExpire: time.Hour}
+	websockets := &config.Policy{AllowWebsockets: true}
+	assert.Nil(t, getRouteMaxStreamDuration(options, websockets),
+		"should not limit streams without a drain window")
+
+	options.UpgradedConnectionDrainWindow = ptr(time.Minute)
+	testutil.AssertProtoJSONEqual(t, `{"maxStreamDuration": "3660s"}`,
+		getRouteMaxStreamDuration(options, websockets))
+	assert.Nil(t, getRouteMaxStreamDuration(options, &config.Policy{}),
+		"should not limit routes without upgrades")
+	assert.Nil(t, getRouteMaxStreamDuration(options, &config.Policy{AllowWebsockets: true, AllowPublicUnauthenticatedAccess: true}),
+		"should not limit public routes")
+}
+
 func Test_buildPolicyRoutes(t *testing.T) {
 	defer func(f func(*config.Policy) string) {
 		getClusterID = f
@@ -2430,3 +2448,215 @@ func Test_buildPomeriumHTTPRoutesWithMCP(t *testing.T) {
 		]`, routes)
 	})
 }
+
+func Test_getResponseHeaders(t *testing.T) {
+	t.Parallel()
+
+	options := &config.Options{SetResponseHeaders: map[string]string{
+		"X-Frame-Options": "SAMEORIGIN",
+		"X-Global":        "global",
+	}}
+	policy := &config.Policy{
+		From: "https://from.example.com",
+		To:   mustParseWeightedURLs(t, "https://to.example.com"),
+		Name: "100% example",
+		ResponseHeaders: []config.ResponseHeader{
+			{Action: config.ResponseHeaderActionSet, Name: "x-frame-options", Value: "DENY"},
+			{Action: config.ResponseHeaderActionAppend, Name: "X-User", Value: "${pomerium.claims.email}"},
+			{Action: config.ResponseHeaderActionSet, Name: "X-Route", Value: "$pomerium.route.name ($pomerium.route.from)"},
+			{Action: config.ResponseHeaderActionRemove, Name: "X-Global"},
+			{Action: config.ResponseHeaderActionRemove, Name: "Server"},
+		},
+	}
+
+	toAdd, toRemove, err := getResponseHeaders(options, policy)
+	require.NoError(t, err)
+	testutil.AssertProtoJSONEqual(t, `[
+		{
+			"appendAction": "OVERWRITE_IF_EXISTS_OR_ADD",
+			"header": { "key": "x-frame-options", "value": "DENY" }
+		},
+		{
+			"appendAction": "APPEND_IF_EXISTS_OR_ADD",
+			"header": {
+				"key": "X-User",
+				"value": "%DYNAMIC_METADATA(envoy.filters.http.ext_authz:response_header_claims:email)%"
+			}
+		},
+		{
+			"appendAction": "OVERWRITE_IF_EXISTS_OR_ADD",
+			"header": { "key": "X-Route", "value": "100%% example (https://from.example.com)" }
+		}
+	]`, toAdd)
+	assert.Equal(t, []string{"Server", "X-Global"}, toRemove)
+}
+
+func Test_buildPolicyRouteRateLimit(t *testing.T) {
+	t.Parallel()
+
+	b := &Builder{filemgr: filemgr.NewManager(), reproxy: reproxy.New()}
+	policy := &config.Policy{
+		From:      "https://from.example.com",
+		To:        mustParseWeightedURLs(t, "https://to.example.com"),
+		RateLimit: &config.RateLimit{RequestsPerSecond: 10, Burst: 20},
+	}
+	route, err := b.buildRouteForPolicyAndMatch(&config.Config{Options: config.NewDefaultOptions()}, policy, "policy-1", mkRouteMatch(policy))
+	require.NoError(t, err)
+
+	testutil.AssertProtoJSONEqual(t, `[{
+		"actions": [{
+			"requestHeaders": {
+				"headerName": "x-pomerium-rate-limit-key",
+				"descriptorKey": "pomerium_rate_limit_key"
+			}
+		}]
+	}]`, route.GetRoute().GetRateLimits())
+	testutil.AssertProtoJSONEqual(t, `{
+		"@type": "type.googleapis.com/envoy.extensions.filters.http.local_ratelimit.v3.LocalRateLimit",
+		"statPrefix": "pomerium_rate_limit",
+		"tokenBucket": { "maxTokens": 20, "tokensPerFill": 10, "fillInterval": "1s" },
+		"filterEnabled": { "defaultValue": { "numerator": 100 } },
+		"filterEnforced": { "defaultValue": { "numerator": 100 } },
+		"descriptors": [{
+			"entries": [{ "key": "pomerium_rate_limit_key" }],
+			"tokenBucket": { "maxTokens": 20, "tokensPerFill": 10, "fillInterval": "1s" }
+		}],
+		"alwaysConsumeDefaultTokenBucket": false,
+		"maxDynamicDescriptors": 10000
+	}`, route.GetTypedPerFilterConfig()[PerFilterConfigLocalRateLimitName])
+	assert.Contains(t, route.GetRequestHeadersToRemove(), "x-pomerium-rate-limit-key")
+}
+
+func Test_buildPolicyRouteGRPCWeb(t *testing.T) {
+	t.Parallel()
+
+	b := &Builder{filemgr: filemgr.NewManager(), reproxy: reproxy.New()}
+
+	t.Run("grpc-web", func(t *testing.T) {
+		t.Parallel()
+
+		policy := &config.Policy{
+			From:    "https://from.example.com",
+			To:      mustParseWeightedURLs(t, "https://to.example.com"),
+			GRPCWeb: &config.GRPCWeb{},
+		}
+		route, err := b.buildRouteForPolicyAndMatch(&config.Config{Options: config.NewDefaultOptions()}, policy, "policy-1", mkRouteMatch(policy))
+		require.NoError(t, err)
+
+		testutil.AssertProtoJSONEqual(t, `{
+			"@type": "type.googleapis.com/envoy.config.route.v3.FilterConfig"
+		}`, route.GetTypedPerFilterConfig()[PerFilterConfigGRPCWebName])
+		assert.NotContains(t, route.GetTypedPerFilterConfig(), PerFilterConfigConnectGRPCBridgeName)
+		assert.NotContains(t, route.GetTypedPerFilterConfig(), PerFilterConfigCORSName)
+	})
+	t.Run("connect with cors", func(t *testing.T) {
+		t.Parallel()
+
+		policy := &config.Policy{
+			From: "https://from.example.com",
+			To:   mustParseWeightedURLs(t, "https://to.example.com"),
+			GRPCWeb: &config.GRPCWeb{
+				Connect:        true,
+				AllowedOrigins: []string{"https://app.example.com", "https://admin.example.com"},
+			},
+		}
+		route, err := b.buildRouteForPolicyAndMatch(&config.Config{Options: config.NewDefaultOptions()}, policy, "policy-1", mkRouteMatch(policy))
+		require.NoError(t, err)
+
+		testutil.AssertProtoJSONEqual(t, `{
+			"@type": "type.googleapis.com/envoy.config.route.v3.FilterConfig"
+		}`, route.GetTypedPerFilterConfig()[PerFilterConfigConnectGRPCBridgeName])
+		testutil.AssertProtoJSONEqual(t, `{
+			"@type": "type.googleapis.com/envoy.extensions.filters.http.cors.v3.CorsPolicy",
+			"allowOriginStringMatch": [
+				{ "exact": "https://app.example.com" },
+				{ "exact": "https://admin.example.com" }
+			],
+			"allowMethods": "GET,POST,OPTIONS",
+			"allowHeaders": "authorization,content-type,x-grpc-web,x-user-agent,grpc-timeout,connect-protocol-version,connect-timeout-ms",
+			"exposeHeaders": "grpc-status,grpc-message,grpc-status-details-bin",
+			"maxAge": "7200",
+			"allowCredentials": true
+		}`, route.GetTypedPerFilterConfig()[PerFilterConfigCORSName])
+	})
+}
+
+func Test_buildPolicyRouteExternalProcessing(t *testing.T) {
+	t.Parallel()
+
+	b := &Builder{filemgr: filemgr.NewManager(), reproxy: reproxy.New()}
+	policy := &config.Policy{
+		From: "https://from.example.com",
+		To:   mustParseWeightedURLs(t, "https://to.example.com"),
+		ExternalProcessing: &config.ExternalProcessing{
+			Target:           "http://scanner.example.com:9000",
+			Timeout:          ptr(2 * time.Second),
+			FailureModeAllow: true,
+			ProcessingMode: config.ExternalProcessingMode{
+				RequestHeaders: config.ExternalProcessingHeaderModeSend,
+				RequestBody:    config.ExternalProcessingBodyModeBuffered,
+			},
+		},
+	}
+	route, err := b.buildRouteForPolicyAndMatch(&config.Config{Options: config.NewDefaultOptions()}, policy, "policy-1", mkRouteMatch(policy))
+	require.NoError(t, err)
+
+	testutil.AssertProtoJSONEqual(t, `{
+		"@type": "type.googleapis.com/envoy.config.route.v3.FilterConfig",
+		"config": {
+			"@type": "type.googleapis.com/envoy.extensions.filters.http.ext_proc.v3.ExtProcPerRoute",
+			"overrides": {
+				"grpcService": {
+					"envoyGrpc": { "clusterName": "`+getExtProcClusterID(policy)+`" },
+					"timeout": "2s"
+				},
+				"processingMode": {
+					"requestHeaderMode": "SEND",
+					"requestBodyMode": "BUFFERED"
+				},
+				"failureModeAllow": true
+			}
+		}
+	}`, route.GetTypedPerFilterConfig()[PerFilterConfigExtProcName])
+}
+
+func Test_buildPolicyRouteRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	b := &Builder{filemgr: filemgr.NewManager(), reproxy: reproxy.New()}
+
+	t.Run("retry policy", func(t *testing.T) {
+		t.Parallel()
+
+		policy := &config.Policy{
+			From: "https://from.example.com",
+			To:   mustParseWeightedURLs(t, "https://to.example.com"),
+			RetryPolicy: &config.RetryPolicy{
+				RetryOn:              []string{"connect-failure"},
+				NumRetries:           null.Uint32From(3),
+				PerTryTimeout:        ptr(5 * time.Second),
+				RetriableStatusCodes: []uint32{502, 503},
+			},
+		}
+		route, err := b.buildRouteForPolicyAndMatch(&config.Config{Options: config.NewDefaultOptions()}, policy, "policy-1", mkRouteMatch(policy))
+		require.NoError(t, err)
+
+		testutil.AssertProtoJSONEqual(t, `{
+			"retryOn": "connect-failure,retriable-status-codes",
+			"numRetries": 3,
+			"perTryTimeout": "5s",
+			"retriableStatusCodes": [502, 503]
+		}`, route.GetRoute().GetRetryPolicy())
+	})
+	t.Run("no retry policy", func(t *testing.T) {
+		t.Parallel()
+
+		policy := &config.Policy{
+			From: "https://from.example.com",
+			To:   mustParseWeightedURLs(t, "https://to.example.com"),
+		}
+		route, err := b.buildRouteForPolicyAndMatch(&config.Config{Options: config.NewDefaultOptions()}, policy, "policy-1", mkRouteMatch(policy))
+		require.NoError(t, err)
+		assert.Nil(t, route.GetRoute().GetRetryPolicy())
+	})
+}
@@ -202,6 +202,17 @@ func clientCABundle(ctx context.Context, cfg *config.Config) []byte {
 	return bundle.Bytes()
 }
 
+// clientCRLBundle returns a bundle of the globally configured client CRLs.
+// Per-route client CRLs are only enforced by the authorize service, as envoy
+// would check them for every route on the listener.
+func clientCRLBundle(ctx context.Context, cfg *config.Config) []byte {
+	crl, err := cfg.GetDownstreamMTLSCRL()
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("invalid client CRL")
+	}
+	return crl
+}
+
 func addCAToBundle(bundle *bytes.Buffer, ca []byte) {
 	if len(ca) == 0 {
 		return
@@ -289,11 +300,20 @@ func (b *Builder) buildDownstreamTLSContextMulti(
 			TlsCertificates: envoyCerts,
 			AlpnProtocols:   getALPNProtos(cfg.Options),
 		},
+		OcspStaplePolicy: getOCSPStaplePolicy(cfg.Options),
 	}
 	b.buildDownstreamValidationContext(ctx, dtc, cfg)
 	return dtc, nil
 }
 
+func getOCSPStaplePolicy(opts *config.Options) envoy_extensions_transport_sockets_tls_v3.DownstreamTlsContext_OcspStaplePolicy {
+	if opts.OCSPStapling && opts.GetOCSPStaplingFailureMode() == config.OCSPStaplingFailureModeHard {
+		// reject handshakes using an expired staple
+		return envoy_extensions_transport_sockets_tls_v3.DownstreamTlsContext_STRICT_STAPLING
+	}
+	return envoy_extensions_transport_sockets_tls_v3.DownstreamTlsContext_LENIENT_STAPLING
+}
+
 func getALPNProtos(opts *config.Options) []string {
 	switch opts.GetCodecType() {
 	case config.CodecTypeHTTP1:
@@ -336,15 +356,8 @@ func (b *Builder) buildDownstreamValidationContext(
 		vc.TrustChainVerification = envoy_extensions_transport_sockets_tls_v3.CertificateValidationContext_ACCEPT_UNTRUSTED
 	}
 
-	if crl := cfg.Options.DownstreamMTLS.CRL; crl != "" {
-		bs, err := base64.StdEncoding.DecodeString(crl)
-		if err != nil {
-			log.Ctx(ctx).Error().Err(err).Msg("invalid client CRL")
-		} else {
-			vc.Crl = b.filemgr.BytesDataSource("client-crl.pem", bs)
-		}
-	} else if crlf := cfg.Options.DownstreamMTLS.CRLFile; crlf != "" {
-		vc.Crl = b.filemgr.FileDataSource(crlf)
+	if crl := clientCRLBundle(ctx, cfg); len(crl) > 0 {
+		vc.Crl = b.filemgr.BytesDataSource("client-crl.pem", crl)
 	}
 
 	dtc.CommonTlsContext.ValidationContextType = &envoy_extensions_transport_sockets_tls_v3.CommonTlsContext_ValidationContext{
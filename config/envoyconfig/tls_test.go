@@ -375,6 +375,32 @@ func Test_buildDownstreamTLSContext(t *testing.T) {
 			}
 		}`, downstreamTLSContext)
 	})
+	t.Run("ocsp-stapling-hard-fail", func(t *testing.T) {
+		downstreamTLSContext, err := b.buildDownstreamTLSContextMulti(t.Context(), &config.Config{Options: &config.Options{
+			OCSPStapling:            true,
+			OCSPStaplingFailureMode: config.OCSPStaplingFailureModeHard,
+		}}, nil)
+		require.NoError(t, err)
+
+		testutil.AssertProtoJSONEqual(t, `{
+			"commonTlsContext": {
+				"tlsParams": {
+					"cipherSuites": [
+						"ECDHE-ECDSA-AES256-GCM-SHA384",
+						"ECDHE-RSA-AES256-GCM-SHA384",
+						"ECDHE-ECDSA-AES128-GCM-SHA256",
+						"ECDHE-RSA-AES128-GCM-SHA256",
+						"ECDHE-ECDSA-CHACHA20-POLY1305",
+						"ECDHE-RSA-CHACHA20-POLY1305"
+					],
+					"tlsMinimumProtocolVersion": "TLSv1_2",
+					"tlsMaximumProtocolVersion": "TLSv1_3"
+				},
+				"alpnProtocols": ["h2", "http/1.1"]
+			},
+			"ocspStaplePolicy": "STRICT_STAPLING"
+		}`, downstreamTLSContext)
+	})
 }
 
 func Test_clientCABundle(t *testing.T) {
@@ -406,6 +432,32 @@ func Test_clientCABundle(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func Test_clientCRLBundle(t *testing.T) {
+	t.Parallel()
+
+	b64 := base64.StdEncoding.EncodeToString
+	cfg := &config.Config{
+		Options: &config.Options{
+			DownstreamMTLS: config.DownstreamMTLSSettings{
+				CRL:     b64([]byte("client CRL 1")),
+				CRLURLs: []string{"https://crl.example.com/ca.crl"},
+			},
+			Policies: []config.Policy{
+				{
+					From:                   "https://foo.example.com",
+					TLSDownstreamClientCRL: b64([]byte("client CRL 3")),
+				},
+			},
+		},
+		DownstreamMTLSCRLs: map[string][]byte{
+			"https://crl.example.com/ca.crl": []byte("client CRL 2"),
+		},
+	}
+	expected := []byte("client CRL 1\nclient CRL 2\n")
+	actual := clientCRLBundle(t.Context(), cfg)
+	assert.Equal(t, expected, actual, "should not include per-route CRLs")
+}
+
 func Test_getAllCertificates(t *testing.T) {
 	t.Parallel()
 
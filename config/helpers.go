@@ -23,6 +23,8 @@ const (
 	StorageInMemoryName = "memory"
 	// StorageFileName is the name of the file storage backend
 	StorageFileName = "file"
+	// StorageRedisName is the name of the Redis storage backend
+	StorageRedisName = "redis"
 )
 
 // IsAll checks to see if we should be running all services
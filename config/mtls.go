@@ -13,6 +13,7 @@ import (
 	envoy_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 
 	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpc/config"
 )
@@ -77,6 +78,20 @@ type DownstreamMTLSSettings struct {
 	// list (or bundle of CRLs) to use when validating client certificates.
 	CRLFile string `mapstructure:"crl_file" yaml:"crl_file,omitempty"`
 
+	// CRLURLs are http(s) URLs to download certificate revocation lists from
+	// when validating client certificates. The CRLs are refreshed
+	// periodically.
+	CRLURLs []string `mapstructure:"crl_urls" yaml:"crl_urls,omitempty"`
+
+	// OCSPCheck enables checking the revocation status of client certificates
+	// with the OCSP responder listed in the certificate. Responder errors are
+	// not treated as revocation unless OCSPFailClosed is set.
+	OCSPCheck bool `mapstructure:"ocsp_check" yaml:"ocsp_check,omitempty"`
+
+	// OCSPFailClosed treats client certificates as revoked when their OCSP
+	// responder can't be reached or returns an invalid response.
+	OCSPFailClosed bool `mapstructure:"ocsp_fail_closed" yaml:"ocsp_fail_closed,omitempty"`
+
 	// Enforcement indicates the behavior applied to requests without a valid
 	// client certificate.
 	Enforcement MTLSEnforcement `mapstructure:"enforcement" yaml:"enforcement,omitempty"`
@@ -163,6 +178,12 @@ func (s *DownstreamMTLSSettings) validate() error {
 		return fmt.Errorf("CRL: %w", err)
 	}
 
+	for _, rawURL := range s.CRLURLs {
+		if err := validateCRLURL(rawURL); err != nil {
+			return err
+		}
+	}
+
 	switch s.Enforcement {
 	case "",
 		MTLSEnforcementPolicy,
@@ -181,6 +202,16 @@ func (s *DownstreamMTLSSettings) validate() error {
 	return nil
 }
 
+func validateCRLURL(rawURL string) error {
+	u, err := urlutil.ParseAndValidateURL(rawURL)
+	if err != nil {
+		return fmt.Errorf("CRL URL: %w", err)
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("CRL URL: unsupported scheme: %s", u.Scheme)
+	}
+	return nil
+}
+
 func (s *DownstreamMTLSSettings) applySettingsProto(
 	ctx context.Context, p *config.DownstreamMtlsSettings,
 ) {
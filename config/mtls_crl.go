@@ -0,0 +1,193 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+const (
+	crlFetchTimeout    = 30 * time.Second
+	crlRefreshInterval = 15 * time.Minute
+	crlMaxSize         = 10 << 20
+)
+
+// GetDownstreamMTLSCRL returns the global client certificate revocation list
+// bundle, including any CRLs downloaded from crl_urls.
+func (cfg *Config) GetDownstreamMTLSCRL() ([]byte, error) {
+	crl, err := cfg.Options.DownstreamMTLS.GetCRL()
+	if err != nil {
+		return nil, err
+	}
+
+	var bundle bytes.Buffer
+	addCRLToBundle(&bundle, crl)
+	for _, rawURL := range cfg.Options.DownstreamMTLS.CRLURLs {
+		addCRLToBundle(&bundle, cfg.DownstreamMTLSCRLs[rawURL])
+	}
+	return bundle.Bytes(), nil
+}
+
+func addCRLToBundle(bundle *bytes.Buffer, crl []byte) {
+	if len(crl) == 0 {
+		return
+	}
+	bundle.Write(crl)
+	// Make sure each CRL ends with a newline character.
+	if crl[len(crl)-1] != '\n' {
+		bundle.WriteByte('\n')
+	}
+}
+
+// CRLSource is a config source which downloads the certificate revocation
+// lists in downstream_mtls.crl_urls, and triggers a change any time they
+// change.
+type CRLSource struct {
+	underlying Source
+	client     *http.Client
+
+	updateMu sync.Mutex
+	crls     map[string][]byte
+
+	mu  sync.RWMutex
+	cfg *Config
+
+	ChangeDispatcher
+}
+
+// NewCRLSource creates a new CRLSource.
+func NewCRLSource(ctx context.Context, underlying Source) *CRLSource {
+	src := &CRLSource{
+		underlying: underlying,
+		client:     &http.Client{Timeout: crlFetchTimeout},
+		crls:       make(map[string][]byte),
+	}
+	underlying.OnConfigChange(ctx, func(ctx context.Context, cfg *Config) {
+		src.update(ctx, cfg, true)
+	})
+	src.update(ctx, underlying.GetConfig(), true)
+
+	go func() {
+		ticker := time.NewTicker(crlRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				src.update(ctx, src.underlying.GetConfig(), false)
+			}
+		}
+	}()
+
+	return src
+}
+
+// GetConfig gets the underlying config with the downloaded CRLs.
+func (src *CRLSource) GetConfig() *Config {
+	src.mu.RLock()
+	defer src.mu.RUnlock()
+
+	return src.cfg
+}
+
+func (src *CRLSource) update(ctx context.Context, cfg *Config, force bool) {
+	src.updateMu.Lock()
+	defer src.updateMu.Unlock()
+
+	changed := src.refresh(ctx, cfg.Options.DownstreamMTLS.CRLURLs)
+	if !changed && !force {
+		return
+	}
+
+	cfg = cfg.Clone()
+	if len(src.crls) > 0 {
+		cfg.DownstreamMTLSCRLs = maps.Clone(src.crls)
+	}
+
+	src.mu.Lock()
+	src.cfg = cfg
+	src.mu.Unlock()
+
+	if changed {
+		log.Ctx(ctx).Info().Msg("config/crlsource: client certificate revocation lists changed, triggering update")
+	}
+	src.Trigger(ctx, cfg)
+}
+
+// refresh downloads every CRL URL, keeping the previous CRL for any URL that
+// fails to download. It returns true if any CRL changed.
+func (src *CRLSource) refresh(ctx context.Context, rawURLs []string) bool {
+	changed := false
+	seen := make(map[string]struct{}, len(rawURLs))
+	for _, rawURL := range rawURLs {
+		seen[rawURL] = struct{}{}
+
+		crl, err := fetchCRL(ctx, src.client, rawURL)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("url", rawURL).Msg("config/crlsource: failed to download certificate revocation list")
+			continue
+		}
+
+		if !bytes.Equal(src.crls[rawURL], crl) {
+			src.crls[rawURL] = crl
+			changed = true
+		}
+	}
+
+	for rawURL := range src.crls {
+		if _, ok := seen[rawURL]; !ok {
+			delete(src.crls, rawURL)
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// fetchCRL downloads a CRL and returns it in PEM format.
+func fetchCRL(ctx context.Context, client *http.Client, rawURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	}
+
+	bs, err := io.ReadAll(io.LimitReader(res.Body, crlMaxSize))
+	if err != nil {
+		return nil, err
+	}
+
+	// CRLs are usually published in DER format, but envoy expects PEM
+	if block, _ := pem.Decode(bs); block == nil {
+		if _, err := x509.ParseRevocationList(bs); err != nil {
+			return nil, fmt.Errorf("invalid CRL: %w", err)
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: bs}), nil
+	}
+
+	if _, err := cryptutil.ParseCRLs(bs); err != nil {
+		return nil, fmt.Errorf("invalid CRL: %w", err)
+	}
+	return bs, nil
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCRL(t *testing.T, number int64) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(number),
+		ThisUpdate: time.Now(),
+		NextUpdate: time.Now().Add(time.Hour),
+	}, ca, key)
+	require.NoError(t, err)
+	return crl
+}
+
+func TestCRLSource(t *testing.T) {
+	t.Parallel()
+
+	der := newTestCRL(t, 1)
+	var current atomic.Pointer[[]byte]
+	current.Store(&der)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(*current.Load())
+	}))
+	t.Cleanup(srv.Close)
+
+	opts := NewDefaultOptions()
+	opts.DownstreamMTLS.CRLURLs = []string{srv.URL + "/ca.crl"}
+	underlying := NewStaticSource(&Config{Options: opts})
+
+	src := NewCRLSource(t.Context(), underlying)
+	cfg := src.GetConfig()
+	pemCRL := pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})
+	assert.Equal(t, map[string][]byte{srv.URL + "/ca.crl": pemCRL}, cfg.DownstreamMTLSCRLs,
+		"should download and convert the CRL to PEM")
+	crl, err := cfg.GetDownstreamMTLSCRL()
+	require.NoError(t, err)
+	assert.Equal(t, pemCRL, crl)
+
+	var triggered atomic.Int32
+	src.OnConfigChange(t.Context(), func(_ context.Context, _ *Config) {
+		triggered.Add(1)
+	})
+
+	src.update(t.Context(), underlying.GetConfig(), false)
+	assert.Equal(t, int32(0), triggered.Load(), "should not trigger when unchanged")
+
+	der2 := newTestCRL(t, 2)
+	current.Store(&der2)
+	src.update(t.Context(), underlying.GetConfig(), false)
+	assert.Eventually(t, func() bool { return triggered.Load() == 1 }, time.Second, time.Millisecond*10,
+		"should trigger when a CRL changes")
+	assert.Equal(t, pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der2}),
+		src.GetConfig().DownstreamMTLSCRLs[srv.URL+"/ca.crl"])
+}
+
+func TestFetchCRL(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/invalid":
+			_, _ = w.Write([]byte("NOT A CRL"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	_, err := fetchCRL(t.Context(), srv.Client(), srv.URL+"/invalid")
+	assert.ErrorContains(t, err, "invalid CRL")
+	_, err = fetchCRL(t.Context(), srv.Client(), srv.URL+"/missing")
+	assert.ErrorContains(t, err, "unexpected status code: 404")
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/endpoints"
 	"github.com/pomerium/pomerium/pkg/grpc/config"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
 	"github.com/pomerium/pomerium/pkg/hpke"
 	"github.com/pomerium/pomerium/pkg/identity/oauth"
 	"github.com/pomerium/pomerium/pkg/identity/oauth/apple"
@@ -75,6 +76,9 @@ type Options struct {
 	// AccessLogFields are the fields to log in access logs.
 	AccessLogFields []log.AccessLogField `mapstructure:"access_log_fields" yaml:"access_log_fields,omitempty"`
 
+	// AccessLogSinks are additional destinations for the proxy's access logs.
+	AccessLogSinks []AccessLogSink `mapstructure:"access_log_sinks" yaml:"access_log_sinks,omitempty"`
+
 	// AuthorizeLogFields are the fields to log in authorize logs.
 	AuthorizeLogFields []log.AuthorizeLogField `mapstructure:"authorize_log_fields" yaml:"authorize_log_fields,omitempty"`
 
@@ -109,6 +113,14 @@ type Options struct {
 	CertFile string `mapstructure:"certificate_file" yaml:"certificate_file,omitempty"`
 	KeyFile  string `mapstructure:"certificate_key_file" yaml:"certificate_key_file,omitempty"`
 
+	// OCSPStapling enables fetching and refreshing OCSP staples for the
+	// configured certificates. Autocert certificates are always stapled.
+	OCSPStapling bool `mapstructure:"ocsp_stapling" yaml:"ocsp_stapling,omitempty"`
+	// OCSPStaplingFailureMode is either "soft" (the default), which serves a
+	// certificate without a staple once its staple expires, or "hard", which
+	// rejects TLS handshakes for the certificate instead.
+	OCSPStaplingFailureMode OCSPStaplingFailureMode `mapstructure:"ocsp_stapling_failure_mode" yaml:"ocsp_stapling_failure_mode,omitempty"`
+
 	// HttpRedirectAddr, if set, specifies the host and port to run the HTTP
 	// to HTTPS redirect server on. If empty, no redirect server is started.
 	HTTPRedirectAddr string `mapstructure:"http_redirect_addr" yaml:"http_redirect_addr,omitempty"`
@@ -142,6 +154,21 @@ type Options struct {
 	CookieHTTPOnly   bool          `mapstructure:"cookie_http_only" yaml:"cookie_http_only,omitempty"`
 	CookieExpire     time.Duration `mapstructure:"cookie_expire" yaml:"cookie_expire,omitempty"`
 	CookieSameSite   string        `mapstructure:"cookie_same_site" yaml:"cookie_same_site,omitempty"`
+	// CookieCompression compresses the session cookie before it is split into chunks. One of
+	// "deflate" or "zstd". Defaults to no compression.
+	CookieCompression string `mapstructure:"cookie_compression" yaml:"cookie_compression,omitempty"`
+	// CookiePrefix adds a "__Host-" or "__Secure-" prefix to the session cookie names, which
+	// makes browsers enforce the Secure attribute and, for "host", prevents the cookies from being
+	// set by other subdomains. One of "host" or "secure". Defaults to no prefix.
+	CookiePrefix string `mapstructure:"cookie_prefix" yaml:"cookie_prefix,omitempty"`
+	// CookiePartitioned sets the Partitioned attribute on cookies, so that browsers which block
+	// third-party cookies still send them when pomerium is embedded in an iframe.
+	CookiePartitioned bool `mapstructure:"cookie_partitioned" yaml:"cookie_partitioned,omitempty"`
+	// CookieMode sets what is stored in the session cookie. With "jwt" the cookie holds the
+	// signed session handle. With "server" the session handle is stored in the databroker and
+	// the cookie only holds an opaque random id, for deployments where the handle doesn't fit
+	// in cookies. Defaults to "jwt".
+	CookieMode string `mapstructure:"cookie_mode" yaml:"cookie_mode,omitempty"`
 
 	// Identity provider configuration variables as specified by RFC6749
 	// https://openid.net/specs/openid-connect-basic-1_0.html#RFC6749
@@ -153,6 +180,18 @@ type Options struct {
 	Scopes                         []string  `mapstructure:"idp_scopes" yaml:"idp_scopes,omitempty"`
 	IDPAccessTokenAllowedAudiences *[]string `mapstructure:"idp_access_token_allowed_audiences" yaml:"idp_access_token_allowed_audiences,omitempty"`
 
+	// IDPClaimsMappers transform identity provider claims, in order, before
+	// they are stored in session and user records.
+	IDPClaimsMappers []ClaimsMapperOptions `mapstructure:"idp_claims_mappers" yaml:"idp_claims_mappers,omitempty"`
+
+	// IDPAccessTokenIntrospectionURL is the OAuth 2.0 token introspection
+	// endpoint used to verify opaque idp access tokens. When set, access tokens
+	// are verified directly with the identity provider instead of the
+	// authenticate service.
+	//
+	// https://www.rfc-editor.org/rfc/rfc7662.html
+	IDPAccessTokenIntrospectionURL string `mapstructure:"idp_access_token_introspection_url" yaml:"idp_access_token_introspection_url,omitempty"`
+
 	// RequestParams are custom request params added to the signin request as
 	// part of an Oauth2 code flow.
 	//
@@ -160,6 +199,13 @@ type Options struct {
 	// https://openid.net/specs/openid-connect-basic-1_0.html#RequestParameters
 	RequestParams map[string]string `mapstructure:"idp_request_params" yaml:"idp_request_params,omitempty"`
 
+	// MFAACRValues are the acr values requested from the identity provider
+	// when a route requires multi-factor authentication. A login with one of
+	// these acr values, or with "mfa" in the amr claim, counts as multi-factor.
+	//
+	// https://openid.net/specs/openid-connect-core-1_0.html#AuthRequest
+	MFAACRValues []string `mapstructure:"idp_mfa_acr_values" yaml:"idp_mfa_acr_values,omitempty"`
+
 	// AuthorizeURLString is the routable destination of the authorize service's
 	// gRPC endpoint. NOTE: As many load balancers do not support
 	// externally routed gRPC so this may be an internal location.
@@ -201,12 +247,39 @@ type Options struct {
 	// When unset "default" will be used.
 	BearerTokenFormat *BearerTokenFormat `mapstructure:"bearer_token_format" yaml:"bearer_token_format,omitempty"`
 
+	// IDPTokenSessionTTL is the maximum lifetime of a session created from an
+	// idp access or identity token. The session expires when the token expires
+	// or after this duration, whichever comes first. When unset, sessions for
+	// tokens without an exp claim expire after cookie_expire.
+	IDPTokenSessionTTL time.Duration `mapstructure:"idp_token_session_ttl" yaml:"idp_token_session_ttl,omitempty"`
+
+	// IDPTokenReverifyInterval is how often the idp access token of a session
+	// created from it is verified again. Sessions whose token is no longer
+	// valid, for example because it was revoked, are deleted. When unset,
+	// tokens are only verified once.
+	IDPTokenReverifyInterval time.Duration `mapstructure:"idp_token_reverify_interval" yaml:"idp_token_reverify_interval,omitempty"`
+
+	// SCIMBearerToken enables the SCIM 2.0 provisioning endpoint of the
+	// authenticate service (/.pomerium/scim/v2). Identity providers
+	// authenticate with this bearer token to push users and groups into the
+	// databroker.
+	SCIMBearerToken     string `mapstructure:"scim_bearer_token" yaml:"scim_bearer_token,omitempty"`
+	SCIMBearerTokenFile string `mapstructure:"scim_bearer_token_file" yaml:"scim_bearer_token_file,omitempty"`
+
 	// Allowlist of group names/IDs to include in the Pomerium JWT.
 	JWTGroupsFilter JWTGroupsFilter
 
 	DefaultUpstreamTimeout time.Duration `mapstructure:"default_upstream_timeout" yaml:"default_upstream_timeout,omitempty"`
 
-	// DebugAddress is the address for the debug listener.
+	// UpgradedConnectionDrainWindow enables terminating upgraded connections,
+	// like WebSockets, once their session expires. Clients are told when the
+	// session expires and the connection is kept open for the drain window
+	// afterwards, so they can renew the session and reconnect. When unset,
+	// upgraded connections are not terminated.
+	UpgradedConnectionDrainWindow *time.Duration `mapstructure:"upgraded_connection_drain_window" yaml:"upgraded_connection_drain_window,omitempty"`
+
+	// DebugAddress is the address for the debug listener. The portal only
+	// shows route health when it is set.
 	DebugAddress null.String `mapstructure:"debug_address" yaml:"debug_address,omitempty"`
 
 	// Address/Port to bind to for prometheus metrics
@@ -235,6 +308,14 @@ type Options struct {
 
 	GRPCClientTimeout time.Duration `mapstructure:"grpc_client_timeout" yaml:"grpc_client_timeout,omitempty"`
 
+	// GRPCMaxMessageSize sets the maximum size in bytes of gRPC messages sent and received by
+	// pomerium's gRPC servers and clients. If unset, gRPC's defaults are used.
+	GRPCMaxMessageSize int `mapstructure:"grpc_max_message_size" yaml:"grpc_max_message_size,omitempty"`
+
+	// GRPCCompression sets the compressor (gzip or zstd) used for requests made by pomerium's
+	// gRPC clients. If unset, requests are not compressed.
+	GRPCCompression string `mapstructure:"grpc_compression" yaml:"grpc_compression,omitempty"`
+
 	// SSH Settings
 
 	// Address/Port to bind to for the SSH server. If unset, SSH will be disabled.
@@ -295,7 +376,10 @@ type Options struct {
 
 	RuntimeFlags RuntimeFlags `mapstructure:"runtime_flags" yaml:"runtime_flags,omitempty"`
 
-	HTTP3AdvertisePort       null.Uint32               `mapstructure:"-" yaml:"-" json:"-"`
+	// HTTP3AdvertisePort is the port advertised in the alt-svc header when the codec type is
+	// http3, e.g. when the UDP listener is behind a port mapping. Defaults to the port of the
+	// main listener.
+	HTTP3AdvertisePort       null.Uint32               `mapstructure:"http3_advertise_port" yaml:"http3_advertise_port,omitempty" json:"-"`
 	CircuitBreakerThresholds *CircuitBreakerThresholds `mapstructure:"circuit_breaker_thresholds" yaml:"circuit_breaker_thresholds" json:"circuit_breaker_thresholds"`
 	// Address/Port to bind to for health check http probes
 	HealthCheckAddr string `mapstructure:"health_check_addr" yaml:"health_check_addr,omitempty"`
@@ -328,6 +412,7 @@ var defaultOptions = Options{
 		Folder: filepath.Join(fileutil.DataDir(), "autocert"),
 	},
 	DataBroker: DataBrokerOptions{
+		MaxForwards: 1,
 		StorageType: "memory",
 	},
 	SkipXffAppend:                       false,
@@ -600,6 +685,29 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("config: invalid shared secret: %w", err)
 	}
 
+	if o.GRPCMaxMessageSize < 0 {
+		return fmt.Errorf("config: invalid grpc_max_message_size: %d", o.GRPCMaxMessageSize)
+	}
+
+	for _, m := range o.IDPClaimsMappers {
+		if err := m.validate(); err != nil {
+			return err
+		}
+	}
+	if o.IDPTokenSessionTTL < 0 {
+		return fmt.Errorf("config: invalid idp_token_session_ttl: %s", o.IDPTokenSessionTTL)
+	}
+	if o.IDPTokenReverifyInterval < 0 {
+		return fmt.Errorf("config: invalid idp_token_reverify_interval: %s", o.IDPTokenReverifyInterval)
+	}
+	if o.UpgradedConnectionDrainWindow != nil && *o.UpgradedConnectionDrainWindow < 0 {
+		return fmt.Errorf("config: invalid upgraded_connection_drain_window: %s", *o.UpgradedConnectionDrainWindow)
+	}
+
+	if !grpcutil.IsValidCompressor(o.GRPCCompression) {
+		return fmt.Errorf("config: unsupported grpc_compression: %s", o.GRPCCompression)
+	}
+
 	if o.AuthenticateURLString != "" {
 		_, err := urlutil.ParseAndValidateURL(o.AuthenticateURLString)
 		if err != nil {
@@ -613,6 +721,13 @@ func (o *Options) Validate() error {
 		}
 	}
 
+	if o.IDPAccessTokenIntrospectionURL != "" {
+		_, err := urlutil.ParseAndValidateURL(o.IDPAccessTokenIntrospectionURL)
+		if err != nil {
+			return fmt.Errorf("config: bad idp_access_token_introspection_url %s : %w", o.IDPAccessTokenIntrospectionURL, err)
+		}
+	}
+
 	if o.SignOutRedirectURLString != "" {
 		_, err := urlutil.ParseAndValidateURL(o.SignOutRedirectURLString)
 		if err != nil {
@@ -739,10 +854,30 @@ func (o *Options) Validate() error {
 		return err
 	}
 
+	if err := o.validateTCPListenPorts(); err != nil {
+		return err
+	}
+
 	if err := ValidateCookieSameSite(o.CookieSameSite); err != nil {
 		return fmt.Errorf("config: invalid cookie_same_site: %w", err)
 	}
 
+	if err := ValidateCookieCompression(o.CookieCompression); err != nil {
+		return fmt.Errorf("config: invalid cookie_compression: %w", err)
+	}
+
+	if err := ValidateCookiePrefix(o.CookiePrefix); err != nil {
+		return fmt.Errorf("config: invalid cookie_prefix: %w", err)
+	}
+
+	if err := ValidateCookieMode(o.CookieMode); err != nil {
+		return fmt.Errorf("config: invalid cookie_mode: %w", err)
+	}
+
+	if o.HTTP3AdvertisePort.Valid && (o.HTTP3AdvertisePort.Uint32 == 0 || o.HTTP3AdvertisePort.Uint32 > 65535) {
+		return fmt.Errorf("config: invalid http3_advertise_port: %d", o.HTTP3AdvertisePort.Uint32)
+	}
+
 	if err := ValidateLogLevel(o.LogLevel); err != nil {
 		return fmt.Errorf("config: invalid log_level: %w", err)
 	}
@@ -757,6 +892,16 @@ func (o *Options) Validate() error {
 		}
 	}
 
+	for i := range o.AccessLogSinks {
+		if err := o.AccessLogSinks[i].validate(); err != nil {
+			return fmt.Errorf("config: invalid access_log_sinks: %w", err)
+		}
+	}
+
+	if err := o.OCSPStaplingFailureMode.validate(); err != nil {
+		return fmt.Errorf("config: invalid ocsp_stapling_failure_mode: %w", err)
+	}
+
 	for _, field := range o.AuthorizeLogFields {
 		if err := field.Validate(); err != nil {
 			return fmt.Errorf("config: invalid authorize_log_fields: %w", err)
@@ -1396,6 +1541,19 @@ func (o *Options) GetClientSecret() (string, error) {
 	return o.ClientSecret, nil
 }
 
+// GetSCIMBearerToken gets the SCIM bearer token from either a file or the
+// config option directly. If from a file spaces are trimmed off the ends.
+func (o *Options) GetSCIMBearerToken() (string, error) {
+	if o.SCIMBearerTokenFile != "" {
+		bs, err := os.ReadFile(o.SCIMBearerTokenFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(bs)), nil
+	}
+	return o.SCIMBearerToken, nil
+}
+
 // GetCookieSecret gets the decoded cookie secret.
 func (o *Options) GetCookieSecret() ([]byte, error) {
 	cookieSecret := o.CookieSecret
@@ -1420,16 +1578,29 @@ func (o *Options) GetCookieSecret() ([]byte, error) {
 
 // GetCookieSameSite gets the cookie same site option.
 func (o *Options) GetCookieSameSite() http.SameSite {
-	str := strings.ToLower(o.CookieSameSite)
-	switch str {
-	case "strict":
-		return http.SameSiteStrictMode
-	case "lax":
-		return http.SameSiteLaxMode
-	case "none":
-		return http.SameSiteNoneMode
+	return httputil.ParseCookieSameSite(o.CookieSameSite)
+}
+
+// GetCookiePrefix gets the cookie prefix option.
+func (o *Options) GetCookiePrefix() httputil.CookiePrefix {
+	switch strings.ToLower(o.CookiePrefix) {
+	case "host":
+		return httputil.CookiePrefixHost
+	case "secure":
+		return httputil.CookiePrefixSecure
 	}
-	return http.SameSiteDefaultMode
+	return httputil.CookiePrefixNone
+}
+
+// UseServerSideSessions returns true if session handles are stored in the
+// databroker instead of the session cookie.
+func (o *Options) UseServerSideSessions() bool {
+	return strings.EqualFold(o.CookieMode, CookieModeServer)
+}
+
+// GetCookieName gets the name of the session cookie, including its prefix.
+func (o *Options) GetCookieName() string {
+	return o.GetCookiePrefix().CookieName(o.CookieName)
 }
 
 // GetCSRFSameSite gets the csrf same site option.
@@ -1486,12 +1657,13 @@ func (o *Options) GetAuthorizeLogFields() []log.AuthorizeLogField {
 // NewCookie creates a new Cookie.
 func (o *Options) NewCookie() *http.Cookie {
 	return &http.Cookie{
-		Name:     o.CookieName,
-		Domain:   o.CookieDomain,
-		Expires:  time.Now().Add(o.CookieExpire),
-		Secure:   true,
-		SameSite: o.GetCookieSameSite(),
-		HttpOnly: o.CookieHTTPOnly,
+		Name:        o.CookieName,
+		Domain:      o.CookieDomain,
+		Expires:     time.Now().Add(o.CookieExpire),
+		Secure:      true,
+		SameSite:    o.GetCookieSameSite(),
+		HttpOnly:    o.CookieHTTPOnly,
+		Partitioned: o.CookiePartitioned,
 	}
 }
 
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pomerium/pomerium/internal/urlutil"
+)
+
+// access log sink types
+const (
+	AccessLogSinkTypeFile          = "file"
+	AccessLogSinkTypeGRPC          = "grpc"
+	AccessLogSinkTypeOpenTelemetry = "otel"
+)
+
+// An AccessLogSink is an additional destination for the proxy's access logs.
+type AccessLogSink struct {
+	// Type is one of "file", "grpc" or "otel".
+	Type string `mapstructure:"type" yaml:"type" json:"type"`
+	// Path is the file to write to, for file sinks, e.g. "/dev/stdout".
+	Path string `mapstructure:"path" yaml:"path,omitempty" json:"path,omitempty"`
+	// Target is the URL of the gRPC access log service or OpenTelemetry
+	// collector, for grpc and otel sinks. An http URL uses plaintext HTTP/2.
+	Target string `mapstructure:"target" yaml:"target,omitempty" json:"target,omitempty"`
+	// LogName identifies the log to grpc and otel sinks.
+	LogName string `mapstructure:"log_name" yaml:"log_name,omitempty" json:"log_name,omitempty"`
+	// TextFormat is a format string using envoy command operators, e.g.
+	// "%REQ(:AUTHORITY)% %RESPONSE_CODE%\n". For file sinks it is the log
+	// line, and for otel sinks it is the log body.
+	TextFormat string `mapstructure:"text_format" yaml:"text_format,omitempty" json:"text_format,omitempty"`
+	// JSONFormat maps keys to format strings using envoy command operators.
+	// For file sinks it is written as a JSON object, and for otel sinks it
+	// sets the log attributes.
+	JSONFormat map[string]string `mapstructure:"json_format" yaml:"json_format,omitempty" json:"json_format,omitempty"`
+}
+
+func (sink *AccessLogSink) validate() error {
+	switch sink.Type {
+	case AccessLogSinkTypeFile:
+		if sink.Path == "" {
+			return errors.New("path is required for file sinks")
+		}
+		if sink.TextFormat != "" && len(sink.JSONFormat) > 0 {
+			return errors.New("only one of text_format or json_format may be set for file sinks")
+		}
+	case AccessLogSinkTypeGRPC, AccessLogSinkTypeOpenTelemetry:
+		if _, err := urlutil.ParseAndValidateURL(sink.Target); err != nil {
+			return fmt.Errorf("invalid target: %w", err)
+		}
+		if sink.Type == AccessLogSinkTypeGRPC && (sink.TextFormat != "" || len(sink.JSONFormat) > 0) {
+			return errors.New("grpc sinks do not support a format")
+		}
+	default:
+		return fmt.Errorf("unknown type %q", sink.Type)
+	}
+	return nil
+}
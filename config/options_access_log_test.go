@@ -0,0 +1,32 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessLogSink(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, (&AccessLogSink{Type: AccessLogSinkTypeFile, Path: "/dev/stdout"}).validate())
+	assert.NoError(t, (&AccessLogSink{Type: AccessLogSinkTypeFile, Path: "/dev/stdout", TextFormat: "%RESPONSE_CODE%\n"}).validate())
+	assert.NoError(t, (&AccessLogSink{Type: AccessLogSinkTypeGRPC, Target: "http://als.example.com:9000"}).validate())
+	assert.NoError(t, (&AccessLogSink{
+		Type:       AccessLogSinkTypeOpenTelemetry,
+		Target:     "https://otel.example.com",
+		TextFormat: "%REQ(:PATH)%",
+		JSONFormat: map[string]string{"status": "%RESPONSE_CODE%"},
+	}).validate())
+
+	assert.Error(t, (&AccessLogSink{}).validate())
+	assert.Error(t, (&AccessLogSink{Type: AccessLogSinkTypeFile}).validate())
+	assert.Error(t, (&AccessLogSink{
+		Type:       AccessLogSinkTypeFile,
+		Path:       "/dev/stdout",
+		TextFormat: "%RESPONSE_CODE%",
+		JSONFormat: map[string]string{"status": "%RESPONSE_CODE%"},
+	}).validate())
+	assert.Error(t, (&AccessLogSink{Type: AccessLogSinkTypeGRPC}).validate())
+	assert.Error(t, (&AccessLogSink{Type: AccessLogSinkTypeGRPC, Target: "http://als.example.com", TextFormat: "%RESPONSE_CODE%"}).validate())
+}
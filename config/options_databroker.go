@@ -1,11 +1,16 @@
 package config
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/volatiletech/null/v9"
 
@@ -15,28 +20,81 @@ import (
 
 // Errors
 var (
+	ErrInvalidDataBrokerACL                     = errors.New("config: invalid databroker acl")
+	ErrInvalidDataBrokerAuditLog                = errors.New("config: invalid databroker audit log")
+	ErrInvalidDataBrokerClusterFollowerCacheTTL = errors.New("config: invalid databroker cluster follower cache ttl")
 	ErrInvalidDataBrokerClusterLeaderID         = errors.New("config: invalid databroker cluster leader id")
+	ErrInvalidDataBrokerClusterLeaseServiceURL  = errors.New("config: invalid databroker cluster lease service url")
 	ErrInvalidDataBrokerClusterNodeID           = errors.New("config: invalid databroker cluster node id")
 	ErrInvalidDataBrokerClusterNodeGRPCAddress  = errors.New("config: invalid databroker cluster node grpc address")
 	ErrInvalidDataBrokerClusterNodeRaftAddress  = errors.New("config: invalid databroker cluster node raft address")
 	ErrInvalidDataBrokerServiceURL              = errors.New("config: bad databroker service url")
+	ErrInvalidDataBrokerStorageEncryptionKey    = errors.New("config: invalid databroker storage encryption key")
 	ErrInvalidDataBrokerInternalServiceURL      = errors.New("config: bad databroker internal service url")
+	ErrInvalidDataBrokerMaxForwards             = errors.New("config: invalid databroker max forwards")
+	ErrInvalidDataBrokerStorageHealthCheck      = errors.New("config: invalid databroker storage health check")
+	ErrInvalidDataBrokerStorageIndexedFields    = errors.New("config: invalid databroker storage indexed fields")
+	ErrInvalidDataBrokerStorageRecordTTL        = errors.New("config: invalid databroker storage record ttl")
+	ErrInvalidDataBrokerStorageRetention        = errors.New("config: invalid databroker storage retention")
+	ErrInvalidDataBrokerStorageSearchFields     = errors.New("config: invalid databroker storage search fields")
+	ErrInvalidDataBrokerStorageSnapshot         = errors.New("config: invalid databroker storage snapshot")
+	ErrInvalidDataBrokerSyncLimit               = errors.New("config: invalid databroker sync limit")
 	ErrMissingDataBrokerStorageConnectionString = errors.New("config: missing databroker storage backend dsn")
 	ErrUnknownDataBrokerStorageType             = errors.New("config: unknown databroker storage backend type")
 )
 
+// Databroker audit log destinations. Any other audit log value is the path of
+// a file to append audit events to.
+const (
+	DataBrokerAuditLogToLog    = "log"
+	DataBrokerAuditLogToRecord = "record"
+)
+
+// DefaultDataBrokerAuditLogRetention is how long audit events stored as
+// databroker records are kept when no retention is configured.
+const DefaultDataBrokerAuditLogRetention = 30 * 24 * time.Hour
+
 // DataBrokerOptions are options related to the databroker.
 type DataBrokerOptions struct {
-	ClusterLeaderID             null.String            `mapstructure:"databroker_cluster_leader_id" yaml:"databroker_cluster_leader_id,omitempty"`
-	ClusterNodeID               null.String            `mapstructure:"databroker_cluster_node_id" yaml:"databroker_cluster_node_id,omitempty"`
-	ClusterNodes                DataBrokerClusterNodes `mapstructure:"databroker_cluster_nodes" yaml:"databroker_cluster_nodes,omitempty"`
-	InternalServiceURL          string                 `mapstructure:"databroker_internal_service_url" yaml:"databroker_internal_service_url,omitempty"`
-	RaftBindAddress             null.String            `mapstructure:"databroker_raft_bind_address" yaml:"databroker_raft_bind_address,omitempty"`
-	ServiceURL                  string                 `mapstructure:"databroker_service_url" yaml:"databroker_service_url,omitempty"`
-	ServiceURLs                 []string               `mapstructure:"databroker_service_urls" yaml:"databroker_service_urls,omitempty"`
-	StorageConnectionString     string                 `mapstructure:"databroker_storage_connection_string" yaml:"databroker_storage_connection_string,omitempty"`
-	StorageConnectionStringFile string                 `mapstructure:"databroker_storage_connection_string_file" yaml:"databroker_storage_connection_string_file,omitempty"`
-	StorageType                 string                 `mapstructure:"databroker_storage_type" yaml:"databroker_storage_type,omitempty"`
+	ACL                                DataBrokerACL            `mapstructure:"databroker_acl" yaml:"databroker_acl,omitempty"`
+	AuditLog                           string                   `mapstructure:"databroker_audit_log" yaml:"databroker_audit_log,omitempty"`
+	AuditLogRetention                  time.Duration            `mapstructure:"databroker_audit_log_retention" yaml:"databroker_audit_log_retention,omitempty"`
+	ClusterFollowerCacheTTL            time.Duration            `mapstructure:"databroker_cluster_follower_cache_ttl" yaml:"databroker_cluster_follower_cache_ttl,omitempty"`
+	ClusterLeaderID                    null.String              `mapstructure:"databroker_cluster_leader_id" yaml:"databroker_cluster_leader_id,omitempty"`
+	ClusterLeaseServiceURL             null.String              `mapstructure:"databroker_cluster_lease_service_url" yaml:"databroker_cluster_lease_service_url,omitempty"`
+	ClusterNodeID                      null.String              `mapstructure:"databroker_cluster_node_id" yaml:"databroker_cluster_node_id,omitempty"`
+	ClusterNodes                       DataBrokerClusterNodes   `mapstructure:"databroker_cluster_nodes" yaml:"databroker_cluster_nodes,omitempty"`
+	InternalServiceURL                 string                   `mapstructure:"databroker_internal_service_url" yaml:"databroker_internal_service_url,omitempty"`
+	MaxForwards                        int                      `mapstructure:"databroker_max_forwards" yaml:"databroker_max_forwards,omitempty"`
+	RaftBindAddress                    null.String              `mapstructure:"databroker_raft_bind_address" yaml:"databroker_raft_bind_address,omitempty"`
+	ServiceURL                         string                   `mapstructure:"databroker_service_url" yaml:"databroker_service_url,omitempty"`
+	ServiceURLs                        []string                 `mapstructure:"databroker_service_urls" yaml:"databroker_service_urls,omitempty"`
+	StorageConnectionString            string                   `mapstructure:"databroker_storage_connection_string" yaml:"databroker_storage_connection_string,omitempty"`
+	StorageConnectionStringFile        string                   `mapstructure:"databroker_storage_connection_string_file" yaml:"databroker_storage_connection_string_file,omitempty"`
+	StorageEncryptionKeys              []string                 `mapstructure:"databroker_storage_encryption_keys" yaml:"databroker_storage_encryption_keys,omitempty"`
+	StorageEncryptionKeysFile          string                   `mapstructure:"databroker_storage_encryption_keys_file" yaml:"databroker_storage_encryption_keys_file,omitempty"`
+	StorageHealthCheckInterval         time.Duration            `mapstructure:"databroker_storage_health_check_interval" yaml:"databroker_storage_health_check_interval,omitempty"`
+	StorageHealthCheckLatencyThreshold time.Duration            `mapstructure:"databroker_storage_health_check_latency_threshold" yaml:"databroker_storage_health_check_latency_threshold,omitempty"`
+	StorageIndexedFields               []DataBrokerRecordFields `mapstructure:"databroker_storage_indexed_fields" yaml:"databroker_storage_indexed_fields,omitempty"`
+	StorageRecordChangeMaxAge          time.Duration            `mapstructure:"databroker_storage_record_change_max_age" yaml:"databroker_storage_record_change_max_age,omitempty"`
+	StorageRecordChangeMaxCount        uint64                   `mapstructure:"databroker_storage_record_change_max_count" yaml:"databroker_storage_record_change_max_count,omitempty"`
+	StorageRecordTTLs                  []DataBrokerRecordTTL    `mapstructure:"databroker_storage_record_ttls" yaml:"databroker_storage_record_ttls,omitempty"`
+	StorageSearchFields                []DataBrokerRecordFields `mapstructure:"databroker_storage_search_fields" yaml:"databroker_storage_search_fields,omitempty"`
+	StorageSnapshotInterval            time.Duration            `mapstructure:"databroker_storage_snapshot_interval" yaml:"databroker_storage_snapshot_interval,omitempty"`
+	StorageSnapshotRetainCount         int                      `mapstructure:"databroker_storage_snapshot_retain_count" yaml:"databroker_storage_snapshot_retain_count,omitempty"`
+	StorageSnapshotStoreURL            string                   `mapstructure:"databroker_storage_snapshot_store_url" yaml:"databroker_storage_snapshot_store_url,omitempty"`
+	StorageType                        string                   `mapstructure:"databroker_storage_type" yaml:"databroker_storage_type,omitempty"`
+	SyncMaxBytesPerSecond              int                      `mapstructure:"databroker_sync_max_bytes_per_second" yaml:"databroker_sync_max_bytes_per_second,omitempty"`
+	SyncMaxRecordsPerSecond            int                      `mapstructure:"databroker_sync_max_records_per_second" yaml:"databroker_sync_max_records_per_second,omitempty"`
+}
+
+// GetAuditLogRetention returns how long audit events stored as databroker
+// records are kept.
+func (o *DataBrokerOptions) GetAuditLogRetention() time.Duration {
+	if o.AuditLogRetention > 0 {
+		return o.AuditLogRetention
+	}
+	return DefaultDataBrokerAuditLogRetention
 }
 
 // GetStorageConnectionString gets the databroker storage connection string from either a file
@@ -50,6 +108,35 @@ func (o *DataBrokerOptions) GetStorageConnectionString() (string, error) {
 	return o.StorageConnectionString, nil
 }
 
+// GetStorageEncryptionKeys gets the keys used to encrypt file storage record
+// values at rest, from either a file or the config option directly. Keys are
+// base64 encoded and must be 32 bytes. The file holds one key per line. The
+// first key is used to encrypt new values, the others to decrypt existing
+// ones.
+func (o *DataBrokerOptions) GetStorageEncryptionKeys() ([][]byte, error) {
+	rawKeys := o.StorageEncryptionKeys
+	if o.StorageEncryptionKeysFile != "" {
+		bs, err := os.ReadFile(o.StorageEncryptionKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		rawKeys = strings.Fields(string(bs))
+	}
+
+	keys := make([][]byte, 0, len(rawKeys))
+	for _, rawKey := range rawKeys {
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(rawKey))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidDataBrokerStorageEncryptionKey, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("%w: must be 32 bytes, got %d", ErrInvalidDataBrokerStorageEncryptionKey, len(key))
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
 // FromProto sets options from a config settings protobuf.
 func (o *DataBrokerOptions) FromProto(src *configpb.Settings) {
 	setNullableString(&o.ClusterLeaderID, src.DatabrokerClusterLeaderId)
@@ -76,9 +163,85 @@ func (o *DataBrokerOptions) ToProto(dst *configpb.Settings) {
 
 // Validate validates the databroker options.
 func (o *DataBrokerOptions) Validate() error {
+	if err := o.ACL.Validate(); err != nil {
+		return err
+	}
+	switch o.AuditLog {
+	case "", DataBrokerAuditLogToLog, DataBrokerAuditLogToRecord:
+	default:
+		if !filepath.IsAbs(o.AuditLog) {
+			return fmt.Errorf("%w: %s must be %q, %q or an absolute file path",
+				ErrInvalidDataBrokerAuditLog, o.AuditLog, DataBrokerAuditLogToLog, DataBrokerAuditLogToRecord)
+		}
+	}
+	if o.AuditLogRetention < 0 {
+		return fmt.Errorf("%w: retention %s must not be negative", ErrInvalidDataBrokerAuditLog, o.AuditLogRetention)
+	}
+	if o.StorageHealthCheckInterval < 0 {
+		return fmt.Errorf("%w: interval %s must not be negative", ErrInvalidDataBrokerStorageHealthCheck, o.StorageHealthCheckInterval)
+	}
+	if o.StorageHealthCheckLatencyThreshold < 0 {
+		return fmt.Errorf("%w: latency threshold %s must not be negative", ErrInvalidDataBrokerStorageHealthCheck, o.StorageHealthCheckLatencyThreshold)
+	}
+	if o.StorageEncryptionKeysFile == "" {
+		if _, err := o.GetStorageEncryptionKeys(); err != nil {
+			return err
+		}
+	}
+	if o.StorageRecordChangeMaxAge < 0 {
+		return fmt.Errorf("%w: record change max age %s must not be negative", ErrInvalidDataBrokerStorageRetention, o.StorageRecordChangeMaxAge)
+	}
+	for _, f := range o.StorageIndexedFields {
+		if err := f.validate(); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidDataBrokerStorageIndexedFields, err)
+		}
+	}
+	for _, f := range o.StorageSearchFields {
+		if err := f.validate(); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidDataBrokerStorageSearchFields, err)
+		}
+	}
+	for _, ttl := range o.StorageRecordTTLs {
+		if ttl.Type == "" {
+			return fmt.Errorf("%w: record type is required", ErrInvalidDataBrokerStorageRecordTTL)
+		}
+		if ttl.TTL < 0 {
+			return fmt.Errorf("%w: %s ttl %s must not be negative", ErrInvalidDataBrokerStorageRecordTTL, ttl.Type, ttl.TTL)
+		}
+	}
+	if o.StorageSnapshotStoreURL != "" {
+		u, err := url.Parse(o.StorageSnapshotStoreURL)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrInvalidDataBrokerStorageSnapshot, o.StorageSnapshotStoreURL, err)
+		}
+		switch u.Scheme {
+		case "file", "gs", "s3":
+		default:
+			return fmt.Errorf("%w: %s must be a file, gs or s3 url", ErrInvalidDataBrokerStorageSnapshot, o.StorageSnapshotStoreURL)
+		}
+	}
+	if o.StorageSnapshotInterval < 0 {
+		return fmt.Errorf("%w: interval %s must not be negative", ErrInvalidDataBrokerStorageSnapshot, o.StorageSnapshotInterval)
+	}
+	if o.StorageSnapshotRetainCount < 0 {
+		return fmt.Errorf("%w: retain count %d must not be negative", ErrInvalidDataBrokerStorageSnapshot, o.StorageSnapshotRetainCount)
+	}
+	if o.SyncMaxRecordsPerSecond < 0 {
+		return fmt.Errorf("%w: max records per second %d must not be negative", ErrInvalidDataBrokerSyncLimit, o.SyncMaxRecordsPerSecond)
+	}
+	if o.SyncMaxBytesPerSecond < 0 {
+		return fmt.Errorf("%w: max bytes per second %d must not be negative", ErrInvalidDataBrokerSyncLimit, o.SyncMaxBytesPerSecond)
+	}
+	if o.MaxForwards < 0 {
+		return fmt.Errorf("%w: %d must not be negative", ErrInvalidDataBrokerMaxForwards, o.MaxForwards)
+	}
+	if o.ClusterFollowerCacheTTL < 0 {
+		return fmt.Errorf("%w: %s must not be negative", ErrInvalidDataBrokerClusterFollowerCacheTTL, o.ClusterFollowerCacheTTL)
+	}
+
 	switch o.StorageType {
 	case StorageInMemoryName, StorageFileName:
-	case StoragePostgresName:
+	case StoragePostgresName, StorageRedisName:
 		if o.StorageConnectionString == "" && o.StorageConnectionStringFile == "" {
 			return ErrMissingDataBrokerStorageConnectionString
 		}
@@ -104,6 +267,12 @@ func (o *DataBrokerOptions) Validate() error {
 			return fmt.Errorf("%w %s: %w", ErrInvalidDataBrokerServiceURL, str, err)
 		}
 	}
+	if o.ClusterLeaseServiceURL.IsValid() {
+		_, err := urlutil.ParseAndValidateURL(o.ClusterLeaseServiceURL.String)
+		if err != nil {
+			return fmt.Errorf("%w %s: %w", ErrInvalidDataBrokerClusterLeaseServiceURL, o.ClusterLeaseServiceURL.String, err)
+		}
+	}
 	for _, node := range o.ClusterNodes {
 		_, err := urlutil.ParseAndValidateURL(node.GRPCAddress)
 		if err != nil {
@@ -139,6 +308,107 @@ func (o *DataBrokerOptions) Validate() error {
 	return nil
 }
 
+// A DataBrokerACLRule restricts the record types a service may read and write
+// in the databroker. Record types may be "*" to match any record type. Write
+// access implies read access.
+type DataBrokerACLRule struct {
+	Service string   `mapstructure:"service" yaml:"service,omitempty"`
+	Read    []string `mapstructure:"read" yaml:"read,omitempty"`
+	Write   []string `mapstructure:"write" yaml:"write,omitempty"`
+}
+
+// DataBrokerACL is a slice of DataBrokerACLRule. Services are identified by
+// the signed JWT attached to databroker requests. Services without a rule are
+// unrestricted.
+type DataBrokerACL []DataBrokerACLRule
+
+// Validate validates the databroker acl.
+func (acl DataBrokerACL) Validate() error {
+	seen := make(map[string]struct{}, len(acl))
+	for _, rule := range acl {
+		if rule.Service == "" {
+			return fmt.Errorf("%w: service is required", ErrInvalidDataBrokerACL)
+		}
+		if _, ok := seen[rule.Service]; ok {
+			return fmt.Errorf("%w: duplicate rule for service %s", ErrInvalidDataBrokerACL, rule.Service)
+		}
+		seen[rule.Service] = struct{}{}
+	}
+	return nil
+}
+
+// AllowsRead returns true if the service may read records of the given type.
+// An empty record type refers to all record types.
+func (acl DataBrokerACL) AllowsRead(service, recordType string) bool {
+	rule, ok := acl.getRule(service)
+	if !ok {
+		return true
+	}
+	return aclRecordTypesMatch(rule.Read, recordType) || aclRecordTypesMatch(rule.Write, recordType)
+}
+
+// AllowsWrite returns true if the service may write records of the given type.
+// An empty record type refers to all record types.
+func (acl DataBrokerACL) AllowsWrite(service, recordType string) bool {
+	rule, ok := acl.getRule(service)
+	if !ok {
+		return true
+	}
+	return aclRecordTypesMatch(rule.Write, recordType)
+}
+
+func (acl DataBrokerACL) getRule(service string) (DataBrokerACLRule, bool) {
+	if service == "" {
+		return DataBrokerACLRule{}, false
+	}
+	for _, rule := range acl {
+		if rule.Service == service {
+			return rule, true
+		}
+	}
+	return DataBrokerACLRule{}, false
+}
+
+func aclRecordTypesMatch(recordTypes []string, recordType string) bool {
+	for _, t := range recordTypes {
+		if t == "*" || (recordType != "" && t == recordType) {
+			return true
+		}
+	}
+	return false
+}
+
+// DataBrokerRecordFields lists fields of the records of a type. Fields are
+// dot-separated paths into the JSON representation of the record data.
+//
+// Record types are type URLs, which contain dots, so they're listed in a
+// struct rather than used as map keys, which the config loader would split on
+// the dots.
+type DataBrokerRecordFields struct {
+	Type   string   `mapstructure:"type" yaml:"type,omitempty"`
+	Fields []string `mapstructure:"fields" yaml:"fields,omitempty"`
+}
+
+func (f DataBrokerRecordFields) validate() error {
+	if f.Type == "" {
+		return errors.New("record type is required")
+	}
+	for _, field := range f.Fields {
+		if field == "" || slices.Contains(strings.Split(field, "."), "") {
+			return fmt.Errorf("%s has an invalid field %q", f.Type, field)
+		}
+	}
+	return nil
+}
+
+// DataBrokerRecordTTL is how long records of a type are kept after they were
+// last modified. Like DataBrokerRecordFields it's listed in a struct so that
+// type URLs survive config parsing.
+type DataBrokerRecordTTL struct {
+	Type string        `mapstructure:"type" yaml:"type,omitempty"`
+	TTL  time.Duration `mapstructure:"ttl" yaml:"ttl,omitempty"`
+}
+
 // DataBrokerClusterNode represents a databroker cluster node.
 type DataBrokerClusterNode struct {
 	ID          string      `mapstructure:"id" yaml:"id,omitempty"`
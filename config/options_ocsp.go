@@ -0,0 +1,65 @@
+package config
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+)
+
+// OCSPStaplingFailureMode determines what happens when a certificate's OCSP
+// staple can't be refreshed before it expires.
+type OCSPStaplingFailureMode string
+
+// OCSP stapling failure modes
+const (
+	// OCSPStaplingFailureModeSoft serves the certificate without a staple.
+	OCSPStaplingFailureModeSoft OCSPStaplingFailureMode = "soft"
+	// OCSPStaplingFailureModeHard rejects TLS handshakes for the certificate.
+	OCSPStaplingFailureModeHard OCSPStaplingFailureMode = "hard"
+)
+
+func (mode OCSPStaplingFailureMode) validate() error {
+	switch mode {
+	case "", OCSPStaplingFailureModeSoft, OCSPStaplingFailureModeHard:
+		return nil
+	}
+	return fmt.Errorf("unknown failure mode: %q", mode)
+}
+
+// GetOCSPStaplingFailureMode returns the OCSP stapling failure mode, which
+// defaults to soft.
+func (o *Options) GetOCSPStaplingFailureMode() OCSPStaplingFailureMode {
+	if o.OCSPStaplingFailureMode == "" {
+		return OCSPStaplingFailureModeSoft
+	}
+	return o.OCSPStaplingFailureMode
+}
+
+// OCSPStapleKey returns the key used to look up the OCSP staple for a
+// certificate in Config.OCSPStaples.
+func OCSPStapleKey(cert *tls.Certificate) string {
+	if len(cert.Certificate) == 0 {
+		return ""
+	}
+	h := sha256.Sum256(cert.Certificate[0])
+	return hex.EncodeToString(h[:])
+}
+
+// withOCSPStaples returns the certificates with any fetched OCSP staples
+// attached. Certificates that already have a staple are left as-is.
+func withOCSPStaples(certs []tls.Certificate, staples map[string][]byte) []tls.Certificate {
+	if len(staples) == 0 {
+		return certs
+	}
+
+	for i := range certs {
+		if len(certs[i].OCSPStaple) > 0 {
+			continue
+		}
+		if staple, ok := staples[OCSPStapleKey(&certs[i])]; ok {
+			certs[i].OCSPStaple = staple
+		}
+	}
+	return certs
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOCSPStaplingFailureMode(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, OCSPStaplingFailureMode("").validate())
+	assert.NoError(t, OCSPStaplingFailureModeSoft.validate())
+	assert.NoError(t, OCSPStaplingFailureModeHard.validate())
+	assert.Error(t, OCSPStaplingFailureMode("strict").validate())
+
+	o := NewDefaultOptions()
+	assert.Equal(t, OCSPStaplingFailureModeSoft, o.GetOCSPStaplingFailureMode())
+	o.OCSPStaplingFailureMode = OCSPStaplingFailureModeHard
+	assert.Equal(t, OCSPStaplingFailureModeHard, o.GetOCSPStaplingFailureMode())
+}
+
+func TestWithOCSPStaples(t *testing.T) {
+	t.Parallel()
+
+	certs := []tls.Certificate{
+		{Certificate: [][]byte{[]byte("A")}},
+		{Certificate: [][]byte{[]byte("B")}, OCSPStaple: []byte("EXISTING")},
+		{Certificate: [][]byte{[]byte("C")}},
+	}
+	staples := map[string][]byte{
+		OCSPStapleKey(&certs[0]): []byte("STAPLE-A"),
+		OCSPStapleKey(&certs[1]): []byte("STAPLE-B"),
+	}
+
+	certs = withOCSPStaples(certs, staples)
+	assert.Equal(t, []byte("STAPLE-A"), certs[0].OCSPStaple)
+	assert.Equal(t, []byte("EXISTING"), certs[1].OCSPStaple)
+	assert.Nil(t, certs[2].OCSPStaple)
+	assert.Empty(t, OCSPStapleKey(&tls.Certificate{}))
+}
@@ -89,6 +89,26 @@ func Test_Validate(t *testing.T) {
 	nm2 := filepath.Join(t.TempDir(), "key-file")
 	goodSSHHostKeyFile.SSHHostKeyFiles = ptr([]string{nm2})
 	os.WriteFile(nm2, []byte("TEST"), 0o600)
+	badGRPCMaxMessageSize := testOptions()
+	badGRPCMaxMessageSize.GRPCMaxMessageSize = -1
+	badUpgradedConnectionDrainWindow := testOptions()
+	badUpgradedConnectionDrainWindow.UpgradedConnectionDrainWindow = ptr(-time.Second)
+	badGRPCCompression := testOptions()
+	badGRPCCompression.GRPCCompression = "brotli"
+	goodGRPCCompression := testOptions()
+	goodGRPCCompression.GRPCCompression = "zstd"
+	badCookiePrefix := testOptions()
+	badCookiePrefix.CookiePrefix = "__Host-"
+	goodCookiePrefix := testOptions()
+	goodCookiePrefix.CookiePrefix = "host"
+	badCookieMode := testOptions()
+	badCookieMode.CookieMode = "opaque"
+	goodCookieMode := testOptions()
+	goodCookieMode.CookieMode = "server"
+	badHTTP3AdvertisePort := testOptions()
+	badHTTP3AdvertisePort.HTTP3AdvertisePort = null.Uint32From(65536)
+	goodHTTP3AdvertisePort := testOptions()
+	goodHTTP3AdvertisePort.HTTP3AdvertisePort = null.Uint32From(8443)
 
 	tests := []struct {
 		name     string
@@ -106,6 +126,16 @@ func Test_Validate(t *testing.T) {
 		{"missing ssh host key file", missingSSHHostKeyFile, true},
 		{"too open ssh host key file", tooOpenSSHHostKeyFile, true},
 		{"good ssh host key file", goodSSHHostKeyFile, false},
+		{"invalid grpc max message size", badGRPCMaxMessageSize, true},
+		{"invalid upgraded connection drain window", badUpgradedConnectionDrainWindow, true},
+		{"invalid grpc compression", badGRPCCompression, true},
+		{"good grpc compression", goodGRPCCompression, false},
+		{"invalid cookie prefix", badCookiePrefix, true},
+		{"good cookie prefix", goodCookiePrefix, false},
+		{"invalid cookie mode", badCookieMode, true},
+		{"good cookie mode", goodCookieMode, false},
+		{"invalid http3 advertise port", badHTTP3AdvertisePort, true},
+		{"good http3 advertise port", goodHTTP3AdvertisePort, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -392,6 +422,31 @@ downstream_mtls:
 	}, o.DownstreamMTLS.MatchSubjectAltNames)
 }
 
+func Test_decodeDataBrokerRecordTypes(t *testing.T) {
+	// Verify that record types containing dots survive config file parsing.
+	const yaml = `
+databroker_storage_indexed_fields:
+  - type: type.googleapis.com/session.Session
+    fields: [user_id]
+databroker_storage_record_ttls:
+  - type: type.googleapis.com/session.Session
+    ttl: 24h
+`
+	cfg := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(cfg, []byte(yaml), 0o644)
+	require.NoError(t, err)
+
+	o, err := optionsFromViper(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, []DataBrokerRecordFields{
+		{Type: "type.googleapis.com/session.Session", Fields: []string{"user_id"}},
+	}, o.DataBroker.StorageIndexedFields)
+	assert.Equal(t, []DataBrokerRecordTTL{
+		{Type: "type.googleapis.com/session.Session", TTL: 24 * time.Hour},
+	}, o.DataBroker.StorageRecordTTLs)
+}
+
 func Test_Checksum(t *testing.T) {
 	o := NewDefaultOptions()
 
@@ -434,7 +489,7 @@ func TestOptionsFromViper(t *testing.T) {
 				CookieName:              "_pomerium",
 				InsecureServer:          true,
 				CookieHTTPOnly:          true,
-				DataBroker:              DataBrokerOptions{StorageType: "memory"},
+				DataBroker:              DataBrokerOptions{MaxForwards: 1, StorageType: "memory"},
 				EnvoyAdminAccessLogPath: os.DevNull,
 				EnvoyAdminProfilePath:   os.DevNull,
 				HealthCheckAddr:         "127.0.0.1:28080",
@@ -450,7 +505,7 @@ func TestOptionsFromViper(t *testing.T) {
 				CookieHTTPOnly:          true,
 				InsecureServer:          true,
 				SetResponseHeaders:      map[string]string{"disable": "true"},
-				DataBroker:              DataBrokerOptions{StorageType: "memory"},
+				DataBroker:              DataBrokerOptions{MaxForwards: 1, StorageType: "memory"},
 				EnvoyAdminAccessLogPath: os.DevNull,
 				EnvoyAdminProfilePath:   os.DevNull,
 				HealthCheckAddr:         "127.0.0.1:28080",
@@ -466,7 +521,7 @@ func TestOptionsFromViper(t *testing.T) {
 				CookieHTTPOnly:          true,
 				InsecureServer:          true,
 				SetResponseHeaders:      map[string]string{"disable": "true"},
-				DataBroker:              DataBrokerOptions{StorageType: "memory"},
+				DataBroker:              DataBrokerOptions{MaxForwards: 1, StorageType: "memory"},
 				EnvoyAdminAccessLogPath: os.DevNull,
 				EnvoyAdminProfilePath:   os.DevNull,
 				HealthCheckAddr:         "127.0.0.1:28080",
@@ -1376,6 +1431,33 @@ func TestOptions_GetCookieSecret(t *testing.T) {
 	})
 }
 
+func TestOptions_GetSCIMBearerToken(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		o := NewDefaultOptions()
+		o.SCIMBearerToken = "TOKEN"
+		token, err := o.GetSCIMBearerToken()
+		assert.NoError(t, err)
+		assert.Equal(t, "TOKEN", token)
+	})
+	t.Run("file", func(t *testing.T) {
+		fp := filepath.Join(t.TempDir(), "scim_bearer_token")
+		require.NoError(t, os.WriteFile(fp, []byte("FILE-TOKEN\n"), 0o600))
+
+		o := NewDefaultOptions()
+		o.SCIMBearerToken = "TOKEN"
+		o.SCIMBearerTokenFile = fp
+		token, err := o.GetSCIMBearerToken()
+		assert.NoError(t, err)
+		assert.Equal(t, "FILE-TOKEN", token)
+	})
+	t.Run("missing file", func(t *testing.T) {
+		o := NewDefaultOptions()
+		o.SCIMBearerTokenFile = filepath.Join(t.TempDir(), "missing")
+		_, err := o.GetSCIMBearerToken()
+		assert.Error(t, err)
+	})
+}
+
 func TestOptions_GetCookieSameSite(t *testing.T) {
 	t.Parallel()
 
@@ -1851,3 +1933,14 @@ func must[T any](t T, err error) T {
 func ptr[T any](v T) *T {
 	return &v
 }
+
+func TestOptions_GetCookieName(t *testing.T) {
+	t.Parallel()
+
+	o := NewDefaultOptions()
+	assert.Equal(t, "_pomerium", o.GetCookieName())
+	o.CookiePrefix = "host"
+	assert.Equal(t, "__Host-_pomerium", o.GetCookieName())
+	o.CookiePrefix = "secure"
+	assert.Equal(t, "__Secure-_pomerium", o.GetCookieName())
+}
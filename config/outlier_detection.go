@@ -0,0 +1,93 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v9"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// OutlierDetection defines when unhealthy upstream hosts are ejected from the
+// load balancing pool.
+type OutlierDetection struct {
+	// Consecutive5xx is the number of consecutive 5xx responses after which a
+	// host is ejected.
+	Consecutive5xx null.Uint32 `mapstructure:"consecutive_5xx" yaml:"consecutive_5xx,omitempty" json:"consecutive_5xx,omitempty"`
+	// ConsecutiveGatewayFailure is the number of consecutive 502, 503 or 504
+	// responses or connection failures after which a host is ejected.
+	ConsecutiveGatewayFailure null.Uint32 `mapstructure:"consecutive_gateway_failure" yaml:"consecutive_gateway_failure,omitempty" json:"consecutive_gateway_failure,omitempty"`
+	// Interval is the time between ejection sweeps.
+	Interval *time.Duration `mapstructure:"interval" yaml:"interval,omitempty" json:"interval,omitempty"`
+	// BaseEjectionTime is how long a host is ejected for. It is multiplied by
+	// the number of times the host has been ejected.
+	BaseEjectionTime *time.Duration `mapstructure:"base_ejection_time" yaml:"base_ejection_time,omitempty" json:"base_ejection_time,omitempty"`
+	// MaxEjectionPercent is the maximum percentage of hosts which can be
+	// ejected at the same time.
+	MaxEjectionPercent null.Uint32 `mapstructure:"max_ejection_percent" yaml:"max_ejection_percent,omitempty" json:"max_ejection_percent,omitempty"`
+}
+
+// OutlierDetectionFromPB converts the OutlierDetection from a protobuf type.
+func OutlierDetectionFromPB(src *configpb.OutlierDetection) *OutlierDetection {
+	if src == nil {
+		return nil
+	}
+
+	dst := &OutlierDetection{}
+	if src.Consecutive_5Xx != nil {
+		dst.Consecutive5xx = null.Uint32From(*src.Consecutive_5Xx)
+	}
+	if src.ConsecutiveGatewayFailure != nil {
+		dst.ConsecutiveGatewayFailure = null.Uint32From(*src.ConsecutiveGatewayFailure)
+	}
+	if src.Interval != nil {
+		interval := src.Interval.AsDuration()
+		dst.Interval = &interval
+	}
+	if src.BaseEjectionTime != nil {
+		baseEjectionTime := src.BaseEjectionTime.AsDuration()
+		dst.BaseEjectionTime = &baseEjectionTime
+	}
+	if src.MaxEjectionPercent != nil {
+		dst.MaxEjectionPercent = null.Uint32From(*src.MaxEjectionPercent)
+	}
+	return dst
+}
+
+// OutlierDetectionToPB converts the OutlierDetection into a protobuf type.
+func OutlierDetectionToPB(src *OutlierDetection) *configpb.OutlierDetection {
+	if src == nil {
+		return nil
+	}
+
+	dst := &configpb.OutlierDetection{
+		Consecutive_5Xx:           src.Consecutive5xx.Ptr(),
+		ConsecutiveGatewayFailure: src.ConsecutiveGatewayFailure.Ptr(),
+		MaxEjectionPercent:        src.MaxEjectionPercent.Ptr(),
+	}
+	if src.Interval != nil {
+		dst.Interval = durationpb.New(*src.Interval)
+	}
+	if src.BaseEjectionTime != nil {
+		dst.BaseEjectionTime = durationpb.New(*src.BaseEjectionTime)
+	}
+	return dst
+}
+
+func (od *OutlierDetection) validate() error {
+	if od == nil {
+		return nil
+	}
+	if od.Interval != nil && *od.Interval <= 0 {
+		return fmt.Errorf("config: outlier_detection interval must be positive")
+	}
+	if od.BaseEjectionTime != nil && *od.BaseEjectionTime <= 0 {
+		return fmt.Errorf("config: outlier_detection base_ejection_time must be positive")
+	}
+	if od.MaxEjectionPercent.IsSet() && od.MaxEjectionPercent.Uint32 > 100 {
+		return fmt.Errorf("config: outlier_detection max_ejection_percent must be at most 100")
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v9"
+)
+
+func TestOutlierDetection_validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, (*OutlierDetection)(nil).validate())
+	assert.NoError(t, (&OutlierDetection{
+		Consecutive5xx:     null.Uint32From(5),
+		Interval:           ptr(10 * time.Second),
+		BaseEjectionTime:   ptr(30 * time.Second),
+		MaxEjectionPercent: null.Uint32From(100),
+	}).validate())
+	assert.Error(t, (&OutlierDetection{Interval: ptr(time.Duration(0))}).validate())
+	assert.Error(t, (&OutlierDetection{BaseEjectionTime: ptr(-time.Second)}).validate())
+	assert.Error(t, (&OutlierDetection{MaxEjectionPercent: null.Uint32From(101)}).validate())
+}
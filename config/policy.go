@@ -35,6 +35,14 @@ type Policy struct {
 	Description string `mapstructure:"description" yaml:"description,omitempty" json:"description,omitempty"`
 	LogoURL     string `mapstructure:"logo_url" yaml:"logo_url,omitempty" json:"logo_url,omitempty"`
 
+	// Category groups the route with other routes in the routes portal.
+	Category string `mapstructure:"category" yaml:"category,omitempty" json:"category,omitempty"`
+	// Tags are used to filter routes in the routes portal.
+	Tags []string `mapstructure:"tags" yaml:"tags,omitempty" json:"tags,omitempty"`
+	// PortalOrder sets the position of the route in the routes portal. Routes
+	// with a lower order are shown first.
+	PortalOrder int `mapstructure:"portal_order" yaml:"portal_order,omitempty" json:"portal_order,omitempty"`
+
 	From string       `mapstructure:"from" yaml:"from"`
 	To   WeightedURLs `mapstructure:"to" yaml:"to"`
 	// Redirect is used for a redirect action instead of `To`
@@ -127,6 +135,16 @@ type Policy struct {
 	TLSDownstreamClientCA     string `mapstructure:"tls_downstream_client_ca" yaml:"tls_downstream_client_ca,omitempty"`
 	TLSDownstreamClientCAFile string `mapstructure:"tls_downstream_client_ca_file" yaml:"tls_downstream_client_ca_file,omitempty"`
 
+	// TLSDownstreamClientCRL defines certificate revocation lists to check, in
+	// addition to the global CRLs, when verifying downstream client
+	// certificates for this route.
+	TLSDownstreamClientCRL     string `mapstructure:"tls_downstream_client_crl" yaml:"tls_downstream_client_crl,omitempty"`
+	TLSDownstreamClientCRLFile string `mapstructure:"tls_downstream_client_crl_file" yaml:"tls_downstream_client_crl_file,omitempty"`
+
+	// TLSDownstreamClientOCSPCheck overrides the global OCSP check setting
+	// for downstream client certificates on this route.
+	TLSDownstreamClientOCSPCheck *bool `mapstructure:"tls_downstream_client_ocsp_check" yaml:"tls_downstream_client_ocsp_check,omitempty"`
+
 	// TLSUpstreamAllowRenegotiation allows server-initiated TLS renegotiation.
 	TLSUpstreamAllowRenegotiation bool `mapstructure:"tls_upstream_allow_renegotiation" yaml:"allow_renegotiation,omitempty"`
 
@@ -181,6 +199,13 @@ type Policy struct {
 	// This expands on any global allowlist set in the main Options.
 	JWTGroupsFilter JWTGroupsFilter
 
+	// JWTClaimsAllowlist limits the claims included in the Pomerium JWT for this
+	// route. Registered claims (iss, aud, jti, iat, exp, sub) are always included.
+	JWTClaimsAllowlist []string `mapstructure:"jwt_claims_allowlist" yaml:"jwt_claims_allowlist,omitempty" json:"jwt_claims_allowlist,omitempty"`
+	// JWTCustomClaims adds claims to the Pomerium JWT for this route, mapping the
+	// claim name to a session or user claim, e.g. "department: user.department".
+	JWTCustomClaims map[string]string `mapstructure:"jwt_custom_claims" yaml:"jwt_custom_claims,omitempty" json:"jwt_custom_claims,omitempty"`
+
 	SubPolicies []SubPolicy `mapstructure:"sub_policies" yaml:"sub_policies,omitempty" json:"sub_policies,omitempty"`
 
 	EnvoyOpts *envoy_config_cluster_v3.Cluster `mapstructure:"_envoy_opts" yaml:"-" json:"-"`
@@ -191,15 +216,49 @@ type Policy struct {
 	// SetResponseHeaders sets response headers.
 	SetResponseHeaders map[string]string `mapstructure:"set_response_headers" yaml:"set_response_headers,omitempty"`
 
+	// RequiredAssuranceLevel is the assurance level a user's login must meet
+	// to access the route. Users who signed in with a lower level are asked to
+	// sign in again, e.g. "mfa" requires multi-factor authentication.
+	RequiredAssuranceLevel string `mapstructure:"required_assurance_level" yaml:"required_assurance_level,omitempty" json:"required_assurance_level,omitempty"`
+
+	// RateLimit limits the rate of requests to the route per user.
+	RateLimit *RateLimit `mapstructure:"rate_limit" yaml:"rate_limit,omitempty" json:"rate_limit,omitempty"`
+
+	// GRPCWeb translates gRPC-Web and Connect requests to gRPC for the route.
+	GRPCWeb *GRPCWeb `mapstructure:"grpc_web" yaml:"grpc_web,omitempty" json:"grpc_web,omitempty"`
+
+	// ExternalProcessing sends the route's requests and responses to an
+	// external gRPC processing service.
+	ExternalProcessing *ExternalProcessing `mapstructure:"external_processing" yaml:"external_processing,omitempty" json:"external_processing,omitempty"`
+
+	// ResponseHeaders are rules to set, append or remove response headers. They
+	// are applied in order after SetResponseHeaders.
+	ResponseHeaders []ResponseHeader `mapstructure:"response_headers" yaml:"response_headers,omitempty" json:"response_headers,omitempty"`
+
+	// CookieSameSite overrides the SameSite attribute of the session cookie
+	// set for the route. One of "strict", "lax" or "none".
+	CookieSameSite string `mapstructure:"cookie_same_site" yaml:"cookie_same_site,omitempty" json:"cookie_same_site,omitempty"`
+	// CookieSecure overrides the Secure attribute of the session cookie set
+	// for the route.
+	CookieSecure *bool `mapstructure:"cookie_secure" yaml:"cookie_secure,omitempty" json:"cookie_secure,omitempty"`
+	// CookieDomain overrides the Domain attribute of the session cookie set
+	// for the route.
+	CookieDomain string `mapstructure:"cookie_domain" yaml:"cookie_domain,omitempty" json:"cookie_domain,omitempty"`
+
 	// IDPClientID is the client id used for the identity provider.
 	IDPClientID string `mapstructure:"idp_client_id" yaml:"idp_client_id,omitempty"`
 	// IDPClientSecret is the client secret used for the identity provider.
 	IDPClientSecret string `mapstructure:"idp_client_secret" yaml:"idp_client_secret,omitempty"`
 	// IDPAccessTokenAllowedAudiences are the allowed audiences for idp access token validation.
 	IDPAccessTokenAllowedAudiences *[]string `mapstructure:"idp_access_token_allowed_audiences" yaml:"idp_access_token_allowed_audiences,omitempty"`
+	// IDPAccessTokenIntrospectionURL is the token introspection endpoint used to verify idp access tokens.
+	IDPAccessTokenIntrospectionURL string `mapstructure:"idp_access_token_introspection_url" yaml:"idp_access_token_introspection_url,omitempty"`
 
 	// ShowErrorDetails indicates whether or not additional error details should be displayed.
 	ShowErrorDetails bool `mapstructure:"show_error_details" yaml:"show_error_details" json:"show_error_details"`
+	// ErrorPages are custom error page templates keyed by status code (403) or
+	// status class (5xx).
+	ErrorPages map[string]string `mapstructure:"error_pages" yaml:"error_pages,omitempty" json:"error_pages,omitempty"`
 
 	Policy *PPLPolicy `mapstructure:"policy" yaml:"policy,omitempty" json:"policy,omitempty"`
 
@@ -208,6 +267,20 @@ type Policy struct {
 	// MCP is an experimental support for Model Context Protocol upstreams
 	MCP                      *MCP                      `mapstructure:"mcp" yaml:"mcp,omitempty" json:"mcp,omitempty"`
 	CircuitBreakerThresholds *CircuitBreakerThresholds `mapstructure:"circuit_breaker_thresholds" yaml:"circuit_breaker_thresholds,omitempty" json:"circuit_breaker_thresholds,omitempty"`
+	// OutlierDetection ejects upstream hosts which keep failing, so that a
+	// single unhealthy host doesn't slow down the route.
+	OutlierDetection *OutlierDetection `mapstructure:"outlier_detection" yaml:"outlier_detection,omitempty" json:"outlier_detection,omitempty"`
+
+	// RetryPolicy retries failed requests to the upstream.
+	RetryPolicy *RetryPolicy `mapstructure:"retry_policy" yaml:"retry_policy,omitempty" json:"retry_policy,omitempty"`
+
+	// TCPListenPorts is a port, or a range of ports like "9000-9010", on
+	// which a TCP route also accepts raw TCP connections, without an HTTP
+	// CONNECT tunnel. When several routes share a port, connections are
+	// routed by the TLS SNI matching the route's from hostname. Connections
+	// on these ports are not authorized, so the route must allow public
+	// unauthenticated access.
+	TCPListenPorts string `mapstructure:"tcp_listen_ports" yaml:"tcp_listen_ports,omitempty" json:"tcp_listen_ports,omitempty"`
 
 	UpstreamTunnel *UpstreamTunnel `mapstructure:"upstream_tunnel" yaml:"upstream_tunnel,omitempty" json:"upstream_tunnel,omitempty"`
 }
@@ -381,12 +454,19 @@ func NewPolicyFromProto(pb *configpb.Route) (*Policy, error) {
 		AllowPublicUnauthenticatedAccess: pb.GetAllowPublicUnauthenticatedAccess(),
 		AllowSPDY:                        pb.GetAllowSpdy(),
 		AllowWebsockets:                  pb.GetAllowWebsockets(),
+		Category:                         pb.GetCategory(),
 		CircuitBreakerThresholds:         CircuitBreakerThresholdsFromPB(pb.CircuitBreakerThresholds),
+		CookieDomain:                     pb.GetCookieDomain(),
+		CookieSameSite:                   pb.GetCookieSameSite(),
+		CookieSecure:                     pb.CookieSecure,
 		CORSAllowPreflight:               pb.GetCorsAllowPreflight(),
 		Description:                      pb.GetDescription(),
 		DependsOn:                        pb.GetDependsOn(),
 		EnableGoogleCloudServerlessAuthentication: pb.GetEnableGoogleCloudServerlessAuthentication(),
+		ErrorPages:                        pb.GetErrorPages(),
+		ExternalProcessing:                ExternalProcessingFromPB(pb.GetExternalProcessing()),
 		From:                              pb.GetFrom(),
+		GRPCWeb:                           GRPCWebFromPB(pb.GetGrpcWeb()),
 		HostPathRegexRewritePattern:       pb.GetHostPathRegexRewritePattern(),
 		HostPathRegexRewriteSubstitution:  pb.GetHostPathRegexRewriteSubstitution(),
 		HostRewrite:                       pb.GetHostRewrite(),
@@ -395,6 +475,9 @@ func NewPolicyFromProto(pb *configpb.Route) (*Policy, error) {
 		IdleTimeout:                       idleTimeout,
 		IDPClientID:                       pb.GetIdpClientId(),
 		IDPClientSecret:                   pb.GetIdpClientSecret(),
+		IDPAccessTokenIntrospectionURL:    pb.GetIdpAccessTokenIntrospectionUrl(),
+		JWTClaimsAllowlist:                pb.GetJwtClaimsAllowlist(),
+		JWTCustomClaims:                   pb.GetJwtCustomClaims(),
 		JWTGroupsFilter:                   NewJWTGroupsFilter(pb.JwtGroupsFilter),
 		JWTIssuerFormat:                   JWTIssuerFormatFromPB(pb.JwtIssuerFormat),
 		KubernetesServiceAccountToken:     pb.GetKubernetesServiceAccountToken(),
@@ -402,19 +485,27 @@ func NewPolicyFromProto(pb *configpb.Route) (*Policy, error) {
 		LogoURL:                           pb.GetLogoUrl(),
 		MCP:                               MCPFromPB(pb.GetMcp()),
 		Name:                              pb.GetName(),
+		OutlierDetection:                  OutlierDetectionFromPB(pb.GetOutlierDetection()),
 		PassIdentityHeaders:               pb.PassIdentityHeaders,
 		Path:                              pb.GetPath(),
+		PortalOrder:                       int(pb.GetPortalOrder()),
 		Prefix:                            pb.GetPrefix(),
 		PrefixRewrite:                     pb.GetPrefixRewrite(),
 		PreserveHostHeader:                pb.GetPreserveHostHeader(),
+		RateLimit:                         RateLimitFromPB(pb.GetRateLimit()),
 		Regex:                             pb.GetRegex(),
 		RegexPriorityOrder:                pb.RegexPriorityOrder,
 		RegexRewritePattern:               pb.GetRegexRewritePattern(),
 		RegexRewriteSubstitution:          pb.GetRegexRewriteSubstitution(),
 		RemoveRequestHeaders:              pb.GetRemoveRequestHeaders(),
+		RequiredAssuranceLevel:            pb.GetRequiredAssuranceLevel(),
+		ResponseHeaders:                   ResponseHeadersFromPB(pb.GetResponseHeaders()),
+		RetryPolicy:                       RetryPolicyFromPB(pb.GetRetryPolicy()),
 		SetRequestHeaders:                 pb.GetSetRequestHeaders(),
 		SetResponseHeaders:                pb.GetSetResponseHeaders(),
 		ShowErrorDetails:                  pb.GetShowErrorDetails(),
+		Tags:                              pb.GetTags(),
+		TCPListenPorts:                    pb.GetTcpListenPorts(),
 		TLSClientCert:                     pb.GetTlsClientCert(),
 		TLSClientCertFile:                 pb.GetTlsClientCertFile(),
 		TLSClientKey:                      pb.GetTlsClientKey(),
@@ -423,6 +514,9 @@ func NewPolicyFromProto(pb *configpb.Route) (*Policy, error) {
 		TLSCustomCAFile:                   pb.GetTlsCustomCaFile(),
 		TLSDownstreamClientCA:             pb.GetTlsDownstreamClientCa(),
 		TLSDownstreamClientCAFile:         pb.GetTlsDownstreamClientCaFile(),
+		TLSDownstreamClientCRL:            pb.GetTlsDownstreamClientCrl(),
+		TLSDownstreamClientCRLFile:        pb.GetTlsDownstreamClientCrlFile(),
+		TLSDownstreamClientOCSPCheck:      pb.TlsDownstreamClientOcspCheck,
 		TLSDownstreamServerName:           pb.GetTlsDownstreamServerName(),
 		TLSServerName:                     pb.GetTlsServerName(),
 		TLSSkipVerify:                     pb.GetTlsSkipVerify(),
@@ -540,15 +634,24 @@ func (p *Policy) ToProto() (*configpb.Route, error) {
 		AllowPublicUnauthenticatedAccess: p.AllowPublicUnauthenticatedAccess,
 		AllowSpdy:                        p.AllowSPDY,
 		AllowWebsockets:                  p.AllowWebsockets,
+		Category:                         p.Category,
 		CircuitBreakerThresholds:         CircuitBreakerThresholdsToPB(p.CircuitBreakerThresholds),
+		CookieDomain:                     p.CookieDomain,
+		CookieSameSite:                   p.CookieSameSite,
+		CookieSecure:                     p.CookieSecure,
 		CorsAllowPreflight:               p.CORSAllowPreflight,
 		Description:                      p.Description,
 		DependsOn:                        p.DependsOn,
 		EnableGoogleCloudServerlessAuthentication: p.EnableGoogleCloudServerlessAuthentication,
 		EnvoyOpts:                         p.EnvoyOpts,
+		ErrorPages:                        p.ErrorPages,
+		ExternalProcessing:                ExternalProcessingToPB(p.ExternalProcessing),
 		From:                              p.From,
+		GrpcWeb:                           GRPCWebToPB(p.GRPCWeb),
 		Id:                                p.ID,
 		IdleTimeout:                       idleTimeout,
+		JwtClaimsAllowlist:                p.JWTClaimsAllowlist,
+		JwtCustomClaims:                   p.JWTCustomClaims,
 		JwtGroupsFilter:                   p.JWTGroupsFilter.ToSlice(),
 		JwtIssuerFormat:                   p.JWTIssuerFormat.ToPB(),
 		KubernetesServiceAccountToken:     p.KubernetesServiceAccountToken,
@@ -556,20 +659,28 @@ func (p *Policy) ToProto() (*configpb.Route, error) {
 		LogoUrl:                           p.LogoURL,
 		Mcp:                               MCPToPB(p.MCP),
 		Name:                              p.Name,
+		OutlierDetection:                  OutlierDetectionToPB(p.OutlierDetection),
 		PassIdentityHeaders:               p.PassIdentityHeaders,
 		Path:                              p.Path,
 		Policies:                          sps,
+		PortalOrder:                       int64(p.PortalOrder),
 		Prefix:                            p.Prefix,
 		PrefixRewrite:                     p.PrefixRewrite,
 		PreserveHostHeader:                p.PreserveHostHeader,
+		RateLimit:                         RateLimitToPB(p.RateLimit),
 		Regex:                             p.Regex,
 		RegexPriorityOrder:                p.RegexPriorityOrder,
 		RegexRewritePattern:               p.RegexRewritePattern,
 		RegexRewriteSubstitution:          p.RegexRewriteSubstitution,
 		RemoveRequestHeaders:              p.RemoveRequestHeaders,
+		RequiredAssuranceLevel:            p.RequiredAssuranceLevel,
+		ResponseHeaders:                   ResponseHeadersToPB(p.ResponseHeaders),
+		RetryPolicy:                       RetryPolicyToPB(p.RetryPolicy),
 		SetRequestHeaders:                 p.SetRequestHeaders,
 		SetResponseHeaders:                p.SetResponseHeaders,
 		ShowErrorDetails:                  p.ShowErrorDetails,
+		Tags:                              p.Tags,
+		TcpListenPorts:                    p.TCPListenPorts,
 		Timeout:                           timeout,
 		TlsClientCert:                     p.TLSClientCert,
 		TlsClientCertFile:                 p.TLSClientCertFile,
@@ -579,6 +690,9 @@ func (p *Policy) ToProto() (*configpb.Route, error) {
 		TlsCustomCaFile:                   p.TLSCustomCAFile,
 		TlsDownstreamClientCa:             p.TLSDownstreamClientCA,
 		TlsDownstreamClientCaFile:         p.TLSDownstreamClientCAFile,
+		TlsDownstreamClientCrl:            p.TLSDownstreamClientCRL,
+		TlsDownstreamClientCrlFile:        p.TLSDownstreamClientCRLFile,
+		TlsDownstreamClientOcspCheck:      p.TLSDownstreamClientOCSPCheck,
 		TlsDownstreamServerName:           p.TLSDownstreamServerName,
 		TlsServerName:                     p.TLSServerName,
 		TlsSkipVerify:                     p.TLSSkipVerify,
@@ -607,6 +721,9 @@ func (p *Policy) ToProto() (*configpb.Route, error) {
 	if p.IDPClientSecret != "" {
 		pb.IdpClientSecret = proto.String(p.IDPClientSecret)
 	}
+	if p.IDPAccessTokenIntrospectionURL != "" {
+		pb.IdpAccessTokenIntrospectionUrl = proto.String(p.IDPAccessTokenIntrospectionURL)
+	}
 	if p.IDPAccessTokenAllowedAudiences != nil {
 		pb.IdpAccessTokenAllowedAudiences = &configpb.Route_StringList{
 			Values: slices.Clone(*p.IDPAccessTokenAllowedAudiences),
@@ -757,6 +874,24 @@ func (p *Policy) Validate() error {
 		p.TLSDownstreamClientCA = base64.StdEncoding.EncodeToString(bs)
 	}
 
+	if p.TLSDownstreamClientCRLFile != "" {
+		bs, err := os.ReadFile(p.TLSDownstreamClientCRLFile)
+		if err != nil {
+			return fmt.Errorf("config: couldn't load downstream client crl: %w", err)
+		}
+		p.TLSDownstreamClientCRL = base64.StdEncoding.EncodeToString(bs)
+	}
+
+	if p.TLSDownstreamClientCRL != "" {
+		bs, err := base64.StdEncoding.DecodeString(p.TLSDownstreamClientCRL)
+		if err != nil {
+			return fmt.Errorf("config: couldn't decode downstream client crl: %w", err)
+		}
+		if _, err := cryptutil.ParseCRLs(bs); err != nil {
+			return fmt.Errorf("config: invalid downstream client crl: %w", err)
+		}
+	}
+
 	if p.KubernetesServiceAccountTokenFile != "" && p.KubernetesServiceAccountToken != "" {
 		return fmt.Errorf("config: specified both `kubernetes_service_account_token_file` and `kubernetes_service_account_token`")
 	}
@@ -797,6 +932,45 @@ func (p *Policy) Validate() error {
 	if p.MCP != nil && p.MCP.Server == nil && p.MCP.Client == nil {
 		return fmt.Errorf("config: mcp must have either server or client set")
 	}
+
+	if err := p.validateErrorPages(); err != nil {
+		return err
+	}
+	if err := p.validateJWTClaims(); err != nil {
+		return err
+	}
+	if err := p.validateResponseHeaders(); err != nil {
+		return err
+	}
+	if err := p.RateLimit.validate(); err != nil {
+		return err
+	}
+	if err := p.GRPCWeb.validate(); err != nil {
+		return err
+	}
+	if err := p.ExternalProcessing.validate(); err != nil {
+		return err
+	}
+	if err := p.validateAssuranceLevel(); err != nil {
+		return err
+	}
+	if err := p.validateCookieAttributes(); err != nil {
+		return err
+	}
+	if err := p.OutlierDetection.validate(); err != nil {
+		return err
+	}
+	if err := p.RetryPolicy.validate(); err != nil {
+		return err
+	}
+	if err := p.validateTCPListenPorts(); err != nil {
+		return err
+	}
+	if p.IDPAccessTokenIntrospectionURL != "" {
+		if _, err := urlutil.ParseAndValidateURL(p.IDPAccessTokenIntrospectionURL); err != nil {
+			return fmt.Errorf("config: bad idp_access_token_introspection_url %s : %w", p.IDPAccessTokenIntrospectionURL, err)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// assurance levels
+const (
+	// AssuranceLevelMFA requires the user to have signed in with multi-factor
+	// authentication.
+	AssuranceLevelMFA = "mfa"
+)
+
+// IsAssuranceLevelSatisfied returns true if the achieved assurance level
+// meets the required assurance level.
+func IsAssuranceLevelSatisfied(required, achieved string) bool {
+	switch required {
+	case "":
+		return true
+	case AssuranceLevelMFA:
+		return achieved == AssuranceLevelMFA
+	}
+	return false
+}
+
+// GetAssuranceLevelForClaims returns the assurance level achieved by an
+// identity provider login, based on the acr and amr claims of the login.
+//
+// https://openid.net/specs/openid-connect-core-1_0.html#IDToken
+// https://www.rfc-editor.org/rfc/rfc8176.html
+func (o *Options) GetAssuranceLevelForClaims(claims map[string]any) string {
+	if acr, ok := claims["acr"].(string); ok && acr != "" && o != nil && slices.Contains(o.MFAACRValues, acr) {
+		return AssuranceLevelMFA
+	}
+
+	switch amr := claims["amr"].(type) {
+	case []any:
+		for _, v := range amr {
+			if v == "mfa" {
+				return AssuranceLevelMFA
+			}
+		}
+	case []string:
+		if slices.Contains(amr, "mfa") {
+			return AssuranceLevelMFA
+		}
+	}
+
+	return ""
+}
+
+// GetStepUpAuthCodeOptions returns the additional authorization request
+// parameters used to ask the identity provider for the given assurance level.
+// The user is always asked to sign in again.
+func (o *Options) GetStepUpAuthCodeOptions(level string) map[string]string {
+	opts := map[string]string{"prompt": "login"}
+	if level == AssuranceLevelMFA && len(o.MFAACRValues) > 0 {
+		opts["acr_values"] = strings.Join(o.MFAACRValues, " ")
+	}
+	return opts
+}
+
+func (p *Policy) validateAssuranceLevel() error {
+	switch p.RequiredAssuranceLevel {
+	case "", AssuranceLevelMFA:
+		return nil
+	}
+	return fmt.Errorf("config: unsupported required_assurance_level %q", p.RequiredAssuranceLevel)
+}
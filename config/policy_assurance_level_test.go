@@ -0,0 +1,46 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsAssuranceLevelSatisfied(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsAssuranceLevelSatisfied("", ""))
+	assert.True(t, IsAssuranceLevelSatisfied("", AssuranceLevelMFA))
+	assert.True(t, IsAssuranceLevelSatisfied(AssuranceLevelMFA, AssuranceLevelMFA))
+	assert.False(t, IsAssuranceLevelSatisfied(AssuranceLevelMFA, ""))
+	assert.False(t, IsAssuranceLevelSatisfied("unknown", AssuranceLevelMFA))
+}
+
+func TestOptions_GetAssuranceLevelForClaims(t *testing.T) {
+	t.Parallel()
+
+	o := &Options{MFAACRValues: []string{"urn:example:mfa"}}
+	assert.Equal(t, "", o.GetAssuranceLevelForClaims(nil))
+	assert.Equal(t, "", o.GetAssuranceLevelForClaims(map[string]any{"acr": "urn:example:pwd"}))
+	assert.Equal(t, AssuranceLevelMFA, o.GetAssuranceLevelForClaims(map[string]any{"acr": "urn:example:mfa"}))
+	assert.Equal(t, "", o.GetAssuranceLevelForClaims(map[string]any{"amr": []any{"pwd"}}))
+	assert.Equal(t, AssuranceLevelMFA, o.GetAssuranceLevelForClaims(map[string]any{"amr": []any{"pwd", "mfa"}}))
+	assert.Equal(t, AssuranceLevelMFA, o.GetAssuranceLevelForClaims(map[string]any{"amr": []string{"mfa"}}))
+}
+
+func TestOptions_GetStepUpAuthCodeOptions(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, map[string]string{"prompt": "login"},
+		(&Options{}).GetStepUpAuthCodeOptions(AssuranceLevelMFA))
+	assert.Equal(t, map[string]string{"prompt": "login", "acr_values": "a b"},
+		(&Options{MFAACRValues: []string{"a", "b"}}).GetStepUpAuthCodeOptions(AssuranceLevelMFA))
+}
+
+func TestPolicy_validateAssuranceLevel(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, (&Policy{}).validateAssuranceLevel())
+	assert.NoError(t, (&Policy{RequiredAssuranceLevel: AssuranceLevelMFA}).validateAssuranceLevel())
+	assert.Error(t, (&Policy{RequiredAssuranceLevel: "high"}).validateAssuranceLevel())
+}
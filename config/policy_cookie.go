@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/urlutil"
+)
+
+// GetCookieAttributesForRequest returns the session cookie attribute
+// overrides of the route matching the request, if any.
+func (o *Options) GetCookieAttributesForRequest(r *http.Request) httputil.CookieAttributes {
+	u := urlutil.GetAbsoluteURL(r)
+	for p := range o.GetAllPolicies() {
+		if p.Matches(u, o.IsRuntimeFlagSet(RuntimeFlagMatchAnyIncomingPort)) {
+			return p.GetCookieAttributes()
+		}
+	}
+	return httputil.CookieAttributes{}
+}
+
+func (p *Policy) validateCookieAttributes() error {
+	if err := ValidateCookieSameSite(p.CookieSameSite); err != nil {
+		return fmt.Errorf("config: invalid cookie_same_site: %w", err)
+	}
+	// browsers reject SameSite=None cookies without the Secure attribute
+	if p.GetCookieAttributes().SameSite == http.SameSiteNoneMode && p.CookieSecure != nil && !*p.CookieSecure {
+		return fmt.Errorf("config: cookie_same_site none requires cookie_secure")
+	}
+	return nil
+}
+
+// GetCookieAttributes returns the cookie attribute overrides for the route.
+func (p *Policy) GetCookieAttributes() httputil.CookieAttributes {
+	attrs := httputil.CookieAttributes{
+		Secure: p.CookieSecure,
+		Domain: p.CookieDomain,
+	}
+	if p.CookieSameSite != "" {
+		attrs.SameSite = httputil.ParseCookieSameSite(p.CookieSameSite)
+	}
+	return attrs
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/internal/httputil"
+)
+
+func TestPolicy_validateCookieAttributes(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, (&Policy{}).validateCookieAttributes())
+	assert.NoError(t, (&Policy{CookieSameSite: "None"}).validateCookieAttributes())
+	assert.NoError(t, (&Policy{CookieSameSite: "lax", CookieSecure: ptr(false)}).validateCookieAttributes())
+	assert.Error(t, (&Policy{CookieSameSite: "sometimes"}).validateCookieAttributes())
+	assert.Error(t, (&Policy{CookieSameSite: "none", CookieSecure: ptr(false)}).validateCookieAttributes())
+}
+
+func TestOptions_GetCookieAttributesForRequest(t *testing.T) {
+	t.Parallel()
+
+	o := NewDefaultOptions()
+	o.Policies = []Policy{
+		{From: "https://embedded.example.com", CookieSameSite: "none", CookieDomain: "example.com"},
+		{From: "https://other.example.com"},
+	}
+
+	assert.Equal(t, httputil.CookieAttributes{SameSite: http.SameSiteNoneMode, Domain: "example.com"},
+		o.GetCookieAttributesForRequest(httptest.NewRequest(http.MethodGet, "https://embedded.example.com/app", nil)))
+	assert.Equal(t, httputil.CookieAttributes{},
+		o.GetCookieAttributesForRequest(httptest.NewRequest(http.MethodGet, "https://other.example.com", nil)))
+	assert.Equal(t, httputil.CookieAttributes{},
+		o.GetCookieAttributesForRequest(httptest.NewRequest(http.MethodGet, "https://unknown.example.com", nil)))
+}
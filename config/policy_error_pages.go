@@ -0,0 +1,89 @@
+package config
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"html/template"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var errorPageKeyRE = regexp.MustCompile(`^[45]([0-9]{2}|xx)$`)
+
+// ErrorPageData is the data available to custom error page templates.
+type ErrorPageData struct {
+	Status     string
+	StatusText string
+	RequestID  string
+	RouteName  string
+	Email      string
+}
+
+// GetErrorPageKey returns the error_pages key for the given status code. An
+// exact status code takes precedence over a status class.
+func (p *Policy) GetErrorPageKey(status int) (string, bool) {
+	if p == nil || len(p.ErrorPages) == 0 {
+		return "", false
+	}
+
+	if key := strconv.Itoa(status); p.ErrorPages[key] != "" {
+		return key, true
+	}
+	if key := strconv.Itoa(status/100) + "xx"; p.ErrorPages[key] != "" {
+		return key, true
+	}
+	return "", false
+}
+
+// GetErrorPageKeys returns the error_pages keys, with exact status codes
+// before status classes.
+func (p *Policy) GetErrorPageKeys() []string {
+	var keys []string
+	for key := range p.ErrorPages {
+		keys = append(keys, key)
+	}
+	rank := func(key string) int {
+		if strings.HasSuffix(key, "xx") {
+			return 1
+		}
+		return 0
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		return cmp.Or(
+			cmp.Compare(rank(a), rank(b)),
+			cmp.Compare(a, b),
+		)
+	})
+	return keys
+}
+
+// RenderErrorPage renders the error page template for the given error_pages
+// key.
+func (p *Policy) RenderErrorPage(key string, data ErrorPageData) ([]byte, error) {
+	tmpl, err := template.New(key).Parse(p.ErrorPages[key])
+	if err != nil {
+		return nil, fmt.Errorf("config: invalid error page template for %s: %w", key, err)
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, data)
+	if err != nil {
+		return nil, fmt.Errorf("config: error rendering error page template for %s: %w", key, err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (p *Policy) validateErrorPages() error {
+	for key, text := range p.ErrorPages {
+		if !errorPageKeyRE.MatchString(key) {
+			return fmt.Errorf("config: invalid error page status %q, expected a status code like 403 or a status class like 5xx", key)
+		}
+		if _, err := template.New(key).Parse(text); err != nil {
+			return fmt.Errorf("config: invalid error page template for %s: %w", key, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_ErrorPages(t *testing.T) {
+	t.Parallel()
+
+	p := &Policy{
+		ErrorPages: map[string]string{
+			"4xx": "client error",
+			"403": "{{.RouteName}}: {{.Email}} is not allowed ({{.RequestID}})",
+			"5xx": "server error",
+			"502": "bad gateway",
+		},
+	}
+
+	for status, expect := range map[int]string{
+		403: "403",
+		404: "4xx",
+		502: "502",
+		503: "5xx",
+	} {
+		key, ok := p.GetErrorPageKey(status)
+		assert.True(t, ok)
+		assert.Equal(t, expect, key)
+	}
+	_, ok := p.GetErrorPageKey(302)
+	assert.False(t, ok)
+	_, ok = (*Policy)(nil).GetErrorPageKey(403)
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{"403", "502", "4xx", "5xx"}, p.GetErrorPageKeys())
+
+	body, err := p.RenderErrorPage("403", ErrorPageData{
+		RouteName: "<app>",
+		Email:     "user@example.com",
+		RequestID: "REQUEST_ID",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "&lt;app&gt;: user@example.com is not allowed (REQUEST_ID)", string(body))
+}
+
+func TestPolicy_GetErrorPageKeys(t *testing.T) {
+	t.Parallel()
+
+	p := &Policy{
+		ErrorPages: map[string]string{
+			"5xx": "server error",
+			"404": "not found",
+			"4xx": "client error",
+			"500": "internal server error",
+		},
+	}
+	assert.Equal(t, []string{"404", "500", "4xx", "5xx"}, p.GetErrorPageKeys())
+	assert.Empty(t, (&Policy{}).GetErrorPageKeys())
+}
+
+func TestPolicy_validateErrorPages(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name       string
+		errorPages map[string]string
+		expectErr  bool
+	}{
+		{"empty", nil, false},
+		{"valid", map[string]string{"403": "{{.Email}}", "5xx": "error"}, false},
+		{"invalid status", map[string]string{"200": "ok"}, true},
+		{"invalid class", map[string]string{"5x": "error"}, true},
+		{"invalid template", map[string]string{"403": "{{.Email"}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := (&Policy{ErrorPages: tc.errorPages}).validateErrorPages()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
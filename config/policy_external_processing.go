@@ -0,0 +1,157 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pomerium/pomerium/internal/urlutil"
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// header send modes for external processing
+const (
+	ExternalProcessingHeaderModeDefault = "default"
+	ExternalProcessingHeaderModeSend    = "send"
+	ExternalProcessingHeaderModeSkip    = "skip"
+)
+
+// body send modes for external processing
+const (
+	ExternalProcessingBodyModeNone            = "none"
+	ExternalProcessingBodyModeStreamed        = "streamed"
+	ExternalProcessingBodyModeBuffered        = "buffered"
+	ExternalProcessingBodyModeBufferedPartial = "buffered_partial"
+)
+
+var (
+	externalProcessingHeaderModes = []string{
+		"",
+		ExternalProcessingHeaderModeDefault,
+		ExternalProcessingHeaderModeSend,
+		ExternalProcessingHeaderModeSkip,
+	}
+	externalProcessingBodyModes = []string{
+		"",
+		ExternalProcessingBodyModeNone,
+		ExternalProcessingBodyModeStreamed,
+		ExternalProcessingBodyModeBuffered,
+		ExternalProcessingBodyModeBufferedPartial,
+	}
+)
+
+// ExternalProcessing sends the requests and responses of a route to an
+// external gRPC processing service, e.g. a DLP or malware scanner, which may
+// inspect and modify them.
+type ExternalProcessing struct {
+	// Target is the URL of the gRPC service, e.g. "https://scanner.example.com:9000".
+	// An http URL uses plaintext HTTP/2.
+	Target string `mapstructure:"target" yaml:"target" json:"target"`
+	// Timeout is the timeout for each call to the service.
+	Timeout *time.Duration `mapstructure:"timeout" yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	// FailureModeAllow lets requests through when the service is unavailable.
+	FailureModeAllow bool `mapstructure:"failure_mode_allow" yaml:"failure_mode_allow,omitempty" json:"failure_mode_allow,omitempty"`
+	// ProcessingMode selects which parts of the request and response are sent
+	// to the service.
+	ProcessingMode ExternalProcessingMode `mapstructure:"processing_mode" yaml:"processing_mode,omitempty" json:"processing_mode,omitempty"`
+}
+
+// ExternalProcessingMode selects which parts of the request and response are
+// sent to an external processing service. Header modes are one of "default",
+// "send" or "skip". Body modes are one of "none", "streamed", "buffered" or
+// "buffered_partial". Unset modes use the envoy defaults.
+type ExternalProcessingMode struct {
+	RequestHeaders  string `mapstructure:"request_headers" yaml:"request_headers,omitempty" json:"request_headers,omitempty"`
+	ResponseHeaders string `mapstructure:"response_headers" yaml:"response_headers,omitempty" json:"response_headers,omitempty"`
+	RequestBody     string `mapstructure:"request_body" yaml:"request_body,omitempty" json:"request_body,omitempty"`
+	ResponseBody    string `mapstructure:"response_body" yaml:"response_body,omitempty" json:"response_body,omitempty"`
+}
+
+// GetTargetURL returns the parsed target URL.
+// ExternalProcessingFromPB converts the ExternalProcessing from a protobuf type.
+func ExternalProcessingFromPB(src *configpb.RouteExternalProcessing) *ExternalProcessing {
+	if src == nil {
+		return nil
+	}
+
+	dst := &ExternalProcessing{
+		Target:           src.GetTarget(),
+		FailureModeAllow: src.GetFailureModeAllow(),
+		ProcessingMode: ExternalProcessingMode{
+			RequestHeaders:  src.GetProcessingMode().GetRequestHeaders(),
+			ResponseHeaders: src.GetProcessingMode().GetResponseHeaders(),
+			RequestBody:     src.GetProcessingMode().GetRequestBody(),
+			ResponseBody:    src.GetProcessingMode().GetResponseBody(),
+		},
+	}
+	if src.Timeout != nil {
+		timeout := src.Timeout.AsDuration()
+		dst.Timeout = &timeout
+	}
+	return dst
+}
+
+// ExternalProcessingToPB converts the ExternalProcessing into a protobuf type.
+func ExternalProcessingToPB(src *ExternalProcessing) *configpb.RouteExternalProcessing {
+	if src == nil {
+		return nil
+	}
+
+	dst := &configpb.RouteExternalProcessing{
+		Target:           src.Target,
+		FailureModeAllow: src.FailureModeAllow,
+		ProcessingMode: &configpb.RouteExternalProcessing_ProcessingMode{
+			RequestHeaders:  src.ProcessingMode.RequestHeaders,
+			ResponseHeaders: src.ProcessingMode.ResponseHeaders,
+			RequestBody:     src.ProcessingMode.RequestBody,
+			ResponseBody:    src.ProcessingMode.ResponseBody,
+		},
+	}
+	if src.Timeout != nil {
+		dst.Timeout = durationpb.New(*src.Timeout)
+	}
+	return dst
+}
+
+func (ep *ExternalProcessing) GetTargetURL() (*url.URL, error) {
+	u, err := urlutil.ParseAndValidateURL(ep.Target)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	return u, nil
+}
+
+func (ep *ExternalProcessing) validate() error {
+	if ep == nil {
+		return nil
+	}
+	if _, err := ep.GetTargetURL(); err != nil {
+		return fmt.Errorf("config: invalid external_processing target: %w", err)
+	}
+	if ep.Timeout != nil && *ep.Timeout <= 0 {
+		return fmt.Errorf("config: external_processing timeout must be greater than zero")
+	}
+	for name, mode := range map[string]string{
+		"request_headers":  ep.ProcessingMode.RequestHeaders,
+		"response_headers": ep.ProcessingMode.ResponseHeaders,
+	} {
+		if !slices.Contains(externalProcessingHeaderModes, mode) {
+			return fmt.Errorf("config: invalid external_processing processing_mode %s: %q", name, mode)
+		}
+	}
+	for name, mode := range map[string]string{
+		"request_body":  ep.ProcessingMode.RequestBody,
+		"response_body": ep.ProcessingMode.ResponseBody,
+	} {
+		if !slices.Contains(externalProcessingBodyModes, mode) {
+			return fmt.Errorf("config: invalid external_processing processing_mode %s: %q", name, mode)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExternalProcessing(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, (*ExternalProcessing)(nil).validate())
+	assert.NoError(t, (&ExternalProcessing{Target: "http://scanner.example.com:9000"}).validate())
+	assert.NoError(t, (&ExternalProcessing{
+		Target:  "https://scanner.example.com",
+		Timeout: ptr(time.Second),
+		ProcessingMode: ExternalProcessingMode{
+			RequestHeaders:  ExternalProcessingHeaderModeSend,
+			ResponseHeaders: ExternalProcessingHeaderModeSkip,
+			RequestBody:     ExternalProcessingBodyModeBuffered,
+			ResponseBody:    ExternalProcessingBodyModeStreamed,
+		},
+	}).validate())
+	assert.Error(t, (&ExternalProcessing{}).validate())
+	assert.Error(t, (&ExternalProcessing{Target: "tcp://scanner.example.com:9000"}).validate())
+	assert.Error(t, (&ExternalProcessing{Target: "http://scanner.example.com", Timeout: ptr(time.Duration(0))}).validate())
+	assert.Error(t, (&ExternalProcessing{
+		Target:         "http://scanner.example.com",
+		ProcessingMode: ExternalProcessingMode{RequestHeaders: "buffered"},
+	}).validate())
+	assert.Error(t, (&ExternalProcessing{
+		Target:         "http://scanner.example.com",
+		ProcessingMode: ExternalProcessingMode{ResponseBody: "send"},
+	}).validate())
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"errors"
+
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// GRPCWeb translates gRPC-Web requests, and optionally Connect requests, to
+// gRPC for a route, so that browser clients can call gRPC upstreams.
+type GRPCWeb struct {
+	// Connect also translates Connect protocol requests.
+	Connect bool `mapstructure:"connect" yaml:"connect,omitempty" json:"connect,omitempty"`
+	// AllowedOrigins are the origins allowed to make cross-origin requests to
+	// the route. Since the requests are made with credentials, each origin
+	// must be listed explicitly and "*" is not allowed.
+	AllowedOrigins []string `mapstructure:"allowed_origins" yaml:"allowed_origins,omitempty" json:"allowed_origins,omitempty"`
+}
+
+// GRPCWebFromPB converts the GRPCWeb from a protobuf type.
+func GRPCWebFromPB(src *configpb.RouteGRPCWeb) *GRPCWeb {
+	if src == nil {
+		return nil
+	}
+
+	return &GRPCWeb{
+		Connect:        src.GetConnect(),
+		AllowedOrigins: src.GetAllowedOrigins(),
+	}
+}
+
+// GRPCWebToPB converts the GRPCWeb into a protobuf type.
+func GRPCWebToPB(src *GRPCWeb) *configpb.RouteGRPCWeb {
+	if src == nil {
+		return nil
+	}
+
+	return &configpb.RouteGRPCWeb{
+		Connect:        src.Connect,
+		AllowedOrigins: src.AllowedOrigins,
+	}
+}
+
+func (gw *GRPCWeb) validate() error {
+	if gw == nil {
+		return nil
+	}
+	for _, origin := range gw.AllowedOrigins {
+		if origin == "" {
+			return errors.New("config: grpc_web allowed_origins must not be empty")
+		}
+		if origin == "*" {
+			return errors.New("config: grpc_web allowed_origins must not contain a wildcard, since requests are made with credentials")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGRPCWeb(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, (*GRPCWeb)(nil).validate())
+	assert.NoError(t, (&GRPCWeb{}).validate())
+	assert.NoError(t, (&GRPCWeb{Connect: true, AllowedOrigins: []string{"https://app.example.com", "https://admin.example.com"}}).validate())
+	assert.Error(t, (&GRPCWeb{AllowedOrigins: []string{"https://app.example.com", "*"}}).validate())
+	assert.Error(t, (&GRPCWeb{AllowedOrigins: []string{""}}).validate())
+}
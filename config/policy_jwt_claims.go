@@ -0,0 +1,56 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// JWTRegisteredClaims are the claims always included in the Pomerium JWT.
+// They cannot be removed by a claims allowlist or replaced by a custom claim.
+var JWTRegisteredClaims = []string{"iss", "aud", "jti", "iat", "exp", "sub"}
+
+// sources for custom JWT claims
+const (
+	JWTCustomClaimSourceSession = "session"
+	JWTCustomClaimSourceUser    = "user"
+)
+
+// ParseJWTCustomClaimSource parses the source of a custom JWT claim. Sources
+// are of the form "session.<claim>" or "user.<claim>" and refer to the claims
+// stored on the session or user record.
+func ParseJWTCustomClaimSource(source string) (recordType, claim string, err error) {
+	recordType, claim, ok := strings.Cut(source, ".")
+	if !ok || claim == "" {
+		return "", "", fmt.Errorf("config: invalid jwt custom claim source %q, expected session.<claim> or user.<claim>", source)
+	}
+	switch recordType {
+	case JWTCustomClaimSourceSession, JWTCustomClaimSourceUser:
+	default:
+		return "", "", fmt.Errorf("config: invalid jwt custom claim source %q, expected session.<claim> or user.<claim>", source)
+	}
+	return recordType, claim, nil
+}
+
+// IsJWTClaimAllowed returns true if the claim should be included in the
+// Pomerium JWT for the route.
+func (p *Policy) IsJWTClaimAllowed(claim string) bool {
+	if p == nil || len(p.JWTClaimsAllowlist) == 0 {
+		return true
+	}
+	return slices.Contains(JWTRegisteredClaims, claim) ||
+		slices.Contains(p.JWTClaimsAllowlist, claim) ||
+		p.JWTCustomClaims[claim] != ""
+}
+
+func (p *Policy) validateJWTClaims() error {
+	for name, source := range p.JWTCustomClaims {
+		if slices.Contains(JWTRegisteredClaims, name) {
+			return fmt.Errorf("config: jwt custom claim %q cannot replace a registered claim", name)
+		}
+		if _, _, err := ParseJWTCustomClaimSource(source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicy_IsJWTClaimAllowed(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, (*Policy)(nil).IsJWTClaimAllowed("email"))
+	assert.True(t, (&Policy{}).IsJWTClaimAllowed("email"))
+
+	p := &Policy{
+		JWTClaimsAllowlist: []string{"email"},
+		JWTCustomClaims:    map[string]string{"dept": "user.department"},
+	}
+	assert.True(t, p.IsJWTClaimAllowed("email"))
+	assert.True(t, p.IsJWTClaimAllowed("dept"))
+	assert.True(t, p.IsJWTClaimAllowed("sub"))
+	assert.False(t, p.IsJWTClaimAllowed("groups"))
+}
+
+func TestPolicy_validateJWTClaims(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name         string
+		customClaims map[string]string
+		expectErr    bool
+	}{
+		{"empty", nil, false},
+		{"valid", map[string]string{"dept": "user.department", "amr": "session.amr"}, false},
+		{"registered claim", map[string]string{"sub": "user.department"}, true},
+		{"invalid record type", map[string]string{"dept": "device.department"}, true},
+		{"missing claim", map[string]string{"dept": "user."}, true},
+		{"missing record type", map[string]string{"dept": "department"}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := (&Policy{JWTCustomClaims: tc.customClaims}).validateJWTClaims()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"errors"
+
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// A RateLimit limits the rate of requests to a route. Requests are counted
+// separately for each authenticated user, or for each client IP address when
+// the request is not authenticated.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained number of requests allowed per second.
+	RequestsPerSecond uint32 `mapstructure:"requests_per_second" yaml:"requests_per_second" json:"requests_per_second"`
+	// Burst is the maximum number of requests allowed at once. It defaults to
+	// RequestsPerSecond.
+	Burst uint32 `mapstructure:"burst" yaml:"burst,omitempty" json:"burst,omitempty"`
+}
+
+// RateLimitFromPB converts the RateLimit from a protobuf type.
+func RateLimitFromPB(src *configpb.RouteRateLimit) *RateLimit {
+	if src == nil {
+		return nil
+	}
+
+	return &RateLimit{
+		RequestsPerSecond: src.GetRequestsPerSecond(),
+		Burst:             src.GetBurst(),
+	}
+}
+
+// RateLimitToPB converts the RateLimit into a protobuf type.
+func RateLimitToPB(src *RateLimit) *configpb.RouteRateLimit {
+	if src == nil {
+		return nil
+	}
+
+	return &configpb.RouteRateLimit{
+		RequestsPerSecond: src.RequestsPerSecond,
+		Burst:             src.Burst,
+	}
+}
+
+// GetBurst returns the maximum number of requests allowed at once.
+func (rl *RateLimit) GetBurst() uint32 {
+	if rl == nil {
+		return 0
+	}
+	return max(rl.Burst, rl.RequestsPerSecond)
+}
+
+func (rl *RateLimit) validate() error {
+	if rl == nil {
+		return nil
+	}
+	if rl.RequestsPerSecond == 0 {
+		return errors.New("config: rate_limit requests_per_second must be greater than zero")
+	}
+	if rl.Burst != 0 && rl.Burst < rl.RequestsPerSecond {
+		return errors.New("config: rate_limit burst must be greater than or equal to requests_per_second")
+	}
+	return nil
+}
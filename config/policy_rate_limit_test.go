@@ -0,0 +1,21 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, uint32(0), (*RateLimit)(nil).GetBurst())
+	assert.Equal(t, uint32(10), (&RateLimit{RequestsPerSecond: 10}).GetBurst())
+	assert.Equal(t, uint32(20), (&RateLimit{RequestsPerSecond: 10, Burst: 20}).GetBurst())
+
+	assert.NoError(t, (*RateLimit)(nil).validate())
+	assert.NoError(t, (&RateLimit{RequestsPerSecond: 10}).validate())
+	assert.NoError(t, (&RateLimit{RequestsPerSecond: 10, Burst: 20}).validate())
+	assert.Error(t, (&RateLimit{}).validate())
+	assert.Error(t, (&RateLimit{RequestsPerSecond: 10, Burst: 5}).validate())
+}
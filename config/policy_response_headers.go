@@ -0,0 +1,172 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pomerium/pomerium/internal/headertemplate"
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// ResponseHeaderAction is an action applied to a response header.
+type ResponseHeaderAction string
+
+// response header actions
+const (
+	// ResponseHeaderActionSet sets a header, replacing any existing value.
+	ResponseHeaderActionSet ResponseHeaderAction = "set"
+	// ResponseHeaderActionAppend adds a header value, keeping any existing values.
+	ResponseHeaderActionAppend ResponseHeaderAction = "append"
+	// ResponseHeaderActionRemove removes a header.
+	ResponseHeaderActionRemove ResponseHeaderAction = "remove"
+)
+
+// A ResponseHeader is a rule applied to the headers of a response sent to the
+// client. Values may reference variables:
+//
+//   - ${pomerium.claims.<name>}: a claim from the user's session or user record
+//   - ${pomerium.route.id}, ${pomerium.route.name}, ${pomerium.route.from}:
+//     route metadata
+type ResponseHeader struct {
+	Action ResponseHeaderAction `mapstructure:"action" yaml:"action" json:"action"`
+	Name   string               `mapstructure:"name" yaml:"name" json:"name"`
+	Value  string               `mapstructure:"value" yaml:"value,omitempty" json:"value,omitempty"`
+}
+
+// ResponseHeadersFromPB converts the response headers from a protobuf type.
+func ResponseHeadersFromPB(src []*configpb.RouteResponseHeader) []ResponseHeader {
+	if len(src) == 0 {
+		return nil
+	}
+
+	dst := make([]ResponseHeader, 0, len(src))
+	for _, h := range src {
+		dst = append(dst, ResponseHeader{
+			Action: ResponseHeaderAction(h.GetAction()),
+			Name:   h.GetName(),
+			Value:  h.GetValue(),
+		})
+	}
+	return dst
+}
+
+// ResponseHeadersToPB converts the response headers into a protobuf type.
+func ResponseHeadersToPB(src []ResponseHeader) []*configpb.RouteResponseHeader {
+	if len(src) == 0 {
+		return nil
+	}
+
+	dst := make([]*configpb.RouteResponseHeader, 0, len(src))
+	for _, h := range src {
+		dst = append(dst, &configpb.RouteResponseHeader{
+			Action: string(h.Action),
+			Name:   h.Name,
+			Value:  h.Value,
+		})
+	}
+	return dst
+}
+
+// A ResponseHeaderVariable is a variable referenced in a response header value.
+// Exactly one of Claim or RouteField is set.
+type ResponseHeaderVariable struct {
+	Claim      string
+	RouteField string
+}
+
+// route fields available as response header variables
+const (
+	ResponseHeaderRouteFieldID   = "id"
+	ResponseHeaderRouteFieldName = "name"
+	ResponseHeaderRouteFieldFrom = "from"
+)
+
+// ParseResponseHeaderVariable parses a variable reference from a response
+// header value template.
+func ParseResponseHeaderVariable(ref []string) (ResponseHeaderVariable, error) {
+	if len(ref) == 3 && ref[0] == "pomerium" {
+		switch ref[1] {
+		case "claims":
+			if ref[2] == "" || strings.ContainsAny(ref[2], ":%()") {
+				return ResponseHeaderVariable{}, fmt.Errorf("config: invalid response header claim name %q", ref[2])
+			}
+			return ResponseHeaderVariable{Claim: ref[2]}, nil
+		case "route":
+			switch ref[2] {
+			case ResponseHeaderRouteFieldID, ResponseHeaderRouteFieldName, ResponseHeaderRouteFieldFrom:
+				return ResponseHeaderVariable{RouteField: ref[2]}, nil
+			}
+		}
+	}
+	return ResponseHeaderVariable{}, fmt.Errorf("config: unknown response header variable %q", strings.Join(ref, "."))
+}
+
+// GetResponseHeaderRouteField returns the value of a route field used as a
+// response header variable.
+func (p *Policy) GetResponseHeaderRouteField(field string) (string, error) {
+	switch field {
+	case ResponseHeaderRouteFieldID:
+		return p.RouteID()
+	case ResponseHeaderRouteFieldName:
+		return p.Name, nil
+	case ResponseHeaderRouteFieldFrom:
+		return p.From, nil
+	}
+	return "", fmt.Errorf("config: unknown response header route field %q", field)
+}
+
+// GetResponseHeaderClaims returns the names of the claims referenced by the
+// route's response headers.
+func (p *Policy) GetResponseHeaderClaims() []string {
+	if p == nil {
+		return nil
+	}
+
+	var claims []string
+	seen := make(map[string]struct{})
+	for _, h := range p.ResponseHeaders {
+		headertemplate.Render(h.Value, func(ref []string) string {
+			v, err := ParseResponseHeaderVariable(ref)
+			if err != nil || v.Claim == "" {
+				return ""
+			}
+			if _, ok := seen[v.Claim]; !ok {
+				seen[v.Claim] = struct{}{}
+				claims = append(claims, v.Claim)
+			}
+			return ""
+		})
+	}
+	return claims
+}
+
+func (p *Policy) validateResponseHeaders() error {
+	for _, h := range p.ResponseHeaders {
+		if h.Name == "" {
+			return errors.New("config: response header name is required")
+		}
+
+		switch h.Action {
+		case ResponseHeaderActionSet, ResponseHeaderActionAppend:
+		case ResponseHeaderActionRemove:
+			if h.Value != "" {
+				return fmt.Errorf("config: response header %q: remove does not take a value", h.Name)
+			}
+		default:
+			return fmt.Errorf("config: response header %q: unknown action %q", h.Name, h.Action)
+		}
+
+		var err error
+		headertemplate.Render(h.Value, func(ref []string) string {
+			if _, e := ParseResponseHeaderVariable(ref); e != nil && err == nil {
+				err = fmt.Errorf("config: response header %q: %w", h.Name, e)
+			}
+			return ""
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
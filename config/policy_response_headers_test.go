@@ -0,0 +1,53 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicy_GetResponseHeaderClaims(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, (*Policy)(nil).GetResponseHeaderClaims())
+
+	p := &Policy{ResponseHeaders: []ResponseHeader{
+		{Action: ResponseHeaderActionSet, Name: "X-User", Value: "${pomerium.claims.email} (${pomerium.claims.name})"},
+		{Action: ResponseHeaderActionAppend, Name: "X-Route", Value: "${pomerium.route.id}"},
+		{Action: ResponseHeaderActionAppend, Name: "X-Email", Value: "$pomerium.claims.email"},
+		{Action: ResponseHeaderActionRemove, Name: "Server"},
+	}}
+	assert.Equal(t, []string{"email", "name"}, p.GetResponseHeaderClaims())
+}
+
+func TestPolicy_validateResponseHeaders(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		header    ResponseHeader
+		expectErr bool
+	}{
+		{"set", ResponseHeader{Action: ResponseHeaderActionSet, Name: "X-A", Value: "a"}, false},
+		{"append", ResponseHeader{Action: ResponseHeaderActionAppend, Name: "X-A", Value: "${pomerium.claims.email}"}, false},
+		{"remove", ResponseHeader{Action: ResponseHeaderActionRemove, Name: "X-A"}, false},
+		{"route variables", ResponseHeader{Action: ResponseHeaderActionSet, Name: "X-A", Value: "$pomerium.route.id $pomerium.route.name $pomerium.route.from"}, false},
+		{"missing name", ResponseHeader{Action: ResponseHeaderActionSet, Value: "a"}, true},
+		{"unknown action", ResponseHeader{Action: "replace", Name: "X-A", Value: "a"}, true},
+		{"remove with value", ResponseHeader{Action: ResponseHeaderActionRemove, Name: "X-A", Value: "a"}, true},
+		{"unknown variable", ResponseHeader{Action: ResponseHeaderActionSet, Name: "X-A", Value: "${pomerium.jwt}"}, true},
+		{"unknown route field", ResponseHeader{Action: ResponseHeaderActionSet, Name: "X-A", Value: "${pomerium.route.to}"}, true},
+		{"invalid claim", ResponseHeader{Action: ResponseHeaderActionSet, Name: "X-A", Value: `${pomerium.claims["a:b"]}`}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := (&Policy{ResponseHeaders: []ResponseHeader{tc.header}}).validateResponseHeaders()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxTCPListenPorts is the maximum number of ports in a tcp_listen_ports range.
+const maxTCPListenPorts = 1024
+
+// GetTCPListenPorts returns the ports a TCP route listens on directly. It
+// returns nil if the route is only reachable through the HTTP CONNECT tunnel.
+func (p *Policy) GetTCPListenPorts() ([]uint32, error) {
+	if p.TCPListenPorts == "" {
+		return nil, nil
+	}
+
+	lo, hi, isRange := strings.Cut(p.TCPListenPorts, "-")
+	first, err := parseTCPListenPort(lo)
+	if err != nil {
+		return nil, err
+	}
+	last := first
+	if isRange {
+		last, err = parseTCPListenPort(hi)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if last < first {
+		return nil, fmt.Errorf("invalid port range %q", p.TCPListenPorts)
+	}
+	if last-first >= maxTCPListenPorts {
+		return nil, fmt.Errorf("port range %q has more than %d ports", p.TCPListenPorts, maxTCPListenPorts)
+	}
+
+	ports := make([]uint32, 0, last-first+1)
+	for port := first; port <= last; port++ {
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+func parseTCPListenPort(raw string) (uint32, error) {
+	port, err := strconv.ParseUint(strings.TrimSpace(raw), 10, 16)
+	if err != nil || port == 0 {
+		return 0, fmt.Errorf("invalid port %q", raw)
+	}
+	return uint32(port), nil
+}
+
+func (p *Policy) validateTCPListenPorts() error {
+	if p.TCPListenPorts == "" {
+		return nil
+	}
+	if !p.IsTCP() {
+		return errors.New("config: tcp_listen_ports is only supported for tcp routes")
+	}
+	// connections to the listen ports are proxied directly to the upstream
+	// without going through the authorize service, so there's no way to
+	// enforce the route's policy on them
+	if !p.AllowPublicUnauthenticatedAccess {
+		return errors.New("config: tcp_listen_ports requires allow_public_unauthenticated_access, " +
+			"connections on the listen ports are not authorized")
+	}
+	if _, err := p.GetTCPListenPorts(); err != nil {
+		return fmt.Errorf("config: invalid tcp_listen_ports: %w", err)
+	}
+	return nil
+}
+
+// validateTCPListenPorts checks that the ports TCP routes listen on don't
+// collide with the addresses pomerium listens on, or with each other. Routes
+// may only share a port if they have different hostnames, since connections on
+// a shared port are routed by TLS SNI.
+func (o *Options) validateTCPListenPorts() error {
+	reserved := map[uint32]string{}
+	for _, addr := range []struct{ name, value string }{
+		{"address", o.Addr},
+		{"grpc_address", o.GetGRPCAddr()},
+		{"http_redirect_addr", o.HTTPRedirectAddr},
+		{"metrics_address", o.MetricsAddr},
+		{"debug_address", o.DebugAddress.String},
+		{"health_check_addr", o.HealthCheckAddr},
+		{"ssh_address", o.SSHAddr},
+		{"envoy_admin_address", o.EnvoyAdminAddress},
+	} {
+		if port, ok := getAddressPort(addr.value); ok {
+			if _, exists := reserved[port]; !exists {
+				reserved[port] = addr.name
+			}
+		}
+	}
+
+	routesByPort := map[uint32]map[string]*Policy{}
+	for p := range o.GetAllPolicies() {
+		ports, err := p.GetTCPListenPorts()
+		if err != nil || len(ports) == 0 {
+			continue
+		}
+		u, err := url.Parse(p.From)
+		if err != nil {
+			continue
+		}
+		for _, port := range ports {
+			if name, ok := reserved[port]; ok {
+				return fmt.Errorf("config: tcp_listen_ports of route %s: port %d is already used by %s", p, port, name)
+			}
+			if routesByPort[port] == nil {
+				routesByPort[port] = map[string]*Policy{}
+			}
+			if other, ok := routesByPort[port][u.Hostname()]; ok {
+				return fmt.Errorf("config: tcp_listen_ports of route %s: port %d is already used by route %s for the same hostname",
+					p, port, other)
+			}
+			routesByPort[port][u.Hostname()] = p
+		}
+	}
+	return nil
+}
+
+func getAddressPort(addr string) (uint32, bool) {
+	_, rawPort, err := net.SplitHostPort(addr)
+	if err != nil {
+		return 0, false
+	}
+	port, err := strconv.ParseUint(rawPort, 10, 16)
+	if err != nil || port == 0 {
+		return 0, false
+	}
+	return uint32(port), true
+}
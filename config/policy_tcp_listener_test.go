@@ -0,0 +1,88 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_GetTCPListenPorts(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		in     string
+		expect []uint32
+		err    bool
+	}{
+		{"", nil, false},
+		{"5432", []uint32{5432}, false},
+		{"9000-9003", []uint32{9000, 9001, 9002, 9003}, false},
+		{"9000 - 9001", []uint32{9000, 9001}, false},
+		{"0", nil, true},
+		{"65536", nil, true},
+		{"abc", nil, true},
+		{"9001-9000", nil, true},
+		{"1000-3000", nil, true},
+	} {
+		ports, err := (&Policy{TCPListenPorts: tc.in}).GetTCPListenPorts()
+		if tc.err {
+			assert.Error(t, err, tc.in)
+		} else {
+			require.NoError(t, err, tc.in)
+			assert.Equal(t, tc.expect, ports, tc.in)
+		}
+	}
+}
+
+func TestPolicy_validateTCPListenPorts(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, (&Policy{}).validateTCPListenPorts())
+	assert.NoError(t, (&Policy{
+		From:                             "tcp+https://db.example.com:5432",
+		TCPListenPorts:                   "5432",
+		AllowPublicUnauthenticatedAccess: true,
+	}).validateTCPListenPorts())
+	assert.Error(t, (&Policy{
+		From:                             "https://www.example.com",
+		TCPListenPorts:                   "5432",
+		AllowPublicUnauthenticatedAccess: true,
+	}).validateTCPListenPorts(), "should require a tcp route")
+	assert.Error(t, (&Policy{
+		From:           "tcp+https://db.example.com:5432",
+		TCPListenPorts: "5432",
+	}).validateTCPListenPorts(), "should require public access")
+	assert.Error(t, (&Policy{
+		From:                             "tcp+https://db.example.com:5432",
+		TCPListenPorts:                   "x",
+		AllowPublicUnauthenticatedAccess: true,
+	}).validateTCPListenPorts(), "should require a valid port")
+}
+
+func TestOptions_validateTCPListenPorts(t *testing.T) {
+	t.Parallel()
+
+	route := func(from, ports string) Policy {
+		return Policy{From: from, To: mustParseWeightedURLs(t, "tcp://db:5432"), TCPListenPorts: ports, AllowPublicUnauthenticatedAccess: true}
+	}
+
+	o := NewDefaultOptions()
+	o.Routes = []Policy{
+		route("tcp+https://db1.example.com:5432", "5432"),
+		route("tcp+https://db2.example.com:5432", "5432"),
+	}
+	assert.NoError(t, o.validateTCPListenPorts(),
+		"should allow routes with different hostnames to share a port")
+
+	o.Routes = append(o.Routes, route("tcp+https://db1.example.com:5433", "5430-5440"))
+	assert.ErrorContains(t, o.validateTCPListenPorts(), "port 5432 is already used by route",
+		"should not allow routes with the same hostname to share a port")
+
+	o.Routes = []Policy{route("tcp+https://db.example.com:443", "443")}
+	assert.ErrorContains(t, o.validateTCPListenPorts(), "port 443 is already used by address")
+
+	o.Routes = []Policy{route("tcp+https://db.example.com:9090", "9090")}
+	o.MetricsAddr = ":9090"
+	assert.ErrorContains(t, o.validateTCPListenPorts(), "port 9090 is already used by metrics_address")
+}
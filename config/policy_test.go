@@ -7,11 +7,13 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	envoy_config_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v9"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/pomerium/pomerium/internal/urlutil"
@@ -57,6 +59,8 @@ func Test_PolicyValidate(t *testing.T) {
 		{"TCP To URLs", Policy{From: "tcp+https://httpbin.corp.example:4000", To: mustParseWeightedURLs(t, "tcp://one.example.com:5000", "tcp://two.example.com:5000")}, false},
 		{"mix of TCP and non-TCP To URLs", Policy{From: "tcp+https://httpbin.corp.example:4000", To: mustParseWeightedURLs(t, "https://example.com", "tcp://example.com:5000")}, true},
 		{"UDP To URLs", Policy{From: "udp+https://httpbin.corp.example:4000", To: mustParseWeightedURLs(t, "udp://one.example.com:5000", "udp://two.example.com:5000")}, false},
+		{"bad downstream client crl", Policy{From: "https://httpbin.corp.example", To: mustParseWeightedURLs(t, "https://httpbin.corp.notatld"), TLSDownstreamClientCRL: "dGhpc2lzZmluZQo="}, true},
+		{"bad downstream client crl file", Policy{From: "https://httpbin.corp.example", To: mustParseWeightedURLs(t, "https://httpbin.corp.notatld"), TLSDownstreamClientCRLFile: "testdata/404.pem"}, true},
 		{"too many depends_on hosts", Policy{From: "https://httpbin.corp.example", To: mustParseWeightedURLs(t, "https://httpbin.corp.notatld"), DependsOn: []string{"a", "b", "c", "d", "e", "f"}}, true},
 	}
 
@@ -340,6 +344,243 @@ func TestPolicy_FromToPb(t *testing.T) {
 		assert.Equal(t, p.Redirect.HTTPSRedirect, policyFromProto.Redirect.HTTPSRedirect)
 	})
 
+	t.Run("portal", func(t *testing.T) {
+		p := &Policy{
+			From:        "https://pomerium.io",
+			To:          mustParseWeightedURLs(t, "http://localhost"),
+			Category:    "CATEGORY",
+			Tags:        []string{"TAG1", "TAG2"},
+			PortalOrder: 3,
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.Category, policyFromPb.Category)
+		assert.Equal(t, p.Tags, policyFromPb.Tags)
+		assert.Equal(t, p.PortalOrder, policyFromPb.PortalOrder)
+	})
+
+	t.Run("error pages", func(t *testing.T) {
+		p := &Policy{
+			From:       "https://pomerium.io",
+			To:         mustParseWeightedURLs(t, "http://localhost"),
+			ErrorPages: map[string]string{"403": "forbidden", "5xx": "server error"},
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.ErrorPages, policyFromPb.ErrorPages)
+	})
+
+	t.Run("JWT claims", func(t *testing.T) {
+		p := &Policy{
+			From:               "https://pomerium.io",
+			To:                 mustParseWeightedURLs(t, "http://localhost"),
+			JWTClaimsAllowlist: []string{"email", "groups"},
+			JWTCustomClaims:    map[string]string{"department": "user.department"},
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.JWTClaimsAllowlist, policyFromPb.JWTClaimsAllowlist)
+		assert.Equal(t, p.JWTCustomClaims, policyFromPb.JWTCustomClaims)
+	})
+
+	t.Run("response headers", func(t *testing.T) {
+		p := &Policy{
+			From: "https://pomerium.io",
+			To:   mustParseWeightedURLs(t, "http://localhost"),
+			ResponseHeaders: []ResponseHeader{
+				{Action: ResponseHeaderActionSet, Name: "X-User", Value: "${pomerium.claims.email}"},
+				{Action: ResponseHeaderActionRemove, Name: "Server"},
+			},
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.ResponseHeaders, policyFromPb.ResponseHeaders)
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		p := &Policy{
+			From:      "https://pomerium.io",
+			To:        mustParseWeightedURLs(t, "http://localhost"),
+			RateLimit: &RateLimit{RequestsPerSecond: 10, Burst: 20},
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.RateLimit, policyFromPb.RateLimit)
+	})
+
+	t.Run("required assurance level", func(t *testing.T) {
+		p := &Policy{
+			From:                   "https://pomerium.io",
+			To:                     mustParseWeightedURLs(t, "http://localhost"),
+			RequiredAssuranceLevel: AssuranceLevelMFA,
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.RequiredAssuranceLevel, policyFromPb.RequiredAssuranceLevel)
+	})
+
+	t.Run("idp access token introspection url", func(t *testing.T) {
+		p := &Policy{
+			From:                           "https://pomerium.io",
+			To:                             mustParseWeightedURLs(t, "http://localhost"),
+			IDPAccessTokenIntrospectionURL: "https://idp.example.com/introspect",
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.IDPAccessTokenIntrospectionURL, policyFromPb.IDPAccessTokenIntrospectionURL)
+	})
+
+	t.Run("cookie attributes", func(t *testing.T) {
+		p := &Policy{
+			From:           "https://pomerium.io",
+			To:             mustParseWeightedURLs(t, "http://localhost"),
+			CookieSameSite: "strict",
+			CookieSecure:   proto.Bool(false),
+			CookieDomain:   "pomerium.io",
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.CookieSameSite, policyFromPb.CookieSameSite)
+		assert.Equal(t, p.CookieSecure, policyFromPb.CookieSecure)
+		assert.Equal(t, p.CookieDomain, policyFromPb.CookieDomain)
+	})
+
+	t.Run("outlier detection", func(t *testing.T) {
+		interval := 10 * time.Second
+		p := &Policy{
+			From: "https://pomerium.io",
+			To:   mustParseWeightedURLs(t, "http://localhost"),
+			OutlierDetection: &OutlierDetection{
+				Consecutive5xx:     null.Uint32From(5),
+				Interval:           &interval,
+				MaxEjectionPercent: null.Uint32From(50),
+			},
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.OutlierDetection, policyFromPb.OutlierDetection)
+	})
+
+	t.Run("grpc web", func(t *testing.T) {
+		p := &Policy{
+			From: "https://pomerium.io",
+			To:   mustParseWeightedURLs(t, "http://localhost"),
+			GRPCWeb: &GRPCWeb{
+				Connect:        true,
+				AllowedOrigins: []string{"https://app.pomerium.io"},
+			},
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.GRPCWeb, policyFromPb.GRPCWeb)
+	})
+
+	t.Run("external processing", func(t *testing.T) {
+		timeout := 2 * time.Second
+		p := &Policy{
+			From: "https://pomerium.io",
+			To:   mustParseWeightedURLs(t, "http://localhost"),
+			ExternalProcessing: &ExternalProcessing{
+				Target:           "http://scanner.pomerium.io:9000",
+				Timeout:          &timeout,
+				FailureModeAllow: true,
+				ProcessingMode: ExternalProcessingMode{
+					RequestHeaders: ExternalProcessingHeaderModeSend,
+					RequestBody:    ExternalProcessingBodyModeBuffered,
+				},
+			},
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.ExternalProcessing, policyFromPb.ExternalProcessing)
+	})
+
+	t.Run("retry policy", func(t *testing.T) {
+		perTryTimeout := 5 * time.Second
+		p := &Policy{
+			From: "https://pomerium.io",
+			To:   mustParseWeightedURLs(t, "http://localhost"),
+			RetryPolicy: &RetryPolicy{
+				RetryOn:              []string{"connect-failure"},
+				NumRetries:           null.Uint32From(3),
+				PerTryTimeout:        &perTryTimeout,
+				RetriableStatusCodes: []uint32{502, 503},
+			},
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.RetryPolicy, policyFromPb.RetryPolicy)
+	})
+
+	t.Run("tcp listen ports", func(t *testing.T) {
+		p := &Policy{
+			From:                             "tcp+https://redis.pomerium.io:6379",
+			To:                               mustParseWeightedURLs(t, "tcp://localhost:6379"),
+			AllowPublicUnauthenticatedAccess: true,
+			TCPListenPorts:                   "7000-7002",
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.TCPListenPorts, policyFromPb.TCPListenPorts)
+	})
+
+	t.Run("downstream client revocation", func(t *testing.T) {
+		p := &Policy{
+			From:                         "https://pomerium.io",
+			To:                           mustParseWeightedURLs(t, "http://localhost"),
+			TLSDownstreamClientCRL:       "CRL",
+			TLSDownstreamClientCRLFile:   "/path/to/crl.pem",
+			TLSDownstreamClientOCSPCheck: proto.Bool(true),
+		}
+		pbPolicy, err := p.ToProto()
+		require.NoError(t, err)
+
+		policyFromPb, err := NewPolicyFromProto(pbPolicy)
+		assert.NoError(t, err)
+		assert.Equal(t, p.TLSDownstreamClientCRL, policyFromPb.TLSDownstreamClientCRL)
+		assert.Equal(t, p.TLSDownstreamClientCRLFile, policyFromPb.TLSDownstreamClientCRLFile)
+		assert.Equal(t, p.TLSDownstreamClientOCSPCheck, policyFromPb.TLSDownstreamClientOCSPCheck)
+	})
+
 	t.Run("JWT issuer format", func(t *testing.T) {
 		for f := range knownJWTIssuerFormats {
 			p := &Policy{
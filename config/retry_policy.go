@@ -0,0 +1,110 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/volatiletech/null/v9"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	configpb "github.com/pomerium/pomerium/pkg/grpc/config"
+)
+
+// retryOnConditions are the envoy retry conditions which may be used in a
+// retry policy.
+var retryOnConditions = []string{
+	// http
+	"5xx",
+	"gateway-error",
+	"reset",
+	"reset-before-request",
+	"connect-failure",
+	"envoy-ratelimited",
+	"retriable-4xx",
+	"refused-stream",
+	"retriable-status-codes",
+	"retriable-headers",
+	"http3-post-connect-failure",
+	// grpc
+	"cancelled",
+	"deadline-exceeded",
+	"internal",
+	"resource-exhausted",
+	"unavailable",
+}
+
+// RetryPolicy defines when requests to a route's upstream are retried.
+// Retries are only safe for idempotent requests.
+type RetryPolicy struct {
+	// RetryOn is the list of conditions under which a request is retried,
+	// e.g. "5xx", "connect-failure" or "retriable-status-codes".
+	RetryOn []string `mapstructure:"retry_on" yaml:"retry_on" json:"retry_on"`
+	// NumRetries is the maximum number of retries. It defaults to 1.
+	NumRetries null.Uint32 `mapstructure:"num_retries" yaml:"num_retries,omitempty" json:"num_retries,omitempty"`
+	// PerTryTimeout is the timeout for each attempt, including the first.
+	PerTryTimeout *time.Duration `mapstructure:"per_try_timeout" yaml:"per_try_timeout,omitempty" json:"per_try_timeout,omitempty"`
+	// RetriableStatusCodes are the response status codes which are retried
+	// with the "retriable-status-codes" condition.
+	RetriableStatusCodes []uint32 `mapstructure:"retriable_status_codes" yaml:"retriable_status_codes,omitempty" json:"retriable_status_codes,omitempty"`
+}
+
+// RetryPolicyFromPB converts the RetryPolicy from a protobuf type.
+func RetryPolicyFromPB(src *configpb.RouteRetryPolicy) *RetryPolicy {
+	if src == nil {
+		return nil
+	}
+
+	dst := &RetryPolicy{
+		RetryOn:              src.GetRetryOn(),
+		RetriableStatusCodes: src.GetRetriableStatusCodes(),
+	}
+	if src.NumRetries != nil {
+		dst.NumRetries = null.Uint32From(*src.NumRetries)
+	}
+	if src.PerTryTimeout != nil {
+		perTryTimeout := src.PerTryTimeout.AsDuration()
+		dst.PerTryTimeout = &perTryTimeout
+	}
+	return dst
+}
+
+// RetryPolicyToPB converts the RetryPolicy into a protobuf type.
+func RetryPolicyToPB(src *RetryPolicy) *configpb.RouteRetryPolicy {
+	if src == nil {
+		return nil
+	}
+
+	dst := &configpb.RouteRetryPolicy{
+		RetryOn:              src.RetryOn,
+		NumRetries:           src.NumRetries.Ptr(),
+		RetriableStatusCodes: src.RetriableStatusCodes,
+	}
+	if src.PerTryTimeout != nil {
+		dst.PerTryTimeout = durationpb.New(*src.PerTryTimeout)
+	}
+	return dst
+}
+
+func (rp *RetryPolicy) validate() error {
+	if rp == nil {
+		return nil
+	}
+	if len(rp.RetryOn) == 0 && len(rp.RetriableStatusCodes) == 0 {
+		return fmt.Errorf("config: retry_policy retry_on is required")
+	}
+	for _, condition := range rp.RetryOn {
+		if !slices.Contains(retryOnConditions, condition) {
+			return fmt.Errorf("config: retry_policy has unknown retry_on condition %q", condition)
+		}
+	}
+	if rp.PerTryTimeout != nil && *rp.PerTryTimeout <= 0 {
+		return fmt.Errorf("config: retry_policy per_try_timeout must be positive")
+	}
+	for _, code := range rp.RetriableStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf("config: retry_policy has invalid retriable status code %d", code)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v9"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, (*RetryPolicy)(nil).validate())
+	assert.NoError(t, (&RetryPolicy{RetryOn: []string{"5xx", "connect-failure"}}).validate())
+	assert.NoError(t, (&RetryPolicy{
+		RetryOn:              []string{"retriable-status-codes", "unavailable"},
+		NumRetries:           null.Uint32From(3),
+		PerTryTimeout:        ptr(time.Second),
+		RetriableStatusCodes: []uint32{502, 503},
+	}).validate())
+	assert.NoError(t, (&RetryPolicy{RetriableStatusCodes: []uint32{503}}).validate())
+	assert.Error(t, (&RetryPolicy{}).validate())
+	assert.Error(t, (&RetryPolicy{RetryOn: []string{"sometimes"}}).validate())
+	assert.Error(t, (&RetryPolicy{RetryOn: []string{"5xx"}, PerTryTimeout: ptr(time.Duration(0))}).validate())
+	assert.Error(t, (&RetryPolicy{RetriableStatusCodes: []uint32{99}}).validate())
+}
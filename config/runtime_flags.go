@@ -14,6 +14,9 @@ var (
 	// and any other files referenced within it
 	RuntimeFlagConfigHotReload = runtimeFlag("config_hot_reload", true)
 
+	// RuntimeFlagDatabrokerDebugService enables the databroker debug gRPC service.
+	RuntimeFlagDatabrokerDebugService = runtimeFlag("databroker_debug_service", false)
+
 	// RuntimeFlagDebugAdminEndpoints enables the admin endpoints for the debug listener.
 	RuntimeFlagDebugAdminEndpoints = runtimeFlag("debug_admin_endpoints", false)
 
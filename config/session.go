@@ -24,6 +24,7 @@ import (
 	"github.com/pomerium/pomerium/internal/sessions/cookie"
 	"github.com/pomerium/pomerium/internal/sessions/header"
 	"github.com/pomerium/pomerium/internal/sessions/queryparam"
+	"github.com/pomerium/pomerium/internal/sessions/serverside"
 	"github.com/pomerium/pomerium/internal/telemetry"
 	"github.com/pomerium/pomerium/internal/telemetry/metrics"
 	"github.com/pomerium/pomerium/internal/urlutil"
@@ -48,8 +49,30 @@ type SessionStore struct {
 
 var _ sessions.SessionStore = (*SessionStore)(nil)
 
+type sessionStoreConfig struct {
+	dataBrokerClient  databroker.DataBrokerServiceClient
+	dataBrokerQuerier storage.Querier
+}
+
+// A SessionStoreOption customizes a SessionStore.
+type SessionStoreOption func(cfg *sessionStoreConfig)
+
+// WithSessionStoreDataBroker sets the databroker client and querier used to
+// store session handles when server-side sessions are enabled.
+func WithSessionStoreDataBroker(client databroker.DataBrokerServiceClient, querier storage.Querier) SessionStoreOption {
+	return func(cfg *sessionStoreConfig) {
+		cfg.dataBrokerClient = client
+		cfg.dataBrokerQuerier = querier
+	}
+}
+
 // NewSessionStore creates a new SessionStore from the Options.
-func NewSessionStore(options *Options) (*SessionStore, error) {
+func NewSessionStore(options *Options, opts ...SessionStoreOption) (*SessionStore, error) {
+	cfg := new(sessionStoreConfig)
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
 	store := &SessionStore{
 		options: options,
 	}
@@ -64,16 +87,29 @@ func NewSessionStore(options *Options) (*SessionStore, error) {
 		return nil, fmt.Errorf("config/sessions: invalid session encoder: %w", err)
 	}
 
-	store.store, err = cookie.NewStore(func() cookie.Options {
+	getCookieOptions := func() cookie.Options {
 		return cookie.Options{
-			Name:     options.CookieName,
-			Domain:   options.CookieDomain,
-			Secure:   true,
-			HTTPOnly: options.CookieHTTPOnly,
-			Expire:   options.CookieExpire,
-			SameSite: options.GetCookieSameSite(),
+			Name:        options.CookieName,
+			Domain:      options.CookieDomain,
+			Secure:      true,
+			HTTPOnly:    options.CookieHTTPOnly,
+			Expire:      options.CookieExpire,
+			SameSite:    options.GetCookieSameSite(),
+			Compression: httputil.CookieCompression(options.CookieCompression),
+			Prefix:      options.GetCookiePrefix(),
+			Partitioned: options.CookiePartitioned,
+		}
+	}
+	cookieAttributes := cookie.WithAttributes(options.GetCookieAttributesForRequest)
+	if options.UseServerSideSessions() {
+		if cfg.dataBrokerClient == nil || cfg.dataBrokerQuerier == nil {
+			return nil, fmt.Errorf("config/sessions: server-side sessions require a databroker")
 		}
-	}, store.encoder)
+		store.store, err = serverside.NewStore(getCookieOptions, store.encoder,
+			cfg.dataBrokerClient, cfg.dataBrokerQuerier, cookieAttributes)
+	} else {
+		store.store, err = cookie.NewStore(getCookieOptions, store.encoder, cookieAttributes)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +169,16 @@ func (store *SessionStore) LoadSessionHandleAndCheckIDP(r *http.Request) (*sessi
 	return h, nil
 }
 
+// EncodeSessionHandle signs a session handle so that it can be used as a bearer
+// token.
+func (store *SessionStore) EncodeSessionHandle(h *sessions.Handle) (string, error) {
+	rawJWT, err := store.encoder.Marshal(h)
+	if err != nil {
+		return "", err
+	}
+	return string(rawJWT), nil
+}
+
 // SaveSession saves the session.
 func (store *SessionStore) SaveSession(w http.ResponseWriter, r *http.Request, v any) error {
 	return store.store.SaveSession(w, r, v)
@@ -145,6 +191,7 @@ type IncomingIDPTokenSessionCreator interface {
 type incomingIDPTokenSessionCreator struct {
 	accessTokenSessionsCreatedCount    metric.Int64Counter
 	accessTokenSessionsCachedCount     metric.Int64Counter
+	accessTokenSessionsDeletedCount    metric.Int64Counter
 	accessTokenCreateSessionDuration   metric.Int64Histogram
 	identityTokenSessionsCreatedCount  metric.Int64Counter
 	identityTokenSessionsCachedCount   metric.Int64Counter
@@ -170,6 +217,9 @@ func NewIncomingIDPTokenSessionCreator(
 		accessTokenSessionsCachedCount: metrics.Int64Counter("config.idp_token_session_creator.access_token.sessions_cached",
 			metric.WithDescription("Number of sessions cached from IDP access tokens."),
 			metric.WithUnit("{session}")),
+		accessTokenSessionsDeletedCount: metrics.Int64Counter("config.idp_token_session_creator.access_token.sessions_deleted",
+			metric.WithDescription("Number of sessions deleted because their IDP access token is no longer valid."),
+			metric.WithUnit("{session}")),
 		accessTokenCreateSessionDuration: metrics.Int64Histogram("config.idp_token_session_creator.access_token.create_session.duration",
 			metric.WithDescription("Duration of create session from IDP access tokens."),
 			metric.WithUnit("ms")),
@@ -232,30 +282,37 @@ func (c *incomingIDPTokenSessionCreator) createSessionForAccessToken(
 
 	sessionID := getAccessTokenSessionID(idp, rawAccessToken)
 	res, err, _ := c.singleflight.Do(sessionID, func() (any, error) {
-		s, err := c.getSession(ctx, sessionID)
-		if err == nil {
+		existing, err := c.getSession(ctx, sessionID)
+		if err == nil && !c.needsReverification(cfg, existing) {
 			c.accessTokenSessionsCachedCount.Add(ctx, 1)
-			return s, nil
-		} else if !storage.IsNotFound(err) {
+			return existing, nil
+		} else if err != nil && !storage.IsNotFound(err) {
 			return nil, err
 		}
 
-		authenticateURL, transport, err := cfg.resolveAuthenticateURL()
+		claims, err := c.verifyAccessToken(ctx, cfg, policy, idp, rawAccessToken)
+		if errors.Is(err, sessions.ErrInvalidSession) && existing != nil {
+			// the access token is no longer valid, so remove the session created from it
+			if err := c.deleteSession(ctx, existing); err != nil {
+				return nil, fmt.Errorf("error deleting session for invalid access token: %w", err)
+			}
+			c.accessTokenSessionsDeletedCount.Add(ctx, 1)
+		}
 		if err != nil {
-			return nil, fmt.Errorf("error resolving authenticate url to verify access token: %w", err)
+			return nil, err
 		}
 
-		res, err := authenticateapi.New(authenticateURL, transport).VerifyAccessToken(ctx, &authenticateapi.VerifyAccessTokenRequest{
-			AccessToken:        rawAccessToken,
-			IdentityProviderID: idp.GetId(),
-		})
+		claims, err = c.mapClaims(ctx, cfg, idp.GetId(), claims)
 		if err != nil {
-			return nil, fmt.Errorf("error verifying access token: %w", err)
-		} else if !res.Valid {
-			return nil, fmt.Errorf("%w: invalid access token", sessions.ErrInvalidSession)
+			return nil, err
 		}
 
-		s = c.newSessionFromIDPClaims(cfg, idp.Id, sessionID, res.Claims)
+		s := c.newSessionFromIDPClaims(cfg, idp.Id, sessionID, claims)
+		// re-verifying a token doesn't extend the lifetime of its session
+		if existing != nil && existing.GetExpiresAt().AsTime().Before(s.GetExpiresAt().AsTime()) {
+			s.ExpiresAt = existing.ExpiresAt
+		}
+		s.SetTokenVerifiedAt(c.timeNow())
 		s.OauthToken = &session.OAuthToken{
 			TokenType:   "Bearer",
 			AccessToken: rawAccessToken,
@@ -268,7 +325,7 @@ func (c *incomingIDPTokenSessionCreator) createSessionForAccessToken(
 		} else if err != nil {
 			return nil, fmt.Errorf("error retrieving existing user: %w", err)
 		}
-		c.fillUserFromIDPClaims(u, res.Claims)
+		c.fillUserFromIDPClaims(u, claims)
 
 		err = c.putSessionAndUser(ctx, s, u)
 		if err != nil {
@@ -286,6 +343,46 @@ func (c *incomingIDPTokenSessionCreator) createSessionForAccessToken(
 	return res.(*session.Session), nil
 }
 
+// verifyAccessToken verifies an access token and returns its claims. If a
+// token introspection endpoint is configured the identity provider is asked
+// directly, otherwise the authenticate service verifies the token.
+func (c *incomingIDPTokenSessionCreator) verifyAccessToken(
+	ctx context.Context,
+	cfg *Config,
+	policy *Policy,
+	idp *identitypb.Provider,
+	rawAccessToken string,
+) (jwtutil.Claims, error) {
+	if introspectionURL := cfg.Options.GetIDPAccessTokenIntrospectionURLForPolicy(policy); introspectionURL != "" {
+		transport, err := cfg.getTokenIntrospectionTransport()
+		if err != nil {
+			return nil, fmt.Errorf("error creating transport to introspect access token: %w", err)
+		}
+
+		claims, err := introspectAccessToken(ctx, transport, introspectionURL, idp, rawAccessToken)
+		if err != nil {
+			return nil, fmt.Errorf("error introspecting access token: %w", err)
+		}
+		return claims, nil
+	}
+
+	authenticateURL, transport, err := cfg.resolveAuthenticateURL()
+	if err != nil {
+		return nil, fmt.Errorf("error resolving authenticate url to verify access token: %w", err)
+	}
+
+	res, err := authenticateapi.New(authenticateURL, transport).VerifyAccessToken(ctx, &authenticateapi.VerifyAccessTokenRequest{
+		AccessToken:        rawAccessToken,
+		IdentityProviderID: idp.GetId(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error verifying access token: %w", err)
+	} else if !res.Valid {
+		return nil, fmt.Errorf("%w: invalid access token", sessions.ErrInvalidSession)
+	}
+	return res.Claims, nil
+}
+
 func (c *incomingIDPTokenSessionCreator) createSessionForIdentityToken(
 	ctx context.Context,
 	cfg *Config,
@@ -327,7 +424,12 @@ func (c *incomingIDPTokenSessionCreator) createSessionForIdentityToken(
 			return nil, fmt.Errorf("%w: invalid identity token", sessions.ErrInvalidSession)
 		}
 
-		s = c.newSessionFromIDPClaims(cfg, idp.Id, sessionID, res.Claims)
+		claims, err := c.mapClaims(ctx, cfg, idp.GetId(), res.Claims)
+		if err != nil {
+			return nil, err
+		}
+
+		s = c.newSessionFromIDPClaims(cfg, idp.Id, sessionID, claims)
 		s.SetRawIDToken(rawIdentityToken)
 
 		u, err := c.getUser(ctx, s.GetUserId())
@@ -336,7 +438,7 @@ func (c *incomingIDPTokenSessionCreator) createSessionForIdentityToken(
 		} else if err != nil {
 			return nil, fmt.Errorf("error retrieving existing user: %w", err)
 		}
-		c.fillUserFromIDPClaims(u, res.Claims)
+		c.fillUserFromIDPClaims(u, claims)
 
 		err = c.putSessionAndUser(ctx, s, u)
 		if err != nil {
@@ -354,6 +456,34 @@ func (c *incomingIDPTokenSessionCreator) createSessionForIdentityToken(
 	return res.(*session.Session), nil
 }
 
+// mapClaims applies the configured claims mappers to idp claims.
+func (c *incomingIDPTokenSessionCreator) mapClaims(
+	ctx context.Context,
+	cfg *Config,
+	idpID string,
+	claims jwtutil.Claims,
+) (jwtutil.Claims, error) {
+	mapper, err := cfg.GetClaimsMapper()
+	if err != nil {
+		return nil, fmt.Errorf("error creating claims mapper: %w", err)
+	} else if mapper == nil {
+		return claims, nil
+	}
+
+	mapped, err := mapper.MapClaims(ctx, idpID, identity.Claims(claims))
+	if err != nil {
+		return nil, fmt.Errorf("error mapping identity provider claims: %w", err)
+	}
+	return jwtutil.Claims(mapped), nil
+}
+
+// needsReverification returns true if the idp access token of a cached
+// session should be verified again.
+func (c *incomingIDPTokenSessionCreator) needsReverification(cfg *Config, s *session.Session) bool {
+	interval := cfg.Options.IDPTokenReverifyInterval
+	return interval > 0 && c.timeNow().Sub(s.GetTokenVerifiedAt()) >= interval
+}
+
 func (c *incomingIDPTokenSessionCreator) newSessionFromIDPClaims(
 	cfg *Config,
 	idpID string,
@@ -371,11 +501,13 @@ func (c *incomingIDPTokenSessionCreator) newSessionFromIDPClaims(
 	} else {
 		s.IssuedAt = timestamppb.New(now)
 	}
-	if expiresAt, ok := claims.GetExpirationTime(); ok {
-		s.ExpiresAt = timestamppb.New(expiresAt)
-	} else {
-		s.ExpiresAt = timestamppb.New(now.Add(cfg.Options.CookieExpire))
+	expiresAt, ok := claims.GetExpirationTime()
+	if ttl := cfg.Options.IDPTokenSessionTTL; ttl > 0 && (!ok || now.Add(ttl).Before(expiresAt)) {
+		expiresAt = now.Add(ttl)
+	} else if !ok {
+		expiresAt = now.Add(cfg.Options.CookieExpire)
 	}
+	s.ExpiresAt = timestamppb.New(expiresAt)
 	s.AccessedAt = timestamppb.New(now)
 	s.AddClaims(identity.Claims(claims).Flatten())
 	if aud, ok := claims.GetAudience(); ok {
@@ -477,6 +609,23 @@ func (c *incomingIDPTokenSessionCreator) putSessionAndUser(ctx context.Context,
 	return nil
 }
 
+func (c *incomingIDPTokenSessionCreator) deleteSession(ctx context.Context, s *session.Session) error {
+	ctx, op := c.telemetry.Start(ctx, "deleteSession", attribute.String("session-id", s.GetId()))
+	defer op.Complete()
+
+	err := c.putRecords(ctx, []*databroker.Record{{
+		Type:      grpcutil.GetTypeURL(s),
+		Id:        s.GetId(),
+		Data:      protoutil.NewAny(s),
+		DeletedAt: timestamppb.New(c.timeNow()),
+	}})
+	if err != nil {
+		return op.Failure(err)
+	}
+
+	return nil
+}
+
 // GetIncomingIDPAccessTokenForPolicy returns the raw idp access token from a request if there is one.
 func (cfg *Config) GetIncomingIDPAccessTokenForPolicy(policy *Policy, r *http.Request) (rawAccessToken string, ok bool) {
 	bearerTokenFormat := BearerTokenFormatUnknown
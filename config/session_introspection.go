@@ -0,0 +1,100 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/pomerium/pomerium/internal/jwtutil"
+	"github.com/pomerium/pomerium/internal/sessions"
+	identitypb "github.com/pomerium/pomerium/pkg/grpc/identity"
+)
+
+// GetIDPAccessTokenIntrospectionURLForPolicy returns the token introspection
+// endpoint used to verify idp access tokens for the given policy. If no
+// endpoint is configured an empty string is returned.
+func (o *Options) GetIDPAccessTokenIntrospectionURLForPolicy(policy *Policy) string {
+	if policy != nil && policy.IDPAccessTokenIntrospectionURL != "" {
+		return policy.IDPAccessTokenIntrospectionURL
+	}
+	if o == nil {
+		return ""
+	}
+	return o.IDPAccessTokenIntrospectionURL
+}
+
+// introspectAccessToken verifies an access token using OAuth 2.0 token
+// introspection and returns the claims of an active token. The client
+// authenticates to the introspection endpoint with its client credentials.
+//
+// https://www.rfc-editor.org/rfc/rfc7662.html
+func introspectAccessToken(
+	ctx context.Context,
+	transport http.RoundTripper,
+	introspectionURL string,
+	idp *identitypb.Provider,
+	rawAccessToken string,
+) (jwtutil.Claims, error) {
+	body := url.Values{
+		"token":           {rawAccessToken},
+		"token_type_hint": {"access_token"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, introspectionURL, strings.NewReader(body.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating token introspection http request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(idp.GetClientId()), url.QueryEscape(idp.GetClientSecret()))
+
+	res, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing token introspection http request: %w", err)
+	}
+	defer res.Body.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("error reading token introspection http response: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected token introspection http response status: %s", res.Status)
+	}
+
+	var claims jwtutil.Claims
+	err = json.Unmarshal(raw, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("error reading token introspection http response: %w", err)
+	}
+
+	if active, _ := claims["active"].(bool); !active {
+		return nil, fmt.Errorf("%w: inactive access token", sessions.ErrInvalidSession)
+	}
+	delete(claims, "active")
+
+	if allowed := idp.GetAccessTokenAllowedAudiences(); allowed != nil {
+		audiences, _ := claims.GetAudience()
+		if !slices.ContainsFunc(audiences, func(aud string) bool {
+			return slices.Contains(allowed.GetValues(), aud)
+		}) {
+			return nil, fmt.Errorf("%w: access token audience not allowed", sessions.ErrInvalidSession)
+		}
+	}
+
+	return claims, nil
+}
+
+func (cfg *Config) getTokenIntrospectionTransport() (http.RoundTripper, error) {
+	transport, err := GetTLSClientTransport(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("get tls client config: %w", err)
+	}
+	return otelhttp.NewTransport(transport), nil
+}
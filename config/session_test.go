@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -382,6 +383,37 @@ func Test_newSessionFromIDPClaims(t *testing.T) {
 	}
 }
 
+func Test_newSessionFromIDPClaims_TTL(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 2, 18, 8, 6, 0, 0, time.UTC)
+	c := &incomingIDPTokenSessionCreator{
+		timeNow: func() time.Time { return now },
+	}
+
+	for _, tc := range []struct {
+		name   string
+		ttl    time.Duration
+		claims jwtutil.Claims
+		expect time.Time
+	}{
+		{"no ttl and no exp", 0, nil, now.Add(14 * time.Hour)},
+		{"no ttl", 0, jwtutil.Claims{"exp": now.Add(time.Hour).Unix()}, now.Add(time.Hour)},
+		{"ttl and no exp", 30 * time.Minute, nil, now.Add(30 * time.Minute)},
+		{"ttl before exp", 30 * time.Minute, jwtutil.Claims{"exp": now.Add(time.Hour).Unix()}, now.Add(30 * time.Minute)},
+		{"exp before ttl", 2 * time.Hour, jwtutil.Claims{"exp": now.Add(time.Hour).Unix()}, now.Add(time.Hour)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &Config{Options: NewDefaultOptions()}
+			cfg.Options.IDPTokenSessionTTL = tc.ttl
+			actual := c.newSessionFromIDPClaims(cfg, "", "S1", tc.claims)
+			assert.Equal(t, tc.expect, actual.GetExpiresAt().AsTime())
+		})
+	}
+}
+
 func Test_fillUserFromIDPClaims(t *testing.T) {
 	t.Parallel()
 
@@ -488,6 +520,119 @@ func TestIncomingIDPTokenSessionCreator_CreateSession(t *testing.T) {
 		assert.Equal(t, "ACCESS_TOKEN", s.GetOauthToken().GetAccessToken())
 		assert.True(t, s.GetRefreshDisabled())
 	})
+	t.Run("access_token_introspection", func(t *testing.T) {
+		t.Parallel()
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/introspect", func(w http.ResponseWriter, r *http.Request) {
+			clientID, clientSecret, _ := r.BasicAuth()
+			assert.Equal(t, "CLIENT_ID_2", clientID)
+			assert.Equal(t, "CLIENT_SECRET_2", clientSecret)
+			assert.Equal(t, "access_token", r.FormValue("token_type_hint"))
+			json.NewEncoder(w).Encode(map[string]any{
+				"active": r.FormValue("token") == "ACCESS_TOKEN",
+				"sub":    "U1",
+				"exp":    time.Now().Add(time.Hour).Unix(),
+			})
+		})
+		srv := httptest.NewServer(mux)
+		t.Cleanup(srv.Close)
+
+		ctx := testutil.GetContext(t, time.Minute)
+		cfg := &Config{Options: NewDefaultOptions()}
+		cfg.Options.ClientSecret = "CLIENT_SECRET_1"
+		cfg.Options.ClientID = "CLIENT_ID_1"
+		cfg.Options.IDPAccessTokenIntrospectionURL = srv.URL + "/introspect"
+		bearerTokenFormatIDPAccessToken := BearerTokenFormatIDPAccessToken
+		cfg.Options.BearerTokenFormat = &bearerTokenFormatIDPAccessToken
+		route := &Policy{}
+		route.IDPClientSecret = "CLIENT_SECRET_2"
+		route.IDPClientID = "CLIENT_ID_2"
+		c := NewIncomingIDPTokenSessionCreator(
+			noop.NewTracerProvider(),
+			func(_ context.Context, _, _ string) (*databroker.Record, error) {
+				return nil, storage.ErrNotFound
+			},
+			func(_ context.Context, records []*databroker.Record) error {
+				assert.Len(t, records, 2, "should put session and user")
+				return nil
+			},
+		)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://www.example.com", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer ACCESS_TOKEN")
+		s, err := c.CreateSession(ctx, cfg, route, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "U1", s.GetUserId())
+		assert.Equal(t, "ACCESS_TOKEN", s.GetOauthToken().GetAccessToken())
+		assert.NotContains(t, s.GetClaims(), "active")
+		assert.True(t, s.GetRefreshDisabled())
+
+		req.Header.Set("Authorization", "Bearer INACTIVE_TOKEN")
+		_, err = c.CreateSession(ctx, cfg, route, req)
+		assert.ErrorIs(t, err, sessions.ErrInvalidSession)
+	})
+	t.Run("access_token_reverification", func(t *testing.T) {
+		t.Parallel()
+
+		var valid atomic.Bool
+		valid.Store(true)
+		mux := http.NewServeMux()
+		mux.HandleFunc("/.pomerium/verify-access-token", func(w http.ResponseWriter, _ *http.Request) {
+			json.NewEncoder(w).Encode(&authenticateapi.VerifyTokenResponse{
+				Valid:  valid.Load(),
+				Claims: jwtutil.Claims{"sub": "U1"},
+			})
+		})
+		srv := httptest.NewTLSServer(mux)
+
+		ctx := testutil.GetContext(t, time.Minute)
+		cfg := &Config{Options: NewDefaultOptions()}
+		cfg.Options.AuthenticateURLString = srv.URL
+		cfg.Options.ClientSecret = "CLIENT_SECRET_1"
+		cfg.Options.ClientID = "CLIENT_ID_1"
+		cfg.Options.IDPTokenReverifyInterval = time.Minute
+		bearerTokenFormatIDPAccessToken := BearerTokenFormatIDPAccessToken
+		cfg.Options.BearerTokenFormat = &bearerTokenFormatIDPAccessToken
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://www.example.com", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer ACCESS_TOKEN")
+
+		now := time.Now()
+		var stored *databroker.Record
+		c := NewIncomingIDPTokenSessionCreator(
+			noop.NewTracerProvider(),
+			func(_ context.Context, recordType, _ string) (*databroker.Record, error) {
+				if stored == nil || stored.GetType() != recordType {
+					return nil, storage.ErrNotFound
+				}
+				return stored, nil
+			},
+			func(_ context.Context, records []*databroker.Record) error {
+				stored = records[0]
+				return nil
+			},
+		).(*incomingIDPTokenSessionCreator)
+		c.timeNow = func() time.Time { return now }
+
+		s1, err := c.CreateSession(ctx, cfg, nil, req)
+		require.NoError(t, err)
+		assert.WithinDuration(t, now, s1.GetTokenVerifiedAt(), time.Second)
+
+		// within the interval the cached session is used
+		valid.Store(false)
+		now = now.Add(30 * time.Second)
+		s2, err := c.CreateSession(ctx, cfg, nil, req)
+		require.NoError(t, err)
+		assert.Equal(t, s1.GetId(), s2.GetId())
+
+		// after the interval the token is verified again and the session deleted
+		now = now.Add(time.Minute)
+		_, err = c.CreateSession(ctx, cfg, nil, req)
+		assert.ErrorIs(t, err, sessions.ErrInvalidSession)
+		assert.NotNil(t, stored.GetDeletedAt(), "should delete the session")
+	})
 	t.Run("identity_token", func(t *testing.T) {
 		t.Parallel()
 
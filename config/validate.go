@@ -5,6 +5,8 @@ import (
 	"net"
 	"strconv"
 	"strings"
+
+	"github.com/pomerium/pomerium/internal/httputil"
 )
 
 // ValidateCookieSameSite validates the cookie same site option.
@@ -17,6 +19,39 @@ func ValidateCookieSameSite(value string) error {
 	return fmt.Errorf("unknown cookie_same_site: %s", value)
 }
 
+// ValidateCookieCompression validates the cookie compression option.
+func ValidateCookieCompression(value string) error {
+	switch httputil.CookieCompression(value) {
+	case httputil.CookieCompressionNone, httputil.CookieCompressionDeflate, httputil.CookieCompressionZstd:
+		return nil
+	}
+	return fmt.Errorf("unknown cookie_compression: %s", value)
+}
+
+// ValidateCookiePrefix validates the cookie prefix option.
+func ValidateCookiePrefix(value string) error {
+	switch strings.ToLower(value) {
+	case "", "host", "secure":
+		return nil
+	}
+	return fmt.Errorf("unknown cookie_prefix: %s", value)
+}
+
+// Cookie modes
+const (
+	CookieModeJWT    = "jwt"
+	CookieModeServer = "server"
+)
+
+// ValidateCookieMode validates the cookie mode option.
+func ValidateCookieMode(value string) error {
+	switch strings.ToLower(value) {
+	case "", CookieModeJWT, CookieModeServer:
+		return nil
+	}
+	return fmt.Errorf("unknown cookie_mode: %s", value)
+}
+
 // ValidateMetricsAddress validates address for the metrics
 func ValidateAddress(addr string) error {
 	_, port, err := net.SplitHostPort(addr)
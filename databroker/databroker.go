@@ -16,6 +16,7 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
 
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/databroker"
@@ -26,6 +27,7 @@ import (
 	"github.com/pomerium/pomerium/pkg/envoy/files"
 	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
 	registrypb "github.com/pomerium/pomerium/pkg/grpc/registry"
+	sessionpb "github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/grpcutil"
 	"github.com/pomerium/pomerium/pkg/health"
 	"github.com/pomerium/pomerium/pkg/identity"
@@ -37,6 +39,8 @@ import (
 type DataBroker struct {
 	cfg         *databrokerConfig
 	srv         databroker.Server
+	debugSrv    databroker.DebugServer
+	sessionSrv  databroker.SessionServer
 	identityMgr *manager.Manager
 	eventsMgr   *events.Manager
 
@@ -66,24 +70,36 @@ func New(ctx context.Context, cfg *config.Config, eventsMgr *events.Manager, opt
 	tracer := tracerProvider.Tracer(trace.PomeriumCoreTracer)
 	// No metrics handler because we have one in the control plane.  Add one
 	// if we no longer register with that grpc Server
-	localGRPCServer := grpc.NewServer(
+	requestIDUI, requestIDSI := grpcutil.RequestIDInterceptors()
+	localGRPCServer := grpc.NewServer(append([]grpc.ServerOption{
 		grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithTracerProvider(tracerProvider))),
-		grpc.ChainStreamInterceptor(log.StreamServerInterceptor(log.Ctx(ctx)), si),
-		grpc.ChainUnaryInterceptor(log.UnaryServerInterceptor(log.Ctx(ctx)), ui),
-	)
+		grpc.ChainStreamInterceptor(log.StreamServerInterceptor(log.Ctx(ctx)), requestIDSI, si),
+		grpc.ChainUnaryInterceptor(log.UnaryServerInterceptor(log.Ctx(ctx)), requestIDUI, ui),
+	}, grpcutil.ServerMessageOptions(cfg.Options.GRPCMaxMessageSize)...)...)
 
-	srv := NewServer(tracerProvider, cfg)
+	local := databroker.NewBackendServer(tracerProvider)
+	srv := newServer(tracerProvider, local, cfg)
+	debugSrv := databroker.NewDebugServer(local)
+	debugSrv.OnConfigChange(ctx, cfg)
 
 	d := &DataBroker{
 		cfg:             getConfig(options...),
 		srv:             srv,
+		debugSrv:        debugSrv,
 		localListener:   localListener,
 		localGRPCServer: localGRPCServer,
 		eventsMgr:       eventsMgr,
 		tracerProvider:  tracerProvider,
 		tracer:          tracer,
 	}
+	d.sessionSrv = databroker.NewSessionServer(d.getLocalDataBrokerServiceClient)
+	d.sessionSrv.OnConfigChange(ctx, cfg)
 	d.Register(d.localGRPCServer)
+	// services can't be registered once the server is running, so reflection
+	// follows the debug service flag at startup
+	if cfg.Options.IsRuntimeFlagSet(config.RuntimeFlagDatabrokerDebugService) {
+		reflection.Register(d.localGRPCServer)
+	}
 
 	sharedKey, err := cfg.Options.GetSharedKey()
 	if err != nil {
@@ -95,12 +111,14 @@ func New(ctx context.Context, cfg *config.Config, eventsMgr *events.Manager, opt
 		grpc.WithInsecure(),
 		grpc.WithChainUnaryInterceptor(grpcutil.WithUnarySignedJWT(func() []byte {
 			return *d.sharedKey.Load()
-		})),
+		}, grpcutil.WithJWTService(cfg.Options.Services))),
 		grpc.WithChainStreamInterceptor(grpcutil.WithStreamSignedJWT(func() []byte {
 			return *d.sharedKey.Load()
-		})),
+		}, grpcutil.WithJWTService(cfg.Options.Services))),
 		grpc.WithStatsHandler(otelgrpc.NewClientHandler(otelgrpc.WithTracerProvider(tracerProvider))),
 	}
+	clientDialOptions = append(clientDialOptions,
+		grpcutil.ClientMessageOptions(cfg.Options.GRPCMaxMessageSize, cfg.Options.GRPCCompression)...)
 
 	ctx = log.WithContext(ctx, func(c zerolog.Context) zerolog.Context {
 		return c.Str("service", "databroker").Str("config-source", "bootstrap")
@@ -130,6 +148,8 @@ func (d *DataBroker) OnConfigChange(ctx context.Context, cfg *config.Config) {
 	}
 
 	d.srv.OnConfigChange(ctx, cfg)
+	d.debugSrv.OnConfigChange(ctx, cfg)
+	d.sessionSrv.OnConfigChange(ctx, cfg)
 }
 
 // Register registers all the gRPC services with the given server.
@@ -137,6 +157,12 @@ func (d *DataBroker) Register(grpcServer *grpc.Server) {
 	databrokerpb.RegisterCheckpointServiceServer(grpcServer, d.srv)
 	databrokerpb.RegisterDataBrokerServiceServer(grpcServer, d.srv)
 	registrypb.RegisterRegistryServer(grpcServer, d.srv)
+	databrokerpb.RegisterDebugServiceServer(grpcServer, d.debugSrv)
+	sessionpb.RegisterSessionServiceServer(grpcServer, d.sessionSrv)
+}
+
+func (d *DataBroker) getLocalDataBrokerServiceClient() databrokerpb.DataBrokerServiceClient {
+	return databrokerpb.NewDataBrokerServiceClient(d.localGRPCConnection)
 }
 
 // Run runs the databroker components.
@@ -206,8 +232,12 @@ func validate(o *config.Options) error {
 
 // NewServer creates a new databroker server.
 func NewServer(tracerProvider oteltrace.TracerProvider, cfg *config.Config) databroker.Server {
-	srv := databroker.NewBackendServer(tracerProvider)
-	srv = databroker.NewClusteredServer(tracerProvider, srv, cfg)
+	return newServer(tracerProvider, databroker.NewBackendServer(tracerProvider), cfg)
+}
+
+func newServer(tracerProvider oteltrace.TracerProvider, local databroker.Server, cfg *config.Config) databroker.Server {
+	srv := databroker.NewClusteredServer(tracerProvider, local, cfg)
+	srv = databroker.NewAuditedServer(srv)
 	srv = databroker.NewSecuredServer(srv)
 	return srv
 }
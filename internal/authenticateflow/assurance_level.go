@@ -0,0 +1,24 @@
+package authenticateflow
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/urlutil"
+)
+
+// ErrAssuranceLevelNotMet indicates that a session does not meet the
+// assurance level requested for a sign in.
+var ErrAssuranceLevelNotMet = errors.New("session does not meet the requested assurance level")
+
+// verifyAssuranceLevel checks the assurance level achieved by a session
+// against the assurance level requested for the sign in.
+func verifyAssuranceLevel(r *http.Request, achieved string) error {
+	required := r.FormValue(urlutil.QueryAssuranceLevel)
+	if !config.IsAssuranceLevelSatisfied(required, achieved) {
+		return fmt.Errorf("%w: %q required", ErrAssuranceLevelNotMet, required)
+	}
+	return nil
+}
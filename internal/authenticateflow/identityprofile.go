@@ -29,8 +29,6 @@ import (
 // between different IdP's or between different clients of the same IdP), a
 // user ID token, and an OAuth2 token.
 
-var cookieChunker = httputil.NewCookieChunker()
-
 // buildIdentityProfile populates an identity profile.
 func buildIdentityProfile(
 	idpID string,
@@ -56,8 +54,13 @@ func buildIdentityProfile(
 }
 
 // loadIdentityProfile loads an identity profile from a chunked set of cookies.
-func loadIdentityProfile(r *http.Request, aead cipher.AEAD) (*identitypb.Profile, error) {
-	cookie, err := cookieChunker.LoadCookie(r, urlutil.QueryIdentityProfile)
+func loadIdentityProfile(
+	r *http.Request,
+	cookieChunker *httputil.CookieChunker,
+	cookiePrefix httputil.CookiePrefix,
+	aead cipher.AEAD,
+) (*identitypb.Profile, error) {
+	cookie, err := cookieChunker.LoadCookie(r, cookiePrefix.CookieName(urlutil.QueryIdentityProfile))
 	if err != nil {
 		return nil, fmt.Errorf("authenticate: error loading identity profile cookie: %w", err)
 	}
@@ -83,6 +86,8 @@ func loadIdentityProfile(r *http.Request, aead cipher.AEAD) (*identitypb.Profile
 // storeIdentityProfile writes the identity profile to a chunked set of cookies.
 func storeIdentityProfile(
 	w http.ResponseWriter,
+	cookieChunker *httputil.CookieChunker,
+	cookiePrefix httputil.CookiePrefix,
 	cookie *http.Cookie,
 	aead cipher.AEAD,
 	profile *identitypb.Profile,
@@ -96,6 +101,7 @@ func storeIdentityProfile(
 	cookie.Name = urlutil.QueryIdentityProfile
 	cookie.Value = base64.RawURLEncoding.EncodeToString(encrypted)
 	cookie.Path = "/"
+	httputil.ApplyCookiePrefix(cookie, cookiePrefix)
 	return cookieChunker.SetCookie(w, cookie)
 }
 
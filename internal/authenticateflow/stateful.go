@@ -31,7 +31,6 @@ import (
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/grpc/user"
-	"github.com/pomerium/pomerium/pkg/grpcutil"
 	"github.com/pomerium/pomerium/pkg/identity"
 	"github.com/pomerium/pomerium/pkg/identity/manager"
 	"github.com/pomerium/pomerium/pkg/telemetry/trace"
@@ -54,6 +53,8 @@ type Stateful struct {
 	// sessionStore is the session store used to persist a user's session
 	sessionStore sessions.SessionStore
 
+	options *config.Options
+
 	defaultIdentityProviderID string
 
 	authenticateURL *url.URL
@@ -67,6 +68,7 @@ func NewStateful(ctx context.Context, tracerProvider oteltrace.TracerProvider, c
 	s := &Stateful{
 		sessionDuration: cfg.Options.CookieExpire,
 		sessionStore:    sessionStore,
+		options:         cfg.Options,
 	}
 
 	var err error
@@ -199,6 +201,7 @@ func (s *Stateful) PersistSession(
 	sess.Audience = h.Audience
 	sess.SetRawIDToken(claims.RawIDToken)
 	sess.AddClaims(claims.Flatten())
+	sess.SetAssuranceLevel(s.options.GetAssuranceLevelForClaims(claims.Claims))
 
 	u, _ := user.Get(ctx, s.dataBrokerClient, sess.GetUserId())
 	if u == nil {
@@ -220,6 +223,14 @@ func (s *Stateful) PersistSession(
 	h.DatabrokerServerVersion = res.GetServerVersion()
 	h.DatabrokerRecordVersion = res.GetRecord().GetVersion()
 
+	// index the session so that it can be found for back-channel logout
+	if indexRecords := session.NewIndexRecords(sess); len(indexRecords) > 0 {
+		_, err = s.dataBrokerClient.Put(ctx, &databroker.PutRequest{Records: indexRecords})
+		if err != nil {
+			return fmt.Errorf("authenticate: error saving session index: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -265,25 +276,11 @@ func (s *Stateful) RevokeSession(
 
 	// Note: session.Delete() cannot be used safely, because the identity
 	// manager expects to be able to read both session ID and user ID from
-	// deleted session records. Instead, we match the behavior used in the
-	// identity manager itself: fetch the existing databroker session record,
-	// explicitly set the DeletedAt timestamp, and Put() that record back.
-
-	res, err := s.dataBrokerClient.Get(ctx, &databroker.GetRequest{
-		Type: grpcutil.GetTypeURL(new(session.Session)),
-		Id:   h.ID,
-	})
+	// deleted session records. session.Revoke() keeps the session data in the
+	// deleted record.
+	sess, err := session.Revoke(ctx, s.dataBrokerClient, h.ID)
 	if err != nil {
-		err = fmt.Errorf("couldn't get session to be revoked: %w", err)
-		log.Ctx(ctx).Error().Err(err).Msg("authenticate: failed to revoke access token")
-		return ""
-	}
-
-	record := res.GetRecord()
-
-	var sess session.Session
-	if err := record.GetData().UnmarshalTo(&sess); err != nil {
-		err = fmt.Errorf("couldn't unmarshal data of session to be revoked: %w", err)
+		err = fmt.Errorf("couldn't revoke session: %w", err)
 		log.Ctx(ctx).Error().Err(err).Msg("authenticate: failed to revoke access token")
 		return ""
 	}
@@ -295,27 +292,33 @@ func (s *Stateful) RevokeSession(
 			log.Ctx(ctx).Error().Err(err).Msg("authenticate: failed to revoke access token")
 		}
 	}
-
-	record.DeletedAt = timestamppb.Now()
-	_, err = s.dataBrokerClient.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{record},
-	})
-	if err != nil {
-		log.Ctx(ctx).Error().Err(err).
-			Msg("authenticate: failed to delete session from session store")
-	}
 	return rawIDToken
 }
 
+// RevokeSessionsByIndex revokes all sessions matching the given session index
+// keys, returning the IDs of the revoked sessions.
+func (s *Stateful) RevokeSessionsByIndex(ctx context.Context, keys ...string) ([]string, error) {
+	return session.RevokeByIndex(ctx, s.dataBrokerClient, keys...)
+}
+
+// RecordLogoutToken records the use of a back-channel logout token, returning
+// session.ErrLogoutTokenReplayed if it was already used.
+func (s *Stateful) RecordLogoutToken(ctx context.Context, issuer, jti string) error {
+	return session.RecordLogoutToken(ctx, s.dataBrokerClient, issuer, jti)
+}
+
 // VerifySession checks that an existing session is still valid.
 func (s *Stateful) VerifySession(
-	ctx context.Context, _ *http.Request, h *sessions.Handle,
+	ctx context.Context, r *http.Request, h *sessions.Handle,
 ) error {
 	sess, err := session.Get(ctx, s.dataBrokerClient, h.ID)
 	if err != nil {
 		return fmt.Errorf("session not found in databroker: %w", err)
 	}
-	return sess.Validate()
+	if err := sess.Validate(); err != nil {
+		return err
+	}
+	return verifyAssuranceLevel(r, sess.GetAssuranceLevel())
 }
 
 // LogAuthenticateEvent is a no-op for the stateful authentication flow.
@@ -418,6 +418,15 @@ func TestStatefulRevokeSession(t *testing.T) {
 			assert.Equal(t, "user-id", s.UserId)
 			return nil, nil
 		})
+	client.EXPECT().SetOptions(ctx, gomock.Any()).Return(&databroker.SetOptionsResponse{}, nil)
+	client.EXPECT().Put(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, r *databroker.PutRequest, _ ...grpc.CallOption) (*databroker.PutResponse, error) {
+			// the revocation is announced so that cached sessions are dropped
+			require.Len(t, r.Records, 1)
+			assert.Equal(t, session.RevocationRecordType, r.GetRecord().GetType())
+			assert.Equal(t, "session-id", r.GetRecord().GetId())
+			return nil, nil
+		})
 
 	idToken := flow.RevokeSession(ctx, nil, authenticator, h)
 
@@ -541,6 +550,15 @@ func TestPersistSession(t *testing.T) {
 			}, nil
 		})
 
+	client.EXPECT().Put(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, r *databroker.PutRequest, _ ...grpc.CallOption) (*databroker.PutResponse, error) {
+			require.Len(t, r.Records, 1)
+			record := r.GetRecord()
+			assert.Equal(t, session.IndexRecordType, record.Type)
+			assert.Equal(t, session.IndexKeyForSubject("https://issuer.example.com", "id-token-user-id")+"|session-id", record.Id)
+			return &databroker.PutResponse{}, nil
+		})
+
 	err = flow.PersistSession(ctx, nil, h, claims, accessToken)
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(1111), h.DatabrokerRecordVersion)
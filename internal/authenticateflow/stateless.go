@@ -3,8 +3,10 @@ package authenticateflow
 import (
 	"context"
 	"crypto/cipher"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 
@@ -12,8 +14,11 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	oteltrace "go.opentelemetry.io/otel/trace"
+	"golang.org/x/crypto/hkdf"
 	"golang.org/x/oauth2"
 	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/pomerium/pomerium/authenticate/events"
@@ -31,6 +36,7 @@ import (
 	identitypb "github.com/pomerium/pomerium/pkg/grpc/identity"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
 	"github.com/pomerium/pomerium/pkg/hpke"
 	"github.com/pomerium/pomerium/pkg/identity"
 	"github.com/pomerium/pomerium/pkg/telemetry/trace"
@@ -47,6 +53,8 @@ type Stateless struct {
 	sharedEncoder encoding.MarshalUnmarshaler
 	// cookieCipher is the cipher to use to encrypt/decrypt session data
 	cookieCipher cipher.AEAD
+	// cookieChunker splits the identity profile across multiple cookies
+	cookieChunker *httputil.CookieChunker
 
 	sessionStore sessions.SessionStore
 
@@ -63,6 +71,7 @@ type Stateless struct {
 
 	getIdentityProvider func(ctx context.Context, tracerProvider oteltrace.TracerProvider, options *config.Options, idpID string) (identity.Authenticator, error)
 	profileTrimFn       func(*identitypb.Profile)
+	additionalRecordsFn func(context.Context, *identitypb.Profile) ([]*databroker.Record, error)
 	authEventFn         events.AuthEventFn
 
 	tracerProvider oteltrace.TracerProvider
@@ -77,6 +86,7 @@ func NewStateless(
 	sessionStore sessions.SessionStore,
 	getIdentityProvider func(ctx context.Context, tracerProvider oteltrace.TracerProvider, options *config.Options, idpID string) (identity.Authenticator, error),
 	profileTrimFn func(*identitypb.Profile),
+	additionalRecordsFn func(context.Context, *identitypb.Profile) ([]*databroker.Record, error),
 	authEventFn events.AuthEventFn,
 	outboundGrpcConn *grpc.CachedOutboundGRPClientConn,
 ) (*Stateless, error) {
@@ -85,6 +95,7 @@ func NewStateless(
 		sessionStore:        sessionStore,
 		getIdentityProvider: getIdentityProvider,
 		profileTrimFn:       profileTrimFn,
+		additionalRecordsFn: additionalRecordsFn,
 		authEventFn:         authEventFn,
 		tracerProvider:      tracerProvider,
 	}
@@ -118,6 +129,16 @@ func NewStateless(
 		return nil, err
 	}
 
+	cookieChunkerKey, err := getCookieChunkerKey(cookieSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cookieChunker = httputil.NewCookieChunker(
+		httputil.WithCookieChunkerCompression(httputil.CookieCompression(cfg.Options.CookieCompression)),
+		httputil.WithCookieChunkerKey(cookieChunkerKey),
+	)
+
 	s.jwk = new(jose.JSONWebKeySet)
 	signingKey, err := cfg.Options.GetSigningKey()
 	if err != nil {
@@ -162,7 +183,7 @@ func NewStateless(
 
 // VerifySession checks that an existing session is still valid.
 func (s *Stateless) VerifySession(ctx context.Context, r *http.Request, _ *sessions.Handle) error {
-	profile, err := loadIdentityProfile(r, s.cookieCipher)
+	profile, err := loadIdentityProfile(r, s.cookieChunker, s.options.GetCookiePrefix(), s.cookieCipher)
 	if err != nil {
 		return fmt.Errorf("identity profile load error: %w", err)
 	}
@@ -176,7 +197,7 @@ func (s *Stateless) VerifySession(ctx context.Context, r *http.Request, _ *sessi
 		return fmt.Errorf("invalid identity profile: %w", err)
 	}
 
-	return nil
+	return verifyAssuranceLevel(r, s.options.GetAssuranceLevelForClaims(profile.GetClaims().AsMap()))
 }
 
 // SignIn redirects to a route callback URL, if the provided request and
@@ -206,7 +227,7 @@ func (s *Stateless) SignIn(
 		return httputil.NewError(http.StatusBadRequest, err)
 	}
 
-	profile, err := loadIdentityProfile(r, s.cookieCipher)
+	profile, err := loadIdentityProfile(r, s.cookieChunker, s.options.GetCookiePrefix(), s.cookieCipher)
 	if err != nil {
 		return httputil.NewError(http.StatusBadRequest, err)
 	}
@@ -217,12 +238,20 @@ func (s *Stateless) SignIn(
 
 	s.logAuthenticateEvent(r, profile)
 
+	var records []*databroker.Record
+	if s.additionalRecordsFn != nil {
+		records, err = s.additionalRecordsFn(r.Context(), profile)
+		if err != nil {
+			return httputil.NewError(http.StatusInternalServerError, fmt.Errorf("error retrieving additional records: %w", err))
+		}
+	}
+
 	encryptURLValues := hpke.EncryptURLValuesV1
 	if hpke.IsEncryptedURLV2(r.Form) {
 		encryptURLValues = hpke.EncryptURLValuesV2
 	}
 
-	redirectTo, err := urlutil.CallbackURL(s.hpkePrivateKey, proxyPublicKey, requestParams, profile, encryptURLValues)
+	redirectTo, err := urlutil.CallbackURL(s.hpkePrivateKey, proxyPublicKey, requestParams, profile, records, encryptURLValues)
 	if err != nil {
 		return httputil.NewError(http.StatusInternalServerError, err)
 	}
@@ -244,7 +273,7 @@ func (s *Stateless) PersistSession(
 	if err != nil {
 		return err
 	}
-	err = storeIdentityProfile(w, s.options.NewCookie(), s.cookieCipher, profile)
+	err = storeIdentityProfile(w, s.cookieChunker, s.options.GetCookiePrefix(), s.options.NewCookie(), s.cookieCipher, profile)
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("failed to store identity profile")
 	}
@@ -254,7 +283,7 @@ func (s *Stateless) PersistSession(
 // GetUserInfoData returns user info data associated with the given request (if
 // any).
 func (s *Stateless) GetUserInfoData(r *http.Request, _ *sessions.Handle) handlers.UserInfoData {
-	profile, _ := loadIdentityProfile(r, s.cookieCipher)
+	profile, _ := loadIdentityProfile(r, s.cookieChunker, s.options.GetCookiePrefix(), s.cookieCipher)
 	return handlers.UserInfoData{
 		Profile: profile,
 	}
@@ -263,9 +292,18 @@ func (s *Stateless) GetUserInfoData(r *http.Request, _ *sessions.Handle) handler
 // RevokeSession revokes the session associated with the provided request,
 // returning the ID token from the revoked session.
 func (s *Stateless) RevokeSession(
-	ctx context.Context, r *http.Request, authenticator identity.Authenticator, _ *sessions.Handle,
+	ctx context.Context, r *http.Request, authenticator identity.Authenticator, h *sessions.Handle,
 ) string {
-	profile, err := loadIdentityProfile(r, s.cookieCipher)
+	// remove the session saved by the route callback, along with its index
+	// records
+	if h != nil {
+		_, err := session.Revoke(ctx, s.dataBrokerClient, h.ID)
+		if err != nil && status.Code(err) != codes.NotFound {
+			log.Ctx(ctx).Error().Err(err).Msg("authenticate: failed to revoke session")
+		}
+	}
+
+	profile, err := loadIdentityProfile(r, s.cookieChunker, s.options.GetCookiePrefix(), s.cookieCipher)
 	if err != nil {
 		return ""
 	}
@@ -279,6 +317,18 @@ func (s *Stateless) RevokeSession(
 	return string(profile.GetIdToken())
 }
 
+// RevokeSessionsByIndex revokes all sessions matching the given session index
+// keys, returning the IDs of the revoked sessions.
+func (s *Stateless) RevokeSessionsByIndex(ctx context.Context, keys ...string) ([]string, error) {
+	return session.RevokeByIndex(ctx, s.dataBrokerClient, keys...)
+}
+
+// RecordLogoutToken records the use of a back-channel logout token, returning
+// session.ErrLogoutTokenReplayed if it was already used.
+func (s *Stateless) RecordLogoutToken(ctx context.Context, issuer, jti string) error {
+	return session.RecordLogoutToken(ctx, s.dataBrokerClient, issuer, jti)
+}
+
 // GetIdentityProviderIDForURLValues returns the identity provider ID
 // associated with the given URL values.
 func (s *Stateless) GetIdentityProviderIDForURLValues(vs url.Values) string {
@@ -419,23 +469,32 @@ func (s *Stateless) Callback(w http.ResponseWriter, r *http.Request) error {
 		sess = session.New(h.IdentityProviderID, h.ID)
 	}
 	populateSessionFromProfile(sess, profile, h, s.options.CookieExpire)
+	sess.SetAssuranceLevel(s.options.GetAssuranceLevelForClaims(profile.GetClaims().AsMap()))
 	u, err := user.Get(r.Context(), s.dataBrokerClient, h.UserID())
 	if err != nil {
 		u = &user.User{Id: h.UserID()}
 	}
 	u.PopulateFromClaims(profile.Claims.AsMap())
 
+	additionalRecords, err := getRecordsFromValues(values)
+	if err != nil {
+		return err
+	}
+
 	redirectURI, err := getRedirectURIFromValues(values)
 	if err != nil {
 		return err
 	}
 
 	// save the records
+	records := []*databroker.Record{
+		databroker.NewRecord(sess),
+		databroker.NewRecord(u),
+	}
+	records = append(records, additionalRecords...)
+	records = append(records, session.NewIndexRecords(sess)...)
 	res, err := s.dataBrokerClient.Put(r.Context(), &databroker.PutRequest{
-		Records: []*databroker.Record{
-			databroker.NewRecord(sess),
-			databroker.NewRecord(u),
-		},
+		Records: records,
 	})
 	if err != nil {
 		return httputil.NewError(http.StatusInternalServerError, fmt.Errorf("proxy: error saving databroker records: %w", err))
@@ -495,6 +554,40 @@ func getProfileFromValues(values url.Values) (*identitypb.Profile, error) {
 	return &profile, nil
 }
 
+// getRecordsFromValues returns any additional databroker records sent by the
+// authenticate service. Session and user records are built from the identity
+// profile, so they may not be overridden.
+func getRecordsFromValues(values url.Values) ([]*databroker.Record, error) {
+	rawRecords := values.Get(urlutil.QueryRecords)
+	if rawRecords == "" {
+		return nil, nil
+	}
+
+	var rawMessages []json.RawMessage
+	err := json.Unmarshal([]byte(rawRecords), &rawMessages)
+	if err != nil {
+		return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid %s: %w", urlutil.QueryRecords, err))
+	}
+
+	records := make([]*databroker.Record, len(rawMessages))
+	for i, rawMessage := range rawMessages {
+		records[i] = new(databroker.Record)
+		err = protojson.Unmarshal(rawMessage, records[i])
+		if err != nil {
+			return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid %s: %w", urlutil.QueryRecords, err))
+		}
+
+		switch records[i].GetType() {
+		case "", grpcutil.GetTypeURL(new(session.Session)), grpcutil.GetTypeURL(new(user.User)):
+			return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid %s: unsupported record type %q", urlutil.QueryRecords, records[i].GetType()))
+		}
+		if records[i].GetId() == "" {
+			return nil, httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid %s: missing record id", urlutil.QueryRecords))
+		}
+	}
+	return records, nil
+}
+
 func getRedirectURIFromValues(values url.Values) (*url.URL, error) {
 	rawRedirectURI := values.Get(urlutil.QueryRedirectURI)
 	if rawRedirectURI == "" {
@@ -506,3 +599,14 @@ func getRedirectURIFromValues(values url.Values) (*url.URL, error) {
 	}
 	return redirectURI, nil
 }
+
+// getCookieChunkerKey derives the key used to sign chunked cookies from the
+// cookie secret, so that the cookie cipher key isn't reused for the HMAC.
+func getCookieChunkerKey(cookieSecret []byte) ([]byte, error) {
+	key := make([]byte, cryptutil.DefaultKeySize)
+	_, err := io.ReadFull(hkdf.New(sha256.New, cookieSecret, nil, []byte("cookie-chunker")), key)
+	if err != nil {
+		return nil, fmt.Errorf("authenticateflow: error deriving cookie chunker key: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,46 @@
+package authenticateflow
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/internal/urlutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+func TestGetRecordsFromValues(t *testing.T) {
+	t.Parallel()
+
+	records, err := getRecordsFromValues(url.Values{})
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	records, err = getRecordsFromValues(url.Values{
+		urlutil.QueryRecords: {`[
+			{ "type": "example.Group", "id": "g1" },
+			{ "type": "example.Device", "id": "d1" }
+		]`},
+	})
+	require.NoError(t, err)
+	testutil.AssertProtoEqual(t, []*databroker.Record{
+		{Type: "example.Group", Id: "g1"},
+		{Type: "example.Device", Id: "d1"},
+	}, records)
+
+	for _, rawRecords := range []string{
+		`{}`,
+		`[{ "type": "example.Group" }]`,
+		`[{ "id": "g1" }]`,
+		`[{ "type": "type.googleapis.com/session.Session", "id": "s1" }]`,
+		`[{ "type": "type.googleapis.com/user.User", "id": "u1" }]`,
+	} {
+		_, err = getRecordsFromValues(url.Values{
+			urlutil.QueryRecords: {rawRecords},
+		})
+		assert.Error(t, err, rawRecords)
+	}
+}
@@ -0,0 +1,100 @@
+package autocert
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/caddyserver/certmagic"
+	"github.com/libdns/libdns"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+)
+
+// configureDNSChallenge configures the acmeMgr to solve the ACME DNS-01
+// challenge using the configured DNS provider.
+func configureDNSChallenge(ctx context.Context, acmeMgr *certmagic.ACMEIssuer, opts config.AutocertOptions) error {
+	if opts.DNSProvider == "" {
+		return nil
+	}
+	client, err := getDNSProviderHTTPClient(opts)
+	if err != nil {
+		return fmt.Errorf("config: creating autocert dns provider http client: %w", err)
+	}
+	provider, err := newDNSProvider(ctx, opts.DNSProvider, opts.DNSProviderOptions, client)
+	if err != nil {
+		return fmt.Errorf("config: creating autocert dns provider: %w", err)
+	}
+	acmeMgr.DNS01Solver = &certmagic.DNS01Solver{
+		DNSManager: certmagic.DNSManager{
+			DNSProvider: provider,
+		},
+	}
+	return nil
+}
+
+// getDNSProviderHTTPClient returns the HTTP client used to call DNS provider
+// APIs. It uses the autocert proxy, and trusts the challenge trusted CA in
+// addition to the system roots.
+func getDNSProviderHTTPClient(opts config.AutocertOptions) (*http.Client, error) {
+	proxy, err := getHTTPProxy(opts)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxy
+	if opts.ChallengeTrustedCA != "" || opts.ChallengeTrustedCAFile != "" {
+		pool, err := cryptutil.GetCertPool(opts.ChallengeTrustedCA, opts.ChallengeTrustedCAFile)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+// newDNSProvider creates the DNS provider with the given name.
+//
+// The providers only implement the TXT record operations needed to solve the
+// DNS-01 challenge, calling the provider APIs directly instead of using the
+// libdns provider modules. github.com/libdns/route53 implements the pre-1.0
+// libdns API, which certmagic no longer accepts, and keeping every provider on
+// the same HTTP client lets them all use the autocert proxy and trusted CA.
+func newDNSProvider(ctx context.Context, name string, options map[string]string, client *http.Client) (certmagic.DNSProvider, error) {
+	switch name {
+	case config.AutocertDNSProviderRoute53:
+		return newRoute53DNSProvider(ctx, options, client)
+	case config.AutocertDNSProviderCloudflare:
+		return newCloudflareDNSProvider(options, client)
+	case config.AutocertDNSProviderRFC2136:
+		return newRFC2136DNSProvider(options)
+	}
+	return nil, fmt.Errorf("unknown dns provider %q", name)
+}
+
+// dnsTXTRecord is a TXT record with an absolute name, without the trailing dot.
+type dnsTXTRecord struct {
+	name string
+	ttl  int
+	text string
+}
+
+// getDNSTXTRecords returns the TXT records in recs, with absolute names.
+func getDNSTXTRecords(zone string, recs []libdns.Record) ([]dnsTXTRecord, error) {
+	txts := make([]dnsTXTRecord, 0, len(recs))
+	for _, rec := range recs {
+		rr := rec.RR()
+		if rr.Type != "TXT" {
+			return nil, fmt.Errorf("unsupported dns record type %q", rr.Type)
+		}
+		txts = append(txts, dnsTXTRecord{
+			name: strings.TrimSuffix(libdns.AbsoluteName(rr.Name, zone), "."),
+			ttl:  max(int(rr.TTL.Seconds()), 60),
+			text: rr.Data,
+		})
+	}
+	return txts, nil
+}
@@ -0,0 +1,163 @@
+package autocert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/libdns/libdns"
+)
+
+const cloudflareAPIURL = "https://api.cloudflare.com/client/v4"
+
+// cloudflareDNSProvider manages ACME challenge records using the Cloudflare API.
+type cloudflareDNSProvider struct {
+	apiURL   string
+	apiToken string
+	zoneID   string
+	client   *http.Client
+}
+
+func newCloudflareDNSProvider(options map[string]string, client *http.Client) (*cloudflareDNSProvider, error) {
+	if options["api_token"] == "" {
+		return nil, errors.New("cloudflare: api_token is required")
+	}
+	return &cloudflareDNSProvider{
+		apiURL:   cloudflareAPIURL,
+		apiToken: options["api_token"],
+		zoneID:   options["zone_id"],
+		client:   client,
+	}, nil
+}
+
+// AppendRecords creates the given TXT records.
+func (p *cloudflareDNSProvider) AppendRecords(ctx context.Context, zone string, recs []libdns.Record) ([]libdns.Record, error) {
+	txts, err := getDNSTXTRecords(zone, recs)
+	if err != nil {
+		return nil, err
+	}
+	zoneID, err := p.getZoneID(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+	for _, txt := range txts {
+		err = p.do(ctx, http.MethodPost, "/zones/"+zoneID+"/dns_records", map[string]any{
+			"type":    "TXT",
+			"name":    txt.name,
+			"content": txt.text,
+			"ttl":     txt.ttl,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return recs, nil
+}
+
+// DeleteRecords deletes the given TXT records.
+func (p *cloudflareDNSProvider) DeleteRecords(ctx context.Context, zone string, recs []libdns.Record) ([]libdns.Record, error) {
+	txts, err := getDNSTXTRecords(zone, recs)
+	if err != nil {
+		return nil, err
+	}
+	zoneID, err := p.getZoneID(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+	for _, txt := range txts {
+		var existing []struct {
+			ID string `json:"id"`
+		}
+		err = p.do(ctx, http.MethodGet, "/zones/"+zoneID+"/dns_records?"+url.Values{
+			"type":    {"TXT"},
+			"name":    {txt.name},
+			"content": {txt.text},
+		}.Encode(), nil, &existing)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range existing {
+			err = p.do(ctx, http.MethodDelete, "/zones/"+zoneID+"/dns_records/"+record.ID, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return recs, nil
+}
+
+func (p *cloudflareDNSProvider) getZoneID(ctx context.Context, zone string) (string, error) {
+	if p.zoneID != "" {
+		return p.zoneID, nil
+	}
+
+	var zones []struct {
+		ID string `json:"id"`
+	}
+	err := p.do(ctx, http.MethodGet, "/zones?"+url.Values{
+		"name": {strings.TrimSuffix(zone, ".")},
+	}.Encode(), nil, &zones)
+	if err != nil {
+		return "", err
+	}
+	if len(zones) == 0 {
+		return "", fmt.Errorf("cloudflare: zone %s not found", zone)
+	}
+	return zones[0].ID, nil
+}
+
+func (p *cloudflareDNSProvider) do(ctx context.Context, method, path string, body, result any) error {
+	var reqBody io.Reader
+	if body != nil {
+		bs, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(bs)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.apiURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.apiToken)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("cloudflare: %w", err)
+	}
+	defer res.Body.Close()
+
+	var envelope struct {
+		Success bool `json:"success"`
+		Errors  []struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"errors"`
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("cloudflare: invalid response (status %d): %w", res.StatusCode, err)
+	}
+	if !envelope.Success {
+		if len(envelope.Errors) > 0 {
+			return fmt.Errorf("cloudflare: %s (code %d)", envelope.Errors[0].Message, envelope.Errors[0].Code)
+		}
+		return fmt.Errorf("cloudflare: request failed with status %d", res.StatusCode)
+	}
+	if result != nil {
+		if err := json.Unmarshal(envelope.Result, result); err != nil {
+			return fmt.Errorf("cloudflare: invalid response: %w", err)
+		}
+	}
+	return nil
+}
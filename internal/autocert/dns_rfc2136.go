@@ -0,0 +1,111 @@
+package autocert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/libdns/libdns"
+	"github.com/miekg/dns"
+)
+
+// rfc2136DNSProvider manages ACME challenge records using RFC 2136 dynamic
+// DNS updates, optionally authenticated with TSIG.
+type rfc2136DNSProvider struct {
+	server        string
+	tsigKeyName   string
+	tsigSecret    string
+	tsigAlgorithm string
+}
+
+func newRFC2136DNSProvider(options map[string]string) (*rfc2136DNSProvider, error) {
+	server := options["server"]
+	if server == "" {
+		return nil, errors.New("rfc2136: server is required")
+	}
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "53")
+	}
+
+	p := &rfc2136DNSProvider{
+		server:        server,
+		tsigSecret:    options["tsig_secret"],
+		tsigAlgorithm: dns.HmacSHA256,
+	}
+	if options["tsig_key_name"] != "" {
+		p.tsigKeyName = dns.Fqdn(options["tsig_key_name"])
+	}
+	if options["tsig_algorithm"] != "" {
+		p.tsigAlgorithm = dns.Fqdn(options["tsig_algorithm"])
+	}
+	return p, nil
+}
+
+// AppendRecords adds the given TXT records.
+func (p *rfc2136DNSProvider) AppendRecords(ctx context.Context, zone string, recs []libdns.Record) ([]libdns.Record, error) {
+	rrs, err := p.getRRs(zone, recs)
+	if err != nil {
+		return nil, err
+	}
+	msg := new(dns.Msg)
+	msg.SetUpdate(dns.Fqdn(zone))
+	msg.Insert(rrs)
+	if err := p.exchange(ctx, msg); err != nil {
+		return nil, err
+	}
+	return recs, nil
+}
+
+// DeleteRecords removes the given TXT records.
+func (p *rfc2136DNSProvider) DeleteRecords(ctx context.Context, zone string, recs []libdns.Record) ([]libdns.Record, error) {
+	rrs, err := p.getRRs(zone, recs)
+	if err != nil {
+		return nil, err
+	}
+	msg := new(dns.Msg)
+	msg.SetUpdate(dns.Fqdn(zone))
+	msg.Remove(rrs)
+	if err := p.exchange(ctx, msg); err != nil {
+		return nil, err
+	}
+	return recs, nil
+}
+
+func (p *rfc2136DNSProvider) getRRs(zone string, recs []libdns.Record) ([]dns.RR, error) {
+	txts, err := getDNSTXTRecords(zone, recs)
+	if err != nil {
+		return nil, err
+	}
+	rrs := make([]dns.RR, 0, len(txts))
+	for _, txt := range txts {
+		rrs = append(rrs, &dns.TXT{
+			Hdr: dns.RR_Header{
+				Name:   dns.Fqdn(txt.name),
+				Rrtype: dns.TypeTXT,
+				Class:  dns.ClassINET,
+				Ttl:    uint32(txt.ttl),
+			},
+			Txt: []string{txt.text},
+		})
+	}
+	return rrs, nil
+}
+
+func (p *rfc2136DNSProvider) exchange(ctx context.Context, msg *dns.Msg) error {
+	client := &dns.Client{Net: "tcp"}
+	if p.tsigKeyName != "" {
+		msg.SetTsig(p.tsigKeyName, p.tsigAlgorithm, 300, time.Now().Unix())
+		client.TsigSecret = map[string]string{p.tsigKeyName: p.tsigSecret}
+	}
+
+	res, _, err := client.ExchangeContext(ctx, msg, p.server)
+	if err != nil {
+		return fmt.Errorf("rfc2136: %w", err)
+	}
+	if res.Rcode != dns.RcodeSuccess {
+		return fmt.Errorf("rfc2136: update failed: %s", dns.RcodeToString[res.Rcode])
+	}
+	return nil
+}
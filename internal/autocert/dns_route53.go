@@ -0,0 +1,258 @@
+package autocert
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/libdns/libdns"
+)
+
+const (
+	route53APIURL        = "https://route53.amazonaws.com/2013-04-01"
+	route53SigningRegion = "us-east-1"
+	route53XMLNS         = "https://route53.amazonaws.com/doc/2013-04-01/"
+)
+
+// route53DNSProvider manages ACME challenge records using the AWS Route 53
+// API. Credentials are loaded from the default AWS credential chain unless
+// they are set in the options.
+type route53DNSProvider struct {
+	apiURL       string
+	hostedZoneID string
+	credentials  aws.CredentialsProvider
+	signer       *v4.Signer
+	client       *http.Client
+}
+
+func newRoute53DNSProvider(ctx context.Context, options map[string]string, client *http.Client) (*route53DNSProvider, error) {
+	loadOptions := []func(*awsconfig.LoadOptions) error{
+		awsconfig.WithHTTPClient(client),
+	}
+	if options["access_key_id"] != "" || options["secret_access_key"] != "" {
+		credentials := aws.Credentials{
+			AccessKeyID:     options["access_key_id"],
+			SecretAccessKey: options["secret_access_key"],
+			Source:          "autocert_dns_provider_options",
+		}
+		loadOptions = append(loadOptions, awsconfig.WithCredentialsProvider(aws.CredentialsProviderFunc(
+			func(context.Context) (aws.Credentials, error) { return credentials, nil })))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, loadOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("route53: error creating aws config: %w", err)
+	}
+
+	return &route53DNSProvider{
+		apiURL:       route53APIURL,
+		hostedZoneID: options["hosted_zone_id"],
+		credentials:  cfg.Credentials,
+		signer:       v4.NewSigner(),
+		client:       client,
+	}, nil
+}
+
+// AppendRecords adds the given TXT records, keeping any existing values.
+func (p *route53DNSProvider) AppendRecords(ctx context.Context, zone string, recs []libdns.Record) ([]libdns.Record, error) {
+	txts, err := getDNSTXTRecords(zone, recs)
+	if err != nil {
+		return nil, err
+	}
+	zoneID, err := p.getHostedZoneID(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+	for _, txt := range txts {
+		err = p.updateTXTValues(ctx, zoneID, txt, func(values []string) []string {
+			if slices.Contains(values, quoteRoute53TXT(txt.text)) {
+				return values
+			}
+			return append(values, quoteRoute53TXT(txt.text))
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return recs, nil
+}
+
+// DeleteRecords removes the given TXT records, keeping any other values.
+func (p *route53DNSProvider) DeleteRecords(ctx context.Context, zone string, recs []libdns.Record) ([]libdns.Record, error) {
+	txts, err := getDNSTXTRecords(zone, recs)
+	if err != nil {
+		return nil, err
+	}
+	zoneID, err := p.getHostedZoneID(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+	for _, txt := range txts {
+		err = p.updateTXTValues(ctx, zoneID, txt, func(values []string) []string {
+			return slices.DeleteFunc(values, func(value string) bool {
+				return value == quoteRoute53TXT(txt.text)
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return recs, nil
+}
+
+type route53ResourceRecordSet struct {
+	Name            string   `xml:"Name"`
+	Type            string   `xml:"Type"`
+	TTL             int      `xml:"TTL"`
+	ResourceRecords []string `xml:"ResourceRecords>ResourceRecord>Value"`
+}
+
+// updateTXTValues replaces the values of the TXT record set for txt.name
+// with the result of update.
+func (p *route53DNSProvider) updateTXTValues(ctx context.Context, zoneID string, txt dnsTXTRecord, update func([]string) []string) error {
+	name := txt.name + "."
+
+	var listResponse struct {
+		ResourceRecordSets []route53ResourceRecordSet `xml:"ResourceRecordSets>ResourceRecordSet"`
+	}
+	err := p.do(ctx, http.MethodGet, "/hostedzone/"+zoneID+"/rrset?"+url.Values{
+		"name":     {name},
+		"type":     {"TXT"},
+		"maxitems": {"1"},
+	}.Encode(), nil, &listResponse)
+	if err != nil {
+		return err
+	}
+	var existing route53ResourceRecordSet
+	if len(listResponse.ResourceRecordSets) > 0 &&
+		strings.EqualFold(listResponse.ResourceRecordSets[0].Name, name) &&
+		listResponse.ResourceRecordSets[0].Type == "TXT" {
+		existing = listResponse.ResourceRecordSets[0]
+	}
+
+	values := update(slices.Clone(existing.ResourceRecords))
+	if slices.Equal(values, existing.ResourceRecords) {
+		return nil
+	}
+
+	action, recordSet := "UPSERT", route53ResourceRecordSet{
+		Name:            name,
+		Type:            "TXT",
+		TTL:             txt.ttl,
+		ResourceRecords: values,
+	}
+	if len(values) == 0 {
+		// deletes must match the existing record set exactly
+		action, recordSet = "DELETE", existing
+	}
+
+	type change struct {
+		Action            string                   `xml:"Action"`
+		ResourceRecordSet route53ResourceRecordSet `xml:"ResourceRecordSet"`
+	}
+	return p.do(ctx, http.MethodPost, "/hostedzone/"+zoneID+"/rrset/", struct {
+		XMLName xml.Name `xml:"ChangeResourceRecordSetsRequest"`
+		XMLNS   string   `xml:"xmlns,attr"`
+		Changes []change `xml:"ChangeBatch>Changes>Change"`
+	}{
+		XMLNS:   route53XMLNS,
+		Changes: []change{{Action: action, ResourceRecordSet: recordSet}},
+	}, nil)
+}
+
+func (p *route53DNSProvider) getHostedZoneID(ctx context.Context, zone string) (string, error) {
+	if p.hostedZoneID != "" {
+		return p.hostedZoneID, nil
+	}
+
+	var listResponse struct {
+		HostedZones []struct {
+			ID   string `xml:"Id"`
+			Name string `xml:"Name"`
+		} `xml:"HostedZones>HostedZone"`
+	}
+	err := p.do(ctx, http.MethodGet, "/hostedzonesbyname?"+url.Values{
+		"dnsname":  {strings.TrimSuffix(zone, ".")},
+		"maxitems": {"1"},
+	}.Encode(), nil, &listResponse)
+	if err != nil {
+		return "", err
+	}
+	if len(listResponse.HostedZones) == 0 ||
+		!strings.EqualFold(listResponse.HostedZones[0].Name, strings.TrimSuffix(zone, ".")+".") {
+		return "", fmt.Errorf("route53: hosted zone %s not found", zone)
+	}
+	return strings.TrimPrefix(listResponse.HostedZones[0].ID, "/hostedzone/"), nil
+}
+
+func (p *route53DNSProvider) do(ctx context.Context, method, path string, body, result any) error {
+	var reqBody []byte
+	if body != nil {
+		bs, err := xml.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = append([]byte(xml.Header), bs...)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.apiURL+path, bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/xml")
+	}
+
+	credentials, err := p.credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("route53: error retrieving aws credentials: %w", err)
+	}
+	payloadHash := sha256.Sum256(reqBody)
+	err = p.signer.SignHTTP(ctx, credentials, req, hex.EncodeToString(payloadHash[:]),
+		"route53", route53SigningRegion, time.Now())
+	if err != nil {
+		return fmt.Errorf("route53: error signing request: %w", err)
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("route53: %w", err)
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("route53: %w", err)
+	}
+	if res.StatusCode/100 != 2 {
+		var errorResponse struct {
+			Code    string `xml:"Error>Code"`
+			Message string `xml:"Error>Message"`
+		}
+		if xml.Unmarshal(resBody, &errorResponse) == nil && errorResponse.Code != "" {
+			return fmt.Errorf("route53: %s: %s", errorResponse.Code, errorResponse.Message)
+		}
+		return fmt.Errorf("route53: request failed with status %d", res.StatusCode)
+	}
+	if result != nil {
+		if err := xml.Unmarshal(resBody, result); err != nil {
+			return fmt.Errorf("route53: invalid response: %w", err)
+		}
+	}
+	return nil
+}
+
+func quoteRoute53TXT(text string) string {
+	return `"` + strings.ReplaceAll(text, `"`, `\"`) + `"`
+}
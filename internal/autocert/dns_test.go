@@ -0,0 +1,257 @@
+package autocert
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"encoding/xml"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/libdns/libdns"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestDNSProviderHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := getDNSProviderHTTPClient(config.AutocertOptions{})
+	require.NoError(t, err)
+	_, err = client.Get(srv.URL)
+	assert.Error(t, err, "should not trust the server by default")
+
+	client, err = getDNSProviderHTTPClient(config.AutocertOptions{
+		ChallengeTrustedCA: base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: srv.Certificate().Raw,
+		})),
+	})
+	require.NoError(t, err)
+	res, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+}
+
+func TestCloudflareDNSProvider(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	records := map[string]M{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		assert.Equal(t, "Bearer TOKEN", r.Header.Get("Authorization"))
+		var result any
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/zones":
+			assert.Equal(t, "example.com", r.URL.Query().Get("name"))
+			result = []M{{"id": "ZONE"}}
+		case r.Method == http.MethodPost && r.URL.Path == "/zones/ZONE/dns_records":
+			var record M
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&record))
+			record["id"] = "RECORD"
+			records["RECORD"] = record
+			result = record
+		case r.Method == http.MethodGet && r.URL.Path == "/zones/ZONE/dns_records":
+			var matches []M
+			for _, record := range records {
+				if record["name"] == r.URL.Query().Get("name") && record["content"] == r.URL.Query().Get("content") {
+					matches = append(matches, record)
+				}
+			}
+			result = matches
+		case r.Method == http.MethodDelete && r.URL.Path == "/zones/ZONE/dns_records/RECORD":
+			delete(records, "RECORD")
+			result = M{"id": "RECORD"}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(M{"success": false, "errors": []M{{"code": 7003, "message": "not found"}}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(M{"success": true, "result": result})
+	}))
+	t.Cleanup(srv.Close)
+
+	p, err := newCloudflareDNSProvider(map[string]string{"api_token": "TOKEN"}, http.DefaultClient)
+	require.NoError(t, err)
+	p.apiURL = srv.URL
+
+	ctx := t.Context()
+	recs := []libdns.Record{libdns.TXT{Name: "_acme-challenge", TTL: 2 * time.Minute, Text: "TOKEN-VALUE"}}
+	_, err = p.AppendRecords(ctx, "example.com.", recs)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]M{"RECORD": {
+		"id":      "RECORD",
+		"type":    "TXT",
+		"name":    "_acme-challenge.example.com",
+		"content": "TOKEN-VALUE",
+		"ttl":     float64(120),
+	}}, records)
+
+	_, err = p.DeleteRecords(ctx, "example.com.", recs)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+
+	p.zoneID = "UNKNOWN"
+	_, err = p.AppendRecords(ctx, "example.com.", recs)
+	assert.ErrorContains(t, err, "not found (code 7003)")
+}
+
+func TestRoute53DNSProvider(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	recordSets := map[string]route53ResourceRecordSet{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		assert.Contains(t, r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=ACCESS/")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/hostedzonesbyname":
+			_, _ = io.WriteString(w, `<ListHostedZonesByNameResponse><HostedZones><HostedZone>`+
+				`<Id>/hostedzone/Z1</Id><Name>example.com.</Name>`+
+				`</HostedZone></HostedZones></ListHostedZonesByNameResponse>`)
+		case r.Method == http.MethodGet && r.URL.Path == "/hostedzone/Z1/rrset":
+			var res struct {
+				XMLName            xml.Name                   `xml:"ListResourceRecordSetsResponse"`
+				ResourceRecordSets []route53ResourceRecordSet `xml:"ResourceRecordSets>ResourceRecordSet"`
+			}
+			if rs, ok := recordSets[r.URL.Query().Get("name")]; ok {
+				res.ResourceRecordSets = append(res.ResourceRecordSets, rs)
+			}
+			_ = xml.NewEncoder(w).Encode(res)
+		case r.Method == http.MethodPost && r.URL.Path == "/hostedzone/Z1/rrset/":
+			var req struct {
+				Changes []struct {
+					Action            string                   `xml:"Action"`
+					ResourceRecordSet route53ResourceRecordSet `xml:"ResourceRecordSet"`
+				} `xml:"ChangeBatch>Changes>Change"`
+			}
+			assert.NoError(t, xml.NewDecoder(r.Body).Decode(&req))
+			for _, change := range req.Changes {
+				switch change.Action {
+				case "UPSERT":
+					recordSets[change.ResourceRecordSet.Name] = change.ResourceRecordSet
+				case "DELETE":
+					assert.Equal(t, recordSets[change.ResourceRecordSet.Name], change.ResourceRecordSet)
+					delete(recordSets, change.ResourceRecordSet.Name)
+				}
+			}
+			_, _ = io.WriteString(w, `<ChangeResourceRecordSetsResponse/>`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `<ErrorResponse><Error><Code>InvalidInput</Code><Message>bad request</Message></Error></ErrorResponse>`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	p := &route53DNSProvider{
+		apiURL: srv.URL,
+		credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "ACCESS", SecretAccessKey: "SECRET"}, nil
+		}),
+		signer: v4.NewSigner(),
+		client: srv.Client(),
+	}
+
+	ctx := t.Context()
+	apex := []libdns.Record{libdns.TXT{Name: "_acme-challenge", TTL: time.Minute, Text: "APEX"}}
+	wildcard := []libdns.Record{libdns.TXT{Name: "_acme-challenge", TTL: time.Minute, Text: "WILDCARD"}}
+
+	_, err := p.AppendRecords(ctx, "example.com.", apex)
+	require.NoError(t, err)
+	_, err = p.AppendRecords(ctx, "example.com.", wildcard)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]route53ResourceRecordSet{
+		"_acme-challenge.example.com.": {
+			Name:            "_acme-challenge.example.com.",
+			Type:            "TXT",
+			TTL:             60,
+			ResourceRecords: []string{`"APEX"`, `"WILDCARD"`},
+		},
+	}, recordSets)
+
+	_, err = p.DeleteRecords(ctx, "example.com.", apex)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`"WILDCARD"`}, recordSets["_acme-challenge.example.com."].ResourceRecords)
+
+	_, err = p.DeleteRecords(ctx, "example.com.", wildcard)
+	require.NoError(t, err)
+	assert.Empty(t, recordSets)
+
+	_, err = p.AppendRecords(ctx, "other.example.", apex)
+	assert.ErrorContains(t, err, "hosted zone other.example. not found")
+}
+
+func TestRFC2136DNSProvider(t *testing.T) {
+	t.Parallel()
+
+	li, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	updates := make(chan *dns.Msg, 2)
+	srv := &dns.Server{
+		Listener:   li,
+		TsigSecret: map[string]string{"acme.": "c2VjcmV0"},
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			res := new(dns.Msg)
+			res.SetReply(r)
+			if r.IsTsig() == nil || w.TsigStatus() != nil {
+				res.Rcode = dns.RcodeNotAuth
+			} else {
+				updates <- r
+				res.SetTsig("acme.", dns.HmacSHA256, 300, time.Now().Unix())
+			}
+			_ = w.WriteMsg(res)
+		}),
+	}
+	go func() { _ = srv.ActivateAndServe() }()
+	t.Cleanup(func() { _ = srv.Shutdown() })
+
+	p, err := newRFC2136DNSProvider(map[string]string{
+		"server":        li.Addr().String(),
+		"tsig_key_name": "acme",
+		"tsig_secret":   "c2VjcmV0",
+	})
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	recs := []libdns.Record{libdns.TXT{Name: "_acme-challenge", TTL: 2 * time.Minute, Text: "TOKEN-VALUE"}}
+
+	_, err = p.AppendRecords(ctx, "example.com.", recs)
+	require.NoError(t, err)
+	update := <-updates
+	assert.Equal(t, "example.com.", update.Question[0].Name)
+	require.Len(t, update.Ns, 1)
+	assert.Equal(t, "_acme-challenge.example.com.\t120\tIN\tTXT\t\"TOKEN-VALUE\"", update.Ns[0].String())
+
+	_, err = p.DeleteRecords(ctx, "example.com.", recs)
+	require.NoError(t, err)
+	update = <-updates
+	require.Len(t, update.Ns, 1)
+	assert.Equal(t, uint16(dns.ClassNONE), update.Ns[0].Header().Class)
+
+	p.tsigSecret = "d3Jvbmc="
+	_, err = p.AppendRecords(ctx, "example.com.", recs)
+	assert.Error(t, err)
+}
@@ -0,0 +1,253 @@
+package autocert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+const (
+	hookTimeout = time.Minute
+	// hookQueueSize is the number of certificate events which can be waiting
+	// for their hooks to run before new events are dropped.
+	hookQueueSize = 100
+	// certificateEventRecordType is the record type used to store the latest
+	// certificate event for each domain in the databroker.
+	certificateEventRecordType = "pomerium.io/AutocertCertificateEvent"
+)
+
+// A CertificateEventType is the type of certificate event.
+type CertificateEventType string
+
+// certificate event types
+const (
+	CertificateEventObtained CertificateEventType = "obtained"
+	CertificateEventRenewed  CertificateEventType = "renewed"
+	CertificateEventFailed   CertificateEventType = "failed"
+)
+
+// A CertificateEvent is emitted when a certificate is obtained, renewed or fails to be obtained or renewed.
+type CertificateEvent struct {
+	Type     CertificateEventType `json:"type"`
+	Domain   string               `json:"domain"`
+	Time     time.Time            `json:"time"`
+	NotAfter time.Time            `json:"notAfter,omitzero"`
+	Error    string               `json:"error,omitempty"`
+	// ConsecutiveFailures is the number of failures for the domain since the last success.
+	ConsecutiveFailures int `json:"consecutiveFailures,omitempty"`
+}
+
+// A CertificateEventHook is called for every certificate event.
+type CertificateEventHook func(ctx context.Context, evt CertificateEvent)
+
+type certificateEvents struct {
+	mu       sync.Mutex
+	hooks    []CertificateEventHook
+	failures map[string]int
+
+	startOnce sync.Once
+	queue     chan queuedCertificateEvent
+}
+
+type queuedCertificateEvent struct {
+	ctx   context.Context
+	evt   CertificateEvent
+	hooks []CertificateEventHook
+}
+
+// OnCertificateEvent registers a hook that is called whenever a certificate is obtained, renewed
+// or fails to be obtained or renewed. Hooks are called asynchronously, in the order events occur,
+// so that slow hooks don't delay certificate management.
+func (mgr *Manager) OnCertificateEvent(hook CertificateEventHook) {
+	mgr.certificateEvents.mu.Lock()
+	mgr.certificateEvents.hooks = append(mgr.certificateEvents.hooks, hook)
+	mgr.certificateEvents.mu.Unlock()
+}
+
+func (mgr *Manager) dispatchCertificateEvent(ctx context.Context, cfg *config.Config, evt CertificateEvent) {
+	evt.Time = time.Now()
+
+	mgr.certificateEvents.mu.Lock()
+	if mgr.certificateEvents.failures == nil {
+		mgr.certificateEvents.failures = make(map[string]int)
+	}
+	if evt.Type == CertificateEventFailed {
+		mgr.certificateEvents.failures[evt.Domain]++
+		evt.ConsecutiveFailures = mgr.certificateEvents.failures[evt.Domain]
+	} else {
+		delete(mgr.certificateEvents.failures, evt.Domain)
+	}
+	hooks := append([]CertificateEventHook{}, mgr.certificateEvents.hooks...)
+	mgr.certificateEvents.mu.Unlock()
+
+	if cfg != nil {
+		if cmd := cfg.Options.AutocertOptions.EventCommand; cmd != "" {
+			hooks = append(hooks, commandHook(cmd))
+		}
+		if u := cfg.Options.AutocertOptions.EventWebhookURL; u != "" {
+			hooks = append(hooks, webhookHook(u))
+		}
+		if cfg.Options.AutocertOptions.EventDataBroker {
+			hooks = append(hooks, mgr.databrokerHook(cfg))
+		}
+	}
+	if len(hooks) == 0 {
+		return
+	}
+
+	mgr.certificateEvents.startOnce.Do(func() {
+		mgr.certificateEvents.queue = make(chan queuedCertificateEvent, hookQueueSize)
+		go mgr.certificateEvents.run()
+	})
+
+	// the hooks outlive the certificate update that triggered them
+	select {
+	case mgr.certificateEvents.queue <- queuedCertificateEvent{ctx: context.WithoutCancel(ctx), evt: evt, hooks: hooks}:
+	default:
+		log.Ctx(ctx).Error().
+			Str("type", string(evt.Type)).
+			Str("domain", evt.Domain).
+			Msg("autocert: certificate event queue is full, dropping event")
+	}
+}
+
+func (events *certificateEvents) run() {
+	for q := range events.queue {
+		for _, hook := range q.hooks {
+			hook(q.ctx, q.evt)
+		}
+	}
+}
+
+// commandHook returns a hook that executes a command with the event passed as environment variables.
+func commandHook(command string) CertificateEventHook {
+	return func(ctx context.Context, evt CertificateEvent) {
+		ctx, cancel := context.WithTimeout(ctx, hookTimeout)
+		defer cancel()
+
+		cmd := exec.CommandContext(ctx, command)
+		cmd.Env = append(os.Environ(),
+			"POMERIUM_CERTIFICATE_EVENT="+string(evt.Type),
+			"POMERIUM_CERTIFICATE_DOMAIN="+evt.Domain,
+			"POMERIUM_CERTIFICATE_ERROR="+evt.Error,
+			"POMERIUM_CERTIFICATE_CONSECUTIVE_FAILURES="+strconv.Itoa(evt.ConsecutiveFailures),
+		)
+		if !evt.NotAfter.IsZero() {
+			cmd.Env = append(cmd.Env, "POMERIUM_CERTIFICATE_NOT_AFTER="+evt.NotAfter.Format(time.RFC3339))
+		}
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).
+				Str("command", command).
+				Bytes("output", out).
+				Msg("autocert: error running certificate event command")
+		}
+	}
+}
+
+// webhookHook returns a hook that POSTs the event as JSON to a URL.
+func webhookHook(rawURL string) CertificateEventHook {
+	return func(ctx context.Context, evt CertificateEvent) {
+		ctx, cancel := context.WithTimeout(ctx, hookTimeout)
+		defer cancel()
+
+		err := postCertificateEvent(ctx, rawURL, evt)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).
+				Str("url", rawURL).
+				Msg("autocert: error sending certificate event webhook")
+		}
+	}
+}
+
+// databrokerHook returns a hook that stores the event as a databroker record.
+// Each domain has a single record, which holds its latest event.
+func (mgr *Manager) databrokerHook(cfg *config.Config) CertificateEventHook {
+	return func(ctx context.Context, evt CertificateEvent) {
+		ctx, cancel := context.WithTimeout(ctx, hookTimeout)
+		defer cancel()
+
+		err := mgr.putCertificateEvent(ctx, cfg, evt)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).
+				Str("domain", evt.Domain).
+				Msg("autocert: error storing certificate event in the databroker")
+		}
+	}
+}
+
+func (mgr *Manager) putCertificateEvent(ctx context.Context, cfg *config.Config, evt CertificateEvent) error {
+	client, err := mgr.getDataBrokerClient(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	data, err := newCertificateEventStruct(evt)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(ctx, &databroker.PutRequest{
+		Records: []*databroker.Record{{
+			Type: certificateEventRecordType,
+			Id:   evt.Domain,
+			Data: protoutil.NewAny(data),
+		}},
+	})
+	return err
+}
+
+// newCertificateEventStruct converts the event to a struct with the same
+// fields as the webhook JSON.
+func newCertificateEventStruct(evt CertificateEvent) (*structpb.Struct, error) {
+	body, err := json.Marshal(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	var data structpb.Struct
+	err = protojson.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func postCertificateEvent(ctx context.Context, rawURL string, evt CertificateEvent) error {
+	body, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	}
+	return nil
+}
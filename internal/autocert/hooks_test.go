@@ -0,0 +1,111 @@
+package autocert
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func TestCertificateEvents(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan CertificateEvent, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var evt CertificateEvent
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&evt))
+		received <- evt
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &config.Config{Options: config.NewDefaultOptions()}
+	cfg.Options.AutocertOptions.EventWebhookURL = srv.URL
+
+	var mgr Manager
+	hookedCh := make(chan CertificateEvent, 10)
+	mgr.OnCertificateEvent(func(_ context.Context, evt CertificateEvent) {
+		hookedCh <- evt
+	})
+
+	ctx := t.Context()
+	mgr.dispatchCertificateEvent(ctx, cfg, CertificateEvent{Type: CertificateEventFailed, Domain: "a.example.com"})
+	mgr.dispatchCertificateEvent(ctx, cfg, CertificateEvent{Type: CertificateEventFailed, Domain: "a.example.com"})
+	mgr.dispatchCertificateEvent(ctx, cfg, CertificateEvent{Type: CertificateEventFailed, Domain: "b.example.com"})
+	mgr.dispatchCertificateEvent(ctx, cfg, CertificateEvent{Type: CertificateEventRenewed, Domain: "a.example.com"})
+	mgr.dispatchCertificateEvent(ctx, cfg, CertificateEvent{Type: CertificateEventFailed, Domain: "a.example.com"})
+
+	// hooks are called asynchronously, in order
+	var hooked []CertificateEvent
+	for range 5 {
+		select {
+		case evt := <-hookedCh:
+			hooked = append(hooked, evt)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for certificate events")
+		}
+	}
+	assert.Equal(t, 1, hooked[0].ConsecutiveFailures)
+	assert.Equal(t, 2, hooked[1].ConsecutiveFailures)
+	assert.Equal(t, 1, hooked[2].ConsecutiveFailures)
+	assert.Equal(t, 0, hooked[3].ConsecutiveFailures)
+	assert.Equal(t, 1, hooked[4].ConsecutiveFailures)
+
+	// the webhook is called after the registered hooks
+	require.Eventually(t, func() bool { return len(received) == 5 }, 5*time.Second, 10*time.Millisecond)
+	evt := <-received
+	assert.Equal(t, CertificateEventFailed, evt.Type)
+	assert.Equal(t, "a.example.com", evt.Domain)
+}
+
+func TestCertificateEventsAsync(t *testing.T) {
+	t.Parallel()
+
+	var mgr Manager
+	release := make(chan struct{})
+	done := make(chan struct{})
+	mgr.OnCertificateEvent(func(_ context.Context, _ CertificateEvent) {
+		<-release
+		close(done)
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	mgr.dispatchCertificateEvent(ctx, nil, CertificateEvent{Type: CertificateEventObtained, Domain: "a.example.com"})
+	// the dispatch returned without waiting for the hook, and the hook isn't
+	// canceled along with the context of the certificate update
+	cancel()
+	close(release)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for the hook")
+	}
+}
+
+func TestNewCertificateEventStruct(t *testing.T) {
+	t.Parallel()
+
+	data, err := newCertificateEventStruct(CertificateEvent{
+		Type:                CertificateEventFailed,
+		Domain:              "a.example.com",
+		Time:                time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Error:               "ERROR",
+		ConsecutiveFailures: 2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"type":                "failed",
+		"domain":              "a.example.com",
+		"time":                "2025-01-02T03:04:05Z",
+		"error":               "ERROR",
+		"consecutiveFailures": float64(2),
+	}, data.AsMap())
+}
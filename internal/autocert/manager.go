@@ -4,11 +4,14 @@ package autocert
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
+	"os"
 	"sort"
 	"strings"
 	"sync"
@@ -27,6 +30,8 @@ import (
 	"github.com/pomerium/pomerium/internal/telemetry/metrics"
 	"github.com/pomerium/pomerium/internal/urlutil"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 )
 
 var (
@@ -59,7 +64,11 @@ type Manager struct {
 	acmeTLSALPNListener net.Listener
 	acmeTLSALPNConfig   *tls.Config
 
+	outboundGRPCConn grpc.CachedOutboundGRPClientConn
+
 	*ocspCache
+	ocspStapler       *ocspStapler
+	certificateEvents certificateEvents
 
 	config.ChangeDispatcher
 }
@@ -91,11 +100,12 @@ func newManager(
 		src:          src,
 		acmeTemplate: acmeTemplate,
 		ocspCache:    ocspRespCache,
+		ocspStapler:  newOCSPStapler(),
 	}
 
 	// set certmagic default storage cache, otherwise cert renewal loop will be based off
 	// certmagic's own default location
-	certmagicStorage, err := GetCertMagicStorage(ctx, src.GetConfig().Options.AutocertOptions.Folder)
+	certmagicStorage, err := mgr.getCertMagicStorage(ctx, src.GetConfig())
 	if err != nil {
 		return nil, err
 	}
@@ -145,11 +155,53 @@ func newManager(
 	return mgr, nil
 }
 
+func (mgr *Manager) getCertMagicStorage(ctx context.Context, cfg *config.Config) (certmagic.Storage, error) {
+	dst := cfg.Options.AutocertOptions.Folder
+	if !strings.HasPrefix(dst, "databroker://") {
+		return GetCertMagicStorage(ctx, dst)
+	}
+
+	sharedKey, err := cfg.Options.GetSharedKey()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := mgr.getDataBrokerClient(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetCertMagicStorage(ctx, dst,
+		WithDataBrokerClient(client),
+		WithSharedKey(sharedKey))
+}
+
+func (mgr *Manager) getDataBrokerClient(ctx context.Context, cfg *config.Config) (databroker.DataBrokerServiceClient, error) {
+	sharedKey, err := cfg.Options.GetSharedKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// the connection outlives any single config change, so it is only closed
+	// when it is replaced
+	cc, err := mgr.outboundGRPCConn.Get(context.WithoutCancel(ctx), &grpc.OutboundOptions{
+		OutboundPort:   cfg.OutboundPort,
+		InstallationID: cfg.Options.InstallationID,
+		ServiceName:    cfg.Options.Services,
+		SignedJWTKey:   sharedKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("autocert: error creating databroker connection: %w", err)
+	}
+
+	return databroker.NewDataBrokerServiceClient(cc), nil
+}
+
 func (mgr *Manager) getCertMagicConfig(ctx context.Context, cfg *config.Config) (*certmagic.Config, error) {
 	mgr.certmagic.MustStaple = cfg.Options.AutocertOptions.MustStaple
 	mgr.certmagic.OnDemand = nil // disable on-demand
 	var err error
-	mgr.certmagic.Storage, err = GetCertMagicStorage(ctx, cfg.Options.AutocertOptions.Folder)
+	mgr.certmagic.Storage, err = mgr.getCertMagicStorage(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +215,18 @@ func (mgr *Manager) getCertMagicConfig(ctx context.Context, cfg *config.Config)
 			return nil, fmt.Errorf("config: failed caching cert: %w", err)
 		}
 	}
-	acmeMgr := certmagic.NewACMEIssuer(mgr.certmagic, mgr.acmeTemplate)
+	// the ACME HTTP client is created along with the issuer, so the trusted
+	// roots and proxy must be set on the template
+	acmeTemplate := mgr.acmeTemplate
+	err = configureTrustedRoots(&acmeTemplate, cfg.Options.AutocertOptions)
+	if err != nil {
+		return nil, err
+	}
+	err = configureHTTPProxy(&acmeTemplate, cfg.Options.AutocertOptions)
+	if err != nil {
+		return nil, err
+	}
+	acmeMgr := certmagic.NewACMEIssuer(mgr.certmagic, acmeTemplate)
 	acmeMgr.DisableHTTPChallenge = !shouldEnableHTTPChallenge(cfg)
 	err = configureCertificateAuthority(acmeMgr, cfg.Options.AutocertOptions)
 	if err != nil {
@@ -173,7 +236,7 @@ func (mgr *Manager) getCertMagicConfig(ctx context.Context, cfg *config.Config)
 	if err != nil {
 		return nil, err
 	}
-	err = configureTrustedRoots(acmeMgr, cfg.Options.AutocertOptions)
+	err = configureDNSChallenge(ctx, acmeMgr, cfg.Options.AutocertOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +277,10 @@ func (mgr *Manager) renewConfigCerts(ctx context.Context) error {
 			needsReload = true
 		}
 	}
+	stapled := mgr.refreshOCSPStaples(ctx, cfg)
+	if stapled {
+		needsReload = true
+	}
 	if !needsReload {
 		return nil
 	}
@@ -225,6 +292,9 @@ func (mgr *Manager) renewConfigCerts(ctx context.Context) error {
 		if len(ocsp) > 0 {
 			c = c.Strs("ocsp-refresh", ocsp)
 		}
+		if stapled {
+			c = c.Bool("ocsp-staples-updated", true)
+		}
 		return c
 	})
 	log.Ctx(ctx).Info().Msg("updating certificates")
@@ -232,6 +302,7 @@ func (mgr *Manager) renewConfigCerts(ctx context.Context) error {
 	cfg = mgr.src.GetConfig().Clone()
 	mgr.updateServer(ctx, cfg)
 	mgr.updateACMETLSALPNServer(ctx, cfg)
+	mgr.updateOCSPStaples(ctx, cfg)
 	if err := mgr.updateAutocert(ctx, cfg); err != nil {
 		return err
 	}
@@ -250,39 +321,95 @@ func (mgr *Manager) update(ctx context.Context, cfg *config.Config) error {
 
 	mgr.updateServer(ctx, cfg)
 	mgr.updateACMETLSALPNServer(ctx, cfg)
+	mgr.updateOCSPStaples(ctx, cfg)
 	return mgr.updateAutocert(ctx, cfg)
 }
 
+// updateOCSPStaples refreshes the OCSP staples for the configured certificates
+// and attaches them to the config.
+func (mgr *Manager) updateOCSPStaples(ctx context.Context, cfg *config.Config) {
+	if !cfg.Options.OCSPStapling {
+		cfg.OCSPStaples = nil
+		return
+	}
+
+	mgr.refreshOCSPStaples(ctx, cfg)
+	cfg.OCSPStaples = mgr.ocspStapler.getStaples()
+}
+
+// refreshOCSPStaples refreshes the OCSP staples for the configured
+// certificates. It returns true if any staple changed.
+func (mgr *Manager) refreshOCSPStaples(ctx context.Context, cfg *config.Config) bool {
+	if !cfg.Options.OCSPStapling {
+		return false
+	}
+
+	certs, err := cfg.Options.GetCertificates()
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("autocert: failed to get certificates for ocsp stapling")
+		return false
+	}
+
+	return mgr.ocspStapler.refresh(ctx, certs, cfg.Options.GetOCSPStaplingFailureMode())
+}
+
 // obtainCert obtains a certificate for given domain, use cached manager if cert exists there.
-func (mgr *Manager) obtainCert(ctx context.Context, domain string, cm *certmagic.Config) (certmagic.Certificate, error) {
+func (mgr *Manager) obtainCert(ctx context.Context, cfg *config.Config, domain string, cm *certmagic.Config) (certmagic.Certificate, error) {
 	cert, err := cm.CacheManagedCertificate(ctx, domain)
 	if err != nil {
 		log.Ctx(ctx).Info().Str("domain", domain).Msg("obtaining certificate")
 		err = cm.ObtainCertSync(ctx, domain)
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("autocert failed to obtain client certificate")
+			mgr.dispatchCertificateEvent(ctx, cfg, CertificateEvent{
+				Type:   CertificateEventFailed,
+				Domain: domain,
+				Error:  err.Error(),
+			})
 			return certmagic.Certificate{}, errObtainCertFailed
 		}
 		metrics.RecordAutocertRenewal()
 		cert, err = cm.CacheManagedCertificate(ctx, domain)
+		if err == nil {
+			mgr.dispatchCertificateEvent(ctx, cfg, CertificateEvent{
+				Type:     CertificateEventObtained,
+				Domain:   domain,
+				NotAfter: cert.Leaf.NotAfter,
+			})
+		}
 	}
 	return cert, err
 }
 
 // renewCert attempts to renew given certificate.
-func (mgr *Manager) renewCert(ctx context.Context, domain string, cert certmagic.Certificate, cm *certmagic.Config) (certmagic.Certificate, error) {
+func (mgr *Manager) renewCert(ctx context.Context, cfg *config.Config, domain string, cert certmagic.Certificate, cm *certmagic.Config) (certmagic.Certificate, error) {
 	expired := time.Now().After(cert.Leaf.NotAfter)
 	log.Ctx(ctx).Info().Str("domain", domain).Msg("renewing certificate")
 	renewCertLock.Lock()
 	err := cm.RenewCertSync(ctx, domain, false)
 	renewCertLock.Unlock()
 	if err != nil {
+		mgr.dispatchCertificateEvent(ctx, cfg, CertificateEvent{
+			Type:     CertificateEventFailed,
+			Domain:   domain,
+			NotAfter: cert.Leaf.NotAfter,
+			Error:    err.Error(),
+		})
 		if expired {
 			return certmagic.Certificate{}, errRenewCertFailed
 		}
 		log.Ctx(ctx).Error().Err(err).Msg("renew client certificated failed, use existing cert")
+		return cm.CacheManagedCertificate(ctx, domain)
+	}
+	cert, err = cm.CacheManagedCertificate(ctx, domain)
+	if err == nil {
+		mgr.dispatchCertificateEvent(ctx, cfg, CertificateEvent{
+			Type:     CertificateEventRenewed,
+			Domain:   domain,
+			NotAfter: cert.Leaf.NotAfter,
+		})
 	}
-	return cm.CacheManagedCertificate(ctx, domain)
+	return cert, err
 }
 
 func (mgr *Manager) updateAutocert(ctx context.Context, cfg *config.Config) error {
@@ -297,9 +424,9 @@ func (mgr *Manager) updateAutocert(ctx context.Context, cfg *config.Config) erro
 	}
 
 	for _, domain := range sourceHostnames(cfg) {
-		cert, err := mgr.obtainCert(ctx, domain, cm)
+		cert, err := mgr.obtainCert(ctx, cfg, domain, cm)
 		if err == nil && cert.NeedsRenewal(cm) {
-			cert, err = mgr.renewCert(ctx, domain, cert, cm)
+			cert, err = mgr.renewCert(ctx, cfg, domain, cert, cm)
 		}
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msg("autocert: failed to obtain client certificate")
@@ -470,6 +597,15 @@ func configureExternalAccountBinding(acmeMgr *certmagic.ACMEIssuer, opts config.
 
 // configureTrustedRoots configures the acmeMgr x509 roots to trust when communicating with an ACME CA.
 func configureTrustedRoots(acmeMgr *certmagic.ACMEIssuer, opts config.AutocertOptions) error {
+	if opts.TrustedCAOnly {
+		// pool only contains the certificate(s) in TrustedCA or TrustedCAFile
+		pool, err := getTrustedCAOnlyPool(opts.TrustedCA, opts.TrustedCAFile)
+		if err != nil {
+			return fmt.Errorf("config: creating trusted certificate pool: %w", err)
+		}
+		acmeMgr.TrustedRoots = pool
+		return nil
+	}
 	if opts.TrustedCA != "" {
 		// pool effectively contains the certificate(s) in the TrustedCA base64 PEM appended to the system roots
 		pool, err := cryptutil.GetCertPool(opts.TrustedCA, "")
@@ -489,14 +625,66 @@ func configureTrustedRoots(acmeMgr *certmagic.ACMEIssuer, opts config.AutocertOp
 	return nil
 }
 
+// configureHTTPProxy configures the acmeMgr proxy to use when communicating with an ACME CA.
+func configureHTTPProxy(acmeMgr *certmagic.ACMEIssuer, opts config.AutocertOptions) error {
+	proxy, err := getHTTPProxy(opts)
+	if err != nil {
+		return err
+	}
+	acmeMgr.HTTPProxy = proxy
+	return nil
+}
+
+// getHTTPProxy returns the proxy function for the autocert proxy url, falling
+// back to the proxy environment variables.
+func getHTTPProxy(opts config.AutocertOptions) (func(*http.Request) (*url.URL, error), error) {
+	if opts.ProxyURL == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	u, err := url.Parse(opts.ProxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("config: bad autocert proxy url: %w", err)
+	}
+	return http.ProxyURL(u), nil
+}
+
+func getTrustedCAOnlyPool(ca, caFile string) (*x509.CertPool, error) {
+	var data []byte
+	switch {
+	case ca != "":
+		var err error
+		data, err = base64.StdEncoding.DecodeString(ca)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64-encoded certificate authority: %w", err)
+		}
+	case caFile != "":
+		var err error
+		data, err = os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read certificate authority file (%s): %w", caFile, err)
+		}
+	default:
+		return nil, errors.New("no certificate authority provided")
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.New("failed to append any PEM-encoded certificates")
+	}
+	return pool, nil
+}
+
 func sourceHostnames(cfg *config.Config) []string {
 	if cfg.Options.NumPolicies() == 0 {
 		return nil
 	}
 
+	// wildcard certificates can only be issued with the DNS-01 challenge
+	allowWildcard := cfg.Options.AutocertOptions.DNSProvider != ""
+
 	dedupe := map[string]struct{}{}
 	for p := range cfg.Options.GetAllPolicies() {
-		if h := eligibleHostname(p); h != "" {
+		if h := eligibleHostname(p, allowWildcard); h != "" {
 			dedupe[h] = struct{}{}
 		}
 	}
@@ -527,15 +715,23 @@ var eligibleSchemes = map[string]struct{}{
 }
 
 // eligibleHostname accepts a route and returns the hostname, if eligible for use
-// with autocert, or the empty string if not.
-func eligibleHostname(p *config.Policy) string {
+// with autocert, or the empty string if not. Wildcard hostnames are only
+// eligible if allowWildcard is set, and only with a single leading wildcard
+// label, e.g. "*.example.com".
+func eligibleHostname(p *config.Policy, allowWildcard bool) string {
 	u, _ := urlutil.ParseAndValidateURL(p.From)
-	if u == nil || strings.Contains(u.Host, "*") {
+	if u == nil {
 		return ""
 	} else if _, ok := eligibleSchemes[u.Scheme]; !ok {
 		return ""
 	}
-	return u.Hostname()
+	hostname := u.Hostname()
+	if strings.Contains(hostname, "*") {
+		if !allowWildcard || !strings.HasPrefix(hostname, "*.") || strings.Contains(hostname[2:], "*") {
+			return ""
+		}
+	}
+	return hostname
 }
 
 func shouldEnableHTTPChallenge(cfg *config.Config) bool {
@@ -574,6 +574,44 @@ func Test_configureTrustedRoots(t *testing.T) {
 				},
 			}
 		},
+		"ok/only": func(t *testing.T) test {
+			roots := x509.NewCertPool()
+			ok := roots.AppendCertsFromPEM(ca.certPEM)
+			require.Equal(t, true, ok)
+			return test{
+				args: args{
+					acmeMgr: newACMEIssuer(),
+					opts: config.AutocertOptions{
+						TrustedCA:     base64.StdEncoding.EncodeToString(ca.certPEM),
+						TrustedCAOnly: true,
+					},
+				},
+				expected: &certmagic.ACMEIssuer{
+					CA:           certmagic.DefaultACME.CA,
+					TestCA:       certmagic.DefaultACME.TestCA,
+					TrustedRoots: roots,
+				},
+				wantErr: false,
+			}
+		},
+		"fail/only": func(t *testing.T) test {
+			roots, err := x509.SystemCertPool()
+			require.NoError(t, err)
+			return test{
+				args: args{
+					acmeMgr: newACMEIssuer(),
+					opts: config.AutocertOptions{
+						TrustedCAOnly: true,
+					},
+				},
+				expected: &certmagic.ACMEIssuer{
+					CA:           certmagic.DefaultACME.CA,
+					TestCA:       certmagic.DefaultACME.TestCA,
+					TrustedRoots: roots,
+				},
+				wantErr: true,
+			}
+		},
 		"fail/pem": func(t *testing.T) test {
 			roots, err := x509.SystemCertPool()
 			require.NoError(t, err)
@@ -631,6 +669,23 @@ func Test_configureTrustedRoots(t *testing.T) {
 	}
 }
 
+func Test_configureHTTPProxy(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "https://acme.example.com/directory", nil)
+
+	var acmeMgr certmagic.ACMEIssuer
+	require.NoError(t, configureHTTPProxy(&acmeMgr, config.AutocertOptions{
+		ProxyURL: "http://proxy.example.com:3128",
+	}))
+	u, err := acmeMgr.HTTPProxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, "http://proxy.example.com:3128", u.String())
+
+	require.NoError(t, configureHTTPProxy(&acmeMgr, config.AutocertOptions{}))
+	assert.NotNil(t, acmeMgr.HTTPProxy, "should fall back to the environment")
+}
+
 func Test_sourceHostnames(t *testing.T) {
 	t.Parallel()
 
@@ -652,6 +707,29 @@ func Test_sourceHostnames(t *testing.T) {
 		"baz.example.com",
 		"quux.example.com",
 	}, sourceHostnames(cfg))
+
+	t.Run("wildcard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &config.Config{
+			Options: config.NewDefaultOptions(),
+		}
+		cfg.Options.Policies = []config.Policy{
+			{From: "https://foo.example.com"},
+			{From: "https://*.example.com"},
+			{From: "https://*.*.example.com"},
+			{From: "https://foo.*.example.com"},
+		}
+		assert.ElementsMatch(t, []string{
+			"foo.example.com",
+		}, sourceHostnames(cfg), "wildcards should be skipped without a dns provider")
+
+		cfg.Options.AutocertOptions.DNSProvider = config.AutocertDNSProviderCloudflare
+		assert.ElementsMatch(t, []string{
+			"foo.example.com",
+			"*.example.com",
+		}, sourceHostnames(cfg))
+	})
 }
 
 func TestShouldEnableHTTPChallenge(t *testing.T) {
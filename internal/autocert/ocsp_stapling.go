@@ -0,0 +1,188 @@
+package autocert
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+const (
+	ocspFetchTimeout       = 10 * time.Second
+	ocspMaxResponseSize    = 1 << 20
+	ocspDefaultRefreshTime = time.Hour
+)
+
+// errOCSPNotSupported indicates a certificate can't be stapled because it has
+// no OCSP server or no issuer in its chain.
+var errOCSPNotSupported = errors.New("certificate does not support ocsp")
+
+type ocspStaple struct {
+	raw        []byte
+	nextUpdate time.Time
+	refreshAt  time.Time
+}
+
+// An ocspStapler fetches and caches OCSP staples for the certificates in the
+// options. Autocert certificates are stapled by certmagic.
+type ocspStapler struct {
+	client *http.Client
+
+	mu      sync.Mutex
+	staples map[string]*ocspStaple
+}
+
+func newOCSPStapler() *ocspStapler {
+	return &ocspStapler{
+		client:  &http.Client{Timeout: ocspFetchTimeout},
+		staples: make(map[string]*ocspStaple),
+	}
+}
+
+// refresh fetches a staple for every certificate whose staple is missing or
+// due for a refresh, and returns true if any staple changed. When a refresh
+// fails the previous staple is kept, until it expires in soft failure mode.
+func (s *ocspStapler) refresh(ctx context.Context, certs []tls.Certificate, failureMode config.OCSPStaplingFailureMode) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	changed := false
+	seen := make(map[string]struct{}, len(certs))
+	for i := range certs {
+		cert := &certs[i]
+		key := config.OCSPStapleKey(cert)
+		if key == "" || len(cert.OCSPStaple) > 0 {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		current := s.staples[key]
+		if current != nil && now.Before(current.refreshAt) {
+			continue
+		}
+
+		staple, err := fetchOCSPStaple(ctx, s.client, cert)
+		if errors.Is(err, errOCSPNotSupported) {
+			continue
+		} else if err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("key", key).Msg("autocert: failed to fetch ocsp staple")
+			if current != nil && !current.nextUpdate.IsZero() && !now.Before(current.nextUpdate) &&
+				failureMode != config.OCSPStaplingFailureModeHard {
+				delete(s.staples, key)
+				changed = true
+			}
+			continue
+		}
+
+		if current == nil || !bytes.Equal(current.raw, staple.raw) {
+			changed = true
+		}
+		s.staples[key] = staple
+	}
+
+	// remove staples for certificates that are no longer configured
+	for key := range s.staples {
+		if _, ok := seen[key]; !ok {
+			delete(s.staples, key)
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// getStaples returns the current staples keyed by config.OCSPStapleKey.
+func (s *ocspStapler) getStaples() map[string][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.staples) == 0 {
+		return nil
+	}
+
+	staples := make(map[string][]byte, len(s.staples))
+	for key, staple := range s.staples {
+		staples[key] = staple.raw
+	}
+	return staples
+}
+
+func fetchOCSPStaple(ctx context.Context, client *http.Client, cert *tls.Certificate) (*ocspStaple, error) {
+	if len(cert.Certificate) < 2 {
+		return nil, errOCSPNotSupported
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(leaf.OCSPServer) == 0 {
+		return nil, errOCSPNotSupported
+	}
+	issuer, err := x509.ParseCertificate(cert.Certificate[1])
+	if err != nil {
+		return nil, err
+	}
+
+	reqData, err := ocsp.CreateRequest(leaf, issuer, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, leaf.OCSPServer[0], bytes.NewReader(reqData))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/ocsp-request")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code from ocsp server: %d", res.StatusCode)
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(res.Body, ocspMaxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ocsp.ParseResponseForCert(raw, leaf, issuer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ocsp response: %w", err)
+	}
+
+	switch resp.Status {
+	case ocsp.Good:
+	case ocsp.Revoked:
+		// the staple is still served, so that clients reject the certificate
+		log.Ctx(ctx).Error().Strs("names", leaf.DNSNames).Msg("autocert: certificate has been revoked")
+	default:
+		return nil, fmt.Errorf("unknown ocsp certificate status")
+	}
+
+	staple := &ocspStaple{
+		raw:        raw,
+		nextUpdate: resp.NextUpdate,
+		refreshAt:  time.Now().Add(ocspDefaultRefreshTime),
+	}
+	if !resp.NextUpdate.IsZero() {
+		// refresh halfway through the validity period
+		staple.refreshAt = resp.ThisUpdate.Add(resp.NextUpdate.Sub(resp.ThisUpdate) / 2)
+	}
+	return staple, nil
+}
@@ -0,0 +1,146 @@
+package autocert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/pomerium/pomerium/config"
+)
+
+func newOCSPTestCertificate(t *testing.T, ca *testCA, ocspServer string) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if ocspServer != "" {
+		tpl.OCSPServer = []string{ocspServer}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	return tls.Certificate{
+		Certificate: [][]byte{der, ca.cert.Raw},
+		PrivateKey:  key,
+	}
+}
+
+func TestOCSPStapler(t *testing.T) {
+	t.Parallel()
+
+	ca, err := newTestCA()
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqData, _ := io.ReadAll(r.Body)
+		ocspReq, err := ocsp.ParseRequest(reqData)
+		if !assert.NoError(t, err) {
+			return
+		}
+		data, err := ocsp.CreateResponse(ca.cert, ca.cert, ocsp.Response{
+			Status:       ocsp.Good,
+			SerialNumber: ocspReq.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+		}, ca.key)
+		if !assert.NoError(t, err) {
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(srv.Close)
+
+	t.Run("fetch", func(t *testing.T) {
+		t.Parallel()
+
+		cert := newOCSPTestCertificate(t, ca, srv.URL)
+		s := newOCSPStapler()
+		s.client = srv.Client()
+
+		assert.True(t, s.refresh(t.Context(), []tls.Certificate{cert}, config.OCSPStaplingFailureModeSoft))
+		staples := s.getStaples()
+		if assert.Contains(t, staples, config.OCSPStapleKey(&cert)) {
+			resp, err := ocsp.ParseResponse(staples[config.OCSPStapleKey(&cert)], ca.cert)
+			require.NoError(t, err)
+			assert.Equal(t, ocsp.Good, resp.Status)
+		}
+
+		assert.False(t, s.refresh(t.Context(), []tls.Certificate{cert}, config.OCSPStaplingFailureModeSoft),
+			"should not refetch a current staple")
+
+		assert.True(t, s.refresh(t.Context(), nil, config.OCSPStaplingFailureModeSoft),
+			"should remove staples for removed certificates")
+		assert.Empty(t, s.getStaples())
+	})
+	t.Run("not supported", func(t *testing.T) {
+		t.Parallel()
+
+		cert := newOCSPTestCertificate(t, ca, "")
+		s := newOCSPStapler()
+
+		assert.False(t, s.refresh(t.Context(), []tls.Certificate{cert}, config.OCSPStaplingFailureModeSoft))
+		assert.Empty(t, s.getStaples())
+	})
+	t.Run("already stapled", func(t *testing.T) {
+		t.Parallel()
+
+		cert := newOCSPTestCertificate(t, ca, srv.URL+"/unused")
+		cert.OCSPStaple = []byte("STAPLE")
+		s := newOCSPStapler()
+
+		assert.False(t, s.refresh(t.Context(), []tls.Certificate{cert}, config.OCSPStaplingFailureModeSoft))
+		assert.Empty(t, s.getStaples())
+	})
+	t.Run("failure mode", func(t *testing.T) {
+		t.Parallel()
+
+		failSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(failSrv.Close)
+
+		cert := newOCSPTestCertificate(t, ca, failSrv.URL)
+		key := config.OCSPStapleKey(&cert)
+		expired := func() *ocspStapler {
+			s := newOCSPStapler()
+			s.staples[key] = &ocspStaple{
+				raw:        []byte("EXPIRED"),
+				nextUpdate: time.Now().Add(-time.Minute),
+				refreshAt:  time.Now().Add(-time.Hour),
+			}
+			return s
+		}
+
+		s := expired()
+		assert.True(t, s.refresh(t.Context(), []tls.Certificate{cert}, config.OCSPStaplingFailureModeSoft))
+		assert.Empty(t, s.getStaples(), "should drop expired staples in soft failure mode")
+
+		s = expired()
+		assert.False(t, s.refresh(t.Context(), []tls.Certificate{cert}, config.OCSPStaplingFailureModeHard))
+		assert.Equal(t, map[string][]byte{key: []byte("EXPIRED")}, s.getStaples(),
+			"should keep expired staples in hard failure mode")
+	})
+}
@@ -12,17 +12,48 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/caddyserver/certmagic"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 )
 
 var (
 	errUnknownStorageProvider = errors.New("unknown storage provider")
+	errNoDataBrokerClient     = errors.New("autocert: databroker storage requires a databroker client")
+	errNoSharedKey            = errors.New("autocert: databroker storage requires a shared key")
 	s3virtualRE               = regexp.MustCompile(`^([-a-zA-Z0-9]+)\.s3\.([-a-zA-Z0-9]+)\.amazonaws\.com(/.*)?$`)
 	s3hostRE                  = regexp.MustCompile(`^([^/]+)/([^/]+)(/.*)?$`)
 	gcsRE                     = regexp.MustCompile(`^([^/]+)(/.*)?$`)
 )
 
+type storageConfig struct {
+	dataBrokerClient databroker.DataBrokerServiceClient
+	sharedKey        []byte
+}
+
+// A StorageOption customizes the certmagic storage provider.
+type StorageOption func(*storageConfig)
+
+// WithDataBrokerClient sets the databroker client used by databroker:// storage.
+func WithDataBrokerClient(client databroker.DataBrokerServiceClient) StorageOption {
+	return func(cfg *storageConfig) {
+		cfg.dataBrokerClient = client
+	}
+}
+
+// WithSharedKey sets the shared key used to encrypt databroker:// storage.
+func WithSharedKey(sharedKey []byte) StorageOption {
+	return func(cfg *storageConfig) {
+		cfg.sharedKey = sharedKey
+	}
+}
+
 // GetCertMagicStorage gets the certmagic storage provider based on the destination.
-func GetCertMagicStorage(ctx context.Context, dst string) (certmagic.Storage, error) {
+func GetCertMagicStorage(ctx context.Context, dst string, options ...StorageOption) (certmagic.Storage, error) {
+	var cfg storageConfig
+	for _, option := range options {
+		option(&cfg)
+	}
+
 	idx := strings.Index(dst, "://")
 	if idx == -1 {
 		return &certmagic.FileStorage{Path: dst}, nil
@@ -93,6 +124,30 @@ func GetCertMagicStorage(ctx context.Context, dst string) (certmagic.Storage, er
 		client := s3.NewFromConfig(cfg)
 
 		return newS3Storage(client, bucket, prefix), nil
+
+	case "databroker":
+		// databroker://{prefix}
+		prefix := strings.Trim(dst[idx+3:], "/")
+		if prefix != "" {
+			prefix += "/"
+		}
+
+		if cfg.dataBrokerClient == nil {
+			return nil, errNoDataBrokerClient
+		}
+
+		if len(cfg.sharedKey) == 0 {
+			return nil, errNoSharedKey
+		}
+
+		// certificate private keys are stored in the databroker, so they are
+		// encrypted with a key derived from the shared secret
+		cipher, err := getDataBrokerStorageCipher(cfg.sharedKey)
+		if err != nil {
+			return nil, fmt.Errorf("autocert: error creating databroker storage cipher: %w", err)
+		}
+
+		return newDataBrokerStorage(cfg.dataBrokerClient, cipher, prefix), nil
 	}
 
 	return nil, errUnknownStorageProvider
@@ -0,0 +1,249 @@
+package autocert
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/caddyserver/certmagic"
+	"golang.org/x/crypto/hkdf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+// databrokerStorageRecordType is the record type used to store autocert data
+// in the databroker.
+const databrokerStorageRecordType = "pomerium.io/AutocertStorage"
+
+const databrokerStorageQueryLimit = 100
+
+// databrokerStorage stores autocert data as databroker records, so that
+// replicas can share certificates without shared disk. Values are encrypted
+// before they are stored.
+type databrokerStorage struct {
+	client databroker.DataBrokerServiceClient
+	cipher cipher.AEAD
+	prefix string
+
+	*locker
+}
+
+// getDataBrokerStorageCipher derives the databroker storage cipher from the
+// shared key.
+func getDataBrokerStorageCipher(sharedKey []byte) (cipher.AEAD, error) {
+	key := make([]byte, cryptutil.DefaultKeySize)
+	_, err := io.ReadFull(hkdf.New(sha256.New, sharedKey, nil, []byte("autocert-storage")), key)
+	if err != nil {
+		return nil, err
+	}
+	return cryptutil.NewAEADCipher(key)
+}
+
+func newDataBrokerStorage(client databroker.DataBrokerServiceClient, cipher cipher.AEAD, prefix string) *databrokerStorage {
+	s := &databrokerStorage{
+		client: client,
+		cipher: cipher,
+		prefix: prefix,
+	}
+	s.locker = &locker{
+		acquire: s.acquireLease,
+		renew:   s.renewLease,
+		release: s.releaseLease,
+	}
+	return s
+}
+
+func (s *databrokerStorage) Store(ctx context.Context, key string, value []byte) error {
+	_, err := s.client.Put(ctx, &databroker.PutRequest{
+		Records: []*databroker.Record{{
+			Type: databrokerStorageRecordType,
+			Id:   s.prefix + key,
+			// the key is used as additional data so that values can't be
+			// swapped between keys
+			Data: protoutil.NewAny(wrapperspb.Bytes(cryptutil.Encrypt(s.cipher, value, []byte(s.prefix+key)))),
+		}},
+	})
+	return err
+}
+
+func (s *databrokerStorage) Load(ctx context.Context, key string) ([]byte, error) {
+	record, err := s.get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decrypt(key, record)
+}
+
+func (s *databrokerStorage) Delete(ctx context.Context, key string) error {
+	// deleting a directory deletes every key in it
+	keys, err := s.listKeys(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	var records []*databroker.Record
+	for _, k := range keys {
+		if k == key || strings.HasPrefix(k, strings.TrimSuffix(key, "/")+"/") {
+			records = append(records, &databroker.Record{
+				Type:      databrokerStorageRecordType,
+				Id:        s.prefix + k,
+				Data:      protoutil.NewAny(wrapperspb.Bytes(nil)),
+				DeletedAt: timestamppb.Now(),
+			})
+		}
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	_, err = s.client.Put(ctx, &databroker.PutRequest{Records: records})
+	return err
+}
+
+func (s *databrokerStorage) Exists(ctx context.Context, key string) bool {
+	_, err := s.get(ctx, key)
+	return err == nil
+}
+
+func (s *databrokerStorage) List(ctx context.Context, prefix string, recursive bool) ([]string, error) {
+	all, err := s.listKeys(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]struct{}{}
+	var keys []string
+	for _, key := range all {
+		if !recursive {
+			// only return the first path segment after the prefix
+			if idx := strings.Index(key[len(prefix):], "/"); idx >= 0 {
+				key = key[:len(prefix)+idx+1]
+			}
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *databrokerStorage) Stat(ctx context.Context, key string) (certmagic.KeyInfo, error) {
+	record, err := s.get(ctx, key)
+	if err != nil {
+		return certmagic.KeyInfo{}, err
+	}
+
+	value, err := s.decrypt(key, record)
+	if err != nil {
+		return certmagic.KeyInfo{}, err
+	}
+
+	return certmagic.KeyInfo{
+		Key:        key,
+		Modified:   record.GetModifiedAt().AsTime(),
+		Size:       int64(len(value)),
+		IsTerminal: true,
+	}, nil
+}
+
+func (s *databrokerStorage) decrypt(key string, record *databroker.Record) ([]byte, error) {
+	var value wrapperspb.BytesValue
+	if err := record.GetData().UnmarshalTo(&value); err != nil {
+		return nil, fmt.Errorf("autocert: invalid databroker storage record: %w", err)
+	}
+	plaintext, err := cryptutil.Decrypt(s.cipher, value.GetValue(), []byte(s.prefix+key))
+	if err != nil {
+		return nil, fmt.Errorf("autocert: error decrypting databroker storage record: %w", err)
+	}
+	return plaintext, nil
+}
+
+func (s *databrokerStorage) get(ctx context.Context, key string) (*databroker.Record, error) {
+	res, err := s.client.Get(ctx, &databroker.GetRequest{
+		Type: databrokerStorageRecordType,
+		Id:   s.prefix + key,
+	})
+	if databroker.IsNotFound(err) {
+		return nil, fs.ErrNotExist
+	} else if err != nil {
+		return nil, err
+	}
+	if res.GetRecord().GetDeletedAt() != nil {
+		return nil, fs.ErrNotExist
+	}
+	return res.GetRecord(), nil
+}
+
+// listKeys returns every stored key starting with prefix.
+func (s *databrokerStorage) listKeys(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	for offset := int64(0); ; offset += databrokerStorageQueryLimit {
+		res, err := s.client.Query(ctx, &databroker.QueryRequest{
+			Type:   databrokerStorageRecordType,
+			Offset: offset,
+			Limit:  databrokerStorageQueryLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range res.GetRecords() {
+			if record.GetDeletedAt() != nil || !strings.HasPrefix(record.GetId(), s.prefix+prefix) {
+				continue
+			}
+			keys = append(keys, record.GetId()[len(s.prefix):])
+		}
+		if offset+databrokerStorageQueryLimit >= res.GetTotalCount() {
+			return keys, nil
+		}
+	}
+}
+
+func (s *databrokerStorage) leaseName(key string) string {
+	return "autocert/" + s.prefix + key
+}
+
+func (s *databrokerStorage) acquireLease(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	res, err := s.client.AcquireLease(ctx, &databroker.AcquireLeaseRequest{
+		Name:     s.leaseName(key),
+		Duration: durationpb.New(ttl),
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return "", errLockHeld
+	} else if err != nil {
+		return "", err
+	}
+	return res.GetId(), nil
+}
+
+func (s *databrokerStorage) renewLease(ctx context.Context, key, leaseID string, ttl time.Duration) error {
+	_, err := s.client.RenewLease(ctx, &databroker.RenewLeaseRequest{
+		Name:     s.leaseName(key),
+		Id:       leaseID,
+		Duration: durationpb.New(ttl),
+	})
+	return err
+}
+
+func (s *databrokerStorage) releaseLease(ctx context.Context, key, leaseID string) error {
+	_, err := s.client.ReleaseLease(ctx, &databroker.ReleaseLeaseRequest{
+		Name: s.leaseName(key),
+		Id:   leaseID,
+	})
+	return err
+}
@@ -6,9 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/pomerium/pomerium/internal/log"
 )
 
 const (
@@ -16,19 +19,44 @@ const (
 	lockPollInterval = time.Second
 )
 
+// errLockHeld is returned by a lease acquire function when the lock is held
+// by someone else.
+var errLockHeld = errors.New("lock is held")
+
 type lockState struct {
 	ID      string
 	Expires time.Time
 }
 
+// A locker implements the certmagic Locker interface. By default locks are
+// stored using store, load and delete. If acquire, renew and release are set,
+// locks are taken atomically as leases instead, which are renewed until they
+// are unlocked.
 type locker struct {
 	store  func(ctx context.Context, key string, value []byte) error
 	load   func(ctx context.Context, key string) ([]byte, error)
 	delete func(ctx context.Context, key string) error
+
+	acquire func(ctx context.Context, key string, ttl time.Duration) (leaseID string, err error)
+	renew   func(ctx context.Context, key, leaseID string, ttl time.Duration) error
+	release func(ctx context.Context, key, leaseID string) error
+
+	mu     sync.Mutex
+	leases map[string]*heldLease
+}
+
+type heldLease struct {
+	id   string
+	stop context.CancelFunc
+	done chan struct{}
 }
 
 func (l *locker) Lock(ctx context.Context, name string) error {
 	key := fmt.Sprintf("locks/%s", name)
+	if l.acquire != nil {
+		return l.lockLease(ctx, key)
+	}
+
 	lockID := uuid.NewString()
 
 	for {
@@ -70,5 +98,70 @@ func (l *locker) Lock(ctx context.Context, name string) error {
 
 func (l *locker) Unlock(ctx context.Context, name string) error {
 	key := fmt.Sprintf("locks/%s", name)
+	if l.acquire != nil {
+		return l.unlockLease(ctx, key)
+	}
 	return l.delete(ctx, key)
 }
+
+func (l *locker) lockLease(ctx context.Context, key string) error {
+	for {
+		leaseID, err := l.acquire(ctx, key, lockDuration)
+		if errors.Is(err, errLockHeld) {
+			select {
+			case <-ctx.Done():
+				return context.Cause(ctx)
+			case <-time.After(lockPollInterval):
+			}
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		// renew the lease in the background until it's unlocked, since
+		// obtaining a certificate may take longer than the lock duration
+		renewCtx, stop := context.WithCancel(context.WithoutCancel(ctx))
+		lease := &heldLease{id: leaseID, stop: stop, done: make(chan struct{})}
+		go l.renewLease(renewCtx, key, lease)
+
+		l.mu.Lock()
+		if l.leases == nil {
+			l.leases = make(map[string]*heldLease)
+		}
+		l.leases[key] = lease
+		l.mu.Unlock()
+		return nil
+	}
+}
+
+func (l *locker) renewLease(ctx context.Context, key string, lease *heldLease) {
+	defer close(lease.done)
+
+	ticker := time.NewTicker(lockDuration / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := l.renew(ctx, key, lease.id, lockDuration); err != nil && ctx.Err() == nil {
+			log.Ctx(ctx).Error().Err(err).Str("key", key).Msg("autocert: failed to renew storage lock")
+		}
+	}
+}
+
+func (l *locker) unlockLease(ctx context.Context, key string) error {
+	l.mu.Lock()
+	lease, ok := l.leases[key]
+	delete(l.leases, key)
+	l.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	lease.stop()
+	<-lease.done
+	return l.release(ctx, key, lease.id)
+}
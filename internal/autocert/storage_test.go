@@ -11,8 +11,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	"github.com/pomerium/pomerium/internal/databroker"
 	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
 )
 
 func TestS3Storage(t *testing.T) {
@@ -68,3 +74,64 @@ func runStorageTests(t *testing.T, s certmagic.Storage) {
 
 	assert.NoError(t, s.Lock(ctx, "a"), "should re-lock")
 }
+
+func TestDataBrokerStorage(t *testing.T) {
+	t.Parallel()
+
+	srv := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(srv.Stop)
+
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, srv)
+	})
+	t.Cleanup(func() { cc.Close() })
+
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+
+	t.Run("missing client", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := GetCertMagicStorage(t.Context(), "databroker://")
+		assert.ErrorIs(t, err, errNoDataBrokerClient)
+	})
+	t.Run("missing shared key", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := GetCertMagicStorage(t.Context(), "databroker://", WithDataBrokerClient(client))
+		assert.ErrorIs(t, err, errNoSharedKey)
+	})
+	t.Run("storage", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := GetCertMagicStorage(t.Context(), "databroker://some/prefix",
+			WithDataBrokerClient(client),
+			WithSharedKey(cryptutil.NewKey()))
+		require.NoError(t, err)
+		runStorageTests(t, s)
+	})
+	t.Run("encrypted", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := GetCertMagicStorage(t.Context(), "databroker://encrypted",
+			WithDataBrokerClient(client),
+			WithSharedKey(cryptutil.NewKey()))
+		require.NoError(t, err)
+		require.NoError(t, s.Store(t.Context(), "key", []byte("PRIVATE KEY")))
+
+		res, err := client.Get(t.Context(), &databrokerpb.GetRequest{
+			Type: databrokerStorageRecordType,
+			Id:   "encrypted/key",
+		})
+		require.NoError(t, err)
+		var value wrapperspb.BytesValue
+		require.NoError(t, res.GetRecord().GetData().UnmarshalTo(&value))
+		assert.NotContains(t, string(value.GetValue()), "PRIVATE KEY")
+
+		other, err := GetCertMagicStorage(t.Context(), "databroker://encrypted",
+			WithDataBrokerClient(client),
+			WithSharedKey(cryptutil.NewKey()))
+		require.NoError(t, err)
+		_, err = other.Load(t.Context(), "key")
+		assert.Error(t, err, "should not decrypt with a different shared key")
+	})
+}
@@ -38,7 +38,6 @@ import (
 	"github.com/pomerium/pomerium/pkg/health"
 	"github.com/pomerium/pomerium/pkg/httputil"
 	"github.com/pomerium/pomerium/pkg/slices"
-	"github.com/pomerium/pomerium/pkg/telemetry/requestid"
 	"github.com/pomerium/pomerium/pkg/telemetry/trace"
 )
 
@@ -123,19 +122,20 @@ func NewServer(
 			grpcutil.MetadataKeyPomeriumVersion, version.FullVersion(),
 		),
 	)
-	srv.GRPCServer = grpc.NewServer(
+	requestIDUI, requestIDSI := grpcutil.RequestIDInterceptors()
+	srv.GRPCServer = grpc.NewServer(append([]grpc.ServerOption{
 		grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithTracerProvider(tracerProvider))),
 		grpc.ChainUnaryInterceptor(
 			log.UnaryServerInterceptor(log.Ctx(ctx)),
-			requestid.UnaryServerInterceptor(),
+			requestIDUI,
 			ui,
 		),
 		grpc.ChainStreamInterceptor(
 			log.StreamServerInterceptor(log.Ctx(ctx)),
-			requestid.StreamServerInterceptor(),
+			requestIDSI,
 			si,
 		),
-	)
+	}, grpcutil.ServerMessageOptions(cfg.Options.GRPCMaxMessageSize)...)...)
 	reflection.Register(srv.GRPCServer)
 	srv.registerAccessLogHandlers()
 
@@ -230,6 +230,7 @@ func NewServer(
 	}
 
 	srv.xdsmgr = xdsmgr.NewManager(res)
+	srv.updateReferencedFiles(ctx, res)
 	envoy_service_discovery_v3.RegisterAggregatedDiscoveryServiceServer(srv.GRPCServer, srv.xdsmgr)
 	if exp := trace.ExporterServerFromContext(ctx); exp != nil {
 		coltracepb.RegisterTraceServiceServer(srv.GRPCServer, exp)
@@ -334,6 +335,7 @@ func (srv *Server) update(ctx context.Context, cfg *config.Config) error {
 		return err
 	}
 	srv.xdsmgr.Update(ctx, res)
+	srv.updateReferencedFiles(ctx, res)
 	return nil
 }
 
@@ -391,6 +393,8 @@ func (srv *Server) getExpectedHealthChecks(cfg *config.Config) (ret []health.Che
 		ret = append(
 			ret,
 			health.StorageBackend,
+			health.StorageBackendPing,
+			health.StorageBackendNotification,
 			health.DatabrokerInitialSync,
 			health.DatabrokerBuildConfig,
 			health.DatabrokerCluster,
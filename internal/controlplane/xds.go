@@ -7,6 +7,7 @@ import (
 
 	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
@@ -94,3 +95,22 @@ func (srv *Server) buildDiscoveryResources(ctx context.Context) (map[string][]*e
 		routeConfigurationTypeURL: routeConfigurationResources,
 	}, nil
 }
+
+// updateReferencedFiles lets the file manager know which cache files are
+// referenced by the discovery resources, so that other files can be evicted.
+func (srv *Server) updateReferencedFiles(ctx context.Context, resources map[string][]*envoy_service_discovery_v3.Resource) {
+	var msgs []proto.Message
+	for _, rs := range resources {
+		for _, r := range rs {
+			msgs = append(msgs, r)
+		}
+	}
+
+	filePaths, err := srv.filemgr.GetReferencedFiles(msgs...)
+	if err != nil {
+		// without the referenced files nothing can safely be evicted
+		log.Ctx(ctx).Error().Err(err).Msg("controlplane: error getting referenced envoy files")
+		return
+	}
+	srv.filemgr.SetReferencedFiles(filePaths)
+}
@@ -0,0 +1,164 @@
+package databroker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+// auditRecordType is the record type used to store audit events when the
+// audit log destination is "record". With file storage the events are removed
+// once they're older than the audit log retention.
+const auditRecordType = "pomerium.io/DataBrokerAuditEvent"
+
+// An auditEvent describes a single databroker mutation.
+type auditEvent struct {
+	Time       time.Time `json:"time"`
+	Service    string    `json:"service,omitempty"`
+	Method     string    `json:"method"`
+	RecordType string    `json:"record_type,omitempty"`
+	RecordID   string    `json:"record_id,omitempty"`
+	LeaseName  string    `json:"lease_name,omitempty"`
+	OldVersion uint64    `json:"old_version,omitempty"`
+	NewVersion uint64    `json:"new_version,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// An auditSink is a destination for audit events.
+type auditSink interface {
+	write(ctx context.Context, events []auditEvent) error
+	close() error
+}
+
+func newAuditSink(destination string, underlying Server) (auditSink, error) {
+	switch destination {
+	case "":
+		return nil, nil
+	case config.DataBrokerAuditLogToLog:
+		return logAuditSink{}, nil
+	case config.DataBrokerAuditLogToRecord:
+		return recordAuditSink{underlying: underlying}, nil
+	default:
+		sink, err := newFileAuditSink(destination)
+		if err != nil {
+			return nil, err
+		}
+		return sink, nil
+	}
+}
+
+// A logAuditSink writes audit events to the log.
+type logAuditSink struct{}
+
+func (logAuditSink) write(ctx context.Context, events []auditEvent) error {
+	for _, evt := range events {
+		log.Ctx(ctx).Info().
+			Time("time", evt.Time).
+			Str("service", evt.Service).
+			Str("method", evt.Method).
+			Str("record-type", evt.RecordType).
+			Str("record-id", evt.RecordID).
+			Str("lease-name", evt.LeaseName).
+			Uint64("old-version", evt.OldVersion).
+			Uint64("new-version", evt.NewVersion).
+			Str("error", evt.Error).
+			Msg("databroker: audit")
+	}
+	return nil
+}
+
+func (logAuditSink) close() error { return nil }
+
+// A fileAuditSink appends audit events to a file as JSON lines.
+type fileAuditSink struct {
+	mu sync.Mutex
+	f  *os.File
+}
+
+func newFileAuditSink(name string) (*fileAuditSink, error) {
+	f, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening audit log file: %w", err)
+	}
+	return &fileAuditSink{f: f}, nil
+}
+
+func (sink *fileAuditSink) write(_ context.Context, events []auditEvent) error {
+	var buf []byte
+	for _, evt := range events {
+		bs, err := json.Marshal(evt)
+		if err != nil {
+			return fmt.Errorf("error marshaling audit event: %w", err)
+		}
+		buf = append(append(buf, bs...), '\n')
+	}
+
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+
+	if sink.f == nil {
+		return errors.New("audit log file is closed")
+	}
+	_, err := sink.f.Write(buf)
+	if err != nil {
+		return fmt.Errorf("error writing audit log file: %w", err)
+	}
+	return nil
+}
+
+func (sink *fileAuditSink) close() error {
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+
+	if sink.f == nil {
+		return nil
+	}
+	err := sink.f.Close()
+	sink.f = nil
+	return err
+}
+
+// A recordAuditSink stores audit events as databroker records.
+type recordAuditSink struct {
+	underlying Server
+}
+
+func (sink recordAuditSink) write(ctx context.Context, events []auditEvent) error {
+	records := make([]*databrokerpb.Record, 0, len(events))
+	for _, evt := range events {
+		bs, err := json.Marshal(evt)
+		if err != nil {
+			return fmt.Errorf("error marshaling audit event: %w", err)
+		}
+		data := new(structpb.Struct)
+		err = data.UnmarshalJSON(bs)
+		if err != nil {
+			return fmt.Errorf("error converting audit event: %w", err)
+		}
+		records = append(records, &databrokerpb.Record{
+			Type: auditRecordType,
+			Id:   uuid.NewString(),
+			Data: protoutil.NewAny(data),
+		})
+	}
+
+	_, err := sink.underlying.Put(ctx, &databrokerpb.PutRequest{Records: records})
+	if err != nil {
+		return fmt.Errorf("error storing audit events: %w", err)
+	}
+	return nil
+}
+
+func (recordAuditSink) close() error { return nil }
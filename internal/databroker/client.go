@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 	oteltrace "go.opentelemetry.io/otel/trace"
@@ -18,9 +19,28 @@ import (
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/telemetry"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	registrypb "github.com/pomerium/pomerium/pkg/grpc/registry"
 	"github.com/pomerium/pomerium/pkg/grpcutil"
 )
 
+const (
+	defaultReadDeadline  = 10 * time.Second
+	defaultUnaryDeadline = time.Minute
+)
+
+// readMethods are the unary methods which only read data. They are safe to
+// retry and are expected to complete quickly. Streaming methods like Sync
+// have no default deadline.
+var readMethods = []string{
+	databrokerpb.DataBrokerService_Get_FullMethodName,
+	databrokerpb.DataBrokerService_ListTypes_FullMethodName,
+	databrokerpb.DataBrokerService_Query_FullMethodName,
+	databrokerpb.DataBrokerService_ServerInfo_FullMethodName,
+	databrokerpb.CheckpointService_GetCheckpoint_FullMethodName,
+	registrypb.Registry_List_FullMethodName,
+}
+
 // A ClientManager manages client connections for gRPC.
 type ClientManager struct {
 	telemetry telemetry.Component
@@ -75,6 +95,14 @@ func (mgr *ClientManager) OnConfigChange(ctx context.Context, cfg *config.Config
 	}))
 }
 
+// GetSharedKey returns the current shared key.
+func (mgr *ClientManager) GetSharedKey() []byte {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	return mgr.sharedKey
+}
+
 // NewClientForConfig creates a new client for the given config.
 func (mgr *ClientManager) NewClientForConfig(cfg *config.Config, rawURL string, options ...grpc.DialOption) (*grpc.ClientConn, error) {
 	ctx, op := mgr.telemetry.Start(context.Background(), "NewClientForConfig")
@@ -105,14 +133,21 @@ func (mgr *ClientManager) NewClientForConfig(cfg *config.Config, rawURL string,
 		grpc.WithChainStreamInterceptor(
 			grpcutil.WithStreamSignedJWT(func() []byte {
 				return sharedKey
-			}),
+			}, grpcutil.WithJWTService(cfg.Options.Services)),
 		),
 		grpc.WithChainUnaryInterceptor(
+			grpcutil.UnaryDeadlineInterceptor(
+				grpcutil.WithDefaultUnaryDeadline(defaultUnaryDeadline),
+				grpcutil.WithMethodDeadline(defaultReadDeadline, readMethods...),
+			),
+			grpcutil.UnaryRetryInterceptor(grpcutil.WithRetryIdempotentMethods(readMethods...)),
 			grpcutil.WithUnarySignedJWT(func() []byte {
 				return sharedKey
-			}),
+			}, grpcutil.WithJWTService(cfg.Options.Services)),
 		),
 	)
+	options = append(options,
+		grpcutil.ClientMessageOptions(cfg.Options.GRPCMaxMessageSize, cfg.Options.GRPCCompression)...)
 	if u.Scheme == "http" {
 		options = append(options, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	} else {
@@ -2,16 +2,24 @@ package databroker
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/volatiletech/null/v9"
 	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/databroker/raft"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/telemetry"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
 )
 
 // A LeaderElector elects a leader from a cluster of nodes.
@@ -153,3 +161,188 @@ func (e *raftLeaderElector) update(
 		onChange()
 	}
 }
+
+const (
+	clusterLeaderLeaseName  = "pomerium.databroker.cluster-leader"
+	clusterLeaderRecordType = "pomerium.io/DataBrokerClusterLeader"
+	clusterLeaderRecordID   = "leader"
+	clusterLeaderLeaseTTL   = 30 * time.Second
+)
+
+type leaseLeaderElector struct {
+	telemetry telemetry.Component
+	client    databrokerpb.DataBrokerServiceClient
+	nodeID    string
+	ttl       time.Duration
+
+	closeCtx context.Context
+	close    context.CancelFunc
+
+	mu              sync.RWMutex
+	electedLeaderID null.String
+}
+
+// NewLeaseLeaderElector creates a new lease leader elector. Every node
+// attempts to acquire the same lease from the lease databroker. The node
+// holding the lease is the leader and publishes its id in a record so that the
+// other nodes know which node to follow.
+func NewLeaseLeaderElector(
+	tracerProvider oteltrace.TracerProvider,
+	client databrokerpb.DataBrokerServiceClient,
+	nodeID string,
+	onChange func(),
+) LeaderElector {
+	return newLeaseLeaderElector(tracerProvider, client, nodeID, clusterLeaderLeaseTTL, onChange)
+}
+
+func newLeaseLeaderElector(
+	tracerProvider oteltrace.TracerProvider,
+	client databrokerpb.DataBrokerServiceClient,
+	nodeID string,
+	ttl time.Duration,
+	onChange func(),
+) *leaseLeaderElector {
+	e := &leaseLeaderElector{
+		telemetry: *telemetry.NewComponent(tracerProvider, zerolog.TraceLevel, "databroker-lease-leader-elector"),
+		client:    client,
+		nodeID:    nodeID,
+		ttl:       ttl,
+	}
+	e.closeCtx, e.close = context.WithCancel(context.Background())
+	go e.run(onChange)
+	return e
+}
+
+func (e *leaseLeaderElector) ElectedLeaderID() null.String {
+	e.mu.RLock()
+	electedLeaderID := e.electedLeaderID
+	e.mu.RUnlock()
+	return electedLeaderID
+}
+
+func (e *leaseLeaderElector) Stop() {
+	e.close()
+}
+
+func (e *leaseLeaderElector) run(onChange func()) {
+	// the lease is renewed several times per ttl so that a single failed
+	// request doesn't cause the leader to lose it
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	var leaseID string
+	defer func() {
+		if leaseID == "" {
+			return
+		}
+		// release the lease so another node can take over immediately
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, _ = e.client.ReleaseLease(ctx, &databrokerpb.ReleaseLeaseRequest{
+			Name: clusterLeaderLeaseName,
+			Id:   leaseID,
+		})
+	}()
+
+	for {
+		var next null.String
+		var err error
+		leaseID, next, err = e.step(e.closeCtx, leaseID)
+		if err != nil && e.closeCtx.Err() == nil {
+			log.Ctx(e.closeCtx).Error().Err(err).Msg("lease leader elector: error electing leader")
+		}
+		e.update(next, onChange)
+
+		select {
+		case <-e.closeCtx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// step acquires or renews the lease and returns the current lease id and the
+// elected leader.
+func (e *leaseLeaderElector) step(ctx context.Context, leaseID string) (string, null.String, error) {
+	ctx, op := e.telemetry.Start(ctx, "Step")
+	defer op.Complete()
+
+	if leaseID != "" {
+		_, err := e.client.RenewLease(ctx, &databrokerpb.RenewLeaseRequest{
+			Name:     clusterLeaderLeaseName,
+			Id:       leaseID,
+			Duration: durationpb.New(e.ttl),
+		})
+		if status.Code(err) == codes.AlreadyExists {
+			leaseID = ""
+		} else if err != nil {
+			// the lease may still be held, so keep its id to retry the renewal,
+			// but without a confirmed lease this node can't remain the leader
+			return leaseID, null.String{}, op.Failure(fmt.Errorf("error renewing lease: %w", err))
+		}
+	}
+
+	if leaseID == "" {
+		res, err := e.client.AcquireLease(ctx, &databrokerpb.AcquireLeaseRequest{
+			Name:     clusterLeaderLeaseName,
+			Duration: durationpb.New(e.ttl),
+		})
+		if status.Code(err) == codes.AlreadyExists {
+			// another node holds the lease
+		} else if err != nil {
+			return "", null.String{}, op.Failure(fmt.Errorf("error acquiring lease: %w", err))
+		} else {
+			leaseID = res.GetId()
+		}
+	}
+
+	if leaseID != "" {
+		_, err := e.client.Put(ctx, &databrokerpb.PutRequest{
+			Records: []*databrokerpb.Record{{
+				Type: clusterLeaderRecordType,
+				Id:   clusterLeaderRecordID,
+				Data: protoutil.NewAnyString(e.nodeID),
+			}},
+		})
+		if err != nil {
+			return leaseID, null.String{}, op.Failure(fmt.Errorf("error publishing leader: %w", err))
+		}
+		return leaseID, null.StringFrom(e.nodeID), nil
+	}
+
+	res, err := e.client.Get(ctx, &databrokerpb.GetRequest{
+		Type: clusterLeaderRecordType,
+		Id:   clusterLeaderRecordID,
+	})
+	if status.Code(err) == codes.NotFound {
+		return "", null.String{}, nil
+	} else if err != nil {
+		return "", null.String{}, op.Failure(fmt.Errorf("error getting leader: %w", err))
+	}
+
+	var leaderID wrapperspb.StringValue
+	err = res.GetRecord().GetData().UnmarshalTo(&leaderID)
+	if err != nil {
+		return "", null.String{}, op.Failure(fmt.Errorf("error decoding leader: %w", err))
+	}
+	// a record naming this node is stale since this node doesn't hold the lease
+	if leaderID.GetValue() == "" || leaderID.GetValue() == e.nodeID {
+		return "", null.String{}, nil
+	}
+	return "", null.StringFrom(leaderID.GetValue()), nil
+}
+
+func (e *leaseLeaderElector) update(next null.String, onChange func()) {
+	e.mu.Lock()
+	prev := e.electedLeaderID
+	e.electedLeaderID = next
+	e.mu.Unlock()
+
+	// a stopped elector has been replaced, so don't report changes
+	if prev != next && onChange != nil && e.closeCtx.Err() == nil {
+		log.Ctx(e.closeCtx).Info().
+			Str("elected-leader-id", next.String).
+			Msg("leader change")
+		onChange()
+	}
+}
@@ -0,0 +1,116 @@
+package databroker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pomerium/pomerium/internal/telemetry"
+	"github.com/pomerium/pomerium/internal/testutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestLeaseLeaderElector(t *testing.T) {
+	t.Parallel()
+
+	authority := NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(authority.Stop)
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, authority)
+	})
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+
+	e1 := newLeaseLeaderElector(noop.NewTracerProvider(), client, "node-1", 300*time.Millisecond, nil)
+	t.Cleanup(e1.Stop)
+	assert.Eventually(t, func() bool {
+		return e1.ElectedLeaderID().String == "node-1"
+	}, 5*time.Second, 10*time.Millisecond, "the first node should acquire the lease")
+
+	e2 := newLeaseLeaderElector(noop.NewTracerProvider(), client, "node-2", 300*time.Millisecond, nil)
+	t.Cleanup(e2.Stop)
+	assert.Eventually(t, func() bool {
+		return e2.ElectedLeaderID().String == "node-1"
+	}, 5*time.Second, 10*time.Millisecond, "the second node should follow the first node")
+
+	e1.Stop()
+	assert.Eventually(t, func() bool {
+		return e2.ElectedLeaderID().String == "node-2"
+	}, 5*time.Second, 10*time.Millisecond, "the second node should take over once the lease is released")
+}
+
+type unavailableRenewClient struct {
+	databrokerpb.DataBrokerServiceClient
+}
+
+func (c unavailableRenewClient) RenewLease(context.Context, *databrokerpb.RenewLeaseRequest, ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unavailable, "unavailable")
+}
+
+func TestLeaseLeaderElector_Step(t *testing.T) {
+	t.Parallel()
+
+	authority := NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(authority.Stop)
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, authority)
+	})
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+
+	newElector := func(client databrokerpb.DataBrokerServiceClient, nodeID string) *leaseLeaderElector {
+		return &leaseLeaderElector{
+			telemetry: *telemetry.NewComponent(noop.NewTracerProvider(), zerolog.TraceLevel, "test"),
+			client:    client,
+			nodeID:    nodeID,
+			ttl:       time.Minute,
+		}
+	}
+
+	t.Run("renew error", func(t *testing.T) {
+		e := newElector(unavailableRenewClient{client}, "node-1")
+		leaseID, leaderID, err := e.step(t.Context(), "lease-1")
+		assert.Error(t, err)
+		assert.Equal(t, "lease-1", leaseID,
+			"should keep the lease id to retry the renewal")
+		assert.False(t, leaderID.Valid,
+			"should not report leadership without a confirmed lease")
+	})
+
+	t.Run("stale leader record", func(t *testing.T) {
+		_, err := client.Put(t.Context(), &databrokerpb.PutRequest{
+			Records: []*databrokerpb.Record{{
+				Type: clusterLeaderRecordType,
+				Id:   clusterLeaderRecordID,
+				Data: protoutil.NewAnyString("node-1"),
+			}},
+		})
+		require.NoError(t, err)
+		_, err = client.AcquireLease(t.Context(), &databrokerpb.AcquireLeaseRequest{
+			Name:     clusterLeaderLeaseName,
+			Duration: durationpb.New(time.Minute),
+		})
+		require.NoError(t, err)
+
+		e := newElector(client, "node-1")
+		leaseID, leaderID, err := e.step(t.Context(), "")
+		assert.NoError(t, err)
+		assert.Empty(t, leaseID)
+		assert.False(t, leaderID.Valid,
+			"should not count a leader record naming this node without a lease")
+
+		e = newElector(client, "node-2")
+		_, leaderID, err = e.step(t.Context(), "")
+		assert.NoError(t, err)
+		assert.Equal(t, "node-1", leaderID.String)
+	})
+}
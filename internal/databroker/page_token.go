@@ -0,0 +1,35 @@
+package databroker
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// A syncLatestPageToken is the position of the last record returned by a
+// SyncLatest page. The record version of the first page is carried forward so
+// that every page reports the same version to sync from.
+type syncLatestPageToken struct {
+	ServerVersion uint64 `json:"s"`
+	RecordVersion uint64 `json:"v"`
+	RecordType    string `json:"t"`
+	RecordID      string `json:"i"`
+}
+
+func decodeSyncLatestPageToken(raw string) (syncLatestPageToken, error) {
+	var token syncLatestPageToken
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return token, fmt.Errorf("error decoding page token: %w", err)
+	}
+	err = json.Unmarshal(data, &token)
+	if err != nil {
+		return token, fmt.Errorf("error unmarshaling page token: %w", err)
+	}
+	return token, nil
+}
+
+func (token syncLatestPageToken) encode() string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
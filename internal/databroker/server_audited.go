@@ -0,0 +1,199 @@
+package databroker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+type auditedServer struct {
+	Server
+
+	mu          sync.Mutex
+	destination string
+	sink        auditSink
+}
+
+// NewAuditedServer creates a server that writes an audit event for every Put,
+// Patch, SetOptions and lease mutation. Events are written to the destination
+// set by the databroker_audit_log option. The caller identity is taken from
+// the JWT claims in the context. Cluster followers forward mutations to the
+// leader, so only the leader writes audit events.
+func NewAuditedServer(underlying Server) Server {
+	return &auditedServer{Server: underlying}
+}
+
+func (srv *auditedServer) AcquireLease(ctx context.Context, req *databrokerpb.AcquireLeaseRequest) (*databrokerpb.AcquireLeaseResponse, error) {
+	res, err := srv.Server.AcquireLease(ctx, req)
+	srv.audit(ctx, newAuditEvent(databrokerpb.DataBrokerService_AcquireLease_FullMethodName, err, func(evt *auditEvent) {
+		evt.LeaseName = req.GetName()
+	}))
+	return res, err
+}
+
+func (srv *auditedServer) Patch(ctx context.Context, req *databrokerpb.PatchRequest) (*databrokerpb.PatchResponse, error) {
+	res, err := srv.Server.Patch(ctx, req)
+	srv.audit(ctx, newRecordAuditEvents(databrokerpb.DataBrokerService_Patch_FullMethodName,
+		req.GetRecords(), res.GetRecords(), res.GetStatuses(), err)...)
+	return res, err
+}
+
+func (srv *auditedServer) Put(ctx context.Context, req *databrokerpb.PutRequest) (*databrokerpb.PutResponse, error) {
+	res, err := srv.Server.Put(ctx, req)
+	srv.audit(ctx, newRecordAuditEvents(databrokerpb.DataBrokerService_Put_FullMethodName,
+		req.GetRecords(), res.GetRecords(), res.GetStatuses(), err)...)
+	return res, err
+}
+
+func (srv *auditedServer) ReleaseLease(ctx context.Context, req *databrokerpb.ReleaseLeaseRequest) (*emptypb.Empty, error) {
+	res, err := srv.Server.ReleaseLease(ctx, req)
+	srv.audit(ctx, newAuditEvent(databrokerpb.DataBrokerService_ReleaseLease_FullMethodName, err, func(evt *auditEvent) {
+		evt.LeaseName = req.GetName()
+	}))
+	return res, err
+}
+
+func (srv *auditedServer) RenewLease(ctx context.Context, req *databrokerpb.RenewLeaseRequest) (*emptypb.Empty, error) {
+	res, err := srv.Server.RenewLease(ctx, req)
+	srv.audit(ctx, newAuditEvent(databrokerpb.DataBrokerService_RenewLease_FullMethodName, err, func(evt *auditEvent) {
+		evt.LeaseName = req.GetName()
+	}))
+	return res, err
+}
+
+func (srv *auditedServer) SetOptions(ctx context.Context, req *databrokerpb.SetOptionsRequest) (*databrokerpb.SetOptionsResponse, error) {
+	res, err := srv.Server.SetOptions(ctx, req)
+	srv.audit(ctx, newAuditEvent(databrokerpb.DataBrokerService_SetOptions_FullMethodName, err, func(evt *auditEvent) {
+		evt.RecordType = req.GetType()
+	}))
+	return res, err
+}
+
+func (srv *auditedServer) OnConfigChange(ctx context.Context, cfg *config.Config) {
+	srv.Server.OnConfigChange(ctx, cfg)
+
+	destination := cfg.Options.DataBroker.AuditLog
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	// nothing changed
+	if srv.destination == destination && (srv.sink != nil || destination == "") {
+		return
+	}
+
+	if srv.sink != nil {
+		err := srv.sink.close()
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("databroker: error closing audit log")
+		}
+		srv.sink = nil
+	}
+
+	srv.destination = destination
+	sink, err := newAuditSink(destination, srv.Server)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("destination", destination).Msg("databroker: error creating audit log")
+		return
+	}
+	srv.sink = sink
+}
+
+func (srv *auditedServer) Stop() {
+	srv.Server.Stop()
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	if srv.sink != nil {
+		_ = srv.sink.close()
+		srv.sink = nil
+	}
+}
+
+func (srv *auditedServer) getSink() auditSink {
+	srv.mu.Lock()
+	sink := srv.sink
+	srv.mu.Unlock()
+	return sink
+}
+
+// isLeader returns false if mutations are forwarded to a cluster leader, in
+// which case the leader audits them.
+func (srv *auditedServer) isLeader() bool {
+	if l, ok := srv.Server.(interface{ isLeader() bool }); ok {
+		return l.isLeader()
+	}
+	return true
+}
+
+// audit writes the events to the audit log. Failing to write an event doesn't
+// fail the mutation.
+func (srv *auditedServer) audit(ctx context.Context, events ...auditEvent) {
+	sink := srv.getSink()
+	if sink == nil || len(events) == 0 || !srv.isLeader() {
+		return
+	}
+
+	var service string
+	if claims, ok := grpcutil.JWTClaimsFromContext(ctx); ok {
+		service = claims.Service
+	}
+	now := time.Now()
+	for i := range events {
+		events[i].Time = now
+		events[i].Service = service
+	}
+
+	err := sink.write(ctx, events)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("databroker: error writing audit log")
+	}
+}
+
+func newAuditEvent(method string, err error, update func(evt *auditEvent)) auditEvent {
+	evt := auditEvent{Method: method}
+	if err != nil {
+		evt.Error = err.Error()
+	}
+	update(&evt)
+	return evt
+}
+
+// newRecordAuditEvents returns an audit event for each record. The new
+// versions are taken from the written records. The old version is only known
+// for conditional writes, where it's the expected version.
+func newRecordAuditEvents(
+	method string,
+	records []*databrokerpb.Record,
+	written []*databrokerpb.Record,
+	statuses []*databrokerpb.RecordStatus,
+	err error,
+) []auditEvent {
+	type key struct{ recordType, recordID string }
+	newVersions := make(map[key]uint64, len(written))
+	for _, record := range written {
+		newVersions[key{record.GetType(), record.GetId()}] = record.GetVersion()
+	}
+
+	events := make([]auditEvent, len(records))
+	for i, record := range records {
+		events[i] = newAuditEvent(method, err, func(evt *auditEvent) {
+			evt.RecordType = record.GetType()
+			evt.RecordID = record.GetId()
+			evt.OldVersion = record.GetExpectedVersion()
+			evt.NewVersion = newVersions[key{record.GetType(), record.GetId()}]
+			if i < len(statuses) && statuses[i].GetCode() != 0 {
+				evt.Error = statuses[i].GetMessage()
+			}
+		})
+	}
+	return events
+}
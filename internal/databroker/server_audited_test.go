@@ -0,0 +1,150 @@
+package databroker_test
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestAuditedServer(t *testing.T) {
+	t.Parallel()
+
+	newClient := func(t *testing.T, auditLog string) (databrokerpb.DataBrokerServiceClient, []byte) {
+		t.Helper()
+
+		srv := databroker.NewBackendServer(noop.NewTracerProvider())
+		srv = databroker.NewAuditedServer(srv)
+		srv = databroker.NewSecuredServer(srv)
+		t.Cleanup(srv.Stop)
+
+		sharedKey := cryptutil.NewKey()
+		srv.OnConfigChange(t.Context(), &config.Config{
+			Options: &config.Options{
+				SharedKey: base64.StdEncoding.EncodeToString(sharedKey),
+				DataBroker: config.DataBrokerOptions{
+					StorageType: config.StorageInMemoryName,
+					AuditLog:    auditLog,
+				},
+			},
+		})
+
+		cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+			databrokerpb.RegisterDataBrokerServiceServer(s, srv)
+		})
+		return databrokerpb.NewDataBrokerServiceClient(cc), sharedKey
+	}
+
+	t.Run("file", func(t *testing.T) {
+		t.Parallel()
+
+		auditLog := filepath.Join(t.TempDir(), "audit.log")
+		client, sharedKey := newClient(t, auditLog)
+
+		ctx, err := grpcutil.WithSignedJWT(t.Context(), sharedKey, grpcutil.WithJWTService("proxy"))
+		require.NoError(t, err)
+
+		res1, err := client.Put(ctx, &databrokerpb.PutRequest{
+			Records: []*databrokerpb.Record{{Type: "example", Id: "r1", Data: protoutil.NewAnyString("v1")}},
+		})
+		require.NoError(t, err)
+		res2, err := client.Put(ctx, &databrokerpb.PutRequest{
+			Records: []*databrokerpb.Record{{
+				Type:            "example",
+				Id:              "r1",
+				Data:            protoutil.NewAnyString("v2"),
+				ExpectedVersion: proto.Uint64(res1.GetRecords()[0].GetVersion()),
+			}},
+		})
+		require.NoError(t, err)
+		_, err = client.AcquireLease(ctx, &databrokerpb.AcquireLeaseRequest{
+			Name:     "lease1",
+			Duration: durationpb.New(time.Minute),
+		})
+		require.NoError(t, err)
+
+		f, err := os.Open(auditLog)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = f.Close() })
+
+		var events []map[string]any
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var evt map[string]any
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &evt))
+			delete(evt, "time")
+			events = append(events, evt)
+		}
+		require.NoError(t, scanner.Err())
+
+		assert.Equal(t, []map[string]any{
+			{
+				"service":     "proxy",
+				"method":      databrokerpb.DataBrokerService_Put_FullMethodName,
+				"record_type": "example",
+				"record_id":   "r1",
+				"new_version": float64(res1.GetRecords()[0].GetVersion()),
+			},
+			{
+				"service":     "proxy",
+				"method":      databrokerpb.DataBrokerService_Put_FullMethodName,
+				"record_type": "example",
+				"record_id":   "r1",
+				"old_version": float64(res1.GetRecords()[0].GetVersion()),
+				"new_version": float64(res2.GetRecords()[0].GetVersion()),
+			},
+			{
+				"service":    "proxy",
+				"method":     databrokerpb.DataBrokerService_AcquireLease_FullMethodName,
+				"lease_name": "lease1",
+			},
+		}, events)
+	})
+
+	t.Run("record", func(t *testing.T) {
+		t.Parallel()
+
+		client, sharedKey := newClient(t, config.DataBrokerAuditLogToRecord)
+
+		ctx, err := grpcutil.WithSignedJWT(t.Context(), sharedKey, grpcutil.WithJWTService("authorize"))
+		require.NoError(t, err)
+
+		_, err = client.SetOptions(ctx, &databrokerpb.SetOptionsRequest{
+			Type:    "example",
+			Options: &databrokerpb.Options{},
+		})
+		require.NoError(t, err)
+
+		res, err := client.Query(ctx, &databrokerpb.QueryRequest{
+			Type:  "pomerium.io/DataBrokerAuditEvent",
+			Limit: 10,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.GetRecords(), 1)
+
+		var evt structpb.Struct
+		require.NoError(t, res.GetRecords()[0].GetData().UnmarshalTo(&evt))
+		assert.Equal(t, "authorize", evt.GetFields()["service"].GetStringValue())
+		assert.Equal(t, databrokerpb.DataBrokerService_SetOptions_FullMethodName, evt.GetFields()["method"].GetStringValue())
+		assert.Equal(t, "example", evt.GetFields()["record_type"].GetStringValue())
+	})
+}
@@ -4,26 +4,33 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"slices"
-	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	oteltrace "go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/registry"
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
 	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/iterutil"
 	"github.com/pomerium/pomerium/pkg/storage"
 	"github.com/pomerium/pomerium/pkg/storage/file"
 	"github.com/pomerium/pomerium/pkg/storage/inmemory"
 	"github.com/pomerium/pomerium/pkg/storage/postgres"
+	"github.com/pomerium/pomerium/pkg/storage/redis"
 	"github.com/pomerium/pomerium/pkg/telemetry/trace"
 )
 
@@ -37,6 +44,15 @@ type backendServer struct {
 	storageType             string
 	storageConnectionString string
 	storageMetricAttributes []attribute.KeyValue
+	fileStorage             fileStorageConfig
+
+	storageHealthCheckInterval         time.Duration
+	storageHealthCheckLatencyThreshold time.Duration
+
+	syncMaxRecordsPerSecond int
+	syncMaxBytesPerSecond   int
+	syncThrottledCount      metric.Int64Counter
+	syncThrottledDuration   metric.Int64Histogram
 
 	stopWG  sync.WaitGroup
 	stopCtx context.Context
@@ -50,14 +66,25 @@ func NewBackendServer(tracerProvider oteltrace.TracerProvider) Server {
 		tracerProvider: tracerProvider,
 		tracer:         tracer,
 		storageType:    config.StorageInMemoryName,
+
+		syncThrottledCount: metrics.Int64Counter("databroker.sync.throttled",
+			metric.WithDescription("Number of records delayed by the sync stream rate limits."),
+			metric.WithUnit("{record}")),
+		syncThrottledDuration: metrics.Int64Histogram("databroker.sync.throttled.duration",
+			metric.WithDescription("Duration records were delayed by the sync stream rate limits."),
+			metric.WithUnit("ms")),
 	}
 
 	srv.stopCtx, srv.stop = context.WithCancelCause(context.Background())
-	srv.stopWG.Add(1)
+	srv.stopWG.Add(2)
 	go func() {
 		defer srv.stopWG.Done()
 		srv.periodicallyClean()
 	}()
+	go func() {
+		defer srv.stopWG.Done()
+		srv.periodicallyProbeHealth()
+	}()
 	return srv
 }
 
@@ -211,8 +238,6 @@ func (srv *backendServer) Query(ctx context.Context, req *databrokerpb.QueryRequ
 		Interface("filter", req.GetFilter()).
 		Msg("query")
 
-	query := strings.ToLower(req.GetQuery())
-
 	db, err := srv.getBackend(ctx)
 	if err != nil {
 		return nil, err
@@ -222,26 +247,41 @@ func (srv *backendServer) Query(ctx context.Context, req *databrokerpb.QueryRequ
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid query filter: %v", err)
 	}
+	if query := req.GetQuery(); query != "" {
+		search := storage.SearchFilterExpression{Query: query}
+		if expr == nil {
+			expr = search
+		} else {
+			expr = storage.AndFilterExpression{expr, search}
+		}
+	}
 
-	serverVersion, recordVersion, seq, err := db.SyncLatest(ctx, req.GetType(), expr)
+	orderBy, err := storage.OrderByFromProto(req.GetOrderBy())
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query order by: %v", err)
 	}
 
-	var filtered []*databrokerpb.Record
-	for record, err := range seq {
-		if err != nil {
-			return nil, err
-		}
-
-		if query != "" && !storage.MatchAny(record.GetData(), query) {
-			continue
-		}
+	var serverVersion, recordVersion uint64
+	var seq storage.RecordIterator
+	if len(orderBy) > 0 {
+		serverVersion, recordVersion, seq, err = db.SyncLatestOrdered(ctx, req.GetType(), expr, orderBy)
+	} else {
+		serverVersion, recordVersion, seq, err = db.SyncLatest(ctx, req.GetType(), expr)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-		filtered = append(filtered, record)
+	filtered, err := iterutil.CollectWithError(seq)
+	if err != nil {
+		return nil, err
 	}
 
 	records, totalCount := databrokerpb.ApplyOffsetAndLimit(filtered, int(req.GetOffset()), int(req.GetLimit()))
+	records, err = storage.MaskRecords(records, req.GetFieldMask())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query field mask: %v", err)
+	}
 	return &databrokerpb.QueryResponse{
 		Records:       records,
 		TotalCount:    int64(totalCount),
@@ -277,6 +317,10 @@ func (srv *backendServer) Put(ctx context.Context, req *databrokerpb.PutRequest)
 		return nil, err
 	}
 
+	if req.GetPartial() {
+		return putPartial(ctx, db, records)
+	}
+
 	serverVersion, err := db.Put(ctx, records)
 	if err != nil {
 		return nil, err
@@ -316,6 +360,10 @@ func (srv *backendServer) Patch(ctx context.Context, req *databrokerpb.PatchRequ
 		return nil, err
 	}
 
+	if req.GetPartial() {
+		return patchPartial(ctx, db, records, req.GetFieldMask())
+	}
+
 	serverVersion, patchedRecords, err := db.Patch(ctx, records, req.GetFieldMask())
 	if err != nil {
 		return nil, err
@@ -328,6 +376,63 @@ func (srv *backendServer) Patch(ctx context.Context, req *databrokerpb.PatchRequ
 	return res, nil
 }
 
+// putPartial puts each record independently, so that a failed record
+// doesn't prevent the other records from being saved.
+func putPartial(ctx context.Context, db storage.Backend, records []*databrokerpb.Record) (*databrokerpb.PutResponse, error) {
+	res := new(databrokerpb.PutResponse)
+	for _, record := range records {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		serverVersion, err := db.Put(ctx, []*databrokerpb.Record{record})
+		res.Statuses = append(res.Statuses, newRecordStatus(err))
+		if err != nil {
+			continue
+		}
+		res.ServerVersion = serverVersion
+		res.Records = append(res.Records, record)
+	}
+	return res, nil
+}
+
+// patchPartial patches each record independently, so that a failed record
+// doesn't prevent the other records from being patched. Unlike a regular
+// patch, records that don't exist are reported with a NotFound status.
+func patchPartial(
+	ctx context.Context,
+	db storage.Backend,
+	records []*databrokerpb.Record,
+	fields *fieldmaskpb.FieldMask,
+) (*databrokerpb.PatchResponse, error) {
+	res := new(databrokerpb.PatchResponse)
+	for _, record := range records {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		serverVersion, patchedRecords, err := db.Patch(ctx, []*databrokerpb.Record{record}, fields)
+		if err == nil && len(patchedRecords) == 0 {
+			err = status.Error(codes.NotFound, "record not found")
+		}
+		res.Statuses = append(res.Statuses, newRecordStatus(err))
+		if err != nil {
+			continue
+		}
+		res.ServerVersion = serverVersion
+		res.Records = append(res.Records, patchedRecords...)
+	}
+	return res, nil
+}
+
+func newRecordStatus(err error) *databrokerpb.RecordStatus {
+	s := status.Convert(err)
+	return &databrokerpb.RecordStatus{
+		Code:    int32(s.Code()),
+		Message: s.Message(),
+	}
+}
+
 // ReleaseLease releases a lease.
 func (srv *backendServer) ReleaseLease(ctx context.Context, req *databrokerpb.ReleaseLeaseRequest) (*emptypb.Empty, error) {
 	ctx, span := srv.tracer.Start(ctx, "databroker.grpc.ReleaseLease")
@@ -437,6 +542,38 @@ func (srv *backendServer) SetOptions(ctx context.Context, req *databrokerpb.SetO
 	}, nil
 }
 
+// Stats returns statistics for each record type.
+func (srv *backendServer) Stats(ctx context.Context, _ *databrokerpb.StatsRequest) (*databrokerpb.StatsResponse, error) {
+	ctx, span := srv.tracer.Start(ctx, "databroker.grpc.Stats")
+	defer span.End()
+
+	backend, err := srv.getBackend(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := backend.Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(databrokerpb.StatsResponse)
+	for _, s := range stats {
+		res.RecordTypes = append(res.RecordTypes, newRecordTypeStats(s))
+	}
+	return res, nil
+}
+
+func newRecordTypeStats(stats storage.RecordTypeStats) *databrokerpb.RecordTypeStats {
+	return &databrokerpb.RecordTypeStats{
+		RecordType:       stats.RecordType,
+		Count:            stats.Count,
+		OldestModifiedAt: timestamppb.New(stats.OldestModifiedAt),
+		NewestModifiedAt: timestamppb.New(stats.NewestModifiedAt),
+		Size:             stats.Size,
+	}
+}
+
 // Sync streams updates for the given record type.
 func (srv *backendServer) Sync(req *databrokerpb.SyncRequest, stream databrokerpb.DataBrokerService_SyncServer) error {
 	ctx := stream.Context()
@@ -461,8 +598,20 @@ func (srv *backendServer) Sync(req *databrokerpb.SyncRequest, stream databrokerp
 	if req.Wait != nil {
 		wait = *req.Wait
 	}
+	srv.mu.RLock()
+	limiter := newSyncLimiter(srv.syncMaxRecordsPerSecond, srv.syncMaxBytesPerSecond)
+	srv.mu.RUnlock()
+
 	seq := backend.Sync(ctx, req.GetType(), req.GetServerVersion(), req.GetRecordVersion(), wait)
 	for record, err := range seq {
+		if err != nil {
+			return err
+		}
+		delay, err := limiter.wait(ctx, proto.Size(record))
+		if delay > 0 {
+			srv.syncThrottledCount.Add(ctx, 1)
+			srv.syncThrottledDuration.Record(ctx, delay.Milliseconds())
+		}
 		if err != nil {
 			return err
 		}
@@ -495,26 +644,74 @@ func (srv *backendServer) SyncLatest(req *databrokerpb.SyncLatestRequest, stream
 		return err
 	}
 
-	serverVersion, recordVersion, seq, err := backend.SyncLatest(ctx, req.GetType(), nil)
-	if err != nil {
-		return err
+	if req.GetPageSize() < 0 {
+		return status.Error(codes.InvalidArgument, "page size must not be negative")
 	}
 
-	for record, err := range seq {
+	var serverVersion, recordVersion uint64
+	var seq storage.RecordIterator
+	if req.GetPageSize() == 0 && req.GetPageToken() == "" {
+		serverVersion, recordVersion, seq, err = backend.SyncLatest(ctx, req.GetType(), nil)
 		if err != nil {
 			return err
 		}
-
-		if req.GetType() == "" || req.GetType() == record.GetType() {
-			err = stream.Send(&databrokerpb.SyncLatestResponse{
-				Response: &databrokerpb.SyncLatestResponse_Record{
-					Record: record,
-				},
-			})
+	} else {
+		var token syncLatestPageToken
+		if req.GetPageToken() != "" {
+			token, err = decodeSyncLatestPageToken(req.GetPageToken())
 			if err != nil {
-				return err
+				return status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
 			}
 		}
+
+		serverVersion, recordVersion, seq, err = backend.SyncLatestAfter(ctx, req.GetType(), nil, token.RecordType, token.RecordID)
+		if err != nil {
+			return err
+		}
+
+		if req.GetPageToken() != "" {
+			if token.ServerVersion != serverVersion {
+				return storage.ErrInvalidServerVersion
+			}
+			// changes made while paging are picked up by syncing from the
+			// record version of the first page
+			recordVersion = token.RecordVersion
+		}
+	}
+
+	var nextPageToken string
+	var last *databrokerpb.Record
+	sent := 0
+	for record, err := range seq {
+		if err != nil {
+			return err
+		}
+
+		if req.GetType() != "" && req.GetType() != record.GetType() {
+			continue
+		}
+
+		// there are more records than fit on this page
+		if req.GetPageSize() > 0 && sent == int(req.GetPageSize()) {
+			nextPageToken = syncLatestPageToken{
+				ServerVersion: serverVersion,
+				RecordVersion: recordVersion,
+				RecordType:    last.GetType(),
+				RecordID:      last.GetId(),
+			}.encode()
+			break
+		}
+
+		err = stream.Send(&databrokerpb.SyncLatestResponse{
+			Response: &databrokerpb.SyncLatestResponse_Record{
+				Record: record,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		last = record
+		sent++
 	}
 
 	// always send the server version last in case there are no records
@@ -525,7 +722,77 @@ func (srv *backendServer) SyncLatest(req *databrokerpb.SyncLatestRequest, stream
 				LatestRecordVersion: recordVersion,
 			},
 		},
+		NextPageToken: nextPageToken,
+	})
+}
+
+// WatchRecord streams the current value of a record followed by any changes to it.
+func (srv *backendServer) WatchRecord(req *databrokerpb.WatchRecordRequest, stream databrokerpb.DataBrokerService_WatchRecordServer) error {
+	ctx := stream.Context()
+	ctx, span := srv.tracer.Start(ctx, "databroker.grpc.WatchRecord")
+	defer span.End()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	log.Ctx(ctx).Debug().
+		Str("type", req.GetType()).
+		Str("id", req.GetId()).
+		Msg("watch record")
+
+	if req.GetType() == "" || req.GetId() == "" {
+		return status.Error(codes.InvalidArgument, "type and id are required")
+	}
+
+	backend, err := srv.getBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	// use sync latest so that no changes are missed between reading the
+	// current record and syncing subsequent changes
+	serverVersion, recordVersion, seq, err := backend.SyncLatest(ctx, req.GetType(), storage.EqualsFilterExpression{
+		Fields: []string{"id"},
+		Value:  req.GetId(),
 	})
+	if err != nil {
+		return err
+	}
+
+	var current *databrokerpb.Record
+	for record, err := range seq {
+		if err != nil {
+			return err
+		}
+		if record.GetType() == req.GetType() && record.GetId() == req.GetId() {
+			current = record
+		}
+	}
+
+	err = stream.Send(&databrokerpb.WatchRecordResponse{
+		Record: current,
+	})
+	if err != nil {
+		return err
+	}
+
+	for record, err := range backend.Sync(ctx, req.GetType(), serverVersion, recordVersion, true) {
+		if err != nil {
+			return err
+		}
+		if record.GetType() != req.GetType() || record.GetId() != req.GetId() {
+			continue
+		}
+
+		err = stream.Send(&databrokerpb.WatchRecordResponse{
+			Record: record,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (srv *backendServer) Stop() {
@@ -547,14 +814,27 @@ func (srv *backendServer) OnConfigChange(ctx context.Context, cfg *config.Config
 		return
 	}
 
+	srv.storageHealthCheckInterval = cfg.Options.DataBroker.StorageHealthCheckInterval
+	srv.storageHealthCheckLatencyThreshold = cfg.Options.DataBroker.StorageHealthCheckLatencyThreshold
+	srv.syncMaxRecordsPerSecond = cfg.Options.DataBroker.SyncMaxRecordsPerSecond
+	srv.syncMaxBytesPerSecond = cfg.Options.DataBroker.SyncMaxBytesPerSecond
+
+	fileStorage, err := newFileStorageConfig(cfg.Options)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("databroker: error reading databroker file storage options")
+		return
+	}
+
 	// nothing changed
-	if srv.storageType == storageType && srv.storageConnectionString == storageConnectionString {
+	if srv.storageType == storageType && srv.storageConnectionString == storageConnectionString &&
+		(storageType != config.StorageFileName || reflect.DeepEqual(srv.fileStorage, fileStorage)) {
 		return
 	}
 
 	// set the options and close any backends so they are re-initialized
 	srv.storageType = storageType
 	srv.storageConnectionString = storageConnectionString
+	srv.fileStorage = fileStorage
 
 	if srv.backend != nil {
 		err := srv.backend.Close()
@@ -599,7 +879,14 @@ func (srv *backendServer) newBackendLocked(ctx context.Context) (storage.Backend
 	switch srv.storageType {
 	case config.StorageFileName:
 		log.Ctx(ctx).Info().Msg("initializing new file store")
-		return file.New(srv.tracerProvider, srv.storageConnectionString, file.WithMetricAttributes(srv.storageMetricAttributes...)), nil
+		// the snapshot store clients outlive the request, so they use the
+		// server's context
+		fileOpts, err := srv.fileStorage.options(srv.stopCtx)
+		if err != nil {
+			return nil, fmt.Errorf("error creating file storage snapshot store: %w", err)
+		}
+		opts := append([]file.Option{file.WithMetricAttributes(srv.storageMetricAttributes...)}, fileOpts...)
+		return file.New(srv.tracerProvider, srv.storageConnectionString, opts...), nil
 	case config.StorageInMemoryName:
 		log.Ctx(ctx).Info().Msg("initializing new in-memory store")
 		return inmemory.New(), nil
@@ -609,6 +896,13 @@ func (srv *backendServer) newBackendLocked(ctx context.Context) (storage.Backend
 		// to the lifetime of the server itself. 'ctx' may be a short-lived request
 		// context, since the backend is lazy-initialized.
 		return postgres.New(srv.stopCtx, srv.storageConnectionString, postgres.WithTracerProvider(srv.tracerProvider)), nil
+	case config.StorageRedisName:
+		log.Ctx(ctx).Info().Msg("initializing new redis store")
+		backend, err := redis.New(srv.stopCtx, srv.storageConnectionString)
+		if err != nil {
+			return nil, err
+		}
+		return backend, nil
 	default:
 		return nil, fmt.Errorf("unsupported storage type: %s", srv.storageType)
 	}
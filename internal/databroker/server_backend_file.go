@@ -0,0 +1,109 @@
+package databroker
+
+import (
+	"context"
+	"time"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/sessions/serverside"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/storage/file"
+)
+
+// fileStorageConfig holds the options that only apply to the file storage
+// backend. It is compared on config changes to determine whether the backend
+// needs to be re-created.
+type fileStorageConfig struct {
+	encryptionKeys       [][]byte
+	indexedFields        map[string][]string
+	recordChangeMaxAge   time.Duration
+	recordChangeMaxCount uint64
+	recordTTLs           map[string]time.Duration
+	searchFields         map[string][]string
+	snapshotInterval     time.Duration
+	snapshotRetainCount  int
+	snapshotStoreURL     string
+}
+
+func newFileStorageConfig(options *config.Options) (fileStorageConfig, error) {
+	o := &options.DataBroker
+	encryptionKeys, err := o.GetStorageEncryptionKeys()
+	if err != nil {
+		return fileStorageConfig{}, err
+	}
+
+	// session index records and server-side session records are only needed
+	// as long as the session they belong to, so unless configured otherwise
+	// they expire with the session
+	indexedFields := recordFieldsByType(o.StorageIndexedFields)
+	searchFields := recordFieldsByType(o.StorageSearchFields)
+
+	recordTTLs := make(map[string]time.Duration, len(o.StorageRecordTTLs))
+	for _, ttl := range o.StorageRecordTTLs {
+		recordTTLs[ttl.Type] = ttl.TTL
+	}
+	for _, recordType := range []string{session.IndexRecordType, serverside.RecordType} {
+		if _, ok := recordTTLs[recordType]; !ok && options.CookieExpire > 0 {
+			recordTTLs[recordType] = options.CookieExpire
+		}
+	}
+	if _, ok := recordTTLs[auditRecordType]; !ok && o.AuditLog == config.DataBrokerAuditLogToRecord {
+		recordTTLs[auditRecordType] = o.GetAuditLogRetention()
+	}
+
+	return fileStorageConfig{
+		encryptionKeys:       encryptionKeys,
+		indexedFields:        indexedFields,
+		recordChangeMaxAge:   o.StorageRecordChangeMaxAge,
+		recordChangeMaxCount: o.StorageRecordChangeMaxCount,
+		recordTTLs:           recordTTLs,
+		searchFields:         searchFields,
+		snapshotInterval:     o.StorageSnapshotInterval,
+		snapshotRetainCount:  o.StorageSnapshotRetainCount,
+		snapshotStoreURL:     o.StorageSnapshotStoreURL,
+	}, nil
+}
+
+func (cfg fileStorageConfig) options(ctx context.Context) ([]file.Option, error) {
+	var opts []file.Option
+	if len(cfg.encryptionKeys) > 0 {
+		opts = append(opts, file.WithEncryptionKeys(cfg.encryptionKeys...))
+	}
+	if cfg.recordChangeMaxAge > 0 {
+		opts = append(opts, file.WithRecordChangeMaxAge(cfg.recordChangeMaxAge))
+	}
+	if cfg.recordChangeMaxCount > 0 {
+		opts = append(opts, file.WithRecordChangeMaxCount(cfg.recordChangeMaxCount))
+	}
+	for recordType, fields := range cfg.indexedFields {
+		opts = append(opts, file.WithIndexedFields(recordType, fields...))
+	}
+	for recordType, fields := range cfg.searchFields {
+		opts = append(opts, file.WithSearchFields(recordType, fields...))
+	}
+	for recordType, ttl := range cfg.recordTTLs {
+		opts = append(opts, file.WithRecordTTL(recordType, ttl))
+	}
+	if cfg.snapshotStoreURL != "" {
+		store, err := file.NewSnapshotStore(ctx, cfg.snapshotStoreURL)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, file.WithSnapshotStore(store))
+		if cfg.snapshotInterval > 0 {
+			opts = append(opts, file.WithSnapshotInterval(cfg.snapshotInterval))
+		}
+		if cfg.snapshotRetainCount > 0 {
+			opts = append(opts, file.WithSnapshotRetainCount(cfg.snapshotRetainCount))
+		}
+	}
+	return opts, nil
+}
+
+func recordFieldsByType(recordFields []config.DataBrokerRecordFields) map[string][]string {
+	m := make(map[string][]string, len(recordFields))
+	for _, f := range recordFields {
+		m[f.Type] = append(m[f.Type], f.Fields...)
+	}
+	return m
+}
@@ -0,0 +1,110 @@
+package databroker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/sessions/serverside"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestServerFileStorageOptions(t *testing.T) {
+	t.Parallel()
+
+	srv := NewBackendServer(noop.NewTracerProvider()).(*backendServer)
+	t.Cleanup(srv.Stop)
+
+	cfg := &config.Config{Options: config.NewDefaultOptions()}
+	cfg.Options.DataBroker.StorageType = config.StorageFileName
+	cfg.Options.DataBroker.StorageConnectionString = "memory://"
+	cfg.Options.DataBroker.StorageIndexedFields = []config.DataBrokerRecordFields{{Type: "example", Fields: []string{"user_id"}}}
+	srv.OnConfigChange(t.Context(), cfg)
+
+	backend1, err := srv.getBackend(t.Context())
+	require.NoError(t, err)
+
+	srv.OnConfigChange(t.Context(), cfg)
+	backend2, err := srv.getBackend(t.Context())
+	require.NoError(t, err)
+	assert.Same(t, backend1, backend2,
+		"should keep the backend when the file storage options are unchanged")
+
+	cfg.Options.DataBroker.StorageRecordTTLs = []config.DataBrokerRecordTTL{{Type: "example", TTL: time.Hour}}
+	srv.OnConfigChange(t.Context(), cfg)
+	backend3, err := srv.getBackend(t.Context())
+	require.NoError(t, err)
+	assert.NotSame(t, backend1, backend3,
+		"should re-create the backend when the file storage options change")
+}
+
+func TestServerFileStorageSnapshotStore(t *testing.T) {
+	t.Parallel()
+
+	srv := NewBackendServer(noop.NewTracerProvider()).(*backendServer)
+	t.Cleanup(srv.Stop)
+
+	cfg := &config.Config{Options: config.NewDefaultOptions()}
+	cfg.Options.DataBroker.StorageType = config.StorageFileName
+	cfg.Options.DataBroker.StorageConnectionString = "file://" + t.TempDir()
+	cfg.Options.DataBroker.StorageSnapshotStoreURL = "ftp://example.com/snapshots"
+	srv.OnConfigChange(t.Context(), cfg)
+
+	_, err := srv.getBackend(t.Context())
+	assert.ErrorContains(t, err, "unknown snapshot store scheme",
+		"should return an error for an invalid snapshot store")
+
+	cfg.Options.DataBroker.StorageSnapshotStoreURL = "file://" + t.TempDir()
+	srv.OnConfigChange(t.Context(), cfg)
+
+	backend, err := srv.getBackend(t.Context())
+	require.NoError(t, err)
+	assert.NotNil(t, backend)
+}
+
+func TestFileStorageConfigDefaultTTLs(t *testing.T) {
+	t.Parallel()
+
+	options := config.NewDefaultOptions()
+	options.CookieExpire = 2 * time.Hour
+
+	cfg, err := newFileStorageConfig(options)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Hour, cfg.recordTTLs[session.IndexRecordType],
+		"should expire session index records with their sessions")
+	assert.Equal(t, 2*time.Hour, cfg.recordTTLs[serverside.RecordType],
+		"should expire server-side session records with their sessions")
+	assert.NotContains(t, cfg.recordTTLs, auditRecordType)
+
+	options.DataBroker.AuditLog = config.DataBrokerAuditLogToRecord
+	cfg, err = newFileStorageConfig(options)
+	require.NoError(t, err)
+	assert.Equal(t, config.DefaultDataBrokerAuditLogRetention, cfg.recordTTLs[auditRecordType],
+		"should expire audit events after the retention")
+
+	options.DataBroker.StorageRecordTTLs = []config.DataBrokerRecordTTL{{Type: session.IndexRecordType, TTL: time.Hour}}
+	cfg, err = newFileStorageConfig(options)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, cfg.recordTTLs[session.IndexRecordType],
+		"should keep a configured ttl")
+}
+
+func TestFileStorageConfigSearchFields(t *testing.T) {
+	t.Parallel()
+
+	options := config.NewDefaultOptions()
+	options.DataBroker.StorageSearchFields = []config.DataBrokerRecordFields{
+		{Type: "type.googleapis.com/user.User", Fields: []string{"name"}},
+		{Type: "type.googleapis.com/user.User", Fields: []string{"email"}},
+	}
+
+	cfg, err := newFileStorageConfig(options)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"type.googleapis.com/user.User": {"name", "email"},
+	}, cfg.searchFields, "should merge the search fields of each record type")
+}
@@ -0,0 +1,117 @@
+package databroker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pomerium/pomerium/pkg/health"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+const (
+	healthProbeRecordType = "pomerium.io/DataBrokerHealthProbe"
+	healthProbeRecordID   = "probe"
+
+	defaultStorageHealthCheckInterval         = 30 * time.Second
+	defaultStorageHealthCheckLatencyThreshold = 5 * time.Second
+)
+
+type healthProbeResult struct {
+	pingLatency         time.Duration
+	pingErr             error
+	notificationLatency time.Duration
+	notificationErr     error
+}
+
+func (srv *backendServer) periodicallyProbeHealth() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-srv.stopCtx.Done():
+			return
+		case <-timer.C:
+		}
+
+		srv.mu.RLock()
+		backend := srv.backend
+		interval := srv.storageHealthCheckInterval
+		threshold := srv.storageHealthCheckLatencyThreshold
+		srv.mu.RUnlock()
+
+		if interval <= 0 {
+			interval = defaultStorageHealthCheckInterval
+		}
+		if threshold <= 0 {
+			threshold = defaultStorageHealthCheckLatencyThreshold
+		}
+
+		if backend != nil {
+			result := probeStorageBackend(srv.stopCtx, backend, threshold)
+			if srv.stopCtx.Err() != nil {
+				return
+			}
+			reportStorageBackendProbe(health.StorageBackendPing, result.pingLatency, result.pingErr, threshold)
+			reportStorageBackendProbe(health.StorageBackendNotification, result.notificationLatency, result.notificationErr, threshold)
+		}
+
+		timer.Reset(interval)
+	}
+}
+
+// probeStorageBackend reads a probe record, which never exists, and then
+// syncs the probe record type from the latest record version. The probe only
+// reads from the backend so that it doesn't add records for syncers and
+// record change history to process.
+func probeStorageBackend(
+	ctx context.Context,
+	backend storage.Backend,
+	threshold time.Duration,
+) (result healthProbeResult) {
+	// give up on anything taking much longer than the threshold
+	ctx, cancel := context.WithTimeout(ctx, 2*threshold)
+	defer cancel()
+
+	start := time.Now()
+	serverVersion, _, latestRecordVersion, err := backend.Versions(ctx)
+	if err == nil {
+		_, err = backend.Get(ctx, healthProbeRecordType, healthProbeRecordID)
+		if errors.Is(err, storage.ErrNotFound) {
+			err = nil
+		}
+	}
+	result.pingLatency = time.Since(start)
+	result.pingErr = err
+	if err != nil {
+		result.notificationErr = err
+		return result
+	}
+
+	start = time.Now()
+	for _, err = range backend.Sync(ctx, healthProbeRecordType, serverVersion, latestRecordVersion, false) {
+		if err != nil {
+			break
+		}
+	}
+	result.notificationLatency = time.Since(start)
+	result.notificationErr = err
+	return result
+}
+
+func reportStorageBackendProbe(check health.Check, latency time.Duration, err error, threshold time.Duration) {
+	attrs := []health.Attr{
+		health.StrAttr("latency", latency.String()),
+		health.StrAttr("latency_threshold", threshold.String()),
+	}
+	if err == nil && latency > threshold {
+		err = fmt.Errorf("latency %s exceeds the threshold of %s", latency, threshold)
+	}
+	if err != nil {
+		health.ReportError(check, err, attrs...)
+	} else {
+		health.ReportRunning(check, attrs...)
+	}
+}
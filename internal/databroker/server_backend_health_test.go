@@ -0,0 +1,30 @@
+package databroker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/pkg/storage/inmemory"
+)
+
+func TestProbeStorageBackend(t *testing.T) {
+	t.Parallel()
+
+	backend := inmemory.New()
+	t.Cleanup(func() { _ = backend.Close() })
+
+	_, _, before, err := backend.Versions(t.Context())
+	require.NoError(t, err)
+
+	result := probeStorageBackend(t.Context(), backend, 5*time.Second)
+	assert.NoError(t, result.pingErr)
+	assert.NoError(t, result.notificationErr)
+	assert.Positive(t, result.pingLatency)
+
+	_, _, after, err := backend.Versions(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, before, after, "should not write to the backend")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/internal/registry"
 	"github.com/pomerium/pomerium/internal/registry/inmemory"
+	"github.com/pomerium/pomerium/internal/registry/records"
 	registrypb "github.com/pomerium/pomerium/pkg/grpc/registry"
 	"github.com/pomerium/pomerium/pkg/storage"
 )
@@ -106,6 +107,9 @@ func (srv *backendServer) newRegistryLocked(ctx context.Context, backend storage
 	case config.StorageInMemoryName:
 		log.Ctx(ctx).Info().Msg("using in-memory registry")
 		return inmemory.New(ctx, DefaultRegistryTTL), nil
+	case config.StorageRedisName:
+		log.Ctx(ctx).Info().Msg("using registry via records")
+		return records.New(backend, DefaultRegistryTTL), nil
 	}
 
 	return nil, fmt.Errorf("unsupported registry type: %s", srv.storageType)
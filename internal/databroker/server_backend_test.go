@@ -152,6 +152,49 @@ func TestServer_Patch(t *testing.T) {
 	}), getResponse.GetRecord().GetData())
 }
 
+func TestServer_Partial(t *testing.T) {
+	t.Parallel()
+
+	srv := newServer(t)
+
+	putRes, err := srv.Put(t.Context(), &databrokerpb.PutRequest{
+		Records: []*databrokerpb.Record{
+			{Type: "example", Id: "r1", Data: protoutil.NewAnyString("v1")},
+			nil,
+			{Type: "example", Id: "r2", Data: protoutil.NewAnyString("v1")},
+		},
+		Partial: true,
+	})
+	require.NoError(t, err)
+	if assert.Len(t, putRes.GetStatuses(), 3) {
+		assert.Equal(t, int32(codes.OK), putRes.GetStatuses()[0].GetCode())
+		assert.NotEqual(t, int32(codes.OK), putRes.GetStatuses()[1].GetCode())
+		assert.Equal(t, int32(codes.OK), putRes.GetStatuses()[2].GetCode())
+	}
+	assert.Len(t, putRes.GetRecords(), 2)
+
+	patchRes, err := srv.Patch(t.Context(), &databrokerpb.PatchRequest{
+		Records: []*databrokerpb.Record{
+			{Type: "example", Id: "r1", Data: protoutil.NewAnyString("v2")},
+			{Type: "example", Id: "r3", Data: protoutil.NewAnyString("v2")},
+		},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"value"}},
+		Partial:   true,
+	})
+	require.NoError(t, err)
+	if assert.Len(t, patchRes.GetStatuses(), 2) {
+		assert.Equal(t, int32(codes.OK), patchRes.GetStatuses()[0].GetCode())
+		assert.Equal(t, int32(codes.NotFound), patchRes.GetStatuses()[1].GetCode())
+	}
+	if assert.Len(t, patchRes.GetRecords(), 1) {
+		assert.Equal(t, "r1", patchRes.GetRecords()[0].GetId())
+	}
+
+	getRes, err := srv.Get(t.Context(), &databrokerpb.GetRequest{Type: "example", Id: "r2"})
+	require.NoError(t, err)
+	testutil.AssertProtoEqual(t, protoutil.NewAnyString("v1"), getRes.GetRecord().GetData())
+}
+
 func TestServer_Options(t *testing.T) {
 	t.Parallel()
 
@@ -203,6 +246,33 @@ func TestServer_Lease(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestServer_Stats(t *testing.T) {
+	t.Parallel()
+
+	srv := newServer(t)
+
+	for _, id := range []string{"1", "2"} {
+		s := new(sessionpb.Session)
+		s.Id = id
+		data := protoutil.NewAny(s)
+		_, err := srv.Put(t.Context(), &databrokerpb.PutRequest{
+			Records: []*databrokerpb.Record{{
+				Type: data.TypeUrl,
+				Id:   s.Id,
+				Data: data,
+			}},
+		})
+		require.NoError(t, err)
+	}
+
+	res, err := srv.Stats(t.Context(), new(databrokerpb.StatsRequest))
+	require.NoError(t, err)
+	require.Len(t, res.GetRecordTypes(), 1)
+	assert.Equal(t, protoutil.GetTypeURL(new(sessionpb.Session)), res.GetRecordTypes()[0].GetRecordType())
+	assert.Equal(t, uint64(2), res.GetRecordTypes()[0].GetCount())
+	assert.NotZero(t, res.GetRecordTypes()[0].GetSize())
+}
+
 func TestServer_Query(t *testing.T) {
 	t.Parallel()
 
@@ -340,6 +410,165 @@ func TestServer_Sync(t *testing.T) {
 	assert.NoError(t, eg.Wait())
 }
 
+func TestServer_SyncLatestPagination(t *testing.T) {
+	t.Parallel()
+
+	srv := newServer(t)
+
+	var records []*databrokerpb.Record
+	for i := range 5 {
+		s := &sessionpb.Session{Id: fmt.Sprint(i)}
+		records = append(records, &databrokerpb.Record{
+			Type: "type.googleapis.com/session.Session",
+			Id:   s.Id,
+			Data: protoutil.NewAny(s),
+		})
+	}
+	_, err := srv.Put(t.Context(), &databrokerpb.PutRequest{Records: records})
+	require.NoError(t, err)
+
+	gs := grpc.NewServer()
+	databrokerpb.RegisterDataBrokerServiceServer(gs, srv)
+	li, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer li.Close()
+
+	eg, ctx := errgroup.WithContext(t.Context())
+	eg.Go(func() error {
+		return gs.Serve(li)
+	})
+	eg.Go(func() error {
+		defer gs.Stop()
+
+		cc, err := grpc.DialContext(ctx, li.Addr().String(), grpc.WithInsecure())
+		if err != nil {
+			return err
+		}
+		defer cc.Close()
+
+		client := databrokerpb.NewDataBrokerServiceClient(cc)
+
+		syncPage := func(pageToken string) (ids []string, versions *databrokerpb.Versions, nextPageToken string, err error) {
+			stream, err := client.SyncLatest(ctx, &databrokerpb.SyncLatestRequest{
+				Type:      "type.googleapis.com/session.Session",
+				PageSize:  2,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, nil, "", err
+			}
+			for {
+				res, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				} else if err != nil {
+					return nil, nil, "", err
+				}
+
+				switch res := res.Response.(type) {
+				case *databrokerpb.SyncLatestResponse_Record:
+					ids = append(ids, res.Record.GetId())
+				case *databrokerpb.SyncLatestResponse_Versions:
+					versions = res.Versions
+				}
+				if res.GetNextPageToken() != "" {
+					nextPageToken = res.GetNextPageToken()
+				}
+			}
+			return ids, versions, nextPageToken, nil
+		}
+
+		ids, firstVersions, pageToken, err := syncPage("")
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, []string{"0", "1"}, ids)
+		assert.NotEmpty(t, pageToken)
+
+		// changes made while paging should not advance the reported version
+		_, err = srv.Put(ctx, &databrokerpb.PutRequest{Records: records[:1]})
+		assert.NoError(t, err)
+
+		ids, versions, pageToken, err := syncPage(pageToken)
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, []string{"2", "3"}, ids)
+		assert.NotEmpty(t, pageToken)
+		testutil.AssertProtoEqual(t, firstVersions, versions)
+
+		ids, versions, pageToken, err = syncPage(pageToken)
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, []string{"4"}, ids)
+		assert.Empty(t, pageToken)
+		testutil.AssertProtoEqual(t, firstVersions, versions)
+
+		_, _, _, err = syncPage("invalid")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		return nil
+	})
+	assert.NoError(t, eg.Wait())
+}
+
+func TestServer_WatchRecord(t *testing.T) {
+	t.Parallel()
+
+	srv := newServer(t)
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, srv)
+	})
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+
+	put := func(id, value string) {
+		_, err := srv.Put(t.Context(), &databrokerpb.PutRequest{
+			Records: []*databrokerpb.Record{{Type: "example", Id: id, Data: protoutil.NewAnyString(value)}},
+		})
+		require.NoError(t, err)
+	}
+	recv := func(stream grpc.ServerStreamingClient[databrokerpb.WatchRecordResponse]) *databrokerpb.Record {
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		return res.GetRecord()
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		stream, err := client.WatchRecord(t.Context(), &databrokerpb.WatchRecordRequest{Type: "example"})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	put("r1", "v1")
+
+	stream, err := client.WatchRecord(t.Context(), &databrokerpb.WatchRecordRequest{Type: "example", Id: "r1"})
+	require.NoError(t, err)
+	testutil.AssertProtoEqual(t, protoutil.NewAnyString("v1"), recv(stream).GetData(),
+		"should return the current record")
+
+	put("r2", "v1")
+	put("r1", "v2")
+	testutil.AssertProtoEqual(t, protoutil.NewAnyString("v2"), recv(stream).GetData(),
+		"should return changes to the record and skip other records")
+
+	_, err = srv.Put(t.Context(), &databrokerpb.PutRequest{
+		Records: []*databrokerpb.Record{{Type: "example", Id: "r1", DeletedAt: timestamppb.Now()}},
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, recv(stream).GetDeletedAt(), "should return deletions")
+
+	stream, err = client.WatchRecord(t.Context(), &databrokerpb.WatchRecordRequest{Type: "example", Id: "r3"})
+	require.NoError(t, err)
+	assert.Nil(t, recv(stream), "should return no record when the record doesn't exist")
+	put("r3", "v1")
+	testutil.AssertProtoEqual(t, protoutil.NewAnyString("v1"), recv(stream).GetData(),
+		"should return the record once it's created")
+}
+
 func TestServerInvalidStorage(t *testing.T) {
 	t.Parallel()
 
@@ -19,6 +19,7 @@ import (
 	"github.com/pomerium/pomerium/internal/telemetry"
 	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
 	registrypb "github.com/pomerium/pomerium/pkg/grpc/registry"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
 	"github.com/pomerium/pomerium/pkg/health"
 )
 
@@ -162,6 +163,13 @@ func (srv *clusteredServer) SetOptions(ctx context.Context, req *databrokerpb.Se
 	return current.SetOptions(ctx, req)
 }
 
+func (srv *clusteredServer) Stats(ctx context.Context, req *databrokerpb.StatsRequest) (*databrokerpb.StatsResponse, error) {
+	srv.mu.RLock()
+	current := srv.currentServer
+	srv.mu.RUnlock()
+	return current.Stats(ctx, req)
+}
+
 func (srv *clusteredServer) Sync(req *databrokerpb.SyncRequest, stream grpc.ServerStreamingServer[databrokerpb.SyncResponse]) error {
 	srv.mu.RLock()
 	current := srv.currentServer
@@ -183,6 +191,13 @@ func (srv *clusteredServer) Watch(req *registrypb.ListRequest, stream grpc.Serve
 	return current.Watch(req, stream)
 }
 
+func (srv *clusteredServer) WatchRecord(req *databrokerpb.WatchRecordRequest, stream grpc.ServerStreamingServer[databrokerpb.WatchRecordResponse]) error {
+	srv.mu.RLock()
+	current := srv.currentServer
+	srv.mu.RUnlock()
+	return current.WatchRecord(req, stream)
+}
+
 func (srv *clusteredServer) Stop() {
 	srv.mu.Lock()
 	defer srv.mu.Unlock()
@@ -218,6 +233,15 @@ func (srv *clusteredServer) OnConfigChange(ctx context.Context, cfg *config.Conf
 	srv.updateServerLocked()
 }
 
+// isLeader returns false if the node is a follower which forwards mutations to
+// the cluster leader.
+func (srv *clusteredServer) isLeader() bool {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+	_, isFollower := srv.currentServer.(*clusteredFollowerServer)
+	return !isFollower
+}
+
 func (srv *clusteredServer) OnLeaderChange() {
 	srv.mu.Lock()
 	defer srv.mu.Unlock()
@@ -252,6 +276,15 @@ func (srv *clusteredServer) updateLeaderElectorLocked() {
 		return
 	}
 
+	// if there's a lease service, use a lease to determine the leader
+	if srv.currentOptions.ClusterLeaseServiceURL.IsValid() {
+		log.Ctx(ctx).Info().Str("cluster-lease-service-url", srv.currentOptions.ClusterLeaseServiceURL.String).Msg("using lease leader elector")
+		srv.currentLeaderElector = NewLeaseLeaderElector(srv.telemetry.GetTracerProvider(),
+			databrokerpb.NewDataBrokerServiceClient(srv.clientManager.GetClient(srv.currentOptions.ClusterLeaseServiceURL.String)),
+			srv.currentOptions.ClusterNodeID.String, srv.OnLeaderChange)
+		return
+	}
+
 	// if there's a raft bind address, use raft to determine the leader
 	if srv.currentOptions.RaftBindAddress.IsValid() {
 		log.Ctx(ctx).Info().Msg("using raft leader elector")
@@ -334,8 +367,30 @@ func (srv *clusteredServer) updateServerLocked() {
 		srv.currentServer = NewClusteredLeaderServer(srv.local)
 	} else {
 		log.Ctx(ctx).Info().Msg("node is a follower")
-		srv.currentServer = NewClusteredFollowerServer(srv.telemetry.GetTracerProvider(), srv.local, srv.clientManager.GetClient(leaderGRPCAddress.String))
+		leaderCC := srv.clientManager.GetClient(leaderGRPCAddress.String)
+		leader := NewCachingForwardingServer(srv.getFollowerUpstreamsLocked(leaderCC, nodeID.String, leaderID.String),
+			srv.currentOptions.ClusterFollowerCacheTTL,
+			grpcutil.WithForwarderSharedKey(srv.clientManager.GetSharedKey),
+			grpcutil.WithForwarderMaxForwards(srv.currentOptions.MaxForwards))
+		srv.currentServer = newClusteredFollowerServer(srv.telemetry.GetTracerProvider(), srv.local, leaderCC, leader)
+	}
+}
+
+// getFollowerUpstreamsLocked returns the upstreams a follower forwards
+// requests to. The leader is always first. If requests may be forwarded more
+// than once, the other cluster nodes are added so that requests fail over to
+// them when the leader is unavailable from this node.
+func (srv *clusteredServer) getFollowerUpstreamsLocked(leaderCC grpc.ClientConnInterface, nodeID, leaderID string) []grpc.ClientConnInterface {
+	upstreams := []grpc.ClientConnInterface{leaderCC}
+	if srv.currentOptions.MaxForwards < 2 {
+		return upstreams
+	}
+	for _, n := range srv.currentOptions.ClusterNodes {
+		if n.ID != nodeID && n.ID != leaderID {
+			upstreams = append(upstreams, srv.clientManager.GetClient(n.GRPCAddress))
+		}
 	}
+	return upstreams
 }
 
 func dataBrokerOptionsAreEqual(o1, o2 config.DataBrokerOptions) bool {
@@ -22,6 +22,7 @@ import (
 	"github.com/pomerium/pomerium/internal/telemetry"
 	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
 	registrypb "github.com/pomerium/pomerium/pkg/grpc/registry"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
 	"github.com/pomerium/pomerium/pkg/health"
 )
 
@@ -43,11 +44,27 @@ type clusteredFollowerServer struct {
 // NewClusteredFollowerServer creates a new clustered follower databroker
 // server. A clustered follower server forwards all requests to a leader
 // databroker via the passed client connection.
-func NewClusteredFollowerServer(tracerProvider oteltrace.TracerProvider, local Server, leaderCC grpc.ClientConnInterface) Server {
+func NewClusteredFollowerServer(
+	tracerProvider oteltrace.TracerProvider,
+	local Server,
+	leaderCC grpc.ClientConnInterface,
+	forwarderOptions ...grpcutil.ForwarderOption,
+) Server {
+	return newClusteredFollowerServer(tracerProvider, local, leaderCC, NewForwardingServer(leaderCC, forwarderOptions...))
+}
+
+// newClusteredFollowerServer creates a new clustered follower databroker
+// server which syncs records from leaderCC and forwards requests to leader.
+func newClusteredFollowerServer(
+	tracerProvider oteltrace.TracerProvider,
+	local Server,
+	leaderCC grpc.ClientConnInterface,
+	leader Server,
+) Server {
 	srv := &clusteredFollowerServer{
 		telemetry: *telemetry.NewComponent(tracerProvider, zerolog.DebugLevel, "databroker-clustered-follower-server"),
 		leaderCC:  leaderCC,
-		leader:    NewForwardingServer(leaderCC),
+		leader:    leader,
 		local:     local,
 		done:      make(chan struct{}, 1),
 	}
@@ -169,6 +186,14 @@ func (srv *clusteredFollowerServer) SetOptions(ctx context.Context, req *databro
 	})
 }
 
+func (srv *clusteredFollowerServer) Stats(ctx context.Context, req *databrokerpb.StatsRequest) (res *databrokerpb.StatsResponse, err error) {
+	return res, srv.invokeReadOnly(ctx, func(handler Server) error {
+		var err error
+		res, err = handler.Stats(ctx, req)
+		return err
+	})
+}
+
 func (srv *clusteredFollowerServer) Sync(req *databrokerpb.SyncRequest, stream grpc.ServerStreamingServer[databrokerpb.SyncResponse]) error {
 	return srv.invokeReadOnly(stream.Context(), func(handler Server) error {
 		return handler.Sync(req, stream)
@@ -187,9 +212,16 @@ func (srv *clusteredFollowerServer) Watch(req *registrypb.ListRequest, stream gr
 	})
 }
 
+func (srv *clusteredFollowerServer) WatchRecord(req *databrokerpb.WatchRecordRequest, stream grpc.ServerStreamingServer[databrokerpb.WatchRecordResponse]) error {
+	return srv.invokeReadOnly(stream.Context(), func(handler Server) error {
+		return handler.WatchRecord(req, stream)
+	})
+}
+
 func (srv *clusteredFollowerServer) Stop() {
 	srv.cancel(errClusteredFollowerServerStopped)
 	<-srv.done
+	srv.leader.Stop()
 }
 
 func (srv *clusteredFollowerServer) OnConfigChange(_ context.Context, _ *config.Config) {}
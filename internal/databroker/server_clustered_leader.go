@@ -105,6 +105,10 @@ func (srv *clusteredLeaderServer) SetOptions(ctx context.Context, req *databroke
 	return srv.local.SetOptions(ctx, req)
 }
 
+func (srv *clusteredLeaderServer) Stats(ctx context.Context, req *databrokerpb.StatsRequest) (res *databrokerpb.StatsResponse, err error) {
+	return srv.local.Stats(ctx, req)
+}
+
 func (srv *clusteredLeaderServer) Sync(req *databrokerpb.SyncRequest, stream grpc.ServerStreamingServer[databrokerpb.SyncResponse]) error {
 	return srv.local.Sync(req, stream)
 }
@@ -117,6 +121,10 @@ func (srv *clusteredLeaderServer) Watch(req *registrypb.ListRequest, stream grpc
 	return srv.local.Watch(req, stream)
 }
 
+func (srv *clusteredLeaderServer) WatchRecord(req *databrokerpb.WatchRecordRequest, stream grpc.ServerStreamingServer[databrokerpb.WatchRecordResponse]) error {
+	return srv.local.WatchRecord(req, stream)
+}
+
 func (srv *clusteredLeaderServer) Stop() {
 	srv.cancel(nil)
 }
@@ -0,0 +1,131 @@
+package databroker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/config"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// A DebugServer implements the databroker debug service.
+type DebugServer interface {
+	databrokerpb.DebugServiceServer
+
+	OnConfigChange(ctx context.Context, cfg *config.Config)
+}
+
+type debugServer struct {
+	local   Server
+	auth    securedServer
+	enabled atomic.Bool
+}
+
+// NewDebugServer creates a new DebugServer which inspects the storage backend
+// of a local backend server. Every method requires a signed JWT and the server
+// is disabled unless the databroker_debug_service runtime flag is set.
+func NewDebugServer(local Server) DebugServer {
+	return &debugServer{local: local}
+}
+
+func (srv *debugServer) DumpRecord(ctx context.Context, req *databrokerpb.DumpRecordRequest) (*databrokerpb.DumpRecordResponse, error) {
+	backend, err := srv.getBackend(ctx, req.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := backend.Get(ctx, req.GetType(), req.GetId())
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "record not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dataJSON, err := protojson.Marshal(record.GetData())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error formatting record data as json: %v", err)
+	}
+
+	return &databrokerpb.DumpRecordResponse{
+		Record:   record,
+		DataJson: string(dataJSON),
+	}, nil
+}
+
+func (srv *debugServer) ListLeases(ctx context.Context, _ *databrokerpb.ListLeasesRequest) (*databrokerpb.ListLeasesResponse, error) {
+	backend, err := srv.getBackend(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	leases, err := backend.ListLeases(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := new(databrokerpb.ListLeasesResponse)
+	for _, lease := range leases {
+		res.Leases = append(res.Leases, &databrokerpb.Lease{
+			Name:      lease.Name,
+			Id:        lease.ID,
+			ExpiresAt: timestamppb.New(lease.ExpiresAt),
+		})
+	}
+	return res, nil
+}
+
+func (srv *debugServer) GetChangeLogStats(ctx context.Context, _ *databrokerpb.GetChangeLogStatsRequest) (*databrokerpb.GetChangeLogStatsResponse, error) {
+	backend, err := srv.getBackend(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(databrokerpb.GetChangeLogStatsResponse)
+	res.ServerVersion, res.EarliestRecordVersion, res.LatestRecordVersion, err = backend.Versions(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	stats, err := backend.Stats(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, s := range stats {
+		res.RecordTypes = append(res.RecordTypes, newRecordTypeStats(s))
+	}
+	return res, nil
+}
+
+func (srv *debugServer) OnConfigChange(ctx context.Context, cfg *config.Config) {
+	srv.enabled.Store(cfg.Options.IsRuntimeFlagSet(config.RuntimeFlagDatabrokerDebugService))
+	srv.auth.updateAuthorization(ctx, cfg)
+}
+
+// getBackend authorizes the request and returns the local storage backend.
+// The caller must be allowed to read the given record type, where an empty
+// record type refers to all record types.
+func (srv *debugServer) getBackend(ctx context.Context, recordType string) (storage.Backend, error) {
+	if !srv.enabled.Load() {
+		return nil, status.Error(codes.Unimplemented, "the databroker debug service is disabled")
+	}
+
+	_, err := srv.auth.authorizeRead(ctx, recordType)
+	if err != nil {
+		return nil, err
+	}
+
+	local, ok := srv.local.(interface {
+		getBackend(ctx context.Context) (storage.Backend, error)
+	})
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the databroker debug service is not supported")
+	}
+	return local.getBackend(ctx)
+}
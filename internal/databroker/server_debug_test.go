@@ -0,0 +1,103 @@
+package databroker_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestDebugServer(t *testing.T) {
+	t.Parallel()
+
+	local := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(local.Stop)
+	debug := databroker.NewDebugServer(local)
+
+	sharedKey := cryptutil.NewKey()
+	cfg := &config.Config{
+		Options: &config.Options{
+			SharedKey: base64.StdEncoding.EncodeToString(sharedKey),
+		},
+	}
+	local.OnConfigChange(t.Context(), cfg)
+	debug.OnConfigChange(t.Context(), cfg)
+
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, local)
+		databrokerpb.RegisterDebugServiceServer(s, debug)
+	})
+	client := databrokerpb.NewDebugServiceClient(cc)
+
+	ctx, err := grpcutil.WithSignedJWT(t.Context(), sharedKey)
+	require.NoError(t, err)
+
+	_, err = client.ListLeases(ctx, new(databrokerpb.ListLeasesRequest))
+	assert.Equal(t, codes.Unimplemented, status.Code(err),
+		"should be disabled by default")
+
+	cfg.Options.RuntimeFlags = config.RuntimeFlags{config.RuntimeFlagDatabrokerDebugService: true}
+	debug.OnConfigChange(t.Context(), cfg)
+
+	_, err = client.ListLeases(t.Context(), new(databrokerpb.ListLeasesRequest))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err),
+		"should require a signed jwt")
+
+	_, err = local.Put(ctx, &databrokerpb.PutRequest{
+		Records: []*databrokerpb.Record{{Type: "example", Id: "r1", Data: protoutil.NewAnyString("v1")}},
+	})
+	require.NoError(t, err)
+	_, err = local.AcquireLease(ctx, &databrokerpb.AcquireLeaseRequest{
+		Name:     "example-lease",
+		Duration: durationpb.New(time.Minute),
+	})
+	require.NoError(t, err)
+
+	t.Run("dump record", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := client.DumpRecord(ctx, &databrokerpb.DumpRecordRequest{Type: "example", Id: "r1"})
+		require.NoError(t, err)
+		assert.Equal(t, "r1", res.GetRecord().GetId())
+		assert.Contains(t, res.GetDataJson(), "v1")
+
+		_, err = client.DumpRecord(ctx, &databrokerpb.DumpRecordRequest{Type: "example", Id: "r2"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("list leases", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := client.ListLeases(ctx, new(databrokerpb.ListLeasesRequest))
+		require.NoError(t, err)
+		if assert.Len(t, res.GetLeases(), 1) {
+			assert.Equal(t, "example-lease", res.GetLeases()[0].GetName())
+		}
+	})
+	t.Run("change log stats", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := client.GetChangeLogStats(ctx, new(databrokerpb.GetChangeLogStatsRequest))
+		require.NoError(t, err)
+		assert.NotZero(t, res.GetServerVersion())
+		assert.NotZero(t, res.GetLatestRecordVersion())
+		if assert.Len(t, res.GetRecordTypes(), 1) {
+			assert.Equal(t, "example", res.GetRecordTypes()[0].GetRecordType())
+			assert.Equal(t, uint64(1), res.GetRecordTypes()[0].GetCount())
+		}
+	})
+}
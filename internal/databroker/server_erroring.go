@@ -82,6 +82,10 @@ func (srv *erroringServer) SetOptions(_ context.Context, _ *databrokerpb.SetOpti
 	return nil, srv.err
 }
 
+func (srv *erroringServer) Stats(_ context.Context, _ *databrokerpb.StatsRequest) (*databrokerpb.StatsResponse, error) {
+	return nil, srv.err
+}
+
 func (srv *erroringServer) Sync(_ *databrokerpb.SyncRequest, _ grpc.ServerStreamingServer[databrokerpb.SyncResponse]) error {
 	return srv.err
 }
@@ -94,5 +98,9 @@ func (srv *erroringServer) Watch(_ *registrypb.ListRequest, _ grpc.ServerStreami
 	return srv.err
 }
 
+func (srv *erroringServer) WatchRecord(_ *databrokerpb.WatchRecordRequest, _ grpc.ServerStreamingServer[databrokerpb.WatchRecordResponse]) error {
+	return srv.err
+}
+
 func (srv *erroringServer) Stop()                                              {}
 func (srv *erroringServer) OnConfigChange(_ context.Context, _ *config.Config) {}
@@ -2,102 +2,316 @@ package databroker
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/log"
 	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
 	registrypb "github.com/pomerium/pomerium/pkg/grpc/registry"
 	"github.com/pomerium/pomerium/pkg/grpcutil"
 )
 
+const (
+	forwardingHealthCheckInterval = 10 * time.Second
+	forwardingHealthCheckTimeout  = 5 * time.Second
+	forwardingMaxRetries          = 3
+)
+
+type forwardingUpstream struct {
+	cc      grpc.ClientConnInterface
+	healthy atomic.Bool
+}
+
 type forwardingServer struct {
-	cc        grpc.ClientConnInterface
-	forwarder grpcutil.Forwarder
+	upstreams []*forwardingUpstream
+	forwarder *metricsForwarder
+	cache     *forwardingCache
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
 }
 
 // NewForwardingServer creates a new server that forwards all requests to
 // another server.
-func NewForwardingServer(cc grpc.ClientConnInterface) Server {
+func NewForwardingServer(cc grpc.ClientConnInterface, options ...grpcutil.ForwarderOption) Server {
+	return NewPooledForwardingServer([]grpc.ClientConnInterface{cc}, options...)
+}
+
+// NewPooledForwardingServer creates a new server that forwards all requests
+// to a pool of upstream servers. Requests are sent to the first healthy
+// upstream. Idempotent requests (Get, Query and SyncLatest) that fail
+// because an upstream is unavailable fail over to the other upstreams and
+// are retried with backoff.
+func NewPooledForwardingServer(ccs []grpc.ClientConnInterface, options ...grpcutil.ForwarderOption) Server {
+	return NewCachingForwardingServer(ccs, 0, options...)
+}
+
+// NewCachingForwardingServer creates a new pooled forwarding server with a
+// read-through cache for Get and Query responses. Cached responses expire
+// after cacheTTL and are invalidated early when a background Sync stream
+// reports a change to the records they depend on. If cacheTTL is 0, no
+// responses are cached.
+func NewCachingForwardingServer(ccs []grpc.ClientConnInterface, cacheTTL time.Duration, options ...grpcutil.ForwarderOption) Server {
 	srv := &forwardingServer{
-		cc:        cc,
-		forwarder: grpcutil.NewForwarder(),
+		forwarder: newMetricsForwarder(grpcutil.NewForwarder(options...)),
+	}
+	for _, cc := range ccs {
+		u := &forwardingUpstream{cc: cc}
+		u.healthy.Store(true)
+		srv.upstreams = append(srv.upstreams, u)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	srv.cancel = cancel
+	if len(srv.upstreams) > 1 {
+		srv.wg.Add(1)
+		go func() {
+			defer srv.wg.Done()
+			srv.runHealthChecks(ctx)
+		}()
+	}
+	if cacheTTL > 0 {
+		srv.cache = newForwardingCache(cacheTTL)
+		srv.wg.Add(1)
+		go func() {
+			defer srv.wg.Done()
+			srv.runCacheInvalidation(ctx)
+		}()
 	}
 	return srv
 }
 
 func (srv *forwardingServer) AcquireLease(ctx context.Context, req *databrokerpb.AcquireLeaseRequest) (res *databrokerpb.AcquireLeaseResponse, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.cc).AcquireLease, req)
+	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.upstream()).AcquireLease, req)
 }
 
 func (srv *forwardingServer) Clear(ctx context.Context, req *emptypb.Empty) (res *databrokerpb.ClearResponse, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.cc).Clear, req)
+	res, err = grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.upstream()).Clear, req)
+	if srv.cache != nil {
+		srv.cache.invalidateAll()
+	}
+	return res, err
 }
 
 func (srv *forwardingServer) Get(ctx context.Context, req *databrokerpb.GetRequest) (res *databrokerpb.GetResponse, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.cc).Get, req)
+	if srv.cache != nil {
+		return srv.cache.get(req, func() (*databrokerpb.GetResponse, error) {
+			return srv.get(ctx, req)
+		})
+	}
+	return srv.get(ctx, req)
+}
+
+func (srv *forwardingServer) get(ctx context.Context, req *databrokerpb.GetRequest) (res *databrokerpb.GetResponse, err error) {
+	return res, srv.retry(ctx, func(cc grpc.ClientConnInterface) error {
+		var err error
+		res, err = grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(cc).Get, req)
+		return err
+	})
 }
 
 func (srv *forwardingServer) GetCheckpoint(ctx context.Context, req *databrokerpb.GetCheckpointRequest) (res *databrokerpb.GetCheckpointResponse, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewCheckpointServiceClient(srv.cc).GetCheckpoint, req)
+	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewCheckpointServiceClient(srv.upstream()).GetCheckpoint, req)
 }
 
 func (srv *forwardingServer) List(ctx context.Context, req *registrypb.ListRequest) (res *registrypb.ServiceList, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, registrypb.NewRegistryClient(srv.cc).List, req)
+	return grpcutil.ForwardUnary(ctx, srv.forwarder, registrypb.NewRegistryClient(srv.upstream()).List, req)
 }
 
 func (srv *forwardingServer) ListTypes(ctx context.Context, req *emptypb.Empty) (res *databrokerpb.ListTypesResponse, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.cc).ListTypes, req)
+	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.upstream()).ListTypes, req)
 }
 
 func (srv *forwardingServer) Patch(ctx context.Context, req *databrokerpb.PatchRequest) (res *databrokerpb.PatchResponse, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.cc).Patch, req)
+	res, err = grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.upstream()).Patch, req)
+	srv.invalidateCachedRecords(req.GetRecords())
+	return res, err
 }
 
 func (srv *forwardingServer) Put(ctx context.Context, req *databrokerpb.PutRequest) (res *databrokerpb.PutResponse, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.cc).Put, req)
+	res, err = grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.upstream()).Put, req)
+	srv.invalidateCachedRecords(req.GetRecords())
+	return res, err
 }
 
 func (srv *forwardingServer) Query(ctx context.Context, req *databrokerpb.QueryRequest) (res *databrokerpb.QueryResponse, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.cc).Query, req)
+	if srv.cache != nil {
+		return srv.cache.query(req, func() (*databrokerpb.QueryResponse, error) {
+			return srv.query(ctx, req)
+		})
+	}
+	return srv.query(ctx, req)
+}
+
+func (srv *forwardingServer) query(ctx context.Context, req *databrokerpb.QueryRequest) (res *databrokerpb.QueryResponse, err error) {
+	return res, srv.retry(ctx, func(cc grpc.ClientConnInterface) error {
+		var err error
+		res, err = grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(cc).Query, req)
+		return err
+	})
 }
 
 func (srv *forwardingServer) ReleaseLease(ctx context.Context, req *databrokerpb.ReleaseLeaseRequest) (res *emptypb.Empty, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.cc).ReleaseLease, req)
+	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.upstream()).ReleaseLease, req)
 }
 
 func (srv *forwardingServer) RenewLease(ctx context.Context, req *databrokerpb.RenewLeaseRequest) (res *emptypb.Empty, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.cc).RenewLease, req)
+	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.upstream()).RenewLease, req)
 }
 
 func (srv *forwardingServer) Report(ctx context.Context, req *registrypb.RegisterRequest) (res *registrypb.RegisterResponse, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, registrypb.NewRegistryClient(srv.cc).Report, req)
+	return grpcutil.ForwardUnary(ctx, srv.forwarder, registrypb.NewRegistryClient(srv.upstream()).Report, req)
 }
 
 func (srv *forwardingServer) ServerInfo(ctx context.Context, req *emptypb.Empty) (res *databrokerpb.ServerInfoResponse, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.cc).ServerInfo, req)
+	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.upstream()).ServerInfo, req)
 }
 
 func (srv *forwardingServer) SetCheckpoint(ctx context.Context, req *databrokerpb.SetCheckpointRequest) (res *databrokerpb.SetCheckpointResponse, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewCheckpointServiceClient(srv.cc).SetCheckpoint, req)
+	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewCheckpointServiceClient(srv.upstream()).SetCheckpoint, req)
 }
 
 func (srv *forwardingServer) SetOptions(ctx context.Context, req *databrokerpb.SetOptionsRequest) (res *databrokerpb.SetOptionsResponse, err error) {
-	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.cc).SetOptions, req)
+	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.upstream()).SetOptions, req)
+}
+
+func (srv *forwardingServer) Stats(ctx context.Context, req *databrokerpb.StatsRequest) (res *databrokerpb.StatsResponse, err error) {
+	return grpcutil.ForwardUnary(ctx, srv.forwarder, databrokerpb.NewDataBrokerServiceClient(srv.upstream()).Stats, req)
 }
 
 func (srv *forwardingServer) Sync(req *databrokerpb.SyncRequest, stream grpc.ServerStreamingServer[databrokerpb.SyncResponse]) error {
-	return grpcutil.ForwardStream(srv.forwarder, stream, databrokerpb.NewDataBrokerServiceClient(srv.cc).Sync, req)
+	defer srv.forwarder.trackStream(stream.Context())()
+	return grpcutil.ForwardStream(srv.forwarder, stream, databrokerpb.NewDataBrokerServiceClient(srv.upstream()).Sync, req)
 }
 
 func (srv *forwardingServer) SyncLatest(req *databrokerpb.SyncLatestRequest, stream grpc.ServerStreamingServer[databrokerpb.SyncLatestResponse]) error {
-	return grpcutil.ForwardStream(srv.forwarder, stream, databrokerpb.NewDataBrokerServiceClient(srv.cc).SyncLatest, req)
+	defer srv.forwarder.trackStream(stream.Context())()
+	trackingStream := &sendTrackingServerStream[databrokerpb.SyncLatestResponse]{ServerStreamingServer: stream}
+	return srv.retry(stream.Context(), func(cc grpc.ClientConnInterface) error {
+		err := grpcutil.ForwardStream(srv.forwarder, trackingStream, databrokerpb.NewDataBrokerServiceClient(cc).SyncLatest, req)
+		// once a response has been sent the stream can't be restarted
+		if err != nil && trackingStream.sent {
+			return backoff.Permanent(err)
+		}
+		return err
+	})
 }
 
 func (srv *forwardingServer) Watch(req *registrypb.ListRequest, stream grpc.ServerStreamingServer[registrypb.ServiceList]) error {
-	return grpcutil.ForwardStream(srv.forwarder, stream, registrypb.NewRegistryClient(srv.cc).Watch, req)
+	defer srv.forwarder.trackStream(stream.Context())()
+	return grpcutil.ForwardStream(srv.forwarder, stream, registrypb.NewRegistryClient(srv.upstream()).Watch, req)
+}
+
+func (srv *forwardingServer) WatchRecord(req *databrokerpb.WatchRecordRequest, stream grpc.ServerStreamingServer[databrokerpb.WatchRecordResponse]) error {
+	defer srv.forwarder.trackStream(stream.Context())()
+	return grpcutil.ForwardStream(srv.forwarder, stream, databrokerpb.NewDataBrokerServiceClient(srv.upstream()).WatchRecord, req)
+}
+
+func (srv *forwardingServer) Stop() {
+	srv.cancel()
+	srv.wg.Wait()
 }
 
-func (srv *forwardingServer) Stop()                                              {}
 func (srv *forwardingServer) OnConfigChange(_ context.Context, _ *config.Config) {}
+
+// upstream returns the first healthy upstream, or the first upstream if none
+// of them are healthy.
+func (srv *forwardingServer) upstream() grpc.ClientConnInterface {
+	return srv.upstreamsByHealth()[0].cc
+}
+
+// upstreamsByHealth returns the healthy upstreams followed by the unhealthy
+// upstreams, each in their original order.
+func (srv *forwardingServer) upstreamsByHealth() []*forwardingUpstream {
+	upstreams := make([]*forwardingUpstream, 0, len(srv.upstreams))
+	for _, u := range srv.upstreams {
+		if u.healthy.Load() {
+			upstreams = append(upstreams, u)
+		}
+	}
+	for _, u := range srv.upstreams {
+		if !u.healthy.Load() {
+			upstreams = append(upstreams, u)
+		}
+	}
+	return upstreams
+}
+
+// retry calls fn with each upstream until one of them succeeds. If all of
+// the upstreams are unavailable, fn is retried with backoff.
+func (srv *forwardingServer) retry(ctx context.Context, fn func(cc grpc.ClientConnInterface) error) error {
+	b := backoff.WithContext(backoff.WithMaxRetries(backoff.NewExponentialBackOff(), forwardingMaxRetries), ctx)
+	return backoff.Retry(func() error {
+		var err error
+		for _, u := range srv.upstreamsByHealth() {
+			err = fn(u.cc)
+			if err == nil {
+				u.healthy.Store(true)
+				return nil
+			}
+
+			var permanentErr *backoff.PermanentError
+			if errors.As(err, &permanentErr) {
+				return err
+			} else if status.Code(err) != codes.Unavailable {
+				return backoff.Permanent(err)
+			}
+
+			log.Ctx(ctx).Debug().Err(err).Msg("databroker-forwarding-server: upstream unavailable, failing over")
+			u.healthy.Store(false)
+		}
+		return err
+	}, b)
+}
+
+func (srv *forwardingServer) runHealthChecks(ctx context.Context) {
+	ticker := time.NewTicker(forwardingHealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, u := range srv.upstreams {
+			u.healthy.Store(srv.checkHealth(ctx, u.cc))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkHealth checks the health of an upstream. Upstreams that don't
+// implement the gRPC health service are assumed to be healthy.
+func (srv *forwardingServer) checkHealth(ctx context.Context, cc grpc.ClientConnInterface) bool {
+	ctx, cancel := context.WithTimeout(ctx, forwardingHealthCheckTimeout)
+	defer cancel()
+
+	res, err := grpc_health_v1.NewHealthClient(cc).Check(ctx, new(grpc_health_v1.HealthCheckRequest))
+	if status.Code(err) == codes.Unimplemented {
+		return true
+	} else if err != nil {
+		return false
+	}
+	return res.GetStatus() == grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+type sendTrackingServerStream[T any] struct {
+	grpc.ServerStreamingServer[T]
+	sent bool
+}
+
+func (stream *sendTrackingServerStream[T]) Send(msg *T) error {
+	stream.sent = true
+	return stream.ServerStreamingServer.Send(msg)
+}
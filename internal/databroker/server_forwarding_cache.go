@@ -0,0 +1,223 @@
+package databroker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/pomerium/pomerium/internal/log"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+type forwardingCacheEntry struct {
+	expiry time.Time
+	value  proto.Message
+}
+
+// A forwardingCache caches Get and Query responses from an upstream
+// databroker. Entries expire after a TTL and are invalidated when the
+// records they depend on change, as observed by a Sync stream.
+//
+// The cache is only used while the Sync stream is established. Otherwise
+// changes could be missed and stale responses returned.
+type forwardingCache struct {
+	ttl time.Duration
+
+	mu         sync.Mutex
+	ready      bool
+	generation uint64
+	gets       map[string]map[string]forwardingCacheEntry // record type -> record id -> entry
+	queries    map[string]map[string]forwardingCacheEntry // record type -> query key -> entry
+}
+
+func newForwardingCache(ttl time.Duration) *forwardingCache {
+	return &forwardingCache{
+		ttl:     ttl,
+		gets:    make(map[string]map[string]forwardingCacheEntry),
+		queries: make(map[string]map[string]forwardingCacheEntry),
+	}
+}
+
+// get returns the cached GetResponse for a request, calling update on a
+// cache miss.
+func (cache *forwardingCache) get(
+	req *databrokerpb.GetRequest,
+	update func() (*databrokerpb.GetResponse, error),
+) (*databrokerpb.GetResponse, error) {
+	msg, err := cache.getOrUpdate(cache.gets, req.GetType(), req.GetId(), func(proto.Message) bool { return true },
+		func() (proto.Message, error) { return update() })
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*databrokerpb.GetResponse), nil
+}
+
+// query returns the cached QueryResponse for a request, calling update on a
+// cache miss. Cached responses older than the request's minimum record
+// version hint are ignored.
+func (cache *forwardingCache) query(
+	req *databrokerpb.QueryRequest,
+	update func() (*databrokerpb.QueryResponse, error),
+) (*databrokerpb.QueryResponse, error) {
+	// queries without a type may depend on records of any type
+	if req.GetType() == "" {
+		return update()
+	}
+
+	keyReq := proto.Clone(req).(*databrokerpb.QueryRequest)
+	keyReq.MinimumRecordVersionHint = nil
+	key, err := proto.MarshalOptions{Deterministic: true}.Marshal(keyReq)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling query request: %w", err)
+	}
+
+	msg, err := cache.getOrUpdate(cache.queries, req.GetType(), string(key), func(msg proto.Message) bool {
+		return req.MinimumRecordVersionHint == nil ||
+			msg.(*databrokerpb.QueryResponse).GetRecordVersion() >= req.GetMinimumRecordVersionHint()
+	}, func() (proto.Message, error) { return update() })
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*databrokerpb.QueryResponse), nil
+}
+
+func (cache *forwardingCache) getOrUpdate(
+	entries map[string]map[string]forwardingCacheEntry,
+	recordType, key string,
+	isValid func(msg proto.Message) bool,
+	update func() (proto.Message, error),
+) (proto.Message, error) {
+	now := time.Now()
+
+	cache.mu.Lock()
+	entry, ok := entries[recordType][key]
+	ready, generation := cache.ready, cache.generation
+	cache.mu.Unlock()
+
+	if ready && ok && now.Before(entry.expiry) && isValid(entry.value) {
+		return proto.Clone(entry.value), nil
+	}
+
+	msg, err := update()
+	if err != nil {
+		return nil, err
+	}
+
+	cache.mu.Lock()
+	// if anything was invalidated while the update was in flight, the
+	// response may already be stale, so don't store it
+	if cache.ready && cache.generation == generation {
+		if entries[recordType] == nil {
+			entries[recordType] = make(map[string]forwardingCacheEntry)
+		}
+		entries[recordType][key] = forwardingCacheEntry{
+			expiry: time.Now().Add(cache.ttl),
+			value:  proto.Clone(msg),
+		}
+	}
+	cache.mu.Unlock()
+
+	return msg, nil
+}
+
+// invalidate removes any cached responses that depend on the given record.
+func (cache *forwardingCache) invalidate(recordType, recordID string) {
+	cache.mu.Lock()
+	cache.generation++
+	delete(cache.gets[recordType], recordID)
+	delete(cache.queries, recordType)
+	cache.mu.Unlock()
+}
+
+// invalidateAll removes all cached responses.
+func (cache *forwardingCache) invalidateAll() {
+	cache.mu.Lock()
+	cache.generation++
+	clear(cache.gets)
+	clear(cache.queries)
+	cache.mu.Unlock()
+}
+
+func (cache *forwardingCache) setReady(ready bool) {
+	cache.mu.Lock()
+	cache.ready = ready
+	cache.generation++
+	clear(cache.gets)
+	clear(cache.queries)
+	cache.mu.Unlock()
+}
+
+// runCacheInvalidation syncs changes from the upstream and invalidates any
+// cached responses for the changed records.
+func (srv *forwardingServer) runCacheInvalidation(ctx context.Context) {
+	bo := backoff.WithContext(backoff.NewExponentialBackOff(backoff.WithMaxElapsedTime(0)), ctx)
+	_ = backoff.RetryNotify(func() error {
+		err := srv.syncCacheInvalidations(ctx, bo)
+		srv.cache.setReady(false)
+		if status.Code(err) == codes.Canceled || ctx.Err() != nil {
+			return backoff.Permanent(err)
+		}
+		return err
+	}, bo, func(err error, d time.Duration) {
+		log.Ctx(ctx).Error().
+			Err(err).
+			Dur("delay", d).
+			Msg("databroker-forwarding-server: error syncing cache invalidations")
+	})
+}
+
+func (srv *forwardingServer) syncCacheInvalidations(ctx context.Context, bo backoff.BackOff) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	client := databrokerpb.NewDataBrokerServiceClient(srv.upstream())
+	serverInfo, err := client.ServerInfo(ctx, new(emptypb.Empty))
+	if err != nil {
+		return fmt.Errorf("error retrieving server info: %w", err)
+	}
+
+	stream, err := client.Sync(ctx, &databrokerpb.SyncRequest{
+		ServerVersion: serverInfo.GetServerVersion(),
+		RecordVersion: serverInfo.GetLatestRecordVersion(),
+	})
+	if err != nil {
+		return fmt.Errorf("error starting sync stream: %w", err)
+	}
+
+	// the stream starts at the latest record version, so any change made
+	// after this point invalidates the cache
+	srv.cache.setReady(true)
+	bo.Reset()
+
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return errors.New("sync stream closed unexpectedly")
+		} else if err != nil {
+			return fmt.Errorf("error receiving sync record: %w", err)
+		}
+
+		srv.cache.invalidate(res.GetRecord().GetType(), res.GetRecord().GetId())
+	}
+}
+
+// invalidateCachedRecords invalidates cached responses for records written
+// through this server, so that subsequent reads observe the write even if
+// the sync stream hasn't delivered it yet.
+func (srv *forwardingServer) invalidateCachedRecords(records []*databrokerpb.Record) {
+	if srv.cache == nil {
+		return
+	}
+	for _, record := range records {
+		srv.cache.invalidate(record.GetType(), record.GetId())
+	}
+}
@@ -0,0 +1,78 @@
+package databroker
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+// A metricsForwarder is a Forwarder which records the latency, result and
+// hop count of every forwarded request.
+type metricsForwarder struct {
+	grpcutil.Forwarder
+
+	duration      metric.Int64Histogram
+	requests      metric.Int64Counter
+	hops          metric.Int64Histogram
+	activeStreams metric.Int64UpDownCounter
+}
+
+func newMetricsForwarder(forwarder grpcutil.Forwarder) *metricsForwarder {
+	return &metricsForwarder{
+		Forwarder: forwarder,
+
+		duration: metrics.Int64Histogram("databroker.forwarding.duration",
+			metric.WithDescription("Duration of forwarded requests. For streams this is the lifetime of the stream."),
+			metric.WithUnit("ms")),
+		requests: metrics.Int64Counter("databroker.forwarding.requests",
+			metric.WithDescription("Number of forwarded requests by gRPC status code."),
+			metric.WithUnit("{request}")),
+		hops: metrics.Int64Histogram("databroker.forwarding.hops",
+			metric.WithDescription("Number of times a request was forwarded before reaching this server."),
+			metric.WithUnit("{hop}")),
+		activeStreams: metrics.Int64UpDownCounter("databroker.forwarding.active_streams",
+			metric.WithDescription("Number of forwarded streams currently open."),
+			metric.WithUnit("{stream}")),
+	}
+}
+
+func (f *metricsForwarder) Forward(ctx context.Context, fn func(ctx context.Context) error) error {
+	method := methodAttribute(ctx)
+
+	// the forwarder ids aren't verified, but they're only used to count hops
+	f.hops.Record(ctx, int64(len(grpcutil.ForwardedForFromIncoming(ctx, nil))),
+		metric.WithAttributes(method))
+
+	start := time.Now()
+	err := f.Forwarder.Forward(ctx, fn)
+	f.duration.Record(ctx, time.Since(start).Milliseconds(),
+		metric.WithAttributes(method))
+	f.requests.Add(ctx, 1,
+		metric.WithAttributes(method, attribute.String("code", status.Code(err).String())))
+	return err
+}
+
+// trackStream counts a forwarded stream as active until the returned function
+// is called.
+func (f *metricsForwarder) trackStream(ctx context.Context) func() {
+	attrs := metric.WithAttributes(methodAttribute(ctx))
+	f.activeStreams.Add(ctx, 1, attrs)
+	return func() {
+		f.activeStreams.Add(context.WithoutCancel(ctx), -1, attrs)
+	}
+}
+
+func methodAttribute(ctx context.Context) attribute.KeyValue {
+	method, ok := grpc.Method(ctx)
+	if !ok {
+		method = "unknown"
+	}
+	return attribute.String("method", method)
+}
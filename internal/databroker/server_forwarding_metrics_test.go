@@ -0,0 +1,97 @@
+package databroker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+func TestMetricsForwarder(t *testing.T) {
+	t.Parallel()
+
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+
+	duration, err := meter.Int64Histogram("duration")
+	require.NoError(t, err)
+	requests, err := meter.Int64Counter("requests")
+	require.NoError(t, err)
+	hops, err := meter.Int64Histogram("hops")
+	require.NoError(t, err)
+	activeStreams, err := meter.Int64UpDownCounter("active_streams")
+	require.NoError(t, err)
+
+	f := &metricsForwarder{
+		Forwarder:     grpcutil.NewForwarder(),
+		duration:      duration,
+		requests:      requests,
+		hops:          hops,
+		activeStreams: activeStreams,
+	}
+
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs(
+		"pomerium-forwarder-id", "a",
+		"pomerium-forwarder-id", "b",
+	))
+	assert.NoError(t, f.Forward(ctx, func(_ context.Context) error { return nil }))
+	assert.Error(t, f.Forward(ctx, func(_ context.Context) error {
+		return status.Error(codes.Unavailable, "UNAVAILABLE")
+	}))
+
+	done := f.trackStream(ctx)
+	assert.Equal(t, int64(1), collectActiveStreams(t, reader))
+	done()
+	assert.Equal(t, int64(0), collectActiveStreams(t, reader))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	codeCounts := map[string]int64{}
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		switch m.Name {
+		case "requests":
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				code, _ := dp.Attributes.Value(attribute.Key("code"))
+				codeCounts[code.AsString()] += dp.Value
+			}
+		case "hops":
+			dps := m.Data.(metricdata.Histogram[int64]).DataPoints
+			require.Len(t, dps, 1)
+			assert.Equal(t, uint64(2), dps[0].Count)
+			assert.Equal(t, int64(4), dps[0].Sum, "should record 2 hops per request")
+		case "duration":
+			dps := m.Data.(metricdata.Histogram[int64]).DataPoints
+			require.Len(t, dps, 1)
+			assert.Equal(t, uint64(2), dps[0].Count)
+		}
+	}
+	assert.Equal(t, map[string]int64{"OK": 1, "Unavailable": 1}, codeCounts)
+}
+
+func collectActiveStreams(t *testing.T, reader sdkmetric.Reader) int64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	var total int64
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "active_streams" {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				total += dp.Value
+			}
+		}
+	}
+	return total
+}
@@ -1,18 +1,27 @@
 package databroker_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/databroker"
 	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
 	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
 )
 
 func TestForwardingServer(t *testing.T) {
@@ -32,3 +41,91 @@ func TestForwardingServer(t *testing.T) {
 
 	assert.Empty(t, cmp.Diff(res1, res2, protocmp.Transform()))
 }
+
+type unavailableDataBrokerServer struct {
+	databrokerpb.UnimplementedDataBrokerServiceServer
+}
+
+func (unavailableDataBrokerServer) Get(_ context.Context, _ *databrokerpb.GetRequest) (*databrokerpb.GetResponse, error) {
+	return nil, status.Error(codes.Unavailable, "unavailable")
+}
+
+func TestPooledForwardingServer(t *testing.T) {
+	t.Parallel()
+
+	cc1 := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, unavailableDataBrokerServer{})
+	})
+	backend := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(backend.Stop)
+	backend.OnConfigChange(t.Context(), &config.Config{
+		Options: &config.Options{
+			DataBroker: config.DataBrokerOptions{StorageType: config.StorageInMemoryName},
+			SharedKey:  cryptutil.NewBase64Key(),
+		},
+	})
+	cc2 := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, backend)
+	})
+
+	_, err := backend.Put(t.Context(), &databrokerpb.PutRequest{
+		Records: []*databrokerpb.Record{{Type: "example", Id: "r1", Data: protoutil.NewAnyString("v1")}},
+	})
+	require.NoError(t, err)
+
+	srv := databroker.NewPooledForwardingServer([]grpc.ClientConnInterface{cc1, cc2})
+	t.Cleanup(srv.Stop)
+
+	res, err := srv.Get(t.Context(), &databrokerpb.GetRequest{Type: "example", Id: "r1"})
+	assert.NoError(t, err, "should fail over to the healthy upstream")
+	assert.Equal(t, "r1", res.GetRecord().GetId())
+
+	_, err = srv.Get(t.Context(), &databrokerpb.GetRequest{Type: "example", Id: "r2"})
+	assert.Equal(t, codes.NotFound, status.Code(err), "should not retry non-transient errors")
+}
+
+func TestCachingForwardingServer(t *testing.T) {
+	t.Parallel()
+
+	backend := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(backend.Stop)
+	backend.OnConfigChange(t.Context(), &config.Config{
+		Options: &config.Options{
+			DataBroker: config.DataBrokerOptions{StorageType: config.StorageInMemoryName},
+			SharedKey:  cryptutil.NewBase64Key(),
+		},
+	})
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, backend)
+	})
+
+	srv := databroker.NewCachingForwardingServer([]grpc.ClientConnInterface{cc}, time.Hour)
+	t.Cleanup(srv.Stop)
+
+	put := func(value string) {
+		_, err := backend.Put(t.Context(), &databrokerpb.PutRequest{
+			Records: []*databrokerpb.Record{{Type: "example", Id: "r1", Data: protoutil.NewAnyString(value)}},
+		})
+		require.NoError(t, err)
+	}
+	getValue := func() string {
+		res, err := srv.Get(t.Context(), &databrokerpb.GetRequest{Type: "example", Id: "r1"})
+		require.NoError(t, err)
+		var value wrapperspb.StringValue
+		require.NoError(t, res.GetRecord().GetData().UnmarshalTo(&value))
+		return value.GetValue()
+	}
+
+	put("v1")
+	assert.Equal(t, "v1", getValue())
+
+	put("v2")
+	assert.Eventually(t, func() bool { return getValue() == "v2" }, 5*time.Second, 10*time.Millisecond,
+		"should invalidate cached responses when records change")
+
+	_, err := srv.Put(t.Context(), &databrokerpb.PutRequest{
+		Records: []*databrokerpb.Record{{Type: "example", Id: "r1", Data: protoutil.NewAnyString("v3")}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "v3", getValue(), "should invalidate cached responses for records written through the server")
+}
@@ -20,9 +20,12 @@ import (
 type securedServer struct {
 	underlying Server
 	sharedKey  atomic.Pointer[[]byte]
+	acl        atomic.Pointer[config.DataBrokerACL]
 }
 
 // NewSecuredServer creates a server that requires a signed JWT for every method.
+// Access to records is further restricted by the databroker acl, based on the
+// service identity in the JWT.
 func NewSecuredServer(underlying Server) Server {
 	srv := &securedServer{
 		underlying: underlying,
@@ -31,138 +34,172 @@ func NewSecuredServer(underlying Server) Server {
 }
 
 func (srv *securedServer) AcquireLease(ctx context.Context, req *databrokerpb.AcquireLeaseRequest) (*databrokerpb.AcquireLeaseResponse, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorizeWrite(ctx, "")
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.AcquireLease(ctx, req)
 }
 
 func (srv *securedServer) Clear(ctx context.Context, req *emptypb.Empty) (*databrokerpb.ClearResponse, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorizeWrite(ctx, "")
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.Clear(ctx, req)
 }
 
 func (srv *securedServer) Get(ctx context.Context, req *databrokerpb.GetRequest) (*databrokerpb.GetResponse, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorizeRead(ctx, req.GetType())
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.Get(ctx, req)
 }
 
 func (srv *securedServer) GetCheckpoint(ctx context.Context, req *databrokerpb.GetCheckpointRequest) (*databrokerpb.GetCheckpointResponse, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorizeWrite(ctx, "")
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.GetCheckpoint(ctx, req)
 }
 
 func (srv *securedServer) List(ctx context.Context, req *registrypb.ListRequest) (*registrypb.ServiceList, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorize(ctx)
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.List(ctx, req)
 }
 
 func (srv *securedServer) ListTypes(ctx context.Context, req *emptypb.Empty) (*databrokerpb.ListTypesResponse, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorizeRead(ctx, "")
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.ListTypes(ctx, req)
 }
 
 func (srv *securedServer) Patch(ctx context.Context, req *databrokerpb.PatchRequest) (*databrokerpb.PatchResponse, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorizeWrite(ctx, getRecordTypes(req.GetRecords())...)
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.Patch(ctx, req)
 }
 
 func (srv *securedServer) Put(ctx context.Context, req *databrokerpb.PutRequest) (*databrokerpb.PutResponse, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorizeWrite(ctx, getRecordTypes(req.GetRecords())...)
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.Put(ctx, req)
 }
 
 func (srv *securedServer) Query(ctx context.Context, req *databrokerpb.QueryRequest) (*databrokerpb.QueryResponse, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorizeRead(ctx, req.GetType())
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.Query(ctx, req)
 }
 
 func (srv *securedServer) ReleaseLease(ctx context.Context, req *databrokerpb.ReleaseLeaseRequest) (*emptypb.Empty, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorizeWrite(ctx, "")
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.ReleaseLease(ctx, req)
 }
 
 func (srv *securedServer) RenewLease(ctx context.Context, req *databrokerpb.RenewLeaseRequest) (*emptypb.Empty, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorizeWrite(ctx, "")
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.RenewLease(ctx, req)
 }
 
 func (srv *securedServer) Report(ctx context.Context, req *registrypb.RegisterRequest) (*registrypb.RegisterResponse, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorize(ctx)
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.Report(ctx, req)
 }
 
 func (srv *securedServer) ServerInfo(ctx context.Context, req *emptypb.Empty) (*databrokerpb.ServerInfoResponse, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorize(ctx)
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.ServerInfo(ctx, req)
 }
 
 func (srv *securedServer) SetCheckpoint(ctx context.Context, req *databrokerpb.SetCheckpointRequest) (*databrokerpb.SetCheckpointResponse, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorizeWrite(ctx, "")
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.SetCheckpoint(ctx, req)
 }
 
 func (srv *securedServer) SetOptions(ctx context.Context, req *databrokerpb.SetOptionsRequest) (*databrokerpb.SetOptionsResponse, error) {
-	if err := srv.authorize(ctx); err != nil {
+	ctx, err := srv.authorizeWrite(ctx, req.GetType())
+	if err != nil {
 		return nil, err
 	}
 	return srv.underlying.SetOptions(ctx, req)
 }
 
+func (srv *securedServer) Stats(ctx context.Context, req *databrokerpb.StatsRequest) (*databrokerpb.StatsResponse, error) {
+	ctx, err := srv.authorizeRead(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	return srv.underlying.Stats(ctx, req)
+}
+
 func (srv *securedServer) Sync(req *databrokerpb.SyncRequest, stream grpc.ServerStreamingServer[databrokerpb.SyncResponse]) error {
-	if err := srv.authorize(stream.Context()); err != nil {
+	if _, err := srv.authorizeRead(stream.Context(), req.GetType()); err != nil {
 		return err
 	}
 	return srv.underlying.Sync(req, stream)
 }
 
 func (srv *securedServer) SyncLatest(req *databrokerpb.SyncLatestRequest, stream grpc.ServerStreamingServer[databrokerpb.SyncLatestResponse]) error {
-	if err := srv.authorize(stream.Context()); err != nil {
+	if _, err := srv.authorizeRead(stream.Context(), req.GetType()); err != nil {
 		return err
 	}
 	return srv.underlying.SyncLatest(req, stream)
 }
 
 func (srv *securedServer) Watch(req *registrypb.ListRequest, stream grpc.ServerStreamingServer[registrypb.ServiceList]) error {
-	if err := srv.authorize(stream.Context()); err != nil {
+	if _, err := srv.authorize(stream.Context()); err != nil {
 		return err
 	}
 	return srv.underlying.Watch(req, stream)
 }
 
+func (srv *securedServer) WatchRecord(req *databrokerpb.WatchRecordRequest, stream grpc.ServerStreamingServer[databrokerpb.WatchRecordResponse]) error {
+	if _, err := srv.authorizeRead(stream.Context(), req.GetType()); err != nil {
+		return err
+	}
+	return srv.underlying.WatchRecord(req, stream)
+}
+
 func (srv *securedServer) Stop() {
 	srv.underlying.Stop()
 }
 
 func (srv *securedServer) OnConfigChange(ctx context.Context, cfg *config.Config) {
 	srv.underlying.OnConfigChange(ctx, cfg)
+	srv.updateAuthorization(ctx, cfg)
+}
 
+// updateAuthorization stores the shared key and acl used to authorize requests.
+func (srv *securedServer) updateAuthorization(ctx context.Context, cfg *config.Config) {
 	sharedKey, err := cfg.Options.GetSharedKey()
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("databroker: failed to load shared key")
@@ -170,12 +207,83 @@ func (srv *securedServer) OnConfigChange(ctx context.Context, cfg *config.Config
 	}
 	sharedKey = slices.Clone(sharedKey)
 	srv.sharedKey.Store(&sharedKey)
+	acl := slices.Clone(cfg.Options.DataBroker.ACL)
+	srv.acl.Store(&acl)
 }
 
-func (srv *securedServer) authorize(ctx context.Context) error {
+// authorize verifies the JWT and returns a context with the JWT's claims.
+func (srv *securedServer) authorize(ctx context.Context) (context.Context, error) {
+	claims, err := srv.verify(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	return grpcutil.NewContextWithJWTClaims(ctx, claims), nil
+}
+
+func (srv *securedServer) authorizeRead(ctx context.Context, recordType string) (context.Context, error) {
+	claims, err := srv.verify(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	acl := srv.acl.Load()
+	if err := checkACLService(acl, claims); err != nil {
+		return ctx, err
+	}
+	if acl != nil && !acl.AllowsRead(claims.Service, recordType) {
+		return ctx, status.Errorf(codes.PermissionDenied, "%s is not allowed to read %s", claims.Service, describeRecordType(recordType))
+	}
+	return grpcutil.NewContextWithJWTClaims(ctx, claims), nil
+}
+
+func (srv *securedServer) authorizeWrite(ctx context.Context, recordTypes ...string) (context.Context, error) {
+	claims, err := srv.verify(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	acl := srv.acl.Load()
+	if err := checkACLService(acl, claims); err != nil {
+		return ctx, err
+	}
+	if acl != nil {
+		for _, recordType := range recordTypes {
+			if !acl.AllowsWrite(claims.Service, recordType) {
+				return ctx, status.Errorf(codes.PermissionDenied, "%s is not allowed to write %s", claims.Service, describeRecordType(recordType))
+			}
+		}
+	}
+	return grpcutil.NewContextWithJWTClaims(ctx, claims), nil
+}
+
+func (srv *securedServer) verify(ctx context.Context) (*grpcutil.JWTClaims, error) {
 	sharedKey := srv.sharedKey.Load()
 	if sharedKey == nil {
-		return status.Error(codes.Unavailable, "no shared key defined")
+		return nil, status.Error(codes.Unavailable, "no shared key defined")
+	}
+	return grpcutil.VerifySignedJWT(ctx, *sharedKey)
+}
+
+// checkACLService denies callers that don't identify themselves when an acl is
+// configured. Otherwise an unnamed caller would bypass every rule.
+func checkACLService(acl *config.DataBrokerACL, claims *grpcutil.JWTClaims) error {
+	if acl != nil && len(*acl) > 0 && claims.Service == "" {
+		return status.Error(codes.PermissionDenied, "a service name is required when a databroker acl is configured")
+	}
+	return nil
+}
+
+func getRecordTypes(records []*databrokerpb.Record) []string {
+	var types []string
+	for _, record := range records {
+		if !slices.Contains(types, record.GetType()) {
+			types = append(types, record.GetType())
+		}
+	}
+	return types
+}
+
+func describeRecordType(recordType string) string {
+	if recordType == "" {
+		return "all record types"
 	}
-	return grpcutil.RequireSignedJWT(ctx, *sharedKey)
+	return "records of type " + recordType
 }
@@ -1,8 +1,10 @@
 package databroker_test
 
 import (
+	"context"
 	"encoding/base64"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -10,6 +12,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/pomerium/pomerium/config"
@@ -18,6 +21,7 @@ import (
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/protoutil"
 )
 
 func TestSecuredServer(t *testing.T) {
@@ -47,3 +51,73 @@ func TestSecuredServer(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, res)
 }
+
+func TestSecuredServer_ACL(t *testing.T) {
+	t.Parallel()
+
+	underlying := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(underlying.Stop)
+	secured := databroker.NewSecuredServer(underlying)
+
+	sharedKey := cryptutil.NewKey()
+	secured.OnConfigChange(t.Context(), &config.Config{
+		Options: &config.Options{
+			SharedKey: base64.StdEncoding.EncodeToString(sharedKey),
+			DataBroker: config.DataBrokerOptions{
+				StorageType: config.StorageInMemoryName,
+				ACL: config.DataBrokerACL{
+					{Service: "proxy", Read: []string{"*"}, Write: []string{"type-a"}},
+				},
+			},
+		},
+	})
+
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, secured)
+		databrokerpb.RegisterCheckpointServiceServer(s, secured)
+	})
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+	checkpointClient := databrokerpb.NewCheckpointServiceClient(cc)
+
+	put := func(ctx context.Context, recordType string) error {
+		_, err := client.Put(ctx, &databrokerpb.PutRequest{
+			Records: []*databrokerpb.Record{{Type: recordType, Id: "r1", Data: protoutil.NewAnyString("v1")}},
+		})
+		return err
+	}
+
+	proxyCtx, err := grpcutil.WithSignedJWT(t.Context(), sharedKey, grpcutil.WithJWTService("proxy"))
+	require.NoError(t, err)
+	otherCtx, err := grpcutil.WithSignedJWT(t.Context(), sharedKey, grpcutil.WithJWTService("authorize"))
+	require.NoError(t, err)
+
+	assert.NoError(t, put(proxyCtx, "type-a"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(put(proxyCtx, "type-b")),
+		"should deny writes to types not in the acl")
+	assert.NoError(t, put(otherCtx, "type-b"),
+		"should not restrict services without a rule")
+
+	_, err = client.Get(proxyCtx, &databrokerpb.GetRequest{Type: "type-b", Id: "r1"})
+	assert.NoError(t, err, "should allow reads matching a wildcard")
+
+	_, err = client.Clear(proxyCtx, new(emptypb.Empty))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err),
+		"should require write access to all types to clear")
+
+	_, err = client.AcquireLease(proxyCtx, &databrokerpb.AcquireLeaseRequest{Name: "lease", Duration: durationpb.New(time.Minute)})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err),
+		"should require write access to all types to acquire a lease")
+	_, err = checkpointClient.SetCheckpoint(proxyCtx, &databrokerpb.SetCheckpointRequest{Checkpoint: &databrokerpb.Checkpoint{}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err),
+		"should require write access to all types to set a checkpoint")
+	_, err = client.ListTypes(proxyCtx, new(emptypb.Empty))
+	assert.NoError(t, err, "should allow listing types with read access to all types")
+
+	anonymousCtx, err := grpcutil.WithSignedJWT(t.Context(), sharedKey)
+	require.NoError(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(put(anonymousCtx, "type-b")),
+		"should deny callers without a service name")
+	_, err = client.ListTypes(anonymousCtx, new(emptypb.Empty))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err),
+		"should deny callers without a service name")
+}
@@ -0,0 +1,74 @@
+package databroker
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/config"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+// A SessionServer implements the session service.
+type SessionServer interface {
+	session.SessionServiceServer
+
+	OnConfigChange(ctx context.Context, cfg *config.Config)
+}
+
+type sessionServer struct {
+	getClient func() databrokerpb.DataBrokerServiceClient
+	auth      securedServer
+}
+
+// NewSessionServer creates a new SessionServer which revokes sessions using
+// the databroker client returned by getClient. Every method requires a signed
+// JWT for a service which is allowed to write sessions.
+func NewSessionServer(getClient func() databrokerpb.DataBrokerServiceClient) SessionServer {
+	return &sessionServer{getClient: getClient}
+}
+
+func (srv *sessionServer) RevokeSession(ctx context.Context, req *session.RevokeSessionRequest) (*session.RevokeSessionResponse, error) {
+	if req.GetSessionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+
+	ctx, err := srv.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = session.Revoke(ctx, srv.getClient(), req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+	return new(session.RevokeSessionResponse), nil
+}
+
+func (srv *sessionServer) RevokeUserSessions(ctx context.Context, req *session.RevokeUserSessionsRequest) (*session.RevokeUserSessionsResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	ctx, err := srv.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionIDs, err := session.RevokeUser(ctx, srv.getClient(), req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &session.RevokeUserSessionsResponse{SessionIds: sessionIDs}, nil
+}
+
+func (srv *sessionServer) OnConfigChange(ctx context.Context, cfg *config.Config) {
+	srv.auth.updateAuthorization(ctx, cfg)
+}
+
+func (srv *sessionServer) authorize(ctx context.Context) (context.Context, error) {
+	return srv.auth.authorizeWrite(ctx, protoutil.GetTypeURL(new(session.Session)))
+}
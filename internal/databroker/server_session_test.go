@@ -0,0 +1,79 @@
+package databroker_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+func TestSessionServer(t *testing.T) {
+	t.Parallel()
+
+	local := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(local.Stop)
+
+	var dataBrokerClient databrokerpb.DataBrokerServiceClient
+	srv := databroker.NewSessionServer(func() databrokerpb.DataBrokerServiceClient { return dataBrokerClient })
+
+	sharedKey := cryptutil.NewKey()
+	cfg := &config.Config{
+		Options: &config.Options{
+			SharedKey: base64.StdEncoding.EncodeToString(sharedKey),
+		},
+	}
+	local.OnConfigChange(t.Context(), cfg)
+	srv.OnConfigChange(t.Context(), cfg)
+
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, local)
+		session.RegisterSessionServiceServer(s, srv)
+	})
+	dataBrokerClient = databrokerpb.NewDataBrokerServiceClient(cc)
+	client := session.NewSessionServiceClient(cc)
+
+	ctx, err := grpcutil.WithSignedJWT(t.Context(), sharedKey)
+	require.NoError(t, err)
+
+	for _, s := range []*session.Session{
+		{Id: "s1", UserId: "u1"},
+		{Id: "s2", UserId: "u1"},
+		{Id: "s3", UserId: "u2"},
+	} {
+		_, err := session.Put(ctx, dataBrokerClient, s)
+		require.NoError(t, err)
+	}
+
+	_, err = client.RevokeSession(t.Context(), &session.RevokeSessionRequest{SessionId: "s3"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err),
+		"should require a signed jwt")
+
+	_, err = client.RevokeSession(ctx, &session.RevokeSessionRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.RevokeSession(ctx, &session.RevokeSessionRequest{SessionId: "s3"})
+	require.NoError(t, err)
+	_, err = session.Get(ctx, dataBrokerClient, "s3")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	res, err := client.RevokeUserSessions(ctx, &session.RevokeUserSessionsRequest{UserId: "u1"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"s1", "s2"}, res.GetSessionIds())
+	for _, id := range []string{"s1", "s2"} {
+		_, err = session.Get(ctx, dataBrokerClient, id)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	}
+}
@@ -0,0 +1,54 @@
+package databroker
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// A syncLimiter limits the number of records and bytes sent per second on a
+// single sync stream, so that one stream can't monopolize the backend.
+type syncLimiter struct {
+	records *rate.Limiter
+	bytes   *rate.Limiter
+}
+
+// newSyncLimiter creates a new syncLimiter. A limit of 0 disables that limit.
+func newSyncLimiter(maxRecordsPerSecond, maxBytesPerSecond int) *syncLimiter {
+	l := new(syncLimiter)
+	if maxRecordsPerSecond > 0 {
+		l.records = rate.NewLimiter(rate.Limit(maxRecordsPerSecond), maxRecordsPerSecond)
+	}
+	if maxBytesPerSecond > 0 {
+		l.bytes = rate.NewLimiter(rate.Limit(maxBytesPerSecond), maxBytesPerSecond)
+	}
+	return l
+}
+
+// wait waits until a record of the given size may be sent and returns how
+// long the stream was throttled.
+func (l *syncLimiter) wait(ctx context.Context, size int) (time.Duration, error) {
+	now := time.Now()
+	var delay time.Duration
+	if l.records != nil {
+		delay = max(delay, l.records.ReserveN(now, 1).DelayFrom(now))
+	}
+	if l.bytes != nil {
+		// a record larger than the budget is sent once the whole budget is
+		// available
+		delay = max(delay, l.bytes.ReserveN(now, min(size, l.bytes.Burst())).DelayFrom(now))
+	}
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return delay, context.Cause(ctx)
+	case <-timer.C:
+	}
+	return delay, nil
+}
@@ -0,0 +1,64 @@
+package databroker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unlimited", func(t *testing.T) {
+		t.Parallel()
+
+		l := newSyncLimiter(0, 0)
+		for range 100 {
+			delay, err := l.wait(t.Context(), 1024)
+			require.NoError(t, err)
+			assert.Zero(t, delay)
+		}
+	})
+	t.Run("records", func(t *testing.T) {
+		t.Parallel()
+
+		l := newSyncLimiter(10, 0)
+		var total time.Duration
+		for range 15 {
+			delay, err := l.wait(t.Context(), 1)
+			require.NoError(t, err)
+			total += delay
+		}
+		assert.Greater(t, total, 300*time.Millisecond,
+			"should throttle records after the burst")
+	})
+	t.Run("bytes", func(t *testing.T) {
+		t.Parallel()
+
+		l := newSyncLimiter(0, 1000)
+		delay, err := l.wait(t.Context(), 5000)
+		require.NoError(t, err)
+		assert.Zero(t, delay, "should allow a large record when the budget is full")
+
+		delay, err = l.wait(t.Context(), 500)
+		require.NoError(t, err)
+		assert.Greater(t, delay, 400*time.Millisecond,
+			"should throttle until the budget is refilled")
+	})
+	t.Run("canceled", func(t *testing.T) {
+		t.Parallel()
+
+		l := newSyncLimiter(1, 0)
+		_, err := l.wait(t.Context(), 1)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		delay, err := l.wait(ctx, 1)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Greater(t, delay, time.Duration(0))
+	})
+}
@@ -3,10 +3,13 @@ package fileutil
 import (
 	"cmp"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 	"sync"
 	"time"
 
@@ -18,12 +21,43 @@ import (
 	"github.com/pomerium/pomerium/internal/signal"
 )
 
+// A FileChangeKind describes how a file changed.
+type FileChangeKind int
+
 const (
-	pollingInterval = time.Millisecond * 200
+	// FileCreated indicates that the file was created.
+	FileCreated FileChangeKind = iota + 1
+	// FileModified indicates that the contents or metadata of the file changed.
+	FileModified
+	// FileRemoved indicates that the file was removed.
+	FileRemoved
 )
 
+// String returns the string representation of the file change kind.
+func (kind FileChangeKind) String() string {
+	switch kind {
+	case FileCreated:
+		return "created"
+	case FileModified:
+		return "modified"
+	case FileRemoved:
+		return "removed"
+	}
+	return fmt.Sprintf("FileChangeKind(%d)", int(kind))
+}
+
+// A FileChange is a change to a watched file.
+type FileChange struct {
+	Path string
+	Kind FileChangeKind
+}
+
+// A FileChangeListener is called with the changes detected by a Watcher.
+type FileChangeListener = func(ctx context.Context, changes []FileChange)
+
 type watchedFile struct {
 	path    string
+	exists  bool
 	size    int64
 	modTime int64
 	hash    uint64
@@ -34,8 +68,10 @@ func newWatchedFile(path string) *watchedFile {
 	return &watchedFile{path: path, force: true}
 }
 
-func (wf *watchedFile) check() (changed bool) {
+func (wf *watchedFile) check() (kind FileChangeKind, changed bool) {
 	fi, _ := os.Stat(wf.path)
+	existed := wf.exists
+	wf.exists = fi != nil
 	changed = swap(&wf.size, getFileSize(fi)) || changed
 	changed = swap(&wf.modTime, getFileModTime(fi)) || changed
 
@@ -45,30 +81,113 @@ func (wf *watchedFile) check() (changed bool) {
 		wf.force = false
 	}
 
-	return changed
+	switch {
+	case !existed && wf.exists:
+		return FileCreated, true
+	case existed && !wf.exists:
+		return FileRemoved, true
+	}
+	return FileModified, changed
+}
+
+type watchedTreeEntry struct {
+	isDir   bool
+	size    int64
+	modTime int64
+}
+
+// A watchedTree is a directory which is watched recursively. Changes are
+// detected using the paths, sizes and modification times of its entries.
+type watchedTree struct {
+	path    string
+	entries map[string]watchedTreeEntry
+}
+
+func newWatchedTree(path string) *watchedTree {
+	return &watchedTree{path: path, entries: map[string]watchedTreeEntry{}}
+}
+
+func (wt *watchedTree) check() (changes []FileChange) {
+	entries := map[string]watchedTreeEntry{}
+	_ = filepath.WalkDir(wt.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// skip entries that can't be read
+			return nil
+		}
+		fi, _ := d.Info()
+		entries[path] = watchedTreeEntry{
+			isDir:   d.IsDir(),
+			size:    getFileSize(fi),
+			modTime: getFileModTime(fi),
+		}
+		return nil
+	})
+
+	for path, entry := range entries {
+		previous, ok := wt.entries[path]
+		switch {
+		case !ok:
+			changes = append(changes, FileChange{Path: path, Kind: FileCreated})
+		// a directory's modification time changes whenever its entries do,
+		// which is already reported for the entries themselves
+		case !entry.isDir && entry != previous:
+			changes = append(changes, FileChange{Path: path, Kind: FileModified})
+		}
+	}
+	for path := range wt.entries {
+		if _, ok := entries[path]; !ok {
+			changes = append(changes, FileChange{Path: path, Kind: FileRemoved})
+		}
+	}
+	wt.entries = entries
+
+	return changes
+}
+
+// directories returns the directories in the tree.
+func (wt *watchedTree) directories() []string {
+	var dirs []string
+	for path, entry := range wt.entries {
+		if entry.isDir {
+			dirs = append(dirs, path)
+		}
+	}
+	return dirs
 }
 
 // A Watcher watches files for changes.
 type Watcher struct {
 	*signal.Signal
 
+	cfg *watcherConfig
+
 	cancelCtx context.Context
 	cancel    context.CancelFunc
 
 	mu             sync.Mutex
 	notifyWatcher  *fsnotify.Watcher
+	checkPending   bool
+	listeners      map[*FileChangeListener]struct{}
+	pendingChanges []FileChange
+	changesReady   chan struct{}
 	filePaths      []string
 	files          map[string]*watchedFile
+	treePaths      []string
+	trees          map[string]*watchedTree
 	directoryPaths []string
 	directories    map[string]struct{}
 }
 
 // NewWatcher creates a new Watcher.
-func NewWatcher() *Watcher {
+func NewWatcher(options ...WatcherOption) *Watcher {
 	w := &Watcher{
-		Signal:      signal.New(),
-		files:       map[string]*watchedFile{},
-		directories: map[string]struct{}{},
+		Signal:       signal.New(),
+		cfg:          getWatcherConfig(options...),
+		listeners:    map[*FileChangeListener]struct{}{},
+		changesReady: make(chan struct{}, 1),
+		files:        map[string]*watchedFile{},
+		trees:        map[string]*watchedTree{},
+		directories:  map[string]struct{}{},
 	}
 	w.cancelCtx, w.cancel = context.WithCancel(context.Background())
 
@@ -80,6 +199,7 @@ func NewWatcher() *Watcher {
 
 	go w.handlePolling()
 	go w.handleNotifications()
+	go w.handleChanges()
 
 	return w
 }
@@ -106,14 +226,50 @@ func (w *Watcher) Watch(filePaths []string) {
 	defer w.mu.Unlock()
 
 	w.filePaths = set.TreeSetFrom(filePaths, cmp.Compare[string]).Slice()
+	w.checkLocked()
+}
+
+// OnChange adds a listener which is called with the paths that changed and
+// how they changed. Listeners are called sequentially, in the order the
+// changes were detected, and never while the watcher is locked, so a listener
+// may update the watched paths. The returned function removes the listener.
+func (w *Watcher) OnChange(li FileChangeListener) (remove func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	key := &li
+	w.listeners[key] = struct{}{}
+	return func() {
+		w.mu.Lock()
+		delete(w.listeners, key)
+		w.mu.Unlock()
+	}
+}
+
+// WatchRecursive updates the directories which are watched recursively. Any
+// change to a file or directory within them is reported as a change.
+func (w *Watcher) WatchRecursive(directoryPaths []string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.treePaths = set.TreeSetFrom(directoryPaths, cmp.Compare[string]).Slice()
+	w.checkLocked()
+}
 
+func (w *Watcher) updateDirectoryPathsLocked() {
 	var dps []string
-	for _, fp := range filePaths {
+	for _, fp := range w.filePaths {
 		dps = append(dps, filepath.Dir(fp))
 	}
+	for _, tp := range w.treePaths {
+		// the parent is watched so that the tree being created or removed
+		// is noticed
+		dps = append(dps, filepath.Dir(tp))
+		if wt, ok := w.trees[tp]; ok {
+			dps = append(dps, wt.directories()...)
+		}
+	}
 	w.directoryPaths = set.TreeSetFrom(dps, cmp.Compare[string]).Slice()
-
-	w.checkLocked()
 }
 
 func (w *Watcher) handleNotifications() {
@@ -129,23 +285,92 @@ func (w *Watcher) handleNotifications() {
 		select {
 		case <-w.cancelCtx.Done():
 			return
-		case err := <-nw.Errors:
+		case err, ok := <-nw.Errors:
+			if !ok {
+				return
+			}
 			log.Debug().Err(err).Msg("fileutil/watcher: filesystem notification error")
-		case evt := <-nw.Events:
-			if evt.Has(fsnotify.Create) || evt.Has(fsnotify.Remove) || evt.Has(fsnotify.Write) {
+			if errors.Is(err, fsnotify.ErrEventOverflow) {
+				// events were dropped, so check everything
+				w.mu.Lock()
+				for _, wf := range w.files {
+					wf.force = true
+				}
+				w.scheduleCheckLocked()
+				w.mu.Unlock()
+			}
+		case evt, ok := <-nw.Events:
+			if !ok {
+				return
+			}
+			if evt.Has(fsnotify.Create) || evt.Has(fsnotify.Remove) || evt.Has(fsnotify.Write) || evt.Has(fsnotify.Rename) {
 				w.mu.Lock()
 				if wf, ok := w.files[evt.Name]; ok {
 					wf.force = true
 				}
+				w.scheduleCheckLocked()
 				w.mu.Unlock()
-				// the actual check will be done via the polling interval to debounce
 			}
 		}
 	}
 }
 
+// scheduleCheckLocked schedules a check after the coalesce interval in notify
+// mode. In polling mode the check will be done via the polling interval.
+func (w *Watcher) scheduleCheckLocked() {
+	if w.cfg.mode != WatcherModeNotify || w.checkPending {
+		return
+	}
+
+	w.checkPending = true
+	time.AfterFunc(w.cfg.coalesceInterval, func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		w.checkPending = false
+		if w.cancelCtx.Err() == nil {
+			w.checkLocked()
+		}
+	})
+}
+
+func (w *Watcher) handleChanges() {
+	for {
+		select {
+		case <-w.cancelCtx.Done():
+			return
+		case <-w.changesReady:
+		}
+
+		w.mu.Lock()
+		changes := w.pendingChanges
+		w.pendingChanges = nil
+		listeners := make([]FileChangeListener, 0, len(w.listeners))
+		for li := range w.listeners {
+			listeners = append(listeners, *li)
+		}
+		w.mu.Unlock()
+
+		if len(changes) == 0 {
+			continue
+		}
+		for _, li := range listeners {
+			li(w.cancelCtx, changes)
+		}
+	}
+}
+
 func (w *Watcher) handlePolling() {
-	ticker := time.NewTicker(pollingInterval)
+	interval := w.cfg.pollingInterval
+	if w.cfg.mode == WatcherModeNotify {
+		w.mu.Lock()
+		if w.notifyWatcher != nil {
+			interval = notifyResyncInterval
+		}
+		w.mu.Unlock()
+	}
+
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
 	for {
@@ -162,11 +387,29 @@ func (w *Watcher) handlePolling() {
 }
 
 func (w *Watcher) checkLocked() {
+	changes := w.checkTreesLocked()
+	w.updateDirectoryPathsLocked()
 	w.checkDirectoriesLocked()
-	if changedPaths := w.checkFilesLocked(); len(changedPaths) > 0 {
-		log.Ctx(w.cancelCtx).Info().Strs("paths", changedPaths).Msg("fileutil/watcher: file change event")
-		w.Signal.Broadcast(w.cancelCtx)
+	changes = append(changes, w.checkFilesLocked()...)
+	if len(changes) == 0 {
+		return
+	}
+
+	slices.SortFunc(changes, func(x, y FileChange) int {
+		return cmp.Compare(x.Path, y.Path)
+	})
+	changedPaths := make([]string, len(changes))
+	for i, change := range changes {
+		changedPaths[i] = change.Path
 	}
+	log.Ctx(w.cancelCtx).Info().Strs("paths", changedPaths).Msg("fileutil/watcher: file change event")
+
+	w.pendingChanges = append(w.pendingChanges, changes...)
+	select {
+	case w.changesReady <- struct{}{}:
+	default:
+	}
+	w.Signal.Broadcast(w.cancelCtx)
 }
 
 func (w *Watcher) checkDirectoriesLocked() {
@@ -195,12 +438,31 @@ func (w *Watcher) checkDirectoriesLocked() {
 		})
 }
 
-func (w *Watcher) checkFilesLocked() (changedPaths []string) {
+func (w *Watcher) checkTreesLocked() (changes []FileChange) {
+	updateMap(w.trees, w.treePaths,
+		func(tp string) *watchedTree {
+			log.Ctx(w.cancelCtx).Debug().Str("path", tp).Msg("fileutil/watcher: watching directory tree")
+			wt := newWatchedTree(tp)
+			_ = wt.check()
+			return wt
+		},
+		func(tp string, _ *watchedTree) {
+			log.Ctx(w.cancelCtx).Debug().Str("path", tp).Msg("fileutil/watcher: stopped watching directory tree")
+		})
+
+	for _, wt := range w.trees {
+		changes = append(changes, wt.check()...)
+	}
+
+	return changes
+}
+
+func (w *Watcher) checkFilesLocked() (changes []FileChange) {
 	updateMap(w.files, w.filePaths,
 		func(fp string) *watchedFile {
 			log.Ctx(w.cancelCtx).Debug().Str("path", fp).Msg("fileutil/watcher: watching file")
 			wf := newWatchedFile(fp)
-			wf.check()
+			_, _ = wf.check()
 			return wf
 		},
 		func(fp string, _ *watchedFile) {
@@ -208,12 +470,12 @@ func (w *Watcher) checkFilesLocked() (changedPaths []string) {
 		})
 
 	for fp, wf := range w.files {
-		if wf.check() {
-			changedPaths = append(changedPaths, fp)
+		if kind, changed := wf.check(); changed {
+			changes = append(changes, FileChange{Path: fp, Kind: kind})
 		}
 	}
 
-	return changedPaths
+	return changes
 }
 
 func getFileSize(fi fs.FileInfo) int64 {
@@ -0,0 +1,82 @@
+package fileutil
+
+import (
+	"fmt"
+	"time"
+)
+
+// A WatcherMode determines how a Watcher detects changes.
+type WatcherMode int
+
+const (
+	// WatcherModePolling checks the watched files every polling interval.
+	// File system notifications are only used to detect changes that don't
+	// affect a file's size or modification time.
+	WatcherModePolling WatcherMode = iota
+	// WatcherModeNotify checks the watched files when a file system
+	// notification is received. Polling is used as a fallback when
+	// notifications are unavailable or have been dropped.
+	WatcherModeNotify
+)
+
+// String returns the string representation of the watcher mode.
+func (mode WatcherMode) String() string {
+	switch mode {
+	case WatcherModePolling:
+		return "polling"
+	case WatcherModeNotify:
+		return "notify"
+	}
+	return fmt.Sprintf("WatcherMode(%d)", int(mode))
+}
+
+const (
+	defaultPollingInterval  = time.Millisecond * 200
+	defaultCoalesceInterval = time.Millisecond * 50
+	// notifyResyncInterval is how often all the files are checked in notify
+	// mode, to catch changes in directories that did not exist yet
+	notifyResyncInterval = time.Second * 10
+)
+
+type watcherConfig struct {
+	mode             WatcherMode
+	pollingInterval  time.Duration
+	coalesceInterval time.Duration
+}
+
+// A WatcherOption customizes the watcher config.
+type WatcherOption func(cfg *watcherConfig)
+
+// WithWatcherMode sets the watcher mode in the watcher config.
+func WithWatcherMode(mode WatcherMode) WatcherOption {
+	return func(cfg *watcherConfig) {
+		cfg.mode = mode
+	}
+}
+
+// WithPollingInterval sets the polling interval in the watcher config.
+func WithPollingInterval(interval time.Duration) WatcherOption {
+	return func(cfg *watcherConfig) {
+		cfg.pollingInterval = interval
+	}
+}
+
+// WithCoalesceInterval sets how long to wait for more file system
+// notifications before checking for changes in notify mode, so that a burst
+// of notifications results in a single change signal.
+func WithCoalesceInterval(interval time.Duration) WatcherOption {
+	return func(cfg *watcherConfig) {
+		cfg.coalesceInterval = interval
+	}
+}
+
+func getWatcherConfig(options ...WatcherOption) *watcherConfig {
+	cfg := new(watcherConfig)
+	WithWatcherMode(WatcherModePolling)(cfg)
+	WithPollingInterval(defaultPollingInterval)(cfg)
+	WithCoalesceInterval(defaultCoalesceInterval)(cfg)
+	for _, option := range options {
+		option(cfg)
+	}
+	return cfg
+}
@@ -2,6 +2,7 @@ package fileutil
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -138,6 +139,128 @@ func TestWatcher_UnWatch(t *testing.T) {
 	expectNoChange(t, ch)
 }
 
+func TestWatcher_Notify(t *testing.T) {
+	t.Parallel()
+
+	tmpdir := t.TempDir()
+	nm := filepath.Join(tmpdir, "test1.txt")
+	now := time.Now()
+
+	require.NoError(t, os.WriteFile(nm, []byte{1, 2, 3, 4}, 0o666))
+	require.NoError(t, os.Chtimes(nm, now, now))
+
+	w := NewWatcher(WithWatcherMode(WatcherModeNotify))
+	defer w.Close()
+	w.Watch([]string{nm})
+
+	ch := w.Bind()
+	t.Cleanup(func() { w.Unbind(ch) })
+
+	require.NoError(t, os.WriteFile(nm, []byte{5, 6, 7, 8}, 0o666))
+	require.NoError(t, os.Chtimes(nm, now, now))
+	expectChange(t, ch)
+
+	require.NoError(t, os.Remove(nm))
+	expectChange(t, ch)
+
+	expectNoChange(t, ch)
+}
+
+func TestWatcher_Recursive(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []WatcherMode{WatcherModePolling, WatcherModeNotify} {
+		t.Run(fmt.Sprint(mode), func(t *testing.T) {
+			t.Parallel()
+
+			tmpdir := t.TempDir()
+			root := filepath.Join(tmpdir, "root")
+			require.NoError(t, os.MkdirAll(filepath.Join(root, "a"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(root, "a", "test1.txt"), []byte{1}, 0o666))
+
+			w := NewWatcher(WithWatcherMode(mode))
+			defer w.Close()
+			w.WatchRecursive([]string{root})
+
+			ch := w.Bind()
+			t.Cleanup(func() { w.Unbind(ch) })
+
+			require.NoError(t, os.WriteFile(filepath.Join(root, "a", "test1.txt"), []byte{1, 2}, 0o666))
+			expectChange(t, ch)
+
+			require.NoError(t, os.Mkdir(filepath.Join(root, "b"), 0o755))
+			expectChange(t, ch)
+
+			require.NoError(t, os.WriteFile(filepath.Join(root, "b", "test2.txt"), []byte{1}, 0o666))
+			expectChange(t, ch)
+
+			require.NoError(t, os.RemoveAll(filepath.Join(root, "a")))
+			expectChange(t, ch)
+
+			w.WatchRecursive(nil)
+			require.NoError(t, os.WriteFile(filepath.Join(root, "b", "test2.txt"), []byte{1, 2}, 0o666))
+			expectNoChange(t, ch)
+		})
+	}
+}
+
+func TestWatcher_OnChange(t *testing.T) {
+	t.Parallel()
+
+	tmpdir := t.TempDir()
+	fp := filepath.Join(tmpdir, "test1.txt")
+	root := filepath.Join(tmpdir, "root")
+	require.NoError(t, os.Mkdir(root, 0o755))
+
+	w := NewWatcher()
+	defer w.Close()
+	w.Watch([]string{fp})
+	w.WatchRecursive([]string{root})
+
+	ch := make(chan []FileChange, 10)
+	remove := w.OnChange(func(_ context.Context, changes []FileChange) {
+		ch <- changes
+	})
+
+	require.NoError(t, os.WriteFile(fp, []byte{1}, 0o666))
+	assert.Equal(t, []FileChange{{Path: fp, Kind: FileCreated}}, waitForChanges(t, ch))
+
+	require.NoError(t, os.WriteFile(fp, []byte{1, 2}, 0o666))
+	assert.Equal(t, []FileChange{{Path: fp, Kind: FileModified}}, waitForChanges(t, ch))
+
+	require.NoError(t, os.Remove(fp))
+	assert.Equal(t, []FileChange{{Path: fp, Kind: FileRemoved}}, waitForChanges(t, ch))
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "test2.txt"), []byte{1}, 0o666))
+	assert.Equal(t, []FileChange{{Path: filepath.Join(root, "test2.txt"), Kind: FileCreated}}, waitForChanges(t, ch))
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "test2.txt"), []byte{1, 2}, 0o666))
+	assert.Equal(t, []FileChange{{Path: filepath.Join(root, "test2.txt"), Kind: FileModified}}, waitForChanges(t, ch))
+
+	require.NoError(t, os.Remove(filepath.Join(root, "test2.txt")))
+	assert.Equal(t, []FileChange{{Path: filepath.Join(root, "test2.txt"), Kind: FileRemoved}}, waitForChanges(t, ch))
+
+	remove()
+	require.NoError(t, os.WriteFile(fp, []byte{1}, 0o666))
+	select {
+	case changes := <-ch:
+		assert.Fail(t, "should not call a removed listener", "changes: %v", changes)
+	case <-time.After(2 * defaultPollingInterval):
+	}
+}
+
+func waitForChanges(t *testing.T, ch chan []FileChange) []FileChange {
+	t.Helper()
+
+	select {
+	case changes := <-ch:
+		return changes
+	case <-time.After(2 * defaultPollingInterval):
+	}
+	assert.Fail(t, "should report changes")
+	return nil
+}
+
 func expectChange(t *testing.T, ch chan context.Context) {
 	t.Helper()
 
@@ -145,7 +268,7 @@ func expectChange(t *testing.T, ch chan context.Context) {
 	select {
 	case <-ch:
 		cnt++
-	case <-time.After(2 * pollingInterval):
+	case <-time.After(2 * defaultPollingInterval):
 	}
 	assert.Greater(t, cnt, 0, "should signal a change")
 }
@@ -157,7 +280,7 @@ func expectNoChange(t *testing.T, ch chan context.Context) {
 	select {
 	case <-ch:
 		cnt++
-	case <-time.After(2 * pollingInterval):
+	case <-time.After(2 * defaultPollingInterval):
 	}
 	assert.Equal(t, 0, cnt, "should not signal a change")
 }
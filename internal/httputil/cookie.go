@@ -1,23 +1,59 @@
 package httputil
 
 import (
+	"bytes"
+	"compress/flate"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
+
+	"github.com/klauspost/compress/zstd"
 )
 
-// ErrCookieTooLarge indicates that a cookie is too large.
-var ErrCookieTooLarge = errors.New("cookie too large")
+var (
+	// ErrCookieTooLarge indicates that a cookie is too large.
+	ErrCookieTooLarge = errors.New("cookie too large")
+	// ErrCookieChunksCorrupt indicates that the chunks of a cookie are missing,
+	// out of order or from different versions of the cookie.
+	ErrCookieChunksCorrupt = errors.New("cookie chunks corrupt")
+)
 
 const (
 	defaultCookieChunkerChunkSize = 3800
 	defaultCookieChunkerMaxChunks = 16
+
+	// maxDecompressedCookieSize limits the size of a decompressed cookie value.
+	maxDecompressedCookieSize = 1 << 20
+)
+
+// A CookieCompression is a compression algorithm used for cookie values.
+type CookieCompression string
+
+// cookie compression algorithms
+const (
+	CookieCompressionNone    CookieCompression = ""
+	CookieCompressionDeflate CookieCompression = "deflate"
+	CookieCompressionZstd    CookieCompression = "zstd"
+)
+
+// compressed cookie values are prefixed with a marker that can't appear in
+// base64 or JWT encoded values
+const (
+	cookieCompressionDeflateMarker = "~d~"
+	cookieCompressionZstdMarker    = "~z~"
 )
 
 type cookieChunkerConfig struct {
-	chunkSize int
-	maxChunks int
+	chunkSize   int
+	maxChunks   int
+	compression CookieCompression
+	key         []byte
 }
 
 // A CookieChunkerOption customizes the cookie chunker.
@@ -37,17 +73,117 @@ func WithCookieChunkerMaxChunks(maxChunks int) CookieChunkerOption {
 	}
 }
 
+// WithCookieChunkerCompression sets the compression algorithm used for cookie
+// values before they are chunked. Values are only stored compressed if doing
+// so makes them smaller. Compressed values are always decompressed when
+// loaded, regardless of this option.
+func WithCookieChunkerCompression(compression CookieCompression) CookieChunkerOption {
+	return func(cfg *cookieChunkerConfig) {
+		cfg.compression = compression
+	}
+}
+
+// WithCookieChunkerKey sets the key of the HMAC used to verify that the chunks
+// of a cookie are reassembled into the value that was set. Without a key the
+// HMAC still detects missing or mixed up chunks, but not forged ones.
+func WithCookieChunkerKey(key []byte) CookieChunkerOption {
+	return func(cfg *cookieChunkerConfig) {
+		cfg.key = key
+	}
+}
+
 func getCookieChunkerConfig(options ...CookieChunkerOption) *cookieChunkerConfig {
 	cfg := new(cookieChunkerConfig)
 	WithCookieChunkerChunkSize(defaultCookieChunkerChunkSize)(cfg)
 	WithCookieChunkerMaxChunks(defaultCookieChunkerMaxChunks)(cfg)
+	WithCookieChunkerCompression(CookieCompressionNone)(cfg)
 	for _, option := range options {
 		option(cfg)
 	}
 	return cfg
 }
 
+// A CookiePrefix is a cookie name prefix which browsers use to enforce cookie
+// attributes.
+//
+// https://developer.mozilla.org/en-US/docs/Web/HTTP/Reference/Headers/Set-Cookie#cookie_prefixes
+type CookiePrefix string
+
+// cookie prefixes
+const (
+	CookiePrefixNone CookiePrefix = ""
+	// CookiePrefixSecure requires the cookie to be Secure.
+	CookiePrefixSecure CookiePrefix = "__Secure-"
+	// CookiePrefixHost requires the cookie to be Secure, have a Path of "/"
+	// and have no Domain, which prevents subdomains from overwriting it.
+	CookiePrefixHost CookiePrefix = "__Host-"
+)
+
+// CookieName returns the name with the prefix added.
+func (prefix CookiePrefix) CookieName(name string) string {
+	if strings.HasPrefix(name, string(prefix)) {
+		return name
+	}
+	return string(prefix) + name
+}
+
+// ApplyCookiePrefix adds the prefix to the cookie's name and sets the
+// attributes required by the prefix.
+func ApplyCookiePrefix(cookie *http.Cookie, prefix CookiePrefix) {
+	cookie.Name = prefix.CookieName(cookie.Name)
+	switch prefix {
+	case CookiePrefixSecure:
+		cookie.Secure = true
+	case CookiePrefixHost:
+		cookie.Secure = true
+		cookie.Path = "/"
+		cookie.Domain = ""
+	}
+}
+
+// ParseCookieSameSite parses a SameSite attribute option. One of "strict",
+// "lax" or "none". Other values return the default mode.
+func ParseCookieSameSite(value string) http.SameSite {
+	switch strings.ToLower(value) {
+	case "strict":
+		return http.SameSiteStrictMode
+	case "lax":
+		return http.SameSiteLaxMode
+	case "none":
+		return http.SameSiteNoneMode
+	}
+	return http.SameSiteDefaultMode
+}
+
+// CookieAttributes override the attributes of a cookie. Zero values leave the
+// attribute unchanged.
+type CookieAttributes struct {
+	SameSite http.SameSite
+	Secure   *bool
+	Domain   string
+}
+
+// Apply applies the attribute overrides to a cookie. It should be called
+// before ApplyCookiePrefix so that the attributes required by the prefix
+// take precedence.
+func (attrs CookieAttributes) Apply(cookie *http.Cookie) {
+	if attrs.SameSite != 0 {
+		cookie.SameSite = attrs.SameSite
+	}
+	if attrs.Secure != nil {
+		cookie.Secure = *attrs.Secure
+	}
+	if attrs.Domain != "" {
+		cookie.Domain = attrs.Domain
+	}
+}
+
 // A CookieChunker breaks up a large cookie into multiple pieces.
+//
+// The first cookie is a header holding the number of chunks and an HMAC over
+// the full value, formatted as "{count}.{hmac}". Cookies set before the HMAC
+// was added hold only the number of chunks, and are loaded without
+// verification so that existing sessions keep working.
 type CookieChunker struct {
 	cfg *cookieChunkerConfig
 }
@@ -61,13 +197,18 @@ func NewCookieChunker(options ...CookieChunkerOption) *CookieChunker {
 
 // SetCookie sets a chunked cookie.
 func (cc *CookieChunker) SetCookie(w http.ResponseWriter, cookie *http.Cookie) error {
-	chunks := chunk(cookie.Value, cc.cfg.chunkSize)
+	value, err := CompressCookieValue(cookie.Value, cc.cfg.compression)
+	if err != nil {
+		return err
+	}
+
+	chunks := chunk(value, cc.cfg.chunkSize)
 	if len(chunks) > cc.cfg.maxChunks {
 		return ErrCookieTooLarge
 	}
 
 	sizeCookie := *cookie
-	sizeCookie.Value = strconv.Itoa(len(chunks))
+	sizeCookie.Value = strconv.Itoa(len(chunks)) + "." + cc.sign(value)
 	http.SetCookie(w, &sizeCookie)
 	for i, chunk := range chunks {
 		chunkCookie := *cookie
@@ -85,7 +226,8 @@ func (cc *CookieChunker) LoadCookie(r *http.Request, name string) (*http.Cookie,
 		return nil, err
 	}
 
-	size, err := strconv.Atoi(sizeCookie.Value)
+	rawSize, mac, hasMAC := strings.Cut(sizeCookie.Value, ".")
+	size, err := strconv.Atoi(rawSize)
 	if err != nil {
 		return nil, err
 	}
@@ -105,11 +247,27 @@ func (cc *CookieChunker) LoadCookie(r *http.Request, name string) (*http.Cookie,
 		}
 	}
 
+	if hasMAC && !hmac.Equal([]byte(mac), []byte(cc.sign(b.String()))) {
+		return nil, ErrCookieChunksCorrupt
+	}
+
+	value, err := DecompressCookieValue(b.String())
+	if err != nil {
+		return nil, err
+	}
+
 	cookie := *sizeCookie
-	cookie.Value = b.String()
+	cookie.Value = value
 	return &cookie, nil
 }
 
+// sign returns the HMAC of a cookie value.
+func (cc *CookieChunker) sign(value string) string {
+	h := hmac.New(sha256.New, cc.cfg.key)
+	_, _ = io.WriteString(h, value)
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
 func chunk(s string, size int) []string {
 	ss := make([]string, 0, len(s)/size+1)
 	for len(s) > 0 {
@@ -120,3 +278,89 @@ func chunk(s string, size int) []string {
 	}
 	return ss
 }
+
+// CompressCookieValue compresses a cookie value with the given algorithm. The
+// compressed value is prefixed with a marker identifying the algorithm. If
+// compression doesn't make the value smaller, the value is returned as-is.
+func CompressCookieValue(value string, compression CookieCompression) (string, error) {
+	var marker string
+	var buf bytes.Buffer
+	switch compression {
+	case CookieCompressionNone:
+		return value, nil
+	case CookieCompressionDeflate:
+		marker = cookieCompressionDeflateMarker
+		w, err := flate.NewWriter(&buf, flate.BestCompression)
+		if err != nil {
+			return "", err
+		}
+		_, err = io.WriteString(w, value)
+		if err != nil {
+			return "", err
+		}
+		err = w.Close()
+		if err != nil {
+			return "", err
+		}
+	case CookieCompressionZstd:
+		marker = cookieCompressionZstdMarker
+		w, err := zstd.NewWriter(&buf, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+		if err != nil {
+			return "", err
+		}
+		_, err = io.WriteString(w, value)
+		if err != nil {
+			return "", err
+		}
+		err = w.Close()
+		if err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("unknown cookie compression: %s", compression)
+	}
+
+	compressed := marker + base64.RawURLEncoding.EncodeToString(buf.Bytes())
+	if len(compressed) >= len(value) {
+		return value, nil
+	}
+	return compressed, nil
+}
+
+// DecompressCookieValue decompresses a cookie value compressed by
+// CompressCookieValue. Values without a compression marker are returned as-is.
+func DecompressCookieValue(value string) (string, error) {
+	var r io.Reader
+	switch {
+	case strings.HasPrefix(value, cookieCompressionDeflateMarker):
+		raw, err := base64.RawURLEncoding.DecodeString(value[len(cookieCompressionDeflateMarker):])
+		if err != nil {
+			return "", err
+		}
+		fr := flate.NewReader(bytes.NewReader(raw))
+		defer fr.Close()
+		r = fr
+	case strings.HasPrefix(value, cookieCompressionZstdMarker):
+		raw, err := base64.RawURLEncoding.DecodeString(value[len(cookieCompressionZstdMarker):])
+		if err != nil {
+			return "", err
+		}
+		zr, err := zstd.NewReader(bytes.NewReader(raw), zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return "", err
+		}
+		defer zr.Close()
+		r = zr
+	default:
+		return value, nil
+	}
+
+	bs, err := io.ReadAll(io.LimitReader(r, maxDecompressedCookieSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(bs) > maxDecompressedCookieSize {
+		return "", ErrCookieTooLarge
+	}
+	return string(bs), nil
+}
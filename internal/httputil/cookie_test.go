@@ -42,7 +42,7 @@ func TestCookieChunker(t *testing.T) {
 		res, err := client.Get(srv1.URL)
 		if assert.NoError(t, err) {
 			assert.Equal(t, []string{
-				"example=5",
+				"example=5." + cc.sign(strings.Repeat("x", 77)),
 				"example0=xxxxxxxxxxxxxxxx",
 				"example1=xxxxxxxxxxxxxxxx",
 				"example2=xxxxxxxxxxxxxxxx",
@@ -93,4 +93,119 @@ func TestCookieChunker(t *testing.T) {
 		client.Get(srv1.URL)
 		client.Get(srv2.URL)
 	})
+	t.Run("compression", func(t *testing.T) {
+		t.Parallel()
+
+		for _, compression := range []CookieCompression{CookieCompressionDeflate, CookieCompressionZstd} {
+			t.Run(string(compression), func(t *testing.T) {
+				t.Parallel()
+
+				value := strings.Repeat("session-state.", 1024)
+				cc := NewCookieChunker(WithCookieChunkerChunkSize(1024), WithCookieChunkerMaxChunks(2),
+					WithCookieChunkerCompression(compression))
+				srv1 := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					assert.NoError(t, cc.SetCookie(w, &http.Cookie{
+						Name:  "example",
+						Value: value,
+					}))
+				}))
+				defer srv1.Close()
+				srv2 := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+					// compressed values are loaded regardless of the compression option
+					cookie, err := NewCookieChunker(WithCookieChunkerChunkSize(1024), WithCookieChunkerMaxChunks(2)).
+						LoadCookie(r, "example")
+					if assert.NoError(t, err) {
+						assert.Equal(t, value, cookie.Value)
+					}
+				}))
+				defer srv2.Close()
+
+				jar, err := cookiejar.New(&cookiejar.Options{})
+				require.NoError(t, err)
+				client := &http.Client{Jar: jar}
+				res, err := client.Get(srv1.URL)
+				if assert.NoError(t, err) {
+					assert.True(t, strings.HasPrefix(res.Header.Values("Set-Cookie")[0], "example=1."),
+						"should fit in a single chunk")
+				}
+				client.Get(srv2.URL)
+			})
+		}
+	})
+
+	t.Run("integrity", func(t *testing.T) {
+		t.Parallel()
+
+		cc := NewCookieChunker(WithCookieChunkerChunkSize(4), WithCookieChunkerKey([]byte("KEY")))
+		load := func(cookies ...*http.Cookie) (*http.Cookie, error) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for _, c := range cookies {
+				r.AddCookie(c)
+			}
+			return cc.LoadCookie(r, "example")
+		}
+		set := func(value string) []*http.Cookie {
+			w := httptest.NewRecorder()
+			require.NoError(t, cc.SetCookie(w, &http.Cookie{Name: "example", Value: value}))
+			return w.Result().Cookies()
+		}
+
+		v1 := set("aaaabbbbcc")
+		v2 := set("xxxxyyyyzz")
+
+		cookie, err := load(v1...)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "aaaabbbbcc", cookie.Value)
+		}
+
+		_, err = load(v1[0], v1[1], v2[2], v1[3])
+		assert.ErrorIs(t, err, ErrCookieChunksCorrupt, "should reject mixed chunks")
+
+		_, err = load(v1[0], &http.Cookie{Name: "example0", Value: "bbbb"}, &http.Cookie{Name: "example1", Value: "aaaa"}, v1[3])
+		assert.ErrorIs(t, err, ErrCookieChunksCorrupt, "should reject reordered chunks")
+
+		_, err = load(&http.Cookie{Name: "example", Value: "2." + cc.sign("aaaabbbbcc")}, v1[1], v1[2], v1[3])
+		assert.ErrorIs(t, err, ErrCookieChunksCorrupt, "should reject a truncated count")
+
+		_, err = NewCookieChunker(WithCookieChunkerKey([]byte("OTHER"))).LoadCookie(func() *http.Request {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for _, c := range v1 {
+				r.AddCookie(c)
+			}
+			return r
+		}(), "example")
+		assert.ErrorIs(t, err, ErrCookieChunksCorrupt, "should reject a different key")
+
+		cookie, err = load(&http.Cookie{Name: "example", Value: "3"}, v1[1], v1[2], v1[3])
+		if assert.NoError(t, err, "should load cookies without an hmac") {
+			assert.Equal(t, "aaaabbbbcc", cookie.Value)
+		}
+	})
+
+	t.Run("incompressible", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := CompressCookieValue("abc", CookieCompressionZstd)
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", value, "should store small values uncompressed")
+	})
+}
+
+func TestApplyCookiePrefix(t *testing.T) {
+	t.Parallel()
+
+	cookie := &http.Cookie{Name: "_pomerium", Domain: "example.com", Path: "/app"}
+	ApplyCookiePrefix(cookie, CookiePrefixNone)
+	assert.Equal(t, &http.Cookie{Name: "_pomerium", Domain: "example.com", Path: "/app"}, cookie)
+
+	cookie = &http.Cookie{Name: "_pomerium", Domain: "example.com", Path: "/app"}
+	ApplyCookiePrefix(cookie, CookiePrefixSecure)
+	assert.Equal(t, &http.Cookie{Name: "__Secure-_pomerium", Domain: "example.com", Path: "/app", Secure: true}, cookie)
+
+	cookie = &http.Cookie{Name: "_pomerium", Domain: "example.com", Path: "/app"}
+	ApplyCookiePrefix(cookie, CookiePrefixHost)
+	assert.Equal(t, &http.Cookie{Name: "__Host-_pomerium", Path: "/", Secure: true}, cookie)
+
+	ApplyCookiePrefix(cookie, CookiePrefixHost)
+	assert.Equal(t, "__Host-_pomerium", cookie.Name, "should not add the prefix twice")
 }
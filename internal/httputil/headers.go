@@ -37,6 +37,14 @@ const (
 	HeaderPomeriumReproxyPolicyHMAC = "x-pomerium-reproxy-policy-hmac"
 	// HeaderPomeriumRoutingKey is a string used for routing user requests to a consistent upstream server.
 	HeaderPomeriumRoutingKey = "x-pomerium-routing-key"
+	// HeaderPomeriumRateLimitKey identifies the user or client IP address that
+	// requests are counted against when a route is rate limited.
+	HeaderPomeriumRateLimitKey = "x-pomerium-rate-limit-key"
+	// HeaderPomeriumSessionExpiresAt is set on the response to upgraded connections, like
+	// WebSockets, with the time the session expires in RFC 3339 format.
+	HeaderPomeriumSessionExpiresAt = "x-pomerium-session-expires-at"
+	// HeaderEnvoyUpstreamStreamDurationMS overrides the maximum duration of the upstream stream.
+	HeaderEnvoyUpstreamStreamDurationMS = "x-envoy-upstream-stream-duration-ms"
 )
 
 // HeadersContentSecurityPolicy are the content security headers added to the service's handlers
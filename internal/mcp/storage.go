@@ -5,15 +5,11 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
-	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/anypb"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	oauth21proto "github.com/pomerium/pomerium/internal/oauth21/gen"
 	rfc7591v1 "github.com/pomerium/pomerium/internal/rfc7591"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
-	"github.com/pomerium/pomerium/pkg/protoutil"
 )
 
 type Storage struct {
@@ -33,15 +29,8 @@ func (storage *Storage) RegisterClient(
 	ctx context.Context,
 	req *rfc7591v1.ClientRegistration,
 ) (string, error) {
-	data := protoutil.NewAny(req)
 	id := uuid.NewString()
-	_, err := storage.client.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{{
-			Id:   id,
-			Data: data,
-			Type: data.TypeUrl,
-		}},
-	})
+	_, err := databroker.NewTypedClient[rfc7591v1.ClientRegistration](storage.client).Put(ctx, id, req)
 	if err != nil {
 		return "", err
 	}
@@ -52,20 +41,10 @@ func (storage *Storage) GetClient(
 	ctx context.Context,
 	id string,
 ) (*rfc7591v1.ClientRegistration, error) {
-	v := new(rfc7591v1.ClientRegistration)
-	rec, err := storage.client.Get(ctx, &databroker.GetRequest{
-		Type: protoutil.GetTypeURL(v),
-		Id:   id,
-	})
+	v, err := databroker.NewTypedClient[rfc7591v1.ClientRegistration](storage.client).Get(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get client by ID: %w", err)
 	}
-
-	err = anypb.UnmarshalTo(rec.Record.Data, v, proto.UnmarshalOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal client registration request: %w", err)
-	}
-
 	return v, nil
 }
 
@@ -73,15 +52,8 @@ func (storage *Storage) CreateAuthorizationRequest(
 	ctx context.Context,
 	req *oauth21proto.AuthorizationRequest,
 ) (string, error) {
-	data := protoutil.NewAny(req)
 	id := uuid.NewString()
-	_, err := storage.client.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{{
-			Id:   id,
-			Data: data,
-			Type: data.TypeUrl,
-		}},
-	})
+	_, err := databroker.NewTypedClient[oauth21proto.AuthorizationRequest](storage.client).Put(ctx, id, req)
 	if err != nil {
 		return "", err
 	}
@@ -92,20 +64,10 @@ func (storage *Storage) GetAuthorizationRequest(
 	ctx context.Context,
 	id string,
 ) (*oauth21proto.AuthorizationRequest, error) {
-	v := new(oauth21proto.AuthorizationRequest)
-	rec, err := storage.client.Get(ctx, &databroker.GetRequest{
-		Type: protoutil.GetTypeURL(v),
-		Id:   id,
-	})
+	v, err := databroker.NewTypedClient[oauth21proto.AuthorizationRequest](storage.client).Get(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get authorization request by ID: %w", err)
 	}
-
-	err = anypb.UnmarshalTo(rec.Record.Data, v, proto.UnmarshalOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal authorization request: %w", err)
-	}
-
 	return v, nil
 }
 
@@ -113,15 +75,7 @@ func (storage *Storage) DeleteAuthorizationRequest(
 	ctx context.Context,
 	id string,
 ) error {
-	data := protoutil.NewAny(&oauth21proto.AuthorizationRequest{})
-	_, err := storage.client.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{{
-			Id:        id,
-			Data:      data,
-			Type:      data.TypeUrl,
-			DeletedAt: timestamppb.Now(),
-		}},
-	})
+	err := databroker.NewTypedClient[oauth21proto.AuthorizationRequest](storage.client).Delete(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete authorization request by ID: %w", err)
 	}
@@ -129,20 +83,10 @@ func (storage *Storage) DeleteAuthorizationRequest(
 }
 
 func (storage *Storage) GetSession(ctx context.Context, id string) (*session.Session, error) {
-	v := new(session.Session)
-	rec, err := storage.client.Get(ctx, &databroker.GetRequest{
-		Type: protoutil.GetTypeURL(v),
-		Id:   id,
-	})
+	v, err := databroker.NewTypedClient[session.Session](storage.client).Get(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get session by ID: %w", err)
 	}
-
-	err = anypb.UnmarshalTo(rec.Record.Data, v, proto.UnmarshalOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal session: %w", err)
-	}
-
 	return v, nil
 }
 
@@ -153,14 +97,8 @@ func (storage *Storage) StoreUpstreamOAuth2Token(
 	userID string,
 	token *oauth21proto.TokenResponse,
 ) error {
-	data := protoutil.NewAny(token)
-	_, err := storage.client.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{{
-			Id:   fmt.Sprintf("%s|%s", host, userID),
-			Data: data,
-			Type: data.TypeUrl,
-		}},
-	})
+	_, err := databroker.NewTypedClient[oauth21proto.TokenResponse](storage.client).
+		Put(ctx, fmt.Sprintf("%s|%s", host, userID), token)
 	if err != nil {
 		return fmt.Errorf("failed to store upstream oauth2 token for session: %w", err)
 	}
@@ -173,20 +111,10 @@ func (storage *Storage) GetUpstreamOAuth2Token(
 	host string,
 	userID string,
 ) (*oauth21proto.TokenResponse, error) {
-	v := new(oauth21proto.TokenResponse)
-	rec, err := storage.client.Get(ctx, &databroker.GetRequest{
-		Type: protoutil.GetTypeURL(v),
-		Id:   fmt.Sprintf("%s|%s", host, userID),
-	})
+	v, err := databroker.NewTypedClient[oauth21proto.TokenResponse](storage.client).Get(ctx, fmt.Sprintf("%s|%s", host, userID))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get upstream oauth2 token for session: %w", err)
 	}
-
-	err = anypb.UnmarshalTo(rec.Record.Data, v, proto.UnmarshalOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal upstream oauth2 token: %w", err)
-	}
-
 	return v, nil
 }
 
@@ -196,15 +124,8 @@ func (storage *Storage) DeleteUpstreamOAuth2Token(
 	host string,
 	userID string,
 ) error {
-	data := protoutil.NewAny(&oauth21proto.TokenResponse{})
-	_, err := storage.client.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{{
-			Id:        fmt.Sprintf("%s|%s", host, userID),
-			Data:      data,
-			Type:      data.TypeUrl,
-			DeletedAt: timestamppb.Now(),
-		}},
-	})
+	err := databroker.NewTypedClient[oauth21proto.TokenResponse](storage.client).
+		Delete(ctx, fmt.Sprintf("%s|%s", host, userID))
 	if err != nil {
 		return fmt.Errorf("failed to delete upstream oauth2 token for session: %w", err)
 	}
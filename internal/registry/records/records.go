@@ -0,0 +1,224 @@
+// Package records implements a registry that stores services as databroker
+// records, so that registrations survive restarts and are shared by every
+// databroker using the same storage backend.
+package records
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/registry"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	pb "github.com/pomerium/pomerium/pkg/grpc/registry"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// RecordType is the databroker record type used for services.
+var RecordType = protoutil.GetTypeURL(new(pb.Service))
+
+// callAfterTTLFactor will request to report back again after TTL/callAfterTTLFactor time
+const callAfterTTLFactor = 2
+
+type recordsServer struct {
+	backend storage.Backend
+	ttl     time.Duration
+}
+
+// New creates a new registry which stores services in the given storage
+// backend. A service expires when it hasn't been reported for the ttl.
+func New(backend storage.Backend, ttl time.Duration) registry.Interface {
+	return &recordsServer{
+		backend: backend,
+		ttl:     ttl,
+	}
+}
+
+// Close closes the registry. The storage backend is not closed.
+func (s *recordsServer) Close() error {
+	return nil
+}
+
+// Report stores the services as records and removes any expired services.
+func (s *recordsServer) Report(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	records := make([]*databroker.Record, 0, len(req.GetServices()))
+	for _, svc := range req.GetServices() {
+		records = append(records, &databroker.Record{
+			Type: RecordType,
+			Id:   recordID(svc),
+			Data: protoutil.NewAny(svc),
+		})
+	}
+	if len(records) > 0 {
+		_, err := s.backend.Put(ctx, records)
+		if err != nil {
+			return nil, fmt.Errorf("registry: error saving services: %w", err)
+		}
+	}
+
+	err := s.removeExpired(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RegisterResponse{
+		CallBackAfter: durationpb.New(s.ttl / callAfterTTLFactor),
+	}, nil
+}
+
+// List returns current snapshot of the services known to the registry
+func (s *recordsServer) List(ctx context.Context, req *pb.ListRequest) (*pb.ServiceList, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	services, _, err := s.list(ctx, req.GetKinds())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ServiceList{Services: services}, nil
+}
+
+// Watch returns a stream of updates as full snapshots. A new snapshot is sent
+// whenever a service record changes or a service expires.
+func (s *recordsServer) Watch(req *pb.ListRequest, stream pb.Registry_WatchServer) error {
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	changed := make(chan struct{}, 1)
+	go s.watchChanges(ctx, changed)
+
+	ticker := time.NewTicker(s.ttl / callAfterTTLFactor)
+	defer ticker.Stop()
+
+	var previous *pb.ServiceList
+	for {
+		services, _, err := s.list(ctx, req.GetKinds())
+		if err != nil {
+			return status.Errorf(codes.Internal, "obtaining service registrations: %v", err)
+		}
+
+		// only send the new list if it changed
+		current := &pb.ServiceList{Services: services}
+		if previous == nil || !proto.Equal(current, previous) {
+			if err := stream.Send(current); err != nil {
+				return status.Errorf(codes.Internal, "sending registration snapshot: %v", err)
+			}
+			previous = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchChanges signals changed whenever a service record changes. If the
+// change stream fails, the caller relies on its ticker instead.
+func (s *recordsServer) watchChanges(ctx context.Context, changed chan<- struct{}) {
+	serverVersion, _, recordVersion, err := s.backend.Versions(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("registry: error getting versions")
+		return
+	}
+
+	for _, err := range s.backend.Sync(ctx, RecordType, serverVersion, recordVersion, true) {
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Ctx(ctx).Error().Err(err).Msg("registry: error watching service changes")
+			}
+			return
+		}
+
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// list returns the unexpired services, sorted by kind and endpoint, along
+// with the expired service records.
+func (s *recordsServer) list(ctx context.Context, kinds []pb.ServiceKind) ([]*pb.Service, []*databroker.Record, error) {
+	_, _, seq, err := s.backend.SyncLatest(ctx, RecordType, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("registry: error listing services: %w", err)
+	}
+
+	expiresBefore := time.Now().Add(-s.ttl)
+	services := []*pb.Service{}
+	var expired []*databroker.Record
+	for record, err := range seq {
+		if err != nil {
+			return nil, nil, fmt.Errorf("registry: error listing services: %w", err)
+		}
+
+		if record.GetModifiedAt().AsTime().Before(expiresBefore) {
+			expired = append(expired, record)
+			continue
+		}
+
+		var svc pb.Service
+		err = record.GetData().UnmarshalTo(&svc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("registry: error unmarshaling service: %w", err)
+		}
+		if len(kinds) > 0 && !slices.Contains(kinds, svc.GetKind()) {
+			continue
+		}
+		services = append(services, &svc)
+	}
+
+	slices.SortFunc(services, func(a, b *pb.Service) int {
+		return cmp.Or(
+			cmp.Compare(a.GetKind(), b.GetKind()),
+			cmp.Compare(a.GetEndpoint(), b.GetEndpoint()),
+		)
+	})
+	return services, expired, nil
+}
+
+func (s *recordsServer) removeExpired(ctx context.Context) error {
+	_, expired, err := s.list(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range expired {
+		// the expected version makes sure a service which was reported again
+		// in the meantime isn't removed
+		record.ExpectedVersion = proto.Uint64(record.GetVersion())
+		record.DeletedAt = timestamppb.Now()
+		_, err = s.backend.Put(ctx, []*databroker.Record{record})
+		if err != nil && !errors.Is(err, storage.ErrVersionMismatch) {
+			return fmt.Errorf("registry: error removing expired service: %w", err)
+		}
+	}
+	return nil
+}
+
+func recordID(svc *pb.Service) string {
+	return svc.GetKind().String() + "|" + svc.GetEndpoint()
+}
@@ -0,0 +1,72 @@
+package records_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/registry/records"
+	"github.com/pomerium/pomerium/internal/testutil"
+	pb "github.com/pomerium/pomerium/pkg/grpc/registry"
+	"github.com/pomerium/pomerium/pkg/iterutil"
+	"github.com/pomerium/pomerium/pkg/storage/inmemory"
+	"github.com/pomerium/pomerium/pkg/storage/storagetest"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	backend := inmemory.New()
+	t.Cleanup(func() { _ = backend.Close() })
+
+	storagetest.TestRegistry(t, records.New(backend, time.Minute))
+}
+
+func TestRegistryPersistence(t *testing.T) {
+	t.Parallel()
+
+	backend := inmemory.New()
+	t.Cleanup(func() { _ = backend.Close() })
+
+	svc := &pb.Service{Kind: pb.ServiceKind_DATABROKER, Endpoint: "http://localhost"}
+	_, err := records.New(backend, time.Minute).Report(t.Context(), &pb.RegisterRequest{Services: []*pb.Service{svc}})
+	require.NoError(t, err)
+
+	res, err := records.New(backend, time.Minute).List(t.Context(), &pb.ListRequest{})
+	require.NoError(t, err)
+	testutil.AssertProtoEqual(t, &pb.ServiceList{Services: []*pb.Service{svc}}, res,
+		"should list services reported to another registry using the same backend")
+}
+
+func TestRegistryExpiry(t *testing.T) {
+	t.Parallel()
+
+	backend := inmemory.New()
+	t.Cleanup(func() { _ = backend.Close() })
+
+	r := records.New(backend, 100*time.Millisecond)
+	_, err := r.Report(t.Context(), &pb.RegisterRequest{Services: []*pb.Service{
+		{Kind: pb.ServiceKind_DATABROKER, Endpoint: "http://localhost"},
+	}})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		res, err := r.List(t.Context(), &pb.ListRequest{})
+		return err == nil && len(res.GetServices()) == 0
+	}, 5*time.Second, 10*time.Millisecond, "should expire services")
+
+	// reporting removes the expired records
+	_, err = r.Report(t.Context(), &pb.RegisterRequest{Services: []*pb.Service{
+		{Kind: pb.ServiceKind_AUTHORIZE, Endpoint: "http://localhost"},
+	}})
+	require.NoError(t, err)
+	_, _, seq, err := backend.SyncLatest(t.Context(), records.RecordType, nil)
+	require.NoError(t, err)
+	all, err := iterutil.CollectWithError(seq)
+	require.NoError(t, err)
+	if assert.Len(t, all, 1, "should remove expired records") {
+		assert.Equal(t, "AUTHORIZE|http://localhost", all[0].GetId())
+	}
+}
@@ -0,0 +1,418 @@
+package scim
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// A groupResource is a SCIM group.
+//
+// https://www.rfc-editor.org/rfc/rfc7643.html#section-4.2
+type groupResource struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []multiValue `json:"members,omitempty"`
+	Meta        *meta        `json:"meta,omitempty"`
+}
+
+func (srv *Handler) newGroupResource(r *http.Request, g *groupRecord, members []*userRecord) *groupResource {
+	res := &groupResource{
+		Schemas:     []string{SchemaGroup},
+		ID:          g.ID,
+		ExternalID:  g.ExternalID,
+		DisplayName: g.Name,
+		Meta: &meta{
+			ResourceType: "Group",
+			Location:     srv.location(r, groupsEndpoint, g.ID),
+		},
+	}
+	for _, u := range members {
+		res.Members = append(res.Members, multiValue{Value: u.ID, Display: u.DisplayName})
+	}
+	return res
+}
+
+func (res *groupResource) memberIDs() []string {
+	ids := make([]string, 0, len(res.Members))
+	for _, m := range res.Members {
+		ids = append(ids, m.Value)
+	}
+	return ids
+}
+
+// applyPatch applies a patch operation to the group. Members are tracked by
+// id in memberIDs.
+func (res *groupResource) applyPatch(op patchOperation, memberIDs []string) ([]string, error) {
+	if memberID, ok := parseMemberPath(op.Path); ok && op.Op == patchOpRemove {
+		return slices.DeleteFunc(memberIDs, func(id string) bool { return id == memberID }), nil
+	}
+
+	switch strings.ToLower(op.Path) {
+	case "":
+		var value struct {
+			DisplayName *string       `json:"displayName"`
+			ExternalID  *string       `json:"externalId"`
+			Members     *[]multiValue `json:"members"`
+		}
+		if err := unmarshalPatchValue(op, &value); err != nil {
+			return nil, err
+		}
+		if value.DisplayName != nil {
+			res.DisplayName = *value.DisplayName
+		}
+		if value.ExternalID != nil {
+			res.ExternalID = *value.ExternalID
+		}
+		if value.Members != nil {
+			return patchMembers(op.Op, memberIDs, *value.Members), nil
+		}
+	case "displayname":
+		return memberIDs, patchString(op, &res.DisplayName)
+	case "externalid":
+		return memberIDs, patchString(op, &res.ExternalID)
+	case "members":
+		var members []multiValue
+		if len(op.Value) > 0 {
+			if err := unmarshalPatchValue(op, &members); err != nil {
+				return nil, err
+			}
+		} else if op.Op == patchOpRemove {
+			// remove all members
+			return nil, nil
+		}
+		return patchMembers(op.Op, memberIDs, members), nil
+	}
+
+	// other attributes aren't stored
+	return memberIDs, nil
+}
+
+func patchMembers(op string, memberIDs []string, members []multiValue) []string {
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.Value)
+	}
+
+	switch op {
+	case patchOpAdd:
+		return append(memberIDs, ids...)
+	case patchOpRemove:
+		return slices.DeleteFunc(memberIDs, func(id string) bool { return slices.Contains(ids, id) })
+	default:
+		return ids
+	}
+}
+
+func (srv *Handler) listGroups(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	groups, err := srv.storage.listGroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	if filter := r.FormValue("filter"); filter != "" {
+		attribute, value, err := parseFilter(filter)
+		if err != nil {
+			return err
+		}
+		groups = slices.DeleteFunc(groups, func(g *groupRecord) bool {
+			switch attribute {
+			case "id":
+				return g.ID != value
+			case "displayname":
+				return g.Name != value
+			case "externalid":
+				return g.ExternalID != value
+			}
+			return true
+		})
+	}
+	slices.SortFunc(groups, func(a, b *groupRecord) int { return cmp.Compare(a.ID, b.ID) })
+
+	page, err := newListResponse(r, groups)
+	if err != nil {
+		return err
+	}
+
+	// members are often excluded when listing groups, as they can be large
+	var users []*userRecord
+	if !strings.Contains(strings.ToLower(r.FormValue("excludedAttributes")), "members") {
+		var memberIDs []string
+		for _, g := range page.Resources {
+			memberIDs = append(memberIDs, g.MemberIDs...)
+		}
+		slices.Sort(memberIDs)
+		users, err = srv.storage.getUsers(ctx, slices.Compact(memberIDs))
+		if err != nil {
+			return err
+		}
+	}
+
+	res := &listResponse[*groupResource]{
+		Schemas:      page.Schemas,
+		TotalResults: page.TotalResults,
+		StartIndex:   page.StartIndex,
+		ItemsPerPage: page.ItemsPerPage,
+		Resources:    make([]*groupResource, 0, len(page.Resources)),
+	}
+	for _, g := range page.Resources {
+		res.Resources = append(res.Resources, srv.newGroupResource(r, g, filterGroupMembers(users, g.ID)))
+	}
+	writeJSON(w, http.StatusOK, res)
+	return nil
+}
+
+func (srv *Handler) createGroup(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	var res groupResource
+	if err := readJSON(r, &res); err != nil {
+		return err
+	}
+	if res.DisplayName == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+
+	g := &groupRecord{ExternalID: res.ExternalID}
+	g.ID = cmp.Or(res.ExternalID, uuid.NewString())
+	g.Name = res.DisplayName
+
+	if _, err := srv.storage.getGroup(ctx, g.ID); err == nil {
+		return newError(http.StatusConflict, "uniqueness", "group already exists")
+	} else if !databroker.IsNotFound(err) {
+		return err
+	}
+
+	if err := srv.setGroupMembers(ctx, g, res.memberIDs()); err != nil {
+		return err
+	}
+	if err := srv.storage.putGroup(ctx, g); err != nil {
+		return err
+	}
+
+	log.Ctx(ctx).Info().Str("group-id", g.ID).Msg("scim: created group")
+	return srv.writeGroup(w, r, http.StatusCreated, g)
+}
+
+func (srv *Handler) getGroup(w http.ResponseWriter, r *http.Request) error {
+	g, err := srv.loadGroup(r)
+	if err != nil {
+		return err
+	}
+	return srv.writeGroup(w, r, http.StatusOK, g)
+}
+
+func (srv *Handler) replaceGroup(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	g, err := srv.loadGroup(r)
+	if err != nil {
+		return err
+	}
+
+	var res groupResource
+	if err := readJSON(r, &res); err != nil {
+		return err
+	}
+	if res.DisplayName == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+
+	g.Name = res.DisplayName
+	g.ExternalID = res.ExternalID
+	if err := srv.setGroupMembers(ctx, g, res.memberIDs()); err != nil {
+		return err
+	}
+	if err := srv.storage.putGroup(ctx, g); err != nil {
+		return err
+	}
+
+	return srv.writeGroup(w, r, http.StatusOK, g)
+}
+
+func (srv *Handler) patchGroup(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	g, err := srv.loadGroup(r)
+	if err != nil {
+		return err
+	}
+
+	ops, err := readPatchRequest(r)
+	if err != nil {
+		return err
+	}
+
+	members, err := srv.getGroupMembers(ctx, g)
+	if err != nil {
+		return err
+	}
+
+	res := srv.newGroupResource(r, g, members)
+	memberIDs := res.memberIDs()
+	for _, op := range ops {
+		memberIDs, err = res.applyPatch(op, memberIDs)
+		if err != nil {
+			return err
+		}
+	}
+	if res.DisplayName == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+
+	g.Name = res.DisplayName
+	g.ExternalID = res.ExternalID
+	if err := srv.setGroupMembers(ctx, g, memberIDs); err != nil {
+		return err
+	}
+	if err := srv.storage.putGroup(ctx, g); err != nil {
+		return err
+	}
+
+	// SCIM allows an empty response to a successful patch
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (srv *Handler) deleteGroup(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	g, err := srv.loadGroup(r)
+	if err != nil {
+		return err
+	}
+
+	if err := srv.setGroupMembers(ctx, g, nil); err != nil {
+		return err
+	}
+	if err := srv.storage.deleteGroup(ctx, g.ID); err != nil {
+		return err
+	}
+
+	log.Ctx(ctx).Info().Str("group-id", g.ID).Msg("scim: deleted group")
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (srv *Handler) loadGroup(r *http.Request) (*groupRecord, error) {
+	g, err := srv.storage.getGroup(r.Context(), mux.Vars(r)["id"])
+	if databroker.IsNotFound(err) {
+		return nil, newError(http.StatusNotFound, "", "group not found")
+	} else if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (srv *Handler) writeGroup(w http.ResponseWriter, r *http.Request, status int, g *groupRecord) error {
+	members, err := srv.getGroupMembers(r.Context(), g)
+	if err != nil {
+		return err
+	}
+
+	res := srv.newGroupResource(r, g, members)
+	w.Header().Set("Location", res.Meta.Location)
+	writeJSON(w, status, res)
+	return nil
+}
+
+// setGroupMembers updates the group memberships of users so that exactly the
+// given users are members of the group, and records the members in the group.
+// Members which haven't been provisioned are ignored. Only the group's current
+// and new members are loaded. The group itself isn't saved.
+//
+// Users are only written if they haven't changed since they were loaded, so
+// concurrent updates to the same users aren't lost. On a conflict the users
+// are loaded again and the update is retried.
+func (srv *Handler) setGroupMembers(ctx context.Context, g *groupRecord, memberIDs []string) error {
+	for attempt := 1; ; attempt++ {
+		err := srv.trySetGroupMembers(ctx, g, memberIDs)
+		if !isVersionMismatch(err) || attempt >= maxSetGroupMembersAttempts {
+			return err
+		}
+		log.Ctx(ctx).Debug().Str("group-id", g.ID).Int("attempt", attempt).
+			Msg("scim: users changed while updating group members, retrying")
+	}
+}
+
+func (srv *Handler) trySetGroupMembers(ctx context.Context, g *groupRecord, memberIDs []string) error {
+	ids := slices.Concat(g.MemberIDs, memberIDs)
+	slices.Sort(ids)
+	users, err := srv.storage.getUsers(ctx, slices.Compact(ids))
+	if err != nil {
+		return err
+	}
+
+	var changed []*userRecord
+	var members []string
+	for _, u := range users {
+		isMember := slices.Contains(u.SCIMGroupIDs, g.ID)
+		shouldBeMember := slices.Contains(memberIDs, u.ID)
+		if shouldBeMember {
+			members = append(members, u.ID)
+		}
+		switch {
+		case shouldBeMember && !isMember:
+			u.setGroupIDs(append(u.SCIMGroupIDs, g.ID))
+			changed = append(changed, u)
+		case !shouldBeMember && isMember:
+			u.setGroupIDs(slices.DeleteFunc(u.SCIMGroupIDs, func(id string) bool { return id == g.ID }))
+			changed = append(changed, u)
+		}
+	}
+	if len(changed) > 0 {
+		if err := srv.storage.putUsersIfUnchanged(ctx, changed...); err != nil {
+			return err
+		}
+	}
+	g.MemberIDs = members
+	return nil
+}
+
+// getGroupMembers returns the members of the group.
+func (srv *Handler) getGroupMembers(ctx context.Context, g *groupRecord) ([]*userRecord, error) {
+	users, err := srv.storage.getUsers(ctx, g.MemberIDs)
+	if err != nil {
+		return nil, err
+	}
+	return filterGroupMembers(users, g.ID), nil
+}
+
+// getGroupNames returns the names of all groups by id.
+func (srv *Handler) getGroupNames(ctx context.Context) (map[string]string, error) {
+	groups, err := srv.storage.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(groups))
+	for _, g := range groups {
+		names[g.ID] = g.Name
+	}
+	return names, nil
+}
+
+// filterGroupMembers returns the users which are members of the group, sorted
+// by id.
+func filterGroupMembers(users []*userRecord, groupID string) []*userRecord {
+	var members []*userRecord
+	for _, u := range users {
+		if slices.Contains(u.SCIMGroupIDs, groupID) {
+			members = append(members, u)
+		}
+	}
+	slices.SortFunc(members, func(a, b *userRecord) int { return cmp.Compare(a.ID, b.ID) })
+	return members
+}
@@ -0,0 +1,128 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// A patchRequest is a SCIM PATCH request.
+//
+// https://www.rfc-editor.org/rfc/rfc7644.html#section-3.5.2
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// patch operations
+const (
+	patchOpAdd     = "add"
+	patchOpRemove  = "remove"
+	patchOpReplace = "replace"
+)
+
+func readPatchRequest(r *http.Request) ([]patchOperation, error) {
+	var req patchRequest
+	if err := readJSON(r, &req); err != nil {
+		return nil, err
+	}
+
+	for i, op := range req.Operations {
+		op.Op = strings.ToLower(op.Op)
+		switch op.Op {
+		case patchOpAdd, patchOpReplace:
+			if len(op.Value) == 0 {
+				return nil, newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("%s operation requires a value", op.Op))
+			}
+		case patchOpRemove:
+			if op.Path == "" {
+				return nil, newError(http.StatusBadRequest, "noTarget", "remove operation requires a path")
+			}
+		default:
+			return nil, newError(http.StatusBadRequest, "invalidSyntax", fmt.Sprintf("unsupported patch operation %q", op.Op))
+		}
+		req.Operations[i] = op
+	}
+	return req.Operations, nil
+}
+
+func unmarshalPatchValue(op patchOperation, dst any) error {
+	if err := json.Unmarshal(op.Value, dst); err != nil {
+		return newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("invalid value for %q: %s", op.Path, err))
+	}
+	return nil
+}
+
+// patchString applies a patch operation to a string attribute.
+func patchString(op patchOperation, dst *string) error {
+	if op.Op == patchOpRemove {
+		*dst = ""
+		return nil
+	}
+	return unmarshalPatchValue(op, dst)
+}
+
+// A flexBool is a boolean which may also be sent as a string, as some
+// identity providers do for the active attribute.
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case bool:
+		*b = flexBool(v)
+	case string:
+		switch strings.ToLower(v) {
+		case "true":
+			*b = true
+		case "false":
+			*b = false
+		default:
+			return fmt.Errorf("invalid boolean %q", v)
+		}
+	default:
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	return nil
+}
+
+var filterRE = regexp.MustCompile(`^\s*([A-Za-z][\w.]*)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// parseFilter parses a SCIM filter. Only a single equality comparison, like
+// `userName eq "alice@example.com"`, is supported.
+//
+// https://www.rfc-editor.org/rfc/rfc7644.html#section-3.4.2.2
+func parseFilter(filter string) (attribute, value string, err error) {
+	m := filterRE.FindStringSubmatch(filter)
+	if m == nil {
+		return "", "", newError(http.StatusBadRequest, "invalidFilter", fmt.Sprintf("unsupported filter %q", filter))
+	}
+	err = json.Unmarshal([]byte(`"`+m[2]+`"`), &value)
+	if err != nil {
+		return "", "", newError(http.StatusBadRequest, "invalidFilter", fmt.Sprintf("invalid filter value %q", m[2]))
+	}
+	return strings.ToLower(m[1]), value, nil
+}
+
+var memberPathRE = regexp.MustCompile(`^(?i:members)\[\s*(?i:value)\s+(?i:eq)\s+"([^"]*)"\s*\]$`)
+
+// parseMemberPath parses a path selecting a single group member, like
+// `members[value eq "u1"]`.
+func parseMemberPath(p string) (memberID string, ok bool) {
+	m := memberPathRE.FindStringSubmatch(p)
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
@@ -0,0 +1,237 @@
+// Package scim implements a SCIM 2.0 service provider for users and groups.
+// Identity providers use it to push users, groups and group memberships into
+// the databroker as directory records, so that changes such as deprovisioning
+// a user take effect immediately instead of at the next directory sync.
+//
+// https://www.rfc-editor.org/rfc/rfc7643.html
+// https://www.rfc-editor.org/rfc/rfc7644.html
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// SCIM schemas
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+const (
+	usersEndpoint                 = "/Users"
+	groupsEndpoint                = "/Groups"
+	serviceProviderConfigEndpoint = "/ServiceProviderConfig"
+
+	contentType     = "application/scim+json"
+	maxRequestSize  = 1 << 20
+	defaultMaxCount = 1000
+
+	maxSetGroupMembersAttempts = 10
+)
+
+// A Handler handles SCIM requests.
+type Handler struct {
+	prefix      string
+	bearerToken string
+	storage     *storage
+}
+
+// New creates a new SCIM Handler for requests below prefix. Requests must be
+// authorized with the given bearer token.
+func New(prefix string, client databroker.DataBrokerServiceClient, bearerToken string) *Handler {
+	return &Handler{
+		prefix:      prefix,
+		bearerToken: bearerToken,
+		storage:     &storage{client: client},
+	}
+}
+
+// HandlerFunc returns a http.HandlerFunc that handles the SCIM endpoints.
+func (srv *Handler) HandlerFunc() http.HandlerFunc {
+	r := mux.NewRouter()
+	r.Use(srv.requireBearerToken)
+
+	r.Path(path.Join(srv.prefix, serviceProviderConfigEndpoint)).Methods(http.MethodGet).Handler(handlerFunc(srv.getServiceProviderConfig))
+
+	r.Path(path.Join(srv.prefix, usersEndpoint)).Methods(http.MethodGet).Handler(handlerFunc(srv.listUsers))
+	r.Path(path.Join(srv.prefix, usersEndpoint)).Methods(http.MethodPost).Handler(handlerFunc(srv.createUser))
+	r.Path(path.Join(srv.prefix, usersEndpoint, "{id}")).Methods(http.MethodGet).Handler(handlerFunc(srv.getUser))
+	r.Path(path.Join(srv.prefix, usersEndpoint, "{id}")).Methods(http.MethodPut).Handler(handlerFunc(srv.replaceUser))
+	r.Path(path.Join(srv.prefix, usersEndpoint, "{id}")).Methods(http.MethodPatch).Handler(handlerFunc(srv.patchUser))
+	r.Path(path.Join(srv.prefix, usersEndpoint, "{id}")).Methods(http.MethodDelete).Handler(handlerFunc(srv.deleteUser))
+
+	r.Path(path.Join(srv.prefix, groupsEndpoint)).Methods(http.MethodGet).Handler(handlerFunc(srv.listGroups))
+	r.Path(path.Join(srv.prefix, groupsEndpoint)).Methods(http.MethodPost).Handler(handlerFunc(srv.createGroup))
+	r.Path(path.Join(srv.prefix, groupsEndpoint, "{id}")).Methods(http.MethodGet).Handler(handlerFunc(srv.getGroup))
+	r.Path(path.Join(srv.prefix, groupsEndpoint, "{id}")).Methods(http.MethodPut).Handler(handlerFunc(srv.replaceGroup))
+	r.Path(path.Join(srv.prefix, groupsEndpoint, "{id}")).Methods(http.MethodPatch).Handler(handlerFunc(srv.patchGroup))
+	r.Path(path.Join(srv.prefix, groupsEndpoint, "{id}")).Methods(http.MethodDelete).Handler(handlerFunc(srv.deleteGroup))
+
+	r.NotFoundHandler = handlerFunc(func(_ http.ResponseWriter, _ *http.Request) error {
+		return newError(http.StatusNotFound, "", "not found")
+	})
+	r.MethodNotAllowedHandler = handlerFunc(func(_ http.ResponseWriter, _ *http.Request) error {
+		return newError(http.StatusMethodNotAllowed, "", "method not allowed")
+	})
+
+	return r.ServeHTTP
+}
+
+func (srv *Handler) requireBearerToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || srv.bearerToken == "" ||
+			subtle.ConstantTimeCompare([]byte(token), []byte(srv.bearerToken)) != 1 {
+			writeError(w, r, newError(http.StatusUnauthorized, "", "invalid bearer token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (srv *Handler) getServiceProviderConfig(w http.ResponseWriter, _ *http.Request) error {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{SchemaServiceProviderConfig},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": defaultMaxCount},
+		"changePassword": map[string]any{"supported": false},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": false},
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication using a bearer token",
+		}},
+	})
+	return nil
+}
+
+// location returns the URL of a resource.
+func (srv *Handler) location(r *http.Request, endpoint, id string) string {
+	return "https://" + r.Host + path.Join(srv.prefix, endpoint, id)
+}
+
+// An Error is a SCIM error response.
+type Error struct {
+	Status   int
+	SCIMType string
+	Detail   string
+}
+
+func newError(status int, scimType, detail string) *Error {
+	return &Error{Status: status, SCIMType: scimType, Detail: detail}
+}
+
+func (err *Error) Error() string {
+	return fmt.Sprintf("scim: %d %s: %s", err.Status, err.SCIMType, err.Detail)
+}
+
+// handlerFunc is an adapter to write errors returned by handlers as SCIM
+// error responses.
+type handlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+func (f handlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := f(w, r); err != nil {
+		writeError(w, r, err)
+	}
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var scimErr *Error
+	if !errors.As(err, &scimErr) {
+		log.Ctx(r.Context()).Error().Err(err).Msg("scim: internal error")
+		scimErr = newError(http.StatusInternalServerError, "", "internal error")
+	}
+
+	res := map[string]any{
+		"schemas": []string{SchemaError},
+		"status":  strconv.Itoa(scimErr.Status),
+		"detail":  scimErr.Detail,
+	}
+	if scimErr.SCIMType != "" {
+		res["scimType"] = scimErr.SCIMType
+	}
+	writeJSON(w, scimErr.Status, res)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func readJSON(r *http.Request, v any) error {
+	bs, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "error reading request body")
+	}
+	err = json.Unmarshal(bs, v)
+	if err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", fmt.Sprintf("invalid request body: %s", err))
+	}
+	return nil
+}
+
+// A listResponse is a page of resources.
+type listResponse[T any] struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []T      `json:"Resources"`
+}
+
+// newListResponse returns the page of resources selected by the startIndex
+// and count query parameters.
+func newListResponse[T any](r *http.Request, all []T) (*listResponse[T], error) {
+	startIndex, count := 1, defaultMaxCount
+	if v := r.FormValue("startIndex"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "invalid startIndex")
+		}
+		startIndex = max(i, 1)
+	}
+	if v := r.FormValue("count"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "invalid count")
+		}
+		count = min(max(i, 0), defaultMaxCount)
+	}
+
+	resources := []T{}
+	if startIndex <= len(all) {
+		resources = all[startIndex-1:]
+	}
+	if count < len(resources) {
+		resources = resources[:count]
+	}
+
+	return &listResponse[T]{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(all),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}, nil
+}
@@ -0,0 +1,206 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pomerium/datasource/pkg/directory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+
+	"github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/testutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	srv := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(srv.Stop)
+
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, srv)
+	})
+	t.Cleanup(func() { cc.Close() })
+
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+	h := New("/scim/v2", client, "TOKEN").HandlerFunc()
+
+	do := func(method, path, token, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "https://authenticate.example.com"+path, strings.NewReader(body))
+		r.Header.Set("Authorization", "Bearer "+token)
+		r.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		h(w, r)
+		return w
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		w := do(http.MethodGet, "/scim/v2/Users", "WRONG", "")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.JSONEq(t, `{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"status":"401","detail":"invalid bearer token"}`, w.Body.String())
+	})
+
+	t.Run("lifecycle", func(t *testing.T) {
+		s := &storage{client: client}
+
+		w := do(http.MethodPost, "/scim/v2/Users", "TOKEN", `{
+			"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+			"externalId": "U1",
+			"userName": "alice@example.com",
+			"name": {"givenName": "Alice", "familyName": "Smith"},
+			"emails": [{"value": "alice@example.com", "type": "work", "primary": true}],
+			"active": true
+		}`)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		assert.Equal(t, "https://authenticate.example.com/scim/v2/Users/U1", w.Header().Get("Location"))
+
+		w = do(http.MethodPost, "/scim/v2/Users", "TOKEN", `{"externalId": "U1", "userName": "alice@example.com"}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		w = do(http.MethodGet, `/scim/v2/Users?filter=userName+eq+%22ALICE@example.com%22`, "TOKEN", "")
+		require.Equal(t, http.StatusOK, w.Code)
+		var list listResponse[userResource]
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+		assert.Equal(t, 1, list.TotalResults)
+		if assert.Len(t, list.Resources, 1) {
+			assert.Equal(t, "U1", list.Resources[0].ID)
+			assert.Equal(t, "Alice Smith", list.Resources[0].DisplayName)
+		}
+
+		w = do(http.MethodPost, "/scim/v2/Groups", "TOKEN", `{
+			"externalId": "G1",
+			"displayName": "Admins",
+			"members": [{"value": "U1"}]
+		}`)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		u, err := s.getUser(t.Context(), "U1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"G1"}, u.GroupIDs, "should add the user to the group")
+
+		w = do(http.MethodGet, "/scim/v2/Groups/G1", "TOKEN", "")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var g groupResource
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &g))
+		assert.Equal(t, []multiValue{{Value: "U1", Display: "Alice Smith"}}, g.Members)
+		assert.Equal(t, "alice@example.com", u.Email)
+
+		// deactivating the user removes its groups and revokes its sessions
+		_, err = session.Put(t.Context(), client, &session.Session{Id: "S1", UserId: "U1"})
+		require.NoError(t, err)
+		w = do(http.MethodPatch, "/scim/v2/Users/U1", "TOKEN", `{
+			"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+			"Operations": [{"op": "Replace", "path": "active", "value": "False"}]
+		}`)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		u, err = s.getUser(t.Context(), "U1")
+		require.NoError(t, err)
+		assert.False(t, u.Active)
+		assert.Empty(t, u.GroupIDs)
+		assert.Equal(t, []string{"G1"}, u.SCIMGroupIDs)
+		_, err = session.Get(t.Context(), client, "S1")
+		assert.True(t, databrokerpb.IsNotFound(err), "should revoke the session")
+
+		w = do(http.MethodPatch, "/scim/v2/Users/U1", "TOKEN", `{
+			"Operations": [{"op": "replace", "value": {"active": true}}]
+		}`)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		u, err = s.getUser(t.Context(), "U1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"G1"}, u.GroupIDs)
+
+		w = do(http.MethodPatch, "/scim/v2/Groups/G1", "TOKEN", `{
+			"Operations": [{"op": "remove", "path": "members[value eq \"U1\"]"}]
+		}`)
+		require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+		u, err = s.getUser(t.Context(), "U1")
+		require.NoError(t, err)
+		assert.Empty(t, u.GroupIDs, "should remove the user from the group")
+
+		w = do(http.MethodDelete, "/scim/v2/Users/U1", "TOKEN", "")
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		w = do(http.MethodGet, "/scim/v2/Users/U1", "TOKEN", "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+// concurrentPutClient adds a user to another group just before the first put,
+// as if another request updated the user concurrently.
+type concurrentPutClient struct {
+	databrokerpb.DataBrokerServiceClient
+	once sync.Once
+}
+
+func (c *concurrentPutClient) Put(ctx context.Context, req *databrokerpb.PutRequest, opts ...grpc.CallOption) (*databrokerpb.PutResponse, error) {
+	var err error
+	c.once.Do(func() {
+		s := &storage{client: c.DataBrokerServiceClient}
+		var u *userRecord
+		u, err = s.getUser(ctx, "U1")
+		if err == nil {
+			u.setGroupIDs(append(u.SCIMGroupIDs, "G2"))
+			err = s.putUsers(ctx, u)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.DataBrokerServiceClient.Put(ctx, req, opts...)
+}
+
+func TestSetGroupMembers_Conflict(t *testing.T) {
+	t.Parallel()
+
+	srv := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(srv.Stop)
+
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, srv)
+	})
+	t.Cleanup(func() { cc.Close() })
+
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+	s := &storage{client: client}
+	require.NoError(t, s.putUsers(t.Context(), &userRecord{
+		User:   directory.User{ID: "U1"},
+		Active: true,
+	}))
+
+	h := New("/scim/v2", &concurrentPutClient{DataBrokerServiceClient: client}, "TOKEN")
+	g := &groupRecord{Group: directory.Group{ID: "G1"}}
+	require.NoError(t, h.setGroupMembers(t.Context(), g, []string{"U1", "U2"}))
+	assert.Equal(t, []string{"U1"}, g.MemberIDs,
+		"should only record provisioned members")
+
+	u, err := s.getUser(t.Context(), "U1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"G1", "G2"}, u.SCIMGroupIDs,
+		"should keep the concurrent update and retry")
+}
+
+func TestParseFilter(t *testing.T) {
+	t.Parallel()
+
+	attribute, value, err := parseFilter(`userName eq "alice@example.com"`)
+	require.NoError(t, err)
+	assert.Equal(t, "username", attribute)
+	assert.Equal(t, "alice@example.com", value)
+
+	attribute, value, err = parseFilter(`displayName EQ "say \"hi\""`)
+	require.NoError(t, err)
+	assert.Equal(t, "displayname", attribute)
+	assert.Equal(t, `say "hi"`, value)
+
+	_, _, err = parseFilter(`userName sw "a"`)
+	assert.Error(t, err)
+}
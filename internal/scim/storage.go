@@ -0,0 +1,270 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/pomerium/datasource/pkg/directory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+// A userRecord is a directory user stored in the databroker along with the
+// SCIM attributes that have no directory equivalent.
+//
+// The group ids pushed by the identity provider are kept in SCIMGroupIDs.
+// Only active users expose them as directory group ids, so a deactivated user
+// loses its group memberships in policy until it's activated again.
+type userRecord struct {
+	directory.User
+	UserName     string   `json:"user_name,omitempty"`
+	ExternalID   string   `json:"external_id,omitempty"`
+	GivenName    string   `json:"given_name,omitempty"`
+	FamilyName   string   `json:"family_name,omitempty"`
+	Active       bool     `json:"active"`
+	SCIMGroupIDs []string `json:"scim_group_ids,omitempty"`
+
+	// version is the databroker record version the user was loaded at.
+	version uint64
+}
+
+func (u *userRecord) setGroupIDs(groupIDs []string) {
+	slices.Sort(groupIDs)
+	u.SCIMGroupIDs = slices.Compact(groupIDs)
+	u.GroupIDs = nil
+	if u.Active {
+		u.GroupIDs = slices.Clone(u.SCIMGroupIDs)
+	}
+}
+
+// A groupRecord is a directory group stored in the databroker along with the
+// SCIM attributes that have no directory equivalent.
+//
+// The ids of the group's members are kept in MemberIDs, so that group
+// operations only need to load the users involved. Users remain the source of
+// truth for memberships.
+type groupRecord struct {
+	directory.Group
+	ExternalID string   `json:"external_id,omitempty"`
+	MemberIDs  []string `json:"member_ids,omitempty"`
+}
+
+type storage struct {
+	client databroker.DataBrokerServiceClient
+}
+
+func (s *storage) getUser(ctx context.Context, id string) (*userRecord, error) {
+	u := new(userRecord)
+	version, err := s.get(ctx, directory.UserRecordType, id, u)
+	if err != nil {
+		return nil, err
+	}
+	u.version = version
+	u.setGroupIDs(u.SCIMGroupIDs)
+	return u, nil
+}
+
+// getUsers gets the users with the given ids. Users which don't exist are
+// skipped.
+func (s *storage) getUsers(ctx context.Context, ids []string) ([]*userRecord, error) {
+	users := make([]*userRecord, 0, len(ids))
+	for _, id := range ids {
+		u, err := s.getUser(ctx, id)
+		if databroker.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+func (s *storage) getGroup(ctx context.Context, id string) (*groupRecord, error) {
+	g := new(groupRecord)
+	_, err := s.get(ctx, directory.GroupRecordType, id, g)
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (s *storage) listUsers(ctx context.Context) ([]*userRecord, error) {
+	records, _, _, err := databroker.InitialSync(ctx, s.client, &databroker.SyncLatestRequest{
+		Type: directory.UserRecordType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scim: error listing users: %w", err)
+	}
+
+	users := make([]*userRecord, 0, len(records))
+	for _, record := range records {
+		u := new(userRecord)
+		if err := unmarshalRecord(record, u); err != nil {
+			return nil, err
+		}
+		u.version = record.GetVersion()
+		u.setGroupIDs(u.SCIMGroupIDs)
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+func (s *storage) listGroups(ctx context.Context) ([]*groupRecord, error) {
+	records, _, _, err := databroker.InitialSync(ctx, s.client, &databroker.SyncLatestRequest{
+		Type: directory.GroupRecordType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scim: error listing groups: %w", err)
+	}
+
+	groups := make([]*groupRecord, 0, len(records))
+	for _, record := range records {
+		g := new(groupRecord)
+		if err := unmarshalRecord(record, g); err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+func (s *storage) putUsers(ctx context.Context, users ...*userRecord) error {
+	records, err := newUserRecords(users)
+	if err != nil {
+		return err
+	}
+	return s.put(ctx, records)
+}
+
+// putUsersIfUnchanged puts users only if their records haven't changed since
+// they were loaded. A version mismatch error is returned otherwise.
+func (s *storage) putUsersIfUnchanged(ctx context.Context, users ...*userRecord) error {
+	records, err := newUserRecords(users)
+	if err != nil {
+		return err
+	}
+	for i, u := range users {
+		records[i].ExpectedVersion = proto.Uint64(u.version)
+	}
+	return s.put(ctx, records)
+}
+
+func newUserRecords(users []*userRecord) ([]*databroker.Record, error) {
+	records := make([]*databroker.Record, 0, len(users))
+	for _, u := range users {
+		u.setGroupIDs(u.SCIMGroupIDs)
+		record, err := newRecord(directory.UserRecordType, u.ID, u)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (s *storage) putGroup(ctx context.Context, g *groupRecord) error {
+	record, err := newRecord(directory.GroupRecordType, g.ID, g)
+	if err != nil {
+		return err
+	}
+	return s.put(ctx, []*databroker.Record{record})
+}
+
+func (s *storage) deleteUser(ctx context.Context, id string) error {
+	return s.delete(ctx, directory.UserRecordType, id)
+}
+
+func (s *storage) deleteGroup(ctx context.Context, id string) error {
+	return s.delete(ctx, directory.GroupRecordType, id)
+}
+
+// get gets a record and returns its version.
+func (s *storage) get(ctx context.Context, recordType, id string, v any) (uint64, error) {
+	res, err := s.client.Get(ctx, &databroker.GetRequest{
+		Type: recordType,
+		Id:   id,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.GetRecord().GetVersion(), unmarshalRecord(res.GetRecord(), v)
+}
+
+func (s *storage) put(ctx context.Context, records []*databroker.Record) error {
+	for _, req := range databroker.OptimumPutRequestsFromRecords(records) {
+		_, err := s.client.Put(ctx, req)
+		if err != nil {
+			return fmt.Errorf("scim: error saving records: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *storage) delete(ctx context.Context, recordType, id string) error {
+	_, err := s.client.Put(ctx, &databroker.PutRequest{
+		Records: []*databroker.Record{{
+			Type:      recordType,
+			Id:        id,
+			Data:      protoutil.NewAny(new(structpb.Struct)),
+			DeletedAt: timestamppb.Now(),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("scim: error deleting record: %w", err)
+	}
+	return nil
+}
+
+// isVersionMismatch returns true if a put failed because a record's expected
+// version didn't match.
+func isVersionMismatch(err error) bool {
+	return status.Code(err) == codes.Aborted
+}
+
+func newRecord(recordType, id string, v any) (*databroker.Record, error) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("scim: error marshaling record: %w", err)
+	}
+
+	data := new(structpb.Struct)
+	err = protojson.Unmarshal(bs, data)
+	if err != nil {
+		return nil, fmt.Errorf("scim: error marshaling record: %w", err)
+	}
+
+	return &databroker.Record{
+		Type: recordType,
+		Id:   id,
+		Data: protoutil.NewAny(data),
+	}, nil
+}
+
+func unmarshalRecord(record *databroker.Record, v any) error {
+	data := new(structpb.Struct)
+	err := record.GetData().UnmarshalTo(data)
+	if err != nil {
+		return fmt.Errorf("scim: error unmarshaling record: %w", err)
+	}
+
+	bs, err := protojson.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("scim: error unmarshaling record: %w", err)
+	}
+
+	err = json.Unmarshal(bs, v)
+	if err != nil {
+		return fmt.Errorf("scim: error unmarshaling record: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,358 @@
+package scim
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+// A userResource is a SCIM user.
+//
+// https://www.rfc-editor.org/rfc/rfc7643.html#section-4.1
+type userResource struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	UserName    string       `json:"userName"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Name        *name        `json:"name,omitempty"`
+	Emails      []multiValue `json:"emails,omitempty"`
+	Active      *flexBool    `json:"active,omitempty"`
+	Groups      []multiValue `json:"groups,omitempty"`
+	Meta        *meta        `json:"meta,omitempty"`
+}
+
+type name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type multiValue struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location"`
+}
+
+func (srv *Handler) newUserResource(r *http.Request, u *userRecord, groupNames map[string]string) *userResource {
+	active := flexBool(u.Active)
+	res := &userResource{
+		Schemas:     []string{SchemaUser},
+		ID:          u.ID,
+		ExternalID:  u.ExternalID,
+		UserName:    u.UserName,
+		DisplayName: u.DisplayName,
+		Active:      &active,
+		Meta: &meta{
+			ResourceType: "User",
+			Location:     srv.location(r, usersEndpoint, u.ID),
+		},
+	}
+	if u.GivenName != "" || u.FamilyName != "" {
+		res.Name = &name{GivenName: u.GivenName, FamilyName: u.FamilyName}
+	}
+	if u.Email != "" {
+		res.Emails = []multiValue{{Value: u.Email, Type: "work", Primary: true}}
+	}
+	for _, groupID := range u.SCIMGroupIDs {
+		res.Groups = append(res.Groups, multiValue{Value: groupID, Display: groupNames[groupID]})
+	}
+	return res
+}
+
+// applyTo copies the user attributes to a user record. The id of a new user is
+// its external id, or its user name if it has no external id. It has to match
+// the user id of the identity provider's tokens for policy to find the user.
+func (res *userResource) applyTo(u *userRecord) error {
+	if res.UserName == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "userName is required")
+	}
+
+	if u.ID == "" {
+		u.ID = cmp.Or(res.ExternalID, res.UserName)
+	}
+	u.UserName = res.UserName
+	u.ExternalID = res.ExternalID
+	u.GivenName, u.FamilyName = "", ""
+	if res.Name != nil {
+		u.GivenName, u.FamilyName = res.Name.GivenName, res.Name.FamilyName
+	}
+	u.DisplayName = res.DisplayName
+	if u.DisplayName == "" && res.Name != nil {
+		u.DisplayName = cmp.Or(res.Name.Formatted, strings.TrimSpace(res.Name.GivenName+" "+res.Name.FamilyName))
+	}
+	u.Email = ""
+	for _, email := range res.Emails {
+		if email.Primary || u.Email == "" {
+			u.Email = email.Value
+		}
+	}
+	u.Active = res.Active == nil || bool(*res.Active)
+	return nil
+}
+
+func (res *userResource) applyPatch(op patchOperation) error {
+	if op.Path == "" {
+		return unmarshalPatchValue(op, res)
+	}
+
+	p := strings.ToLower(op.Path)
+	switch {
+	case p == "active":
+		if op.Op == patchOpRemove {
+			res.Active = nil
+			return nil
+		}
+		return unmarshalPatchValue(op, &res.Active)
+	case p == "username":
+		return patchString(op, &res.UserName)
+	case p == "externalid":
+		return patchString(op, &res.ExternalID)
+	case p == "displayname":
+		return patchString(op, &res.DisplayName)
+	case strings.HasPrefix(p, "name."):
+		if res.Name == nil {
+			res.Name = new(name)
+		}
+		switch p {
+		case "name.formatted":
+			return patchString(op, &res.Name.Formatted)
+		case "name.givenname":
+			return patchString(op, &res.Name.GivenName)
+		case "name.familyname":
+			return patchString(op, &res.Name.FamilyName)
+		}
+	case p == "emails":
+		if op.Op == patchOpRemove {
+			res.Emails = nil
+			return nil
+		}
+		return unmarshalPatchValue(op, &res.Emails)
+	case strings.HasPrefix(p, "emails[") && strings.HasSuffix(p, "].value"):
+		// e.g. emails[type eq "work"].value
+		var email string
+		if err := patchString(op, &email); err != nil {
+			return err
+		}
+		res.Emails = nil
+		if email != "" {
+			res.Emails = []multiValue{{Value: email, Primary: true}}
+		}
+	}
+
+	// other attributes aren't stored
+	return nil
+}
+
+func (srv *Handler) listUsers(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	users, err := srv.storage.listUsers(ctx)
+	if err != nil {
+		return err
+	}
+
+	if filter := r.FormValue("filter"); filter != "" {
+		attribute, value, err := parseFilter(filter)
+		if err != nil {
+			return err
+		}
+		users = slices.DeleteFunc(users, func(u *userRecord) bool {
+			switch attribute {
+			case "id":
+				return u.ID != value
+			case "username":
+				// user names are case-insensitive
+				return !strings.EqualFold(u.UserName, value)
+			case "externalid":
+				return u.ExternalID != value
+			case "emails.value", "emails":
+				return !strings.EqualFold(u.Email, value)
+			}
+			return true
+		})
+	}
+	slices.SortFunc(users, func(a, b *userRecord) int { return cmp.Compare(a.ID, b.ID) })
+
+	groupNames, err := srv.getGroupNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	resources := make([]*userResource, 0, len(users))
+	for _, u := range users {
+		resources = append(resources, srv.newUserResource(r, u, groupNames))
+	}
+
+	res, err := newListResponse(r, resources)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, res)
+	return nil
+}
+
+func (srv *Handler) createUser(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	var res userResource
+	if err := readJSON(r, &res); err != nil {
+		return err
+	}
+
+	u := new(userRecord)
+	if err := res.applyTo(u); err != nil {
+		return err
+	}
+
+	if _, err := srv.storage.getUser(ctx, u.ID); err == nil {
+		return newError(http.StatusConflict, "uniqueness", "user already exists")
+	} else if !databroker.IsNotFound(err) {
+		return err
+	}
+
+	if err := srv.storage.putUsers(ctx, u); err != nil {
+		return err
+	}
+
+	log.Ctx(ctx).Info().Str("user-id", u.ID).Msg("scim: created user")
+	return srv.writeUser(w, r, http.StatusCreated, u)
+}
+
+func (srv *Handler) getUser(w http.ResponseWriter, r *http.Request) error {
+	u, err := srv.loadUser(r)
+	if err != nil {
+		return err
+	}
+	return srv.writeUser(w, r, http.StatusOK, u)
+}
+
+func (srv *Handler) replaceUser(w http.ResponseWriter, r *http.Request) error {
+	u, err := srv.loadUser(r)
+	if err != nil {
+		return err
+	}
+
+	var res userResource
+	if err := readJSON(r, &res); err != nil {
+		return err
+	}
+
+	return srv.updateUser(w, r, u, &res)
+}
+
+func (srv *Handler) patchUser(w http.ResponseWriter, r *http.Request) error {
+	u, err := srv.loadUser(r)
+	if err != nil {
+		return err
+	}
+
+	ops, err := readPatchRequest(r)
+	if err != nil {
+		return err
+	}
+
+	res := srv.newUserResource(r, u, nil)
+	for _, op := range ops {
+		if err := res.applyPatch(op); err != nil {
+			return err
+		}
+	}
+
+	return srv.updateUser(w, r, u, res)
+}
+
+func (srv *Handler) updateUser(w http.ResponseWriter, r *http.Request, u *userRecord, res *userResource) error {
+	ctx := r.Context()
+
+	wasActive := u.Active
+	if err := res.applyTo(u); err != nil {
+		return err
+	}
+
+	if err := srv.storage.putUsers(ctx, u); err != nil {
+		return err
+	}
+
+	if wasActive && !u.Active {
+		log.Ctx(ctx).Info().Str("user-id", u.ID).Msg("scim: deactivated user")
+		if err := srv.revokeUserSessions(ctx, u.ID); err != nil {
+			return err
+		}
+	}
+
+	return srv.writeUser(w, r, http.StatusOK, u)
+}
+
+func (srv *Handler) deleteUser(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	u, err := srv.loadUser(r)
+	if err != nil {
+		return err
+	}
+
+	if err := srv.storage.deleteUser(ctx, u.ID); err != nil {
+		return err
+	}
+
+	log.Ctx(ctx).Info().Str("user-id", u.ID).Msg("scim: deleted user")
+	if err := srv.revokeUserSessions(ctx, u.ID); err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (srv *Handler) loadUser(r *http.Request) (*userRecord, error) {
+	u, err := srv.storage.getUser(r.Context(), mux.Vars(r)["id"])
+	if databroker.IsNotFound(err) {
+		return nil, newError(http.StatusNotFound, "", "user not found")
+	} else if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (srv *Handler) writeUser(w http.ResponseWriter, r *http.Request, status int, u *userRecord) error {
+	groupNames, err := srv.getGroupNames(r.Context())
+	if err != nil {
+		return err
+	}
+
+	res := srv.newUserResource(r, u, groupNames)
+	w.Header().Set("Location", res.Meta.Location)
+	writeJSON(w, status, res)
+	return nil
+}
+
+// revokeUserSessions revokes the sessions of a deprovisioned user, so that the
+// user has to sign in again.
+func (srv *Handler) revokeUserSessions(ctx context.Context, userID string) error {
+	revoked, err := session.RevokeUser(ctx, srv.storage.client, userID)
+	if err != nil {
+		return err
+	}
+	if len(revoked) > 0 {
+		log.Ctx(ctx).Info().
+			Str("user-id", userID).
+			Strs("session-ids", revoked).
+			Msg("scim: revoked sessions")
+	}
+	return nil
+}
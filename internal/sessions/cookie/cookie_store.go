@@ -4,11 +4,14 @@ package cookie
 import (
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pomerium/pomerium/internal/encoding"
+	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/sessions"
 )
 
@@ -35,6 +38,18 @@ const (
 	MaxNumChunks = 5
 )
 
+// A ChunkedCookieError indicates that a chunked cookie is missing chunks or
+// that its chunks don't match the length and checksum recorded in the first
+// chunk.
+type ChunkedCookieError struct {
+	Name   string
+	Reason string
+}
+
+func (err *ChunkedCookieError) Error() string {
+	return fmt.Sprintf("internal/sessions: chunked cookie %s is corrupt: %s", err.Name, err.Reason)
+}
+
 // Options holds options for Store
 type Options struct {
 	Name     string
@@ -43,22 +58,64 @@ type Options struct {
 	HTTPOnly bool
 	Secure   bool
 	SameSite http.SameSite
+	// Compression is the compression algorithm applied to the cookie value
+	// before it is chunked.
+	Compression httputil.CookieCompression
+	// MaxChunkSize overrides the default MaxChunkSize if set.
+	MaxChunkSize int
+	// MaxNumChunks overrides the default MaxNumChunks if set.
+	MaxNumChunks int
+	// Prefix is added to the cookie name. The attributes required by the
+	// prefix are enforced.
+	Prefix httputil.CookiePrefix
+	// Partitioned sets the Partitioned attribute (CHIPS) on the cookie, which
+	// is needed for the cookie to be sent in third-party contexts (e.g. iframes).
+	Partitioned bool
+}
+
+func (opts Options) maxChunkSize() int {
+	if opts.MaxChunkSize > 0 {
+		return opts.MaxChunkSize
+	}
+	return MaxChunkSize
+}
+
+func (opts Options) maxNumChunks() int {
+	if opts.MaxNumChunks > 0 {
+		return opts.MaxNumChunks
+	}
+	return MaxNumChunks
 }
 
 // A GetOptionsFunc is a getter for cookie options.
 type GetOptionsFunc func() Options
 
+// A GetAttributesFunc returns the cookie attribute overrides for a request.
+type GetAttributesFunc func(r *http.Request) httputil.CookieAttributes
+
+// A StoreOption customizes a Store.
+type StoreOption func(cs *Store)
+
+// WithAttributes overrides the attributes of the cookies set for a request,
+// e.g. to use different attributes for some routes.
+func WithAttributes(getAttributes GetAttributesFunc) StoreOption {
+	return func(cs *Store) {
+		cs.getAttributes = getAttributes
+	}
+}
+
 // Store implements the session store interface for session cookies.
 type Store struct {
-	getOptions GetOptionsFunc
-	encoder    encoding.Marshaler
-	decoder    encoding.Unmarshaler
+	getOptions    GetOptionsFunc
+	getAttributes GetAttributesFunc
+	encoder       encoding.Marshaler
+	decoder       encoding.Unmarshaler
 }
 
 // NewStore returns a new store that implements the SessionStore interface
 // using http cookies.
-func NewStore(getOptions GetOptionsFunc, encoder encoding.MarshalUnmarshaler) (sessions.SessionStore, error) {
-	cs, err := NewCookieLoader(getOptions, encoder)
+func NewStore(getOptions GetOptionsFunc, encoder encoding.MarshalUnmarshaler, options ...StoreOption) (sessions.SessionStore, error) {
+	cs, err := NewCookieLoader(getOptions, encoder, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -68,12 +125,15 @@ func NewStore(getOptions GetOptionsFunc, encoder encoding.MarshalUnmarshaler) (s
 
 // NewCookieLoader returns a new store that implements the SessionLoader
 // interface using http cookies.
-func NewCookieLoader(getOptions GetOptionsFunc, dencoder encoding.Unmarshaler) (*Store, error) {
+func NewCookieLoader(getOptions GetOptionsFunc, dencoder encoding.Unmarshaler, options ...StoreOption) (*Store, error) {
 	if dencoder == nil {
 		return nil, fmt.Errorf("internal/sessions: dencoder cannot be nil")
 	}
 	cs := newStore(getOptions)
 	cs.decoder = dencoder
+	for _, option := range options {
+		option(cs)
+	}
 	return cs, nil
 }
 
@@ -83,26 +143,33 @@ func newStore(getOptions GetOptionsFunc) *Store {
 	}
 }
 
-func (cs *Store) makeCookie(value string) *http.Cookie {
+func (cs *Store) makeCookie(r *http.Request, value string) *http.Cookie {
 	opts := cs.getOptions()
-	return &http.Cookie{
-		Name:     opts.Name,
-		Value:    value,
-		Path:     "/",
-		Domain:   opts.Domain,
-		HttpOnly: opts.HTTPOnly,
-		Secure:   opts.Secure,
-		Expires:  timeNow().Add(opts.Expire),
-		SameSite: opts.SameSite,
+	cookie := &http.Cookie{
+		Name:        opts.Name,
+		Value:       value,
+		Path:        "/",
+		Domain:      opts.Domain,
+		HttpOnly:    opts.HTTPOnly,
+		Secure:      opts.Secure,
+		Expires:     timeNow().Add(opts.Expire),
+		SameSite:    opts.SameSite,
+		Partitioned: opts.Partitioned,
 	}
+	if cs.getAttributes != nil && r != nil {
+		cs.getAttributes(r).Apply(cookie)
+	}
+	httputil.ApplyCookiePrefix(cookie, opts.Prefix)
+	return cookie
 }
 
 // ClearSession clears the session cookie from a request
-func (cs *Store) ClearSession(w http.ResponseWriter, _ *http.Request) {
-	c := cs.makeCookie("")
+func (cs *Store) ClearSession(w http.ResponseWriter, r *http.Request) {
+	c := cs.makeCookie(r, "")
 	c.MaxAge = -1
 	c.Expires = timeNow().Add(-time.Hour)
 	http.SetCookie(w, c)
+	expireStaleChunks(w, r, c, 1)
 }
 
 func getCookies(r *http.Request, name string) []*http.Cookie {
@@ -119,13 +186,21 @@ func getCookies(r *http.Request, name string) []*http.Cookie {
 // LoadSession returns a State from the cookie in the request.
 func (cs *Store) LoadSession(r *http.Request) (string, error) {
 	opts := cs.getOptions()
-	cookies := getCookies(r, opts.Name)
+	cookies := getCookies(r, opts.Prefix.CookieName(opts.Name))
 	if len(cookies) == 0 {
 		return "", sessions.ErrNoSessionFound
 	}
 	var err error
 	for _, cookie := range cookies {
-		jwt := loadChunkedCookie(r, cookie)
+		var jwt string
+		jwt, err = loadChunkedCookie(r, cookie, opts.maxNumChunks())
+		if err != nil {
+			continue
+		}
+		jwt, err = httputil.DecompressCookieValue(jwt)
+		if err != nil {
+			continue
+		}
 		h := &sessions.Handle{}
 		err = cs.decoder.Unmarshal([]byte(jwt), h)
 		if err == nil {
@@ -136,7 +211,7 @@ func (cs *Store) LoadSession(r *http.Request) (string, error) {
 }
 
 // SaveSession saves a session handle to a request's cookie store.
-func (cs *Store) SaveSession(w http.ResponseWriter, _ *http.Request, x any) error {
+func (cs *Store) SaveSession(w http.ResponseWriter, r *http.Request, x any) error {
 	var value string
 	switch v := x.(type) {
 	case []byte:
@@ -154,25 +229,37 @@ func (cs *Store) SaveSession(w http.ResponseWriter, _ *http.Request, x any) erro
 		value = string(data)
 	}
 
-	cs.setSessionCookie(w, value)
-	return nil
+	return cs.setSessionCookie(w, r, value)
 }
 
-func (cs *Store) setSessionCookie(w http.ResponseWriter, val string) {
-	cs.setCookie(w, cs.makeCookie(val))
+func (cs *Store) setSessionCookie(w http.ResponseWriter, r *http.Request, val string) error {
+	opts := cs.getOptions()
+	val, err := httputil.CompressCookieValue(val, opts.Compression)
+	if err != nil {
+		return err
+	}
+	return cs.setCookie(w, r, cs.makeCookie(r, val), opts.maxChunkSize(), opts.maxNumChunks())
 }
 
-func (cs *Store) setCookie(w http.ResponseWriter, cookie *http.Cookie) {
-	if len(cookie.String()) <= MaxChunkSize {
+func (cs *Store) setCookie(w http.ResponseWriter, r *http.Request, cookie *http.Cookie, maxChunkSize, maxNumChunks int) error {
+	if len(cookie.String()) <= maxChunkSize {
 		http.SetCookie(w, cookie)
-		return
+		expireStaleChunks(w, r, cookie, 1)
+		return nil
+	}
+	chunks := chunk(cookie.Value, maxChunkSize)
+	if len(chunks)-1 > maxNumChunks {
+		return fmt.Errorf("internal/sessions: cookie %s requires %d chunks, but at most %d are allowed",
+			cookie.Name, len(chunks), maxNumChunks+1)
 	}
-	for i, c := range chunk(cookie.Value, MaxChunkSize) {
+	for i, c := range chunks {
 		// start with a copy of our original cookie
 		nc := *cookie
 		if i == 0 {
-			// if this is the first cookie, add our canary byte
-			nc.Value = fmt.Sprintf("%s%s", string(ChunkedCanaryByte), c)
+			// if this is the first cookie, add our canary byte and the
+			// length and checksum of the full value
+			nc.Value = fmt.Sprintf("%s%d.%08x%s%s", string(ChunkedCanaryByte),
+				len(cookie.Value), crc32.ChecksumIEEE([]byte(cookie.Value)), string(ChunkedCanaryByte), c)
 		} else {
 			// subsequent parts will be postfixed with their part number
 			nc.Name = fmt.Sprintf("%s_%d", cookie.Name, i)
@@ -180,30 +267,95 @@ func (cs *Store) setCookie(w http.ResponseWriter, cookie *http.Cookie) {
 		}
 		http.SetCookie(w, &nc)
 	}
+	expireStaleChunks(w, r, cookie, len(chunks))
+	return nil
 }
 
-func loadChunkedCookie(r *http.Request, c *http.Cookie) string {
+// expireStaleChunks expires any "{name}_{i}" chunk cookies sent with the
+// request where i >= numChunks. These are left over from a previous, larger,
+// version of the cookie and would otherwise be appended to the new value when
+// it is loaded.
+func expireStaleChunks(w http.ResponseWriter, r *http.Request, cookie *http.Cookie, numChunks int) {
+	if r == nil {
+		return
+	}
+
+	for _, c := range r.Cookies() {
+		suffix, ok := strings.CutPrefix(c.Name, cookie.Name+"_")
+		if !ok {
+			continue
+		}
+		i, err := strconv.Atoi(suffix)
+		if err != nil || i < numChunks {
+			continue
+		}
+
+		nc := *cookie
+		nc.Name = c.Name
+		nc.Value = ""
+		nc.MaxAge = -1
+		nc.Expires = timeNow().Add(-time.Hour)
+		http.SetCookie(w, &nc)
+	}
+}
+
+// loadChunkedCookie loads the full value of a cookie that may be split into
+// chunks. The first chunk of a chunked cookie is formatted as
+// "%{length}.{crc32}%{data}". Cookies written before the length and checksum
+// were added are formatted as "%{data}" and are not verified.
+func loadChunkedCookie(r *http.Request, c *http.Cookie, maxNumChunks int) (string, error) {
 	if len(c.Value) == 0 {
-		return ""
+		return "", nil
 	}
 	// if the first byte is our canary byte, we need to handle the multipart bit
 	if []byte(c.Value)[0] != ChunkedCanaryByte {
-		return c.Value
+		return c.Value, nil
+	}
+
+	data := c.Value[1:]
+	length, checksum, hasChecksum := -1, uint32(0), false
+	if header, rest, ok := strings.Cut(data, string(ChunkedCanaryByte)); ok {
+		rawLength, rawChecksum, ok := strings.Cut(header, ".")
+		if !ok {
+			return "", &ChunkedCookieError{Name: c.Name, Reason: "invalid header"}
+		}
+		var err error
+		length, err = strconv.Atoi(rawLength)
+		if err != nil {
+			return "", &ChunkedCookieError{Name: c.Name, Reason: "invalid length"}
+		}
+		sum, err := strconv.ParseUint(rawChecksum, 16, 32)
+		if err != nil {
+			return "", &ChunkedCookieError{Name: c.Name, Reason: "invalid checksum"}
+		}
+		checksum, hasChecksum, data = uint32(sum), true, rest
 	}
 
-	data := c.Value
 	var b strings.Builder
-	fmt.Fprintf(&b, "%s", data[1:])
-	for i := 1; i <= MaxNumChunks; i++ {
+	b.WriteString(data)
+	for i := 1; i <= maxNumChunks; i++ {
+		if hasChecksum && b.Len() >= length {
+			break
+		}
 		next, err := r.Cookie(fmt.Sprintf("%s_%d", c.Name, i))
 		if err != nil {
 			break // break if we can't find the next cookie
 		}
-		fmt.Fprintf(&b, "%s", next.Value)
+		b.WriteString(next.Value)
 	}
 	data = b.String()
 
-	return data
+	if hasChecksum {
+		if len(data) < length {
+			return "", &ChunkedCookieError{Name: c.Name, Reason: fmt.Sprintf("expected %d bytes, got %d: missing or truncated chunks", length, len(data))}
+		} else if len(data) > length {
+			return "", &ChunkedCookieError{Name: c.Name, Reason: fmt.Sprintf("expected %d bytes, got %d", length, len(data))}
+		} else if crc32.ChecksumIEEE([]byte(data)) != checksum {
+			return "", &ChunkedCookieError{Name: c.Name, Reason: "checksum mismatch"}
+		}
+	}
+
+	return data, nil
 }
 
 func chunk(s string, size int) []string {
@@ -12,11 +12,13 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pomerium/pomerium/internal/encoding"
 	"github.com/pomerium/pomerium/internal/encoding/jws"
 	"github.com/pomerium/pomerium/internal/encoding/mock"
+	"github.com/pomerium/pomerium/internal/httputil"
 	"github.com/pomerium/pomerium/internal/sessions"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 )
@@ -170,3 +172,217 @@ func TestStore_SaveSession(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_Compression(t *testing.T) {
+	key := cryptutil.NewKey()
+	encoder, err := jws.NewHS256Signer(key)
+	require.NoError(t, err)
+
+	state := &sessions.Handle{ID: "xyz", Subject: strings.Repeat("subject", 2000)}
+	for _, compression := range []httputil.CookieCompression{
+		httputil.CookieCompressionNone,
+		httputil.CookieCompressionDeflate,
+		httputil.CookieCompressionZstd,
+	} {
+		t.Run(string(compression), func(t *testing.T) {
+			s := &Store{
+				getOptions: func() Options {
+					return Options{
+						Name:        "_pomerium",
+						Secure:      true,
+						Compression: compression,
+					}
+				},
+				encoder: encoder,
+				decoder: encoder,
+			}
+
+			w := httptest.NewRecorder()
+			require.NoError(t, s.SaveSession(w, nil, state))
+			if compression == httputil.CookieCompressionNone {
+				require.Greater(t, len(w.Result().Cookies()), 1)
+			} else {
+				require.Len(t, w.Result().Cookies(), 1, "should fit in a single chunk")
+			}
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for _, cookie := range w.Result().Cookies() {
+				r.AddCookie(cookie)
+			}
+			jwt, err := s.LoadSession(r)
+			require.NoError(t, err)
+
+			var h sessions.Handle
+			require.NoError(t, encoder.Unmarshal([]byte(jwt), &h))
+			require.Equal(t, state.Subject, h.Subject)
+		})
+	}
+}
+
+func TestStore_ChunkIntegrity(t *testing.T) {
+	key := cryptutil.NewKey()
+	encoder, err := jws.NewHS256Signer(key)
+	require.NoError(t, err)
+
+	s := &Store{
+		getOptions: func() Options {
+			return Options{
+				Name:         "_pomerium",
+				Secure:       true,
+				MaxChunkSize: 512,
+				MaxNumChunks: 10,
+			}
+		},
+		encoder: encoder,
+		decoder: encoder,
+	}
+
+	w := httptest.NewRecorder()
+	require.NoError(t, s.SaveSession(w, nil, &sessions.Handle{ID: "xyz", Subject: strings.Repeat("x", 2048)}))
+	cookies := w.Result().Cookies()
+	require.Greater(t, len(cookies), 4)
+
+	t.Run("ok", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		for _, cookie := range cookies {
+			r.AddCookie(cookie)
+		}
+		_, err := s.LoadSession(r)
+		require.NoError(t, err)
+	})
+	t.Run("missing chunk", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		for _, cookie := range cookies[:len(cookies)-1] {
+			r.AddCookie(cookie)
+		}
+		_, err := s.LoadSession(r)
+		var chunkErr *ChunkedCookieError
+		require.ErrorAs(t, err, &chunkErr)
+		require.ErrorIs(t, err, sessions.ErrMalformed)
+	})
+	t.Run("modified chunk", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		for i, cookie := range cookies {
+			if i == 2 {
+				cookie = &http.Cookie{Name: cookie.Name, Value: strings.Repeat("A", len(cookie.Value))}
+			}
+			r.AddCookie(cookie)
+		}
+		_, err := s.LoadSession(r)
+		var chunkErr *ChunkedCookieError
+		require.ErrorAs(t, err, &chunkErr)
+		require.Equal(t, "checksum mismatch", chunkErr.Reason)
+	})
+	t.Run("too many chunks", func(t *testing.T) {
+		s := &Store{
+			getOptions: func() Options {
+				return Options{Name: "_pomerium", MaxChunkSize: 512, MaxNumChunks: 2}
+			},
+			encoder: encoder,
+		}
+		err := s.SaveSession(httptest.NewRecorder(), nil, &sessions.Handle{ID: "xyz", Subject: strings.Repeat("x", 2048)})
+		require.Error(t, err)
+	})
+}
+
+func TestStore_ExpireStaleChunks(t *testing.T) {
+	key := cryptutil.NewKey()
+	encoder, err := jws.NewHS256Signer(key)
+	require.NoError(t, err)
+
+	s := &Store{
+		getOptions: func() Options {
+			return Options{Name: "_pomerium", MaxChunkSize: 512}
+		},
+		encoder: encoder,
+		decoder: encoder,
+	}
+
+	w := httptest.NewRecorder()
+	require.NoError(t, s.SaveSession(w, nil, &sessions.Handle{ID: "xyz", Subject: strings.Repeat("x", 2048)}))
+	large := w.Result().Cookies()
+	require.Greater(t, len(large), 2)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	for _, cookie := range large {
+		r.AddCookie(cookie)
+	}
+	r.AddCookie(&http.Cookie{Name: "_pomerium_other", Value: "x"})
+
+	w = httptest.NewRecorder()
+	require.NoError(t, s.SaveSession(w, r, &sessions.Handle{ID: "xyz"}))
+
+	var expired []string
+	for _, cookie := range w.Result().Cookies() {
+		if cookie.MaxAge < 0 {
+			expired = append(expired, cookie.Name)
+		}
+	}
+	var want []string
+	for i := 1; i < len(large); i++ {
+		want = append(want, fmt.Sprintf("_pomerium_%d", i))
+	}
+	require.Equal(t, want, expired)
+}
+
+func TestStore_Partitioned(t *testing.T) {
+	key := cryptutil.NewKey()
+	encoder, err := jws.NewHS256Signer(key)
+	require.NoError(t, err)
+
+	s := &Store{
+		getOptions: func() Options {
+			return Options{Name: "_pomerium", Secure: true, Partitioned: true, MaxChunkSize: 512}
+		},
+		encoder: encoder,
+		decoder: encoder,
+	}
+
+	w := httptest.NewRecorder()
+	require.NoError(t, s.SaveSession(w, nil, &sessions.Handle{ID: "xyz", Subject: strings.Repeat("x", 2048)}))
+	values := w.Header().Values("Set-Cookie")
+	require.Greater(t, len(values), 1)
+	for _, value := range values {
+		require.Contains(t, value, "; Partitioned", "all chunks should be partitioned")
+	}
+}
+
+func TestStore_Attributes(t *testing.T) {
+	key := cryptutil.NewKey()
+	encoder, err := jws.NewHS256Signer(key)
+	require.NoError(t, err)
+
+	secure := false
+	s, err := NewStore(func() Options {
+		return Options{Name: "_pomerium", Domain: "example.com", Secure: true, SameSite: http.SameSiteLaxMode}
+	}, encoder, WithAttributes(func(r *http.Request) httputil.CookieAttributes {
+		if r.Host == "embedded.example.com" {
+			return httputil.CookieAttributes{SameSite: http.SameSiteNoneMode, Domain: "embedded.example.com"}
+		}
+		if r.Host == "insecure.example.com" {
+			return httputil.CookieAttributes{Secure: &secure}
+		}
+		return httputil.CookieAttributes{}
+	}))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		host     string
+		domain   string
+		secure   bool
+		sameSite http.SameSite
+	}{
+		{"app.example.com", "example.com", true, http.SameSiteLaxMode},
+		{"embedded.example.com", "embedded.example.com", true, http.SameSiteNoneMode},
+		{"insecure.example.com", "example.com", false, http.SameSiteLaxMode},
+	} {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "https://"+tc.host, nil)
+		require.NoError(t, s.SaveSession(w, r, "TOKEN"))
+		cookies := w.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, tc.domain, cookies[0].Domain, tc.host)
+		assert.Equal(t, tc.secure, cookies[0].Secure, tc.host)
+		assert.Equal(t, tc.sameSite, cookies[0].SameSite, tc.host)
+	}
+}
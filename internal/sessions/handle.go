@@ -3,6 +3,7 @@ package sessions
 import (
 	"encoding/json"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/go-jose/go-jose/v3/jwt"
@@ -22,6 +23,7 @@ type Handle struct {
 	Subject  string           `json:"sub,omitempty"`
 	Audience jwt.Audience     `json:"aud,omitempty"`
 	IssuedAt *jwt.NumericDate `json:"iat,omitempty"`
+	Expiry   *jwt.NumericDate `json:"exp,omitempty"`
 	ID       string           `json:"jti,omitempty"`
 
 	// Azure returns OID which should be used instead of subject.
@@ -36,6 +38,10 @@ type Handle struct {
 
 	// IdentityProviderID is the identity provider for the session.
 	IdentityProviderID string `json:"idp_id,omitempty"`
+
+	// RouteIDs limits the handle to the given routes. If empty the handle can
+	// be used for any route.
+	RouteIDs []string `json:"route_ids,omitempty"`
 }
 
 // NewHandle creates a new Handle.
@@ -59,6 +65,24 @@ func (h *Handle) WithNewIssuer(issuer string, audience []string) Handle {
 	return nh
 }
 
+// IsScoped returns true if the handle has an expiry or is limited to specific
+// routes.
+func (h *Handle) IsScoped() bool {
+	return h.Expiry != nil || len(h.RouteIDs) > 0
+}
+
+// ValidateForRoute checks that the handle has not expired and that it can be
+// used for the given route.
+func (h *Handle) ValidateForRoute(routeID string) error {
+	if h.Expiry != nil && timeNow().After(h.Expiry.Time()) {
+		return ErrExpired
+	}
+	if len(h.RouteIDs) > 0 && !slices.Contains(h.RouteIDs, routeID) {
+		return ErrInvalidAudience
+	}
+	return nil
+}
+
 // UserID returns the corresponding user ID for a session.
 func (h *Handle) UserID() string {
 	if h.OID != "" {
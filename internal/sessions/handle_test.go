@@ -2,6 +2,7 @@ package sessions
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -64,3 +65,31 @@ func TestHandle_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestHandle_ValidateForRoute(t *testing.T) {
+	t.Parallel()
+
+	past := jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	future := jwt.NewNumericDate(time.Now().Add(time.Minute))
+
+	tests := []struct {
+		name    string
+		in      *Handle
+		routeID string
+		want    error
+	}{
+		{"unscoped", &Handle{ID: "xyz"}, "r1", nil},
+		{"not expired", &Handle{ID: "xyz", Expiry: future}, "r1", nil},
+		{"expired", &Handle{ID: "xyz", Expiry: past}, "r1", ErrExpired},
+		{"allowed route", &Handle{ID: "xyz", RouteIDs: []string{"r1", "r2"}}, "r2", nil},
+		{"other route", &Handle{ID: "xyz", RouteIDs: []string{"r1", "r2"}}, "r3", ErrInvalidAudience},
+		{"no route", &Handle{ID: "xyz", RouteIDs: []string{"r1"}}, "", ErrInvalidAudience},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.in.ValidateForRoute(tt.routeID); !errors.Is(err, tt.want) {
+				t.Errorf("Handle.ValidateForRoute() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+// Package serverside provides a session store which keeps session handles in
+// the databroker. The session cookie only holds an opaque random id, so that
+// large session handles don't have to fit in cookies.
+package serverside
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/encoding"
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/sessions/cookie"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// RecordType is the record type of server-side session records.
+const RecordType = "pomerium.io/ServerSideSession"
+
+// idSize is the number of random bytes in a session id.
+const idSize = 32
+
+var _ sessions.SessionStore = (*Store)(nil)
+
+// timeNow is time.Now but pulled out as a variable for tests.
+var timeNow = time.Now
+
+// Store implements the session store interface by saving session handles to
+// the databroker and the ids of the databroker records to a cookie.
+type Store struct {
+	getOptions cookie.GetOptionsFunc
+	cookie     sessions.SessionStore
+	encoder    encoding.Marshaler
+	client     databroker.DataBrokerServiceClient
+	querier    storage.Querier
+}
+
+// NewStore returns a new server-side session store. Session records are
+// looked up with the querier, typically a sync querier for RecordType, and
+// then with the databroker client if the querier can't find them.
+func NewStore(
+	getOptions cookie.GetOptionsFunc,
+	encoder encoding.Marshaler,
+	client databroker.DataBrokerServiceClient,
+	querier storage.Querier,
+	options ...cookie.StoreOption,
+) (*Store, error) {
+	cs, err := cookie.NewStore(getOptions, idCodec{}, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{
+		getOptions: getOptions,
+		cookie:     cs,
+		encoder:    encoder,
+		client:     client,
+		querier:    querier,
+	}, nil
+}
+
+// ClearSession deletes the session record and clears the session cookie.
+func (s *Store) ClearSession(w http.ResponseWriter, r *http.Request) {
+	if id, err := s.cookie.LoadSession(r); err == nil {
+		if err := s.deleteRecord(r.Context(), id); err != nil {
+			log.Ctx(r.Context()).Error().Err(err).Msg("sessions/serverside: failed to delete session record")
+		}
+	}
+	s.cookie.ClearSession(w, r)
+}
+
+// LoadSession returns the session handle JWT for the id in the session cookie.
+func (s *Store) LoadSession(r *http.Request) (string, error) {
+	id, err := s.cookie.LoadSession(r)
+	if err != nil {
+		return "", err
+	}
+
+	record, err := s.getRecord(r.Context(), id)
+	if err != nil {
+		return "", err
+	}
+
+	fields := new(structpb.Struct)
+	if err := record.GetData().UnmarshalTo(fields); err != nil {
+		return "", fmt.Errorf("%w: %w", sessions.ErrMalformed, err)
+	}
+	if expiresAt := fields.GetFields()["expires_at"].GetStringValue(); expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", sessions.ErrMalformed, err)
+		}
+		if timeNow().After(t) {
+			// storage backends without record ttls keep expired records, so
+			// they're removed when they're found
+			if err := s.deleteRecord(r.Context(), id); err != nil {
+				log.Ctx(r.Context()).Error().Err(err).Msg("sessions/serverside: failed to delete expired session record")
+			}
+			return "", sessions.ErrExpired
+		}
+	}
+	rawJWT := fields.GetFields()["jwt"].GetStringValue()
+	if rawJWT == "" {
+		return "", sessions.ErrMalformed
+	}
+	return rawJWT, nil
+}
+
+// SaveSession saves the session handle to a new session record and sets the
+// session cookie to the id of the record. The previous record, if any, is
+// deleted so that session ids aren't reused.
+func (s *Store) SaveSession(w http.ResponseWriter, r *http.Request, x any) error {
+	var rawJWT string
+	switch v := x.(type) {
+	case []byte:
+		rawJWT = string(v)
+	case string:
+		rawJWT = v
+	default:
+		data, err := s.encoder.Marshal(x)
+		if err != nil {
+			return err
+		}
+		rawJWT = string(data)
+	}
+
+	ctx := r.Context()
+	if id, err := s.cookie.LoadSession(r); err == nil {
+		if err := s.deleteRecord(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	id := newID()
+	fields := map[string]*structpb.Value{
+		"jwt": structpb.NewStringValue(rawJWT),
+	}
+	if expire := s.getOptions().Expire; expire > 0 {
+		fields["expires_at"] = structpb.NewStringValue(timeNow().Add(expire).UTC().Format(time.RFC3339))
+	}
+	res, err := s.client.Put(ctx, &databroker.PutRequest{
+		Records: []*databroker.Record{{
+			Type: RecordType,
+			Id:   id,
+			Data: protoutil.NewAny(&structpb.Struct{Fields: fields}),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("sessions/serverside: error saving session record: %w", err)
+	}
+	s.invalidateCache(ctx, res.GetRecords()...)
+
+	return s.cookie.SaveSession(w, r, id)
+}
+
+func (s *Store) getRecord(ctx context.Context, id string) (*databroker.Record, error) {
+	req := &databroker.QueryRequest{
+		Type:  RecordType,
+		Limit: 1,
+	}
+	req.SetFilterByID(id)
+
+	res, err := s.querier.Query(ctx, req, grpc.WaitForReady(true))
+	if err != nil || len(res.GetRecords()) == 0 {
+		// the querier may be unavailable or may not have synced a record
+		// saved by another instance yet
+		res, err = s.client.Query(ctx, req, grpc.WaitForReady(true))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(res.GetRecords()) == 0 || res.GetRecords()[0].GetDeletedAt() != nil {
+		return nil, sessions.ErrNoSessionFound
+	}
+	return res.GetRecords()[0], nil
+}
+
+func (s *Store) deleteRecord(ctx context.Context, id string) error {
+	res, err := s.client.Put(ctx, &databroker.PutRequest{
+		Records: []*databroker.Record{{
+			Type:      RecordType,
+			Id:        id,
+			Data:      protoutil.NewAny(new(structpb.Struct)),
+			DeletedAt: timestamppb.Now(),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("sessions/serverside: error deleting session record: %w", err)
+	}
+	s.invalidateCache(ctx, res.GetRecords()...)
+	return nil
+}
+
+// invalidateCache makes the querier fall back to the databroker until it has
+// synced the given records.
+func (s *Store) invalidateCache(ctx context.Context, records ...*databroker.Record) {
+	for _, record := range records {
+		req := &databroker.QueryRequest{
+			Type:                     record.GetType(),
+			Limit:                    1,
+			MinimumRecordVersionHint: proto.Uint64(record.GetVersion()),
+		}
+		req.SetFilterByID(record.GetId())
+		s.querier.InvalidateCache(ctx, req)
+	}
+}
+
+func newID() string {
+	b := make([]byte, idSize)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// idCodec validates the session ids stored in cookies.
+type idCodec struct{}
+
+func (idCodec) Marshal(any) ([]byte, error) {
+	return nil, errors.New("sessions/serverside: session ids cannot be marshaled")
+}
+
+func (idCodec) Unmarshal(data []byte, _ any) error {
+	b, err := base64.RawURLEncoding.DecodeString(string(data))
+	if err != nil || len(b) != idSize {
+		return errors.New("sessions/serverside: invalid session id")
+	}
+	return nil
+}
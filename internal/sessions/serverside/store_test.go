@@ -0,0 +1,140 @@
+package serverside_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/sessions/cookie"
+	"github.com/pomerium/pomerium/internal/sessions/serverside"
+	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	srv := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(srv.Stop)
+
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, srv)
+	})
+	t.Cleanup(func() { cc.Close() })
+
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+	querier := storage.NewSyncQuerier(client, serverside.RecordType)
+	t.Cleanup(querier.Stop)
+
+	encoder, err := jws.NewHS256Signer(cryptutil.NewKey())
+	require.NoError(t, err)
+
+	store, err := serverside.NewStore(func() cookie.Options {
+		return cookie.Options{Name: "_pomerium", Secure: true, Expire: time.Hour}
+	}, encoder, client, querier)
+	require.NoError(t, err)
+
+	// withCookies returns a request with the cookies set by a response.
+	withCookies := func(w *httptest.ResponseRecorder) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+		for _, c := range w.Result().Cookies() {
+			if c.MaxAge >= 0 {
+				r.AddCookie(c)
+			}
+		}
+		return r
+	}
+
+	t.Run("missing", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+		_, err := store.LoadSession(r)
+		assert.ErrorIs(t, err, sessions.ErrNoSessionFound)
+	})
+	t.Run("invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+		r.AddCookie(&http.Cookie{Name: "_pomerium", Value: "not-an-id"})
+		_, err := store.LoadSession(r)
+		assert.ErrorIs(t, err, sessions.ErrMalformed)
+	})
+	t.Run("unknown id", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "https://example.com", nil)
+		r.AddCookie(&http.Cookie{Name: "_pomerium", Value: base64.RawURLEncoding.EncodeToString(cryptutil.NewKey())})
+		_, err := store.LoadSession(r)
+		assert.ErrorIs(t, err, sessions.ErrNoSessionFound)
+	})
+	t.Run("save load and clear", func(t *testing.T) {
+		t.Parallel()
+
+		h := &sessions.Handle{ID: "SESSION_ID", Issuer: "authenticate.example.com"}
+		rawJWT, err := encoder.Marshal(h)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		require.NoError(t, store.SaveSession(w, httptest.NewRequest(http.MethodGet, "https://example.com", nil), h))
+		r := withCookies(w)
+
+		c, err := r.Cookie("_pomerium")
+		require.NoError(t, err)
+		assert.NotContains(t, c.Value, ".", "cookie should only hold an opaque id")
+
+		loaded, err := store.LoadSession(r)
+		assert.NoError(t, err)
+		assert.Equal(t, string(rawJWT), loaded)
+
+		// saving again replaces the id and deletes the previous record
+		w = httptest.NewRecorder()
+		require.NoError(t, store.SaveSession(w, r, rawJWT))
+		_, err = store.LoadSession(r)
+		assert.ErrorIs(t, err, sessions.ErrNoSessionFound)
+		r = withCookies(w)
+		loaded, err = store.LoadSession(r)
+		assert.NoError(t, err)
+		assert.Equal(t, string(rawJWT), loaded)
+
+		store.ClearSession(httptest.NewRecorder(), r)
+		_, err = store.LoadSession(r)
+		assert.ErrorIs(t, err, sessions.ErrNoSessionFound)
+	})
+	t.Run("expired", func(t *testing.T) {
+		t.Parallel()
+
+		// expiration times are stored with second precision, so the record
+		// expires right away
+		expiringStore, err := serverside.NewStore(func() cookie.Options {
+			return cookie.Options{Name: "_pomerium", Secure: true, Expire: time.Nanosecond}
+		}, encoder, client, querier)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		require.NoError(t, expiringStore.SaveSession(w, httptest.NewRequest(http.MethodGet, "https://example.com", nil), "JWT"))
+		r := withCookies(w)
+
+		_, err = expiringStore.LoadSession(r)
+		assert.ErrorIs(t, err, sessions.ErrExpired)
+
+		c, err := r.Cookie("_pomerium")
+		require.NoError(t, err)
+		_, err = client.Get(t.Context(), &databrokerpb.GetRequest{Type: serverside.RecordType, Id: c.Value})
+		assert.Equal(t, codes.NotFound, status.Code(err), "should delete the expired record")
+	})
+}
@@ -3,6 +3,9 @@ package telemetry
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -11,10 +14,70 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
 	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 
 	"github.com/pomerium/pomerium/pkg/telemetry/trace"
 )
 
+// defaultDurationBuckets are the histogram bucket boundaries, in seconds, used
+// for operation durations.
+var defaultDurationBuckets = []float64{
+	0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60,
+}
+
+type componentConfig struct {
+	attributes      []attribute.KeyValue
+	logSampleRate   uint64
+	spanSampleRate  uint64
+	durationBuckets []float64
+}
+
+// A ComponentOption customizes the component config.
+type ComponentOption func(cfg *componentConfig)
+
+// WithAttributes adds attributes to every operation of the component.
+func WithAttributes(attributes ...attribute.KeyValue) ComponentOption {
+	return func(cfg *componentConfig) {
+		cfg.attributes = append(cfg.attributes, attributes...)
+	}
+}
+
+// WithLogSampling logs only 1 of every n successful completions of each
+// operation. Failures are always logged. A rate of 0 or 1 logs every operation.
+func WithLogSampling(n uint64) ComponentOption {
+	return func(cfg *componentConfig) {
+		cfg.logSampleRate = n
+	}
+}
+
+// WithSpanSampling traces only 1 of every n calls to each operation. Operations
+// started within a sampled span are always traced, so that existing traces
+// stay complete. A rate of 0 or 1 traces every operation.
+func WithSpanSampling(n uint64) ComponentOption {
+	return func(cfg *componentConfig) {
+		cfg.spanSampleRate = n
+	}
+}
+
+// WithDurationBuckets sets the histogram bucket boundaries, in seconds, used
+// for the duration of the component's operations. Components with the same
+// name share their histograms, which use the buckets of the first component
+// to complete each operation.
+func WithDurationBuckets(boundaries ...float64) ComponentOption {
+	return func(cfg *componentConfig) {
+		cfg.durationBuckets = boundaries
+	}
+}
+
+func getComponentConfig(options ...ComponentOption) *componentConfig {
+	cfg := new(componentConfig)
+	WithDurationBuckets(defaultDurationBuckets...)(cfg)
+	for _, option := range options {
+		option(cfg)
+	}
+	return cfg
+}
+
 // A Component represents a component of Pomerium and is used to trace and log operations
 type Component struct {
 	logLevel       zerolog.Level
@@ -22,10 +85,20 @@ type Component struct {
 	attributes     []attribute.KeyValue
 	tracer         oteltrace.Tracer
 	tracerProvider oteltrace.TracerProvider
+	noopTracer     oteltrace.Tracer
+
+	logSampleRate   uint64
+	spanSampleRate  uint64
+	durationBuckets []float64
+	// counters are shared by copies of the component, keyed by operation
+	// name
+	logCounters  *sync.Map
+	spanCounters *sync.Map
 }
 
 // NewComponent creates a new Component.
-func NewComponent(tracerProvider oteltrace.TracerProvider, logLevel zerolog.Level, component string, attributes ...attribute.KeyValue) *Component {
+func NewComponent(tracerProvider oteltrace.TracerProvider, logLevel zerolog.Level, component string, options ...ComponentOption) *Component {
+	cfg := getComponentConfig(options...)
 	tracer := tracerProvider.Tracer(trace.PomeriumCoreTracer)
 
 	c := &Component{
@@ -33,9 +106,15 @@ func NewComponent(tracerProvider oteltrace.TracerProvider, logLevel zerolog.Leve
 		component:      component,
 		tracer:         tracer,
 		tracerProvider: tracerProvider,
+		noopTracer:     noop.NewTracerProvider().Tracer(trace.PomeriumCoreTracer),
 		attributes: append([]attribute.KeyValue{
 			attribute.String("component", component),
-		}, attributes...),
+		}, cfg.attributes...),
+		logSampleRate:   cfg.logSampleRate,
+		spanSampleRate:  cfg.spanSampleRate,
+		durationBuckets: cfg.durationBuckets,
+		logCounters:     new(sync.Map),
+		spanCounters:    new(sync.Map),
 	}
 	return c
 }
@@ -51,7 +130,13 @@ func (c *Component) Start(ctx context.Context, operationName string, attributes
 	attributes = append(c.attributes, attributes...)
 
 	// setup tracing
-	ctx, span := c.tracer.Start(ctx, c.component+"."+operationName, oteltrace.WithAttributes(attributes...))
+	tracer := c.tracer
+	if !c.shouldTrace(ctx, operationName) {
+		// the noop span keeps the parent span context, so any child spans
+		// are still part of the parent trace
+		tracer = c.noopTracer
+	}
+	ctx, span := tracer.Start(ctx, c.component+"."+operationName, oteltrace.WithAttributes(attributes...))
 
 	// setup logging
 	ctx = logger(ctx, attributes...).WithContext(ctx)
@@ -71,6 +156,26 @@ func (c *Component) GetTracerProvider() oteltrace.TracerProvider {
 	return c.tracerProvider
 }
 
+func (c *Component) shouldTrace(ctx context.Context, operationName string) bool {
+	if c.spanSampleRate <= 1 || oteltrace.SpanContextFromContext(ctx).IsSampled() {
+		return true
+	}
+	return sample(c.spanCounters, operationName, c.spanSampleRate)
+}
+
+func (c *Component) shouldLogSuccess(operationName string) bool {
+	if c.logSampleRate <= 1 {
+		return true
+	}
+	return sample(c.logCounters, operationName, c.logSampleRate)
+}
+
+// sample returns true for the first and then every nth call for the key.
+func sample(counters *sync.Map, key string, n uint64) bool {
+	v, _ := counters.LoadOrStore(key, new(atomic.Uint64))
+	return (v.(*atomic.Uint64).Add(1)-1)%n == 0
+}
+
 type ActiveGauge struct {
 	c   *Component
 	g   metric.Int64Gauge
@@ -122,13 +227,25 @@ func (op *Operation) complete(err error, attributes ...attribute.KeyValue) {
 	attributes = append(op.c.attributes, attributes...)
 
 	getInt64Counter(op.c.component, op.name+".calls").Add(op.ctx, 1, metric.WithAttributes(attributes...))
-	getFloat64Histogram(op.c.component, op.name+".duration", metric.WithUnit("s")).Record(op.ctx, time.Since(op.start).Seconds(), metric.WithAttributes(attributes...))
+
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+	getDurationHistogram(op.c.component, op.name, op.c.durationBuckets).Record(op.ctx, time.Since(op.start).Seconds(),
+		metric.WithAttributes(append(slices.Clip(attributes), attribute.String("outcome", outcome))...))
 
 	if err == nil {
 		getInt64Counter(op.c.component, op.name+".successes").Add(op.ctx, 1, metric.WithAttributes(attributes...))
 
-		l := logger(op.ctx, attributes...)
-		l.WithLevel(op.c.logLevel).Msgf("%s.%s succeeded", op.c.component, op.name)
+		if op.c.shouldLogSuccess(op.name) {
+			l := logger(op.ctx, attributes...)
+			evt := l.WithLevel(op.c.logLevel)
+			if op.c.logSampleRate > 1 {
+				evt = evt.Uint64("log-sample-rate", op.c.logSampleRate)
+			}
+			evt.Msgf("%s.%s succeeded", op.c.component, op.name)
+		}
 
 		op.span.SetStatus(codes.Ok, "ok")
 	} else {
@@ -0,0 +1,103 @@
+package telemetry
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestComponent_LogSampling(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	ctx := zerolog.New(&buf).WithContext(t.Context())
+
+	c := NewComponent(noop.NewTracerProvider(), zerolog.InfoLevel, "test", WithLogSampling(3))
+	for range 7 {
+		_, op := c.Start(ctx, "Op")
+		op.Complete()
+	}
+	_, op := c.Start(ctx, "Op")
+	_ = op.Failure(errors.New("ERROR"))
+
+	assert.Equal(t, 3, strings.Count(buf.String(), "test.Op succeeded"),
+		"should log 1 of every 3 successes")
+	assert.Equal(t, 1, strings.Count(buf.String(), "test.Op failed"),
+		"should always log failures")
+	assert.Contains(t, buf.String(), `"log-sample-rate":3`)
+}
+
+func TestComponent_SpanSampling(t *testing.T) {
+	t.Parallel()
+
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+
+	c := NewComponent(tp, zerolog.InfoLevel, "test", WithSpanSampling(4))
+	for range 8 {
+		_, op := c.Start(t.Context(), "Op")
+		op.Complete()
+	}
+	assert.Len(t, sr.Ended(), 2, "should trace 1 of every 4 operations")
+
+	ctx, parent := tp.Tracer("test").Start(t.Context(), "Parent")
+	for range 3 {
+		_, op := c.Start(ctx, "Op")
+		op.Complete()
+	}
+	parent.End()
+	assert.Len(t, sr.Ended(), 6, "should trace every operation within a sampled span")
+}
+
+func TestComponent_DurationHistogram(t *testing.T) {
+	// the global meter provider is replaced, so this test can't run in parallel
+	reader := sdkmetric.NewManualReader()
+	original := otel.GetMeterProvider()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	t.Cleanup(func() { otel.SetMeterProvider(original) })
+
+	c := NewComponent(noop.NewTracerProvider(), zerolog.InfoLevel, "test-duration",
+		WithDurationBuckets(1, 10))
+	for range 2 {
+		_, op := c.Start(t.Context(), "Op")
+		op.Complete()
+	}
+	// a component with the same name shares the histogram of the first one
+	c = NewComponent(noop.NewTracerProvider(), zerolog.InfoLevel, "test-duration",
+		WithDurationBuckets(5))
+	_, op := c.Start(t.Context(), "Op")
+	_ = op.Failure(errors.New("ERROR"))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+
+	counts := map[string]uint64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "test-duration.Op.duration" {
+				continue
+			}
+			assert.Equal(t, "s", m.Unit)
+			h, ok := m.Data.(metricdata.Histogram[float64])
+			require.True(t, ok, "should be a float64 histogram")
+			for _, dp := range h.DataPoints {
+				assert.Equal(t, []float64{1, 10}, dp.Bounds)
+				outcome, _ := dp.Attributes.Value(attribute.Key("outcome"))
+				counts[outcome.AsString()] += dp.Count
+			}
+		}
+	}
+	assert.Equal(t, map[string]uint64{"success": 2, "failure": 1}, counts)
+}
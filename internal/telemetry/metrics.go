@@ -1,6 +1,7 @@
 package telemetry
 
 import (
+	"fmt"
 	"sync"
 
 	"go.opentelemetry.io/otel"
@@ -56,9 +57,16 @@ func getInt64Counter(component, name string) metric.Int64Counter {
 	return c
 }
 
-func getFloat64Histogram(component, name string, options ...metric.Float64HistogramOption) metric.Float64Histogram {
+// getDurationHistogram returns the histogram of the durations of an operation.
+// The histogram is created by the first call for the component and operation,
+// so the buckets of that call are used for every later call. OpenTelemetry
+// identifies instruments by name, so registering the histogram again with
+// different buckets would not change them either.
+func getDurationHistogram(component, operationName string, buckets []float64) metric.Float64Histogram {
+	key := [2]string{component, operationName + ".duration"}
+
 	metricLock.RLock()
-	h, ok := histograms[[2]string{component, name}]
+	h, ok := histograms[key]
 	metricLock.RUnlock()
 	if ok {
 		return h
@@ -67,12 +75,16 @@ func getFloat64Histogram(component, name string, options ...metric.Float64Histog
 	metricLock.Lock()
 	defer metricLock.Unlock()
 
-	h, ok = histograms[[2]string{component, name}]
+	h, ok = histograms[key]
 	if ok {
 		return h
 	}
 
-	h, _ = otel.Meter(component).Float64Histogram(component+"."+name, options...)
-	histograms[[2]string{component, name}] = h
+	h, _ = otel.Meter(component).Float64Histogram(component+"."+operationName+".duration",
+		metric.WithDescription(fmt.Sprintf("Duration of %s.%s operations.", component, operationName)),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(buckets...),
+	)
+	histograms[key] = h
 	return h
 }
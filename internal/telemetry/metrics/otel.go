@@ -44,3 +44,21 @@ func Int64Histogram(name string, options ...metric.Int64HistogramOption) metric.
 	}
 	return c
 }
+
+// Float64Histogram returns a float64 histogram.
+func Float64Histogram(name string, options ...metric.Float64HistogramOption) metric.Float64Histogram {
+	h, err := Meter.Float64Histogram(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// Int64UpDownCounter returns an int64 up-down counter.
+func Int64UpDownCounter(name string, options ...metric.Int64UpDownCounterOption) metric.Int64UpDownCounter {
+	c, err := Meter.Int64UpDownCounter(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
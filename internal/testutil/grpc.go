@@ -13,11 +13,11 @@ import (
 )
 
 // NewGRPCServer starts a gRPC server and returns a client connection to it.
-func NewGRPCServer(t testing.TB, register func(s *grpc.Server)) *grpc.ClientConn {
+func NewGRPCServer(t testing.TB, register func(s *grpc.Server), options ...grpc.ServerOption) *grpc.ClientConn {
 	t.Helper()
 
 	li := bufconn.Listen(1024 * 1024)
-	s := grpc.NewServer()
+	s := grpc.NewServer(options...)
 	register(s)
 	go func() {
 		err := s.Serve(li)
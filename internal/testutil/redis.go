@@ -0,0 +1,45 @@
+package testutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/log"
+	"github.com/testcontainers/testcontainers-go/wait"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/pomerium/pomerium/pkg/telemetry/trace"
+)
+
+// WithTestRedis starts a redis server.
+func WithTestRedis(tb testing.TB, handler func(dsn string)) {
+	tb.Helper()
+
+	ctx := oteltrace.ContextWithSpan(tb.Context(), trace.ValidNoopSpan{})
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Name:         "pomerium-redis",
+			Image:        "redis:7",
+			ExposedPorts: []string{"6379/tcp"},
+			WaitingFor: wait.ForAll(
+				wait.ForLog("Ready to accept connections"),
+				wait.ForListeningPort("6379"),
+			),
+		},
+		Started: true,
+		Logger:  log.TestLogger(tb),
+		Reuse:   true,
+	})
+	if err != nil {
+		tb.Fatalf("testutil/redis: failed to create container: %v", err)
+	}
+
+	port, err := container.MappedPort(ctx, "6379")
+	if err != nil {
+		tb.Fatalf("testutil/redis: failed to get mapped port: %v", err)
+	}
+
+	handler(fmt.Sprintf("redis://localhost:%s/0", port.Port()))
+}
@@ -1,6 +1,7 @@
 package urlutil
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/pomerium/pomerium/internal/version"
 	"github.com/pomerium/pomerium/pkg/endpoints"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/grpc/identity"
 	"github.com/pomerium/pomerium/pkg/hpke"
 )
@@ -33,11 +35,13 @@ func versionStr() string {
 }
 
 // CallbackURL builds the callback URL using an HPKE encrypted query string.
+// Any additional databroker records are passed along to be saved by the proxy.
 func CallbackURL(
 	authenticatePrivateKey *hpke.PrivateKey,
 	proxyPublicKey *hpke.PublicKey,
 	requestParams url.Values,
 	profile *identity.Profile,
+	records []*databroker.Record,
 	encryptURLValues hpke.EncryptURLValuesFunc,
 ) (string, error) {
 	redirectURL, err := ParseAndValidateURL(requestParams.Get(QueryRedirectURI))
@@ -71,6 +75,21 @@ func CallbackURL(
 		return "", fmt.Errorf("error marshaling identity profile: %w", err)
 	}
 	callbackParams.Set(QueryIdentityProfile, string(rawProfile))
+
+	if len(records) > 0 {
+		rawRecords := make([]json.RawMessage, len(records))
+		for i, record := range records {
+			rawRecords[i], err = protojson.Marshal(record)
+			if err != nil {
+				return "", fmt.Errorf("error marshaling databroker record: %w", err)
+			}
+		}
+		bs, err := json.Marshal(rawRecords)
+		if err != nil {
+			return "", fmt.Errorf("error marshaling databroker records: %w", err)
+		}
+		callbackParams.Set(QueryRecords, string(bs))
+	}
 	callbackParams.Set(QueryVersion, versionStr())
 
 	BuildTimeParameters(callbackParams, signInExpiry)
@@ -84,13 +103,14 @@ func CallbackURL(
 	return callbackURL.String(), nil
 }
 
-// RedirectURL returns the redirect URL from the query string or a cookie.
-func RedirectURL(r *http.Request) (string, bool) {
+// RedirectURL returns the redirect URL from the query string or the cookie
+// with the given name.
+func RedirectURL(r *http.Request, cookieName string) (string, bool) {
 	if v := r.FormValue(QueryRedirectURI); v != "" {
 		return v, true
 	}
 
-	if c, err := r.Cookie(QueryRedirectURI); err == nil {
+	if c, err := r.Cookie(cookieName); err == nil {
 		return c.Value, true
 	}
 
@@ -123,13 +143,14 @@ func SignInURL(
 	return signInURL.String(), nil
 }
 
-// SignOutURL returns the /.pomerium/sign_out URL.
-func SignOutURL(r *http.Request, authenticateURL *url.URL, key []byte) string {
+// SignOutURL returns the /.pomerium/sign_out URL. The redirect URL is taken
+// from the query string or the cookie named redirectCookieName.
+func SignOutURL(r *http.Request, authenticateURL *url.URL, key []byte, redirectCookieName string) string {
 	u := authenticateURL.ResolveReference(&url.URL{
 		Path: endpoints.PathPomeriumSignOut,
 	})
 	q := u.Query()
-	if redirectURI, ok := RedirectURL(r); ok {
+	if redirectURI, ok := RedirectURL(r, redirectCookieName); ok {
 		q.Set(QueryRedirectURI, redirectURI)
 	}
 	q.Set(QueryVersion, versionStr())
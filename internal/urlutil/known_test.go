@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/grpc/identity"
 	"github.com/pomerium/pomerium/pkg/hpke"
 )
@@ -23,7 +24,7 @@ func TestCallbackURL(t *testing.T) {
 		QueryRedirectURI: {"https://redirect.example.com"},
 	}, &identity.Profile{
 		ProviderId: "IDP-1",
-	}, hpke.EncryptURLValuesV1)
+	}, nil, hpke.EncryptURLValuesV1)
 	require.NoError(t, err)
 
 	signInURL, err := ParseAndValidateURL(rawSignInURL)
@@ -37,6 +38,31 @@ func TestCallbackURL(t *testing.T) {
 	assert.NotEmpty(t, q.Get(QueryVersion))
 	assert.Equal(t, "https://redirect.example.com", q.Get(QueryRedirectURI))
 	assert.JSONEq(t, `{ "providerId": "IDP-1" }`, q.Get(QueryIdentityProfile))
+	assert.False(t, q.Has(QueryRecords))
+
+	t.Run("records", func(t *testing.T) {
+		t.Parallel()
+
+		rawSignInURL, err := CallbackURL(k1, k2.PublicKey(), url.Values{
+			QueryRedirectURI: {"https://redirect.example.com"},
+		}, &identity.Profile{
+			ProviderId: "IDP-1",
+		}, []*databroker.Record{
+			{Type: "example.Group", Id: "g1"},
+			{Type: "example.Device", Id: "d1"},
+		}, hpke.EncryptURLValuesV1)
+		require.NoError(t, err)
+
+		signInURL, err := ParseAndValidateURL(rawSignInURL)
+		require.NoError(t, err)
+
+		_, q, err := hpke.DecryptURLValues(k2, signInURL.Query())
+		require.NoError(t, err)
+		assert.JSONEq(t, `[
+			{ "type": "example.Group", "id": "g1" },
+			{ "type": "example.Device", "id": "d1" }
+		]`, q.Get(QueryRecords))
+	})
 }
 
 func TestRedirectURI(t *testing.T) {
@@ -46,7 +72,7 @@ func TestRedirectURI(t *testing.T) {
 		}).Encode(), nil)
 		require.NoError(t, err)
 
-		redirectURI, ok := RedirectURL(r)
+		redirectURI, ok := RedirectURL(r, QueryRedirectURI)
 		assert.True(t, ok)
 		assert.Equal(t, "https://www.example.com/redirect", redirectURI)
 	})
@@ -57,7 +83,7 @@ func TestRedirectURI(t *testing.T) {
 		require.NoError(t, err)
 		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-		redirectURI, ok := RedirectURL(r)
+		redirectURI, ok := RedirectURL(r, QueryRedirectURI)
 		assert.True(t, ok)
 		assert.Equal(t, "https://www.example.com/redirect", redirectURI)
 	})
@@ -69,7 +95,7 @@ func TestRedirectURI(t *testing.T) {
 			Value: "https://www.example.com/redirect",
 		})
 
-		redirectURI, ok := RedirectURL(r)
+		redirectURI, ok := RedirectURL(r, QueryRedirectURI)
 		assert.True(t, ok)
 		assert.Equal(t, "https://www.example.com/redirect", redirectURI)
 	})
@@ -107,7 +133,7 @@ func TestSignOutURL(t *testing.T) {
 	}).Encode(), nil)
 	authenticateURL := MustParseAndValidateURL("https://authenticate.example.com")
 
-	rawSignOutURL := SignOutURL(r, authenticateURL, []byte("TEST"))
+	rawSignOutURL := SignOutURL(r, authenticateURL, []byte("TEST"), QueryRedirectURI)
 	signOutURL, err := ParseAndValidateURL(rawSignOutURL)
 	require.NoError(t, err)
 
@@ -5,6 +5,7 @@ package urlutil
 // conjunction with a HMAC to ensure authenticity.
 const (
 	QueryAdditionalHosts    = "pomerium_additional_hosts"
+	QueryAssuranceLevel     = "pomerium_assurance_level"
 	QueryCallbackURI        = "pomerium_callback_uri"
 	QueryDeviceCredentialID = "pomerium_device_credential_id"
 	QueryDeviceType         = "pomerium_device_type"
@@ -14,6 +15,7 @@ const (
 	QueryIdentityProviderID = "pomerium_idp_id"
 	QueryIsProgrammatic     = "pomerium_programmatic"
 	QueryIssued             = "pomerium_issued"
+	QueryRecords            = "pomerium_records"
 	QueryPomeriumJWT        = "pomerium_jwt"
 	QueryRedirectURI        = "pomerium_redirect_uri"
 	QuerySession            = "pomerium_session"
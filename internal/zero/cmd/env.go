@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/spf13/viper"
 
@@ -20,6 +21,9 @@ const (
 	// BootstrapConfigWritebackURI controls how changes to the bootstrap config are persisted.
 	// See controller.WithBootstrapConfigWritebackURI for details.
 	BootstrapConfigWritebackURI = "BOOTSTRAP_CONFIG_WRITEBACK_URI"
+	// BundleDryRun can be set to true to log the changes resource bundles would make instead of
+	// applying them. See controller.WithBundleDryRun for details.
+	BundleDryRun = "POMERIUM_ZERO_BUNDLE_DRY_RUN"
 )
 
 func getToken(configFile string) string {
@@ -76,3 +80,15 @@ func getBootstrapConfigFileName() (string, error) {
 func getBootstrapConfigWritebackURI() string {
 	return os.Getenv(BootstrapConfigWritebackURI)
 }
+
+func getBundleDryRun() (bool, error) {
+	raw, ok := os.LookupEnv(BundleDryRun)
+	if !ok || raw == "" {
+		return false, nil
+	}
+	dryRun, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %w", BundleDryRun, err)
+	}
+	return dryRun, nil
+}
@@ -39,3 +39,22 @@ pomerium_zero_token = "FROM_TOML"
 		assert.Equal(t, "FROM_TOML", getToken(fp))
 	})
 }
+
+func Test_getBundleDryRun(t *testing.T) {
+	t.Run("unset", func(t *testing.T) {
+		dryRun, err := getBundleDryRun()
+		assert.NoError(t, err)
+		assert.False(t, dryRun)
+	})
+	t.Run("true", func(t *testing.T) {
+		t.Setenv(BundleDryRun, "true")
+		dryRun, err := getBundleDryRun()
+		assert.NoError(t, err)
+		assert.True(t, dryRun)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv(BundleDryRun, "maybe")
+		_, err := getBundleDryRun()
+		assert.Error(t, err)
+	})
+}
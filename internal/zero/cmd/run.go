@@ -34,6 +34,15 @@ func Run(ctx context.Context, configFile string) error {
 		controller.WithTracerProvider(trace.NewTracerProvider(ctx, "Zero")),
 	}
 
+	bundleDryRun, err := getBundleDryRun()
+	if err != nil {
+		return err
+	}
+	if bundleDryRun {
+		log.Ctx(ctx).Warn().Msg("resource bundle dry run enabled, changes will be logged but not applied")
+		opts = append(opts, controller.WithBundleDryRun(true))
+	}
+
 	bootstrapConfigFileName, err := getBootstrapConfigFileName()
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("would not be able to save cluster bootstrap config, that will prevent Pomerium from starting independent from the control plane")
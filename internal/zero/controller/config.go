@@ -24,6 +24,8 @@ type controllerConfig struct {
 	databrokerRequestTimeout time.Duration
 	shutdownTimeout          time.Duration
 	tracerProvider           oteltrace.TracerProvider
+
+	bundleDryRun bool
 }
 
 // WithTmpDir sets the temporary directory to use.
@@ -131,6 +133,15 @@ func WithTracerProvider(tracerProvider oteltrace.TracerProvider) Option {
 	}
 }
 
+// WithBundleDryRun sets whether resource bundles are only previewed. When
+// enabled, the changes each updated bundle would make are logged, but not
+// applied to the databroker.
+func WithBundleDryRun(dryRun bool) Option {
+	return func(c *controllerConfig) {
+		c.bundleDryRun = dryRun
+	}
+}
+
 func newControllerConfig(opts ...Option) *controllerConfig {
 	c := new(controllerConfig)
 
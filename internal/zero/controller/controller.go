@@ -187,6 +187,7 @@ func (c *controller) runReconcilerLeased(ctx context.Context, client databroker.
 			reconciler.WithAPI(c.api),
 			reconciler.WithDataBrokerClient(client),
 			reconciler.WithTracerProvider(c.cfg.tracerProvider),
+			reconciler.WithDryRun(c.cfg.bundleDryRun),
 		)
 	})
 }
@@ -212,7 +213,7 @@ func (c *controller) runHealthChecksLeased(ctx context.Context, client databroke
 }
 
 func (c *controller) runUsageReporter(ctx context.Context, client databroker.DataBrokerServiceClient) error {
-	ur := usagereporter.New(c.api, c.bootstrapConfig.GetConfig().ZeroPseudonymizationKey, time.Minute)
+	ur := usagereporter.New(c.api, c.bootstrapConfig.GetConfig().ZeroPseudonymizationKey)
 	return retry.WithBackoff(ctx, "zero-usage-reporter", func(ctx context.Context) error {
 		// start the usage reporter
 		return ur.Run(ctx, client)
@@ -0,0 +1,55 @@
+package usagereporter
+
+import "time"
+
+const (
+	defaultFlushInterval       = time.Minute
+	defaultMaxBatchSize        = 1000
+	defaultMaxRetryElapsedTime = 5 * time.Minute
+	shutdownFlushTimeout       = 5 * time.Second
+)
+
+type config struct {
+	flushInterval       time.Duration
+	maxBatchSize        int
+	maxRetryElapsedTime time.Duration
+}
+
+// An Option customizes the usage reporter config.
+type Option func(*config)
+
+// WithFlushInterval sets how often updated users are reported. Updates to the
+// same user within the interval are reported once.
+func WithFlushInterval(interval time.Duration) Option {
+	return func(cfg *config) {
+		cfg.flushInterval = interval
+	}
+}
+
+// WithMaxBatchSize sets the maximum number of users reported in a single
+// request. When more users than this are updated, they are reported without
+// waiting for the flush interval.
+func WithMaxBatchSize(size int) Option {
+	return func(cfg *config) {
+		cfg.maxBatchSize = size
+	}
+}
+
+// WithMaxRetryElapsedTime sets how long a failed report is retried before the
+// users are put back into the queue to be reported on the next flush.
+func WithMaxRetryElapsedTime(elapsed time.Duration) Option {
+	return func(cfg *config) {
+		cfg.maxRetryElapsedTime = elapsed
+	}
+}
+
+func getConfig(options ...Option) *config {
+	cfg := new(config)
+	WithFlushInterval(defaultFlushInterval)(cfg)
+	WithMaxBatchSize(defaultMaxBatchSize)(cfg)
+	WithMaxRetryElapsedTime(defaultMaxRetryElapsedTime)(cfg)
+	for _, option := range options {
+		option(cfg)
+	}
+	return cfg
+}
@@ -0,0 +1,196 @@
+package usagereporter
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+// Usage which couldn't be reported is persisted to the databroker as pending
+// records, so that it's reported after a restart even if the sessions it came
+// from have since been removed.
+const (
+	pendingRecordType = "pomerium.io/ZeroUsageReporterPendingUser"
+	pendingQueryLimit = 100
+)
+
+// loadPending restores the pending records from the databroker.
+func (ur *UsageReporter) loadPending(ctx context.Context, client databroker.DataBrokerServiceClient) error {
+	var records []usageReporterRecord
+	for offset := int64(0); ; offset += pendingQueryLimit {
+		res, err := client.Query(ctx, &databroker.QueryRequest{
+			Type:   pendingRecordType,
+			Offset: offset,
+			Limit:  pendingQueryLimit,
+		})
+		if err != nil {
+			return fmt.Errorf("error querying pending usage: %w", err)
+		}
+		for _, record := range res.GetRecords() {
+			if record.GetDeletedAt() != nil {
+				continue
+			}
+			r, err := pendingRecordToUsageReporterRecord(record)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling pending usage: %w", err)
+			}
+			records = append(records, r)
+		}
+		if offset+pendingQueryLimit >= res.GetTotalCount() {
+			break
+		}
+	}
+
+	ur.mu.Lock()
+	defer ur.mu.Unlock()
+
+	for _, record := range records {
+		r := ur.byUserID[record.userID]
+		nr := r
+		nr.userID = record.userID
+		nr.userEmail = cmp.Or(nr.userEmail, record.userEmail)
+		nr.lastSignedInAt = latest(nr.lastSignedInAt, record.lastSignedInAt)
+		ur.byUserID[record.userID] = nr
+		ur.insertUpdateLocked(record.userID)
+		ur.pending.Insert(record.userID)
+	}
+
+	return nil
+}
+
+// savePending stores records which couldn't be reported in the databroker.
+func (ur *UsageReporter) savePending(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	records []usageReporterRecord,
+) error {
+	dbrecords := make([]*databroker.Record, 0, len(records))
+	for _, record := range records {
+		dbrecords = append(dbrecords, usageReporterRecordToPendingRecord(record))
+	}
+	err := ur.putPending(ctx, client, dbrecords)
+	if err != nil {
+		return err
+	}
+
+	ur.mu.Lock()
+	for _, record := range records {
+		ur.pending.Insert(record.userID)
+	}
+	ur.mu.Unlock()
+
+	return nil
+}
+
+// saveUpdates stores any updates which haven't been reported yet in the
+// databroker.
+func (ur *UsageReporter) saveUpdates(ctx context.Context, client databroker.DataBrokerServiceClient) error {
+	ur.mu.Lock()
+	records := make([]usageReporterRecord, 0, ur.updates.Size())
+	for userID := range ur.updates.Items() {
+		records = append(records, ur.byUserID[userID])
+	}
+	ur.mu.Unlock()
+
+	if len(records) == 0 {
+		return nil
+	}
+	return ur.savePending(ctx, client, records)
+}
+
+// deletePending removes any pending records for records which were reported.
+func (ur *UsageReporter) deletePending(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	records []usageReporterRecord,
+) error {
+	var userIDs []string
+	ur.mu.Lock()
+	for _, record := range records {
+		if ur.pending.Contains(record.userID) {
+			userIDs = append(userIDs, record.userID)
+		}
+	}
+	ur.mu.Unlock()
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	dbrecords := make([]*databroker.Record, 0, len(userIDs))
+	for _, userID := range userIDs {
+		dbrecords = append(dbrecords, &databroker.Record{
+			Type:      pendingRecordType,
+			Id:        userID,
+			Data:      protoutil.NewAny(&structpb.Struct{}),
+			DeletedAt: timestamppb.Now(),
+		})
+	}
+	err := ur.putPending(ctx, client, dbrecords)
+	if err != nil {
+		return err
+	}
+
+	ur.mu.Lock()
+	for _, userID := range userIDs {
+		ur.pending.Remove(userID)
+	}
+	ur.mu.Unlock()
+
+	return nil
+}
+
+func (ur *UsageReporter) putPending(
+	ctx context.Context,
+	client databroker.DataBrokerServiceClient,
+	records []*databroker.Record,
+) error {
+	for _, req := range databroker.OptimumPutRequestsFromRecords(records) {
+		_, err := client.Put(ctx, req)
+		if err != nil {
+			return fmt.Errorf("error storing pending usage: %w", err)
+		}
+	}
+	return nil
+}
+
+func usageReporterRecordToPendingRecord(record usageReporterRecord) *databroker.Record {
+	return &databroker.Record{
+		Type: pendingRecordType,
+		Id:   record.userID,
+		Data: protoutil.NewAny(&structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"user_id":           structpb.NewStringValue(record.userID),
+				"user_email":        structpb.NewStringValue(record.userEmail),
+				"last_signed_in_at": structpb.NewStringValue(record.lastSignedInAt.Format(time.RFC3339Nano)),
+			},
+		}),
+	}
+}
+
+func pendingRecordToUsageReporterRecord(record *databroker.Record) (usageReporterRecord, error) {
+	var s structpb.Struct
+	err := record.GetData().UnmarshalTo(&s)
+	if err != nil {
+		return usageReporterRecord{}, err
+	}
+
+	r := usageReporterRecord{
+		userID:    cmp.Or(s.GetFields()["user_id"].GetStringValue(), record.GetId()),
+		userEmail: s.GetFields()["user_email"].GetStringValue(),
+	}
+	if v := s.GetFields()["last_signed_in_at"].GetStringValue(); v != "" {
+		r.lastSignedInAt, err = time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return usageReporterRecord{}, err
+		}
+	}
+	return r, nil
+}
@@ -2,15 +2,21 @@
 //
 // Usage is determined from session and user records in the databroker. The usage reporter
 // uses SyncLatest and Sync to retrieve this data, builds a collection of records and then
-// sends them to the Zero Cluster API every minute.
+// sends them to the Zero Cluster API every flush interval (a minute by default), in batches
+// of at most the max batch size.
 //
 // All usage users are reported on start but only the changed users are reported while running.
+// A user updated several times within a flush interval is only reported once. Failed reports
+// are retried with exponential backoff and then put back into the queue for the next flush.
+// They are also persisted to the databroker, so that they are reported after a restart even
+// if the sessions they came from no longer exist.
 // The Zero Cluster API is tolerant of redundant data.
 package usagereporter
 
 import (
 	"cmp"
 	"context"
+	"slices"
 	"sync"
 	"time"
 
@@ -41,22 +47,28 @@ type usageReporterRecord struct {
 type UsageReporter struct {
 	api                 API
 	pseudonymizationKey []byte
-	reportInterval      time.Duration
+	cfg                 *config
+
+	batchReady chan struct{}
 
 	mu       sync.Mutex
 	byUserID map[string]usageReporterRecord
 	updates  *set.Set[string]
+	pending  *set.Set[string]
 }
 
 // New creates a new UsageReporter.
-func New(api API, pseudonymizationKey []byte, reportInterval time.Duration) *UsageReporter {
+func New(api API, pseudonymizationKey []byte, options ...Option) *UsageReporter {
 	return &UsageReporter{
 		api:                 api,
 		pseudonymizationKey: pseudonymizationKey,
-		reportInterval:      reportInterval,
+		cfg:                 getConfig(options...),
+
+		batchReady: make(chan struct{}, 1),
 
 		byUserID: make(map[string]usageReporterRecord),
 		updates:  set.New[string](0),
+		pending:  set.New[string](0),
 	}
 }
 
@@ -64,7 +76,13 @@ func New(api API, pseudonymizationKey []byte, reportInterval time.Duration) *Usa
 func (ur *UsageReporter) Run(ctx context.Context, client databroker.DataBrokerServiceClient) error {
 	ctx = log.Ctx(ctx).With().Logger().WithContext(ctx)
 
-	// first initialize the user collection
+	// restore any usage that couldn't be reported before
+	err := ur.loadPending(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	// then initialize the user collection
 	serverVersion, latestSessionRecordVersion, latestUserRecordVersion, err := ur.runInit(ctx, client)
 	if err != nil {
 		return err
@@ -78,6 +96,8 @@ func (ur *UsageReporter) report(ctx context.Context, records []usageReporterReco
 	req := cluster.ReportUsageRequest{
 		Users: convertUsageReporterRecords(ur.pseudonymizationKey, records),
 	}
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxElapsedTime = ur.cfg.maxRetryElapsedTime
 	return backoff.Retry(func() error {
 		log.Ctx(ctx).Debug().Int("updated-users", len(req.Users)).Msg("reporting usage")
 		err := ur.api.ReportUsage(ctx, req)
@@ -85,19 +105,21 @@ func (ur *UsageReporter) report(ctx context.Context, records []usageReporterReco
 			log.Ctx(ctx).Error().Err(err).Msg("error reporting usage")
 		}
 		return err
-	}, backoff.WithContext(backoff.NewExponentialBackOff(), ctx))
+	}, backoff.WithContext(bo, ctx))
 }
 
 func (ur *UsageReporter) runInit(
 	ctx context.Context,
 	client databroker.DataBrokerServiceClient,
 ) (serverVersion, latestSessionRecordVersion, latestUserRecordVersion uint64, err error) {
-	_, latestSessionRecordVersion, err = databroker.SyncLatestRecords(ctx, client, ur.onUpdateSession)
+	_, latestSessionRecordVersion, err = databroker.NewTypedClient[session.Session](client).
+		SyncLatest(ctx, ur.onUpdateSession)
 	if err != nil {
 		return 0, 0, 0, err
 	}
 
-	serverVersion, latestUserRecordVersion, err = databroker.SyncLatestRecords(ctx, client, ur.onUpdateUser)
+	serverVersion, latestUserRecordVersion, err = databroker.NewTypedClient[user.User](client).
+		SyncLatest(ctx, ur.onUpdateUser)
 	if err != nil {
 		return 0, 0, 0, err
 	}
@@ -112,43 +134,109 @@ func (ur *UsageReporter) runSync(
 ) error {
 	eg, ctx := errgroup.WithContext(ctx)
 	eg.Go(func() error {
-		return databroker.SyncRecords(ctx, client, serverVersion, latestSessionRecordVersion, ur.onUpdateSession)
+		return databroker.NewTypedClient[session.Session](client).
+			Sync(ctx, serverVersion, latestSessionRecordVersion, ur.onUpdateSession)
 	})
 	eg.Go(func() error {
-		return databroker.SyncRecords(ctx, client, serverVersion, latestUserRecordVersion, ur.onUpdateUser)
+		return databroker.NewTypedClient[user.User](client).
+			Sync(ctx, serverVersion, latestUserRecordVersion, ur.onUpdateUser)
 	})
 	eg.Go(func() error {
-		return ur.runReporter(ctx)
+		return ur.runReporter(ctx, client)
 	})
 	return eg.Wait()
 }
 
-func (ur *UsageReporter) runReporter(ctx context.Context) error {
-	// every minute collect any updates and submit them to the API
-	timer := time.NewTicker(ur.reportInterval)
-	defer timer.Stop()
+func (ur *UsageReporter) runReporter(ctx context.Context, client databroker.DataBrokerServiceClient) error {
+	// every flush interval collect any updates and submit them to the API
+	ticker := time.NewTicker(ur.cfg.flushInterval)
+	defer ticker.Stop()
 
 	for {
-		// collect the updated records since last run
-		ur.mu.Lock()
-		records := make([]usageReporterRecord, 0, ur.updates.Size())
-		for userID := range ur.updates.Items() {
-			records = append(records, ur.byUserID[userID])
+		ur.flush(ctx, client)
+
+		select {
+		case <-ctx.Done():
+			// make a final attempt to report any pending updates
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownFlushTimeout)
+			ur.flush(flushCtx, client)
+			cancel()
+
+			// the final flush may have timed out, so persist whatever is
+			// left to report it after a restart
+			saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownFlushTimeout)
+			err := ur.saveUpdates(saveCtx, client)
+			cancel()
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).Msg("failed to persist pending usage")
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		case <-ur.batchReady:
 		}
-		ur.updates = set.New[string](0)
-		ur.mu.Unlock()
+	}
+}
+
+// flush reports the updated records since the last flush. Records which
+// couldn't be reported are put back into the queue and persisted to the
+// databroker, so they survive a restart.
+func (ur *UsageReporter) flush(ctx context.Context, client databroker.DataBrokerServiceClient) {
+	ur.mu.Lock()
+	records := make([]usageReporterRecord, 0, ur.updates.Size())
+	for userID := range ur.updates.Items() {
+		records = append(records, ur.byUserID[userID])
+	}
+	ur.updates = set.New[string](0)
+	ur.mu.Unlock()
 
-		if len(records) > 0 {
-			err := ur.report(ctx, records)
+	slices.SortFunc(records, func(x, y usageReporterRecord) int {
+		return cmp.Compare(x.userID, y.userID)
+	})
+
+	for len(records) > 0 {
+		batch := records[:min(len(records), max(ur.cfg.maxBatchSize, 1))]
+		err := ur.report(ctx, batch)
+		if err != nil {
+			ur.requeue(records)
+			if ctx.Err() != nil {
+				// the records will be reported by the final flush
+				return
+			}
+			log.Ctx(ctx).Error().Err(err).Int("users", len(records)).
+				Msg("failed to report usage, will retry on the next flush")
+			err = ur.savePending(ctx, client, records)
 			if err != nil {
-				return err
+				log.Ctx(ctx).Error().Err(err).Msg("failed to persist pending usage")
 			}
+			return
 		}
+		err = ur.deletePending(ctx, client, batch)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("failed to delete reported pending usage")
+		}
+		records = records[len(batch):]
+	}
+}
 
+func (ur *UsageReporter) requeue(records []usageReporterRecord) {
+	ur.mu.Lock()
+	defer ur.mu.Unlock()
+
+	// the current record for the user will be reported, which may have been
+	// updated in the meantime
+	for _, record := range records {
+		ur.updates.Insert(record.userID)
+	}
+}
+
+// insertUpdateLocked marks the user as updated and triggers a flush once
+// there are enough updates for a full batch.
+func (ur *UsageReporter) insertUpdateLocked(userID string) {
+	ur.updates.Insert(userID)
+	if ur.updates.Size() >= ur.cfg.maxBatchSize {
 		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-timer.C:
+		case ur.batchReady <- struct{}{}:
+		default:
 		}
 	}
 }
@@ -169,7 +257,7 @@ func (ur *UsageReporter) onUpdateSession(s *session.Session) {
 	nr.userID = userID
 	if nr != r {
 		ur.byUserID[userID] = nr
-		ur.updates.Insert(userID)
+		ur.insertUpdateLocked(userID)
 	}
 }
 
@@ -189,7 +277,7 @@ func (ur *UsageReporter) onUpdateUser(u *user.User) {
 	nr.userEmail = cmp.Or(nr.userEmail, u.GetEmail())
 	if nr != r {
 		ur.byUserID[userID] = nr
-		ur.updates.Insert(userID)
+		ur.insertUpdateLocked(userID)
 	}
 }
 
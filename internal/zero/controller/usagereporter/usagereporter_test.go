@@ -59,7 +59,7 @@ func TestUsageReporter(t *testing.T) {
 			}
 			return nil
 		},
-	}, []byte("bQjwPpxcwJRbvsSMFgbZFkXmxFJ"), time.Millisecond*10)
+	}, []byte("bQjwPpxcwJRbvsSMFgbZFkXmxFJ"), WithFlushInterval(time.Millisecond*10))
 
 	eg, ctx := errgroup.WithContext(ctx)
 	eg.Go(func() error {
@@ -124,6 +124,113 @@ func TestUsageReporter(t *testing.T) {
 	}
 }
 
+func TestUsageReporter_flush(t *testing.T) {
+	t.Parallel()
+
+	tm1 := time.Date(2024, time.September, 11, 11, 56, 0, 0, time.UTC)
+	tm2 := time.Date(2024, time.September, 12, 11, 56, 0, 0, time.UTC)
+
+	client := newTestDataBrokerClient(t)
+
+	var requests []cluster.ReportUsageRequest
+	var reportErr error
+	ur := New(mockAPI{
+		reportUsage: func(_ context.Context, req cluster.ReportUsageRequest) error {
+			if reportErr != nil {
+				return reportErr
+			}
+			requests = append(requests, req)
+			return nil
+		},
+	}, []byte("XXX"), WithMaxBatchSize(2), WithMaxRetryElapsedTime(time.Millisecond))
+
+	ur.onUpdateSession(&session.Session{UserId: "U1", IssuedAt: timestamppb.New(tm1)})
+	ur.onUpdateSession(&session.Session{UserId: "U1", IssuedAt: timestamppb.New(tm2)})
+	ur.onUpdateSession(&session.Session{UserId: "U2", IssuedAt: timestamppb.New(tm1)})
+	select {
+	case <-ur.batchReady:
+	default:
+		assert.Fail(t, "should signal a full batch")
+	}
+	ur.onUpdateSession(&session.Session{UserId: "U3", IssuedAt: timestamppb.New(tm1)})
+
+	reportErr = errors.New("ERROR")
+	ur.flush(t.Context(), client)
+	assert.Empty(t, requests)
+	assert.Equal(t, 3, ur.updates.Size(), "should requeue users after a failure")
+
+	reportErr = nil
+	ur.flush(t.Context(), client)
+	assert.Equal(t, []cluster.ReportUsageRequest{
+		{Users: convertUsageReporterRecords([]byte("XXX"), []usageReporterRecord{
+			{userID: "U1", lastSignedInAt: tm2},
+			{userID: "U2", lastSignedInAt: tm1},
+		})},
+		{Users: convertUsageReporterRecords([]byte("XXX"), []usageReporterRecord{
+			{userID: "U3", lastSignedInAt: tm1},
+		})},
+	}, requests, "should report each user once, in batches")
+	assert.Equal(t, 0, ur.updates.Size())
+}
+
+func TestUsageReporter_pending(t *testing.T) {
+	t.Parallel()
+
+	tm1 := time.Date(2024, time.September, 11, 11, 56, 0, 0, time.UTC)
+
+	client := newTestDataBrokerClient(t)
+
+	ur1 := New(mockAPI{
+		reportUsage: func(_ context.Context, _ cluster.ReportUsageRequest) error {
+			return errors.New("UNAVAILABLE")
+		},
+	}, []byte("XXX"), WithMaxRetryElapsedTime(time.Millisecond))
+	ur1.onUpdateSession(&session.Session{UserId: "U1", IssuedAt: timestamppb.New(tm1)})
+	ur1.onUpdateUser(&user.User{Id: "U1", Email: "u1@example.com"})
+	ur1.flush(t.Context(), client)
+
+	var requests []cluster.ReportUsageRequest
+	ur2 := New(mockAPI{
+		reportUsage: func(_ context.Context, req cluster.ReportUsageRequest) error {
+			requests = append(requests, req)
+			return nil
+		},
+	}, []byte("XXX"))
+	assert.NoError(t, ur2.loadPending(t.Context(), client))
+	ur2.flush(t.Context(), client)
+	assert.Equal(t, []cluster.ReportUsageRequest{
+		{Users: convertUsageReporterRecords([]byte("XXX"), []usageReporterRecord{
+			{userID: "U1", userEmail: "u1@example.com", lastSignedInAt: tm1},
+		})},
+	}, requests, "should report usage persisted by a previous reporter")
+
+	ur3 := New(mockAPI{}, []byte("XXX"))
+	assert.NoError(t, ur3.loadPending(t.Context(), client))
+	assert.Equal(t, 0, ur3.updates.Size(), "should delete pending usage once it's reported")
+
+	ur4 := New(mockAPI{}, []byte("XXX"))
+	ur4.onUpdateSession(&session.Session{UserId: "U2", IssuedAt: timestamppb.New(tm1)})
+	assert.NoError(t, ur4.saveUpdates(t.Context(), client))
+
+	ur5 := New(mockAPI{}, []byte("XXX"))
+	assert.NoError(t, ur5.loadPending(t.Context(), client))
+	assert.True(t, ur5.updates.Contains("U2"), "should restore unreported updates saved on shutdown")
+}
+
+func newTestDataBrokerClient(t *testing.T) databrokerpb.DataBrokerServiceClient {
+	t.Helper()
+
+	srv := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(srv.Stop)
+
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, srv)
+	})
+	t.Cleanup(func() { cc.Close() })
+
+	return databrokerpb.NewDataBrokerServiceClient(cc)
+}
+
 func Test_convertUsageReporterRecords(t *testing.T) {
 	t.Parallel()
 
@@ -29,6 +29,8 @@ type reconcilerConfig struct {
 
 	syncBackoffMaxInterval time.Duration
 	tracerProvider         oteltrace.TracerProvider
+
+	dryRun bool
 }
 
 // Option configures the resource bundles reconciler
@@ -100,6 +102,15 @@ func WithTracerProvider(tracerProvider oteltrace.TracerProvider) Option {
 	}
 }
 
+// WithDryRun configures the reconciler to only compute and log the changes
+// each updated resource bundle would make to the databroker, without applying
+// them.
+func WithDryRun(dryRun bool) Option {
+	return func(cfg *reconcilerConfig) {
+		cfg.dryRun = dryRun
+	}
+}
+
 func newConfig(opts ...Option) *reconcilerConfig {
 	cfg := &reconcilerConfig{}
 	for _, opt := range []Option{
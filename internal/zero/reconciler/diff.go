@@ -0,0 +1,62 @@
+package reconciler
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// A RecordRef identifies a databroker record.
+type RecordRef struct {
+	Type string
+	ID   string
+}
+
+// String returns the record reference as "type/id".
+func (ref RecordRef) String() string {
+	return ref.Type + "/" + ref.ID
+}
+
+// A BundleDiff is the set of changes applying a resource bundle would make to
+// the databroker.
+type BundleDiff struct {
+	Added    []RecordRef
+	Modified []RecordRef
+	Removed  []RecordRef
+}
+
+// IsEmpty returns true if applying the bundle would not change anything.
+func (diff BundleDiff) IsEmpty() bool {
+	return len(diff.Added) == 0 && len(diff.Modified) == 0 && len(diff.Removed) == 0
+}
+
+// GetBundleDiff returns the changes needed to bring the current databroker
+// records to the target bundle records. Records are compared with
+// EqualRecord, the same as when the bundle is applied.
+func GetBundleDiff(current, target databroker.RecordSetBundle) BundleDiff {
+	return BundleDiff{
+		Added:    getRecordRefs(current.GetAdded(target)),
+		Modified: getRecordRefs(current.GetModified(target, EqualRecord)),
+		Removed:  getRecordRefs(current.GetRemoved(target)),
+	}
+}
+
+func getRecordRefs(rsb databroker.RecordSetBundle) []RecordRef {
+	var refs []RecordRef
+	for _, record := range rsb.Flatten() {
+		refs = append(refs, RecordRef{Type: record.GetType(), ID: record.GetId()})
+	}
+	slices.SortFunc(refs, func(x, y RecordRef) int {
+		return cmp.Or(cmp.Compare(x.Type, y.Type), cmp.Compare(x.ID, y.ID))
+	})
+	return refs
+}
+
+func recordRefStrings(refs []RecordRef) []string {
+	strs := make([]string, len(refs))
+	for i, ref := range refs {
+		strs[i] = ref.String()
+	}
+	return strs
+}
@@ -0,0 +1,42 @@
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestGetBundleDiff(t *testing.T) {
+	t.Parallel()
+
+	newRecord := func(typ, id, value string) *databroker.Record {
+		return &databroker.Record{Type: typ, Id: id, Data: protoutil.NewAny(wrapperspb.String(value))}
+	}
+
+	current := make(databroker.RecordSetBundle)
+	current.Add(newRecord("type1", "a", "1"))
+	current.Add(newRecord("type1", "b", "1"))
+	current.Add(newRecord("type1", "c", "1"))
+	current.Add(newRecord("type2", "a", "1"))
+
+	target := make(databroker.RecordSetBundle)
+	target.Add(newRecord("type1", "a", "1"))
+	target.Add(newRecord("type1", "b", "2"))
+	target.Add(newRecord("type1", "d", "1"))
+	target.Add(newRecord("type3", "a", "1"))
+
+	diff := GetBundleDiff(current, target)
+	assert.Equal(t, BundleDiff{
+		Added:    []RecordRef{{Type: "type1", ID: "d"}, {Type: "type3", ID: "a"}},
+		Modified: []RecordRef{{Type: "type1", ID: "b"}},
+		Removed:  []RecordRef{{Type: "type1", ID: "c"}, {Type: "type2", ID: "a"}},
+	}, diff)
+	assert.False(t, diff.IsEmpty())
+	assert.Equal(t, []string{"type1/d", "type3/a"}, recordRefStrings(diff.Added))
+
+	assert.True(t, GetBundleDiff(target, target).IsEmpty())
+}
@@ -9,6 +9,9 @@ package reconciler
  *
  * WatchAndSync watches the API for changes, and calls SyncBundle on each change.
  *
+ * In dry-run mode the changes each updated bundle would make to the databroker are
+ * logged instead of applied.
+ *
  */
 
 import (
@@ -140,6 +143,15 @@ func (c *service) syncBundle(ctx context.Context, key string) error {
 		return fmt.Errorf("download bundle: %w", err)
 	}
 
+	if c.config.dryRun {
+		// the bundle cache entry isn't updated, so the changes will be
+		// computed again on the next sync until they are applied
+		if result.ContentUpdated {
+			return c.previewUpdatedBundle(ctx, key, cached, fd)
+		}
+		return nil
+	}
+
 	if result.ContentUpdated {
 		return c.syncUpdatedBundle(ctx, key, cached, result, fd)
 	}
@@ -194,6 +206,30 @@ func (c *service) syncUpdatedBundle(ctx context.Context, key string, cached *Bun
 	return nil
 }
 
+// previewUpdatedBundle logs the changes applying the bundle would make to the
+// databroker.
+func (c *service) previewUpdatedBundle(ctx context.Context, key string, cached *BundleCacheEntry, fd ReadWriteSeekCloser) error {
+	_, err := fd.Seek(0, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("seek to start: %w", err)
+	}
+
+	bundleRecords, databrokerRecords, err := c.getBundleRecords(ctx, fd, cached.GetRecordTypes())
+	if err != nil {
+		return err
+	}
+
+	diff := GetBundleDiff(databrokerRecords, bundleRecords)
+	log.Ctx(ctx).Info().
+		Str("bundle", key).
+		Bool("dry-run", true).
+		Strs("added", recordRefStrings(diff.Added)).
+		Strs("modified", recordRefStrings(diff.Modified)).
+		Strs("removed", recordRefStrings(diff.Removed)).
+		Msg("bundle changes not applied")
+	return nil
+}
+
 func (c *service) getUpdatedMetadata(ctx context.Context, key string, cached BundleCacheEntry, result *zero.DownloadResult) error {
 	log.Ctx(ctx).Debug().Str("bundle", key).
 		Interface("cached-entry", cached).
@@ -245,18 +281,33 @@ func strUnion(a, b []string) []string {
 	return out
 }
 
-func (c *service) syncBundleToDatabroker(ctx context.Context, src io.Reader, currentRecordTypes []string) ([]string, error) {
-	bundleRecords, err := ReadBundleRecords(src)
+// getBundleRecords reads the bundle records and gets the databroker records
+// of the same types, as well as the types previously synced from the bundle.
+func (c *service) getBundleRecords(
+	ctx context.Context,
+	src io.Reader,
+	currentRecordTypes []string,
+) (bundleRecords, databrokerRecords databroker.RecordSetBundle, err error) {
+	bundleRecords, err = ReadBundleRecords(src)
 	if err != nil {
-		return nil, fmt.Errorf("read bundle records: %w", err)
+		return nil, nil, fmt.Errorf("read bundle records: %w", err)
 	}
 
-	databrokerRecords, err := GetDatabrokerRecords(ctx,
+	databrokerRecords, err = GetDatabrokerRecords(ctx,
 		c.config.databrokerClient,
 		strUnion(bundleRecords.RecordTypes(), currentRecordTypes),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("get databroker records: %w", err)
+		return nil, nil, fmt.Errorf("get databroker records: %w", err)
+	}
+
+	return bundleRecords, databrokerRecords, nil
+}
+
+func (c *service) syncBundleToDatabroker(ctx context.Context, src io.Reader, currentRecordTypes []string) ([]string, error) {
+	bundleRecords, databrokerRecords, err := c.getBundleRecords(ctx, src, currentRecordTypes)
+	if err != nil {
+		return nil, err
 	}
 
 	err = databroker.NewReconciler(
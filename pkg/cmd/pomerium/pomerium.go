@@ -127,6 +127,9 @@ func (p *Pomerium) Start(ctx context.Context, tracerProvider oteltrace.TracerPro
 	// trigger changes when underlying files are changed
 	src = config.NewFileWatcherSource(ctx, src)
 
+	// download client certificate revocation lists
+	src = config.NewCRLSource(ctx, src)
+
 	src, err = autocert.New(ctx, src)
 	if err != nil {
 		return err
@@ -220,10 +223,18 @@ func (p *Pomerium) Start(ctx context.Context, tracerProvider oteltrace.TracerPro
 	p.errGroup, ctx = errgroup.WithContext(ctx)
 	if authorizeServer != nil {
 		p.errGroup.Go(func() error {
-			health.ReportRunning(health.AuthorizationService)
 			defer health.ReportTerminating(health.AuthorizationService)
 			return authorizeServer.Run(ctx)
 		})
+		p.errGroup.Go(func() error {
+			// authorization decisions depend on synced data, so don't report
+			// the service as running until the first sync completes
+			if err := authorizeServer.WaitForSync(ctx); err != nil {
+				return nil
+			}
+			health.ReportRunning(health.AuthorizationService)
+			return nil
+		})
 	}
 	p.errGroup.Go(func() error {
 		for _, check := range controlPlaneChecks {
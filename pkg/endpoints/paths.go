@@ -17,6 +17,9 @@ const (
 	PathPomeriumAPI                 = "/.pomerium/api"
 	PathPomeriumAPILogin            = "/.pomerium/api/v1/login"
 	PathPomeriumAPIRoutes           = "/.pomerium/api/v1/routes"
+	PathPomeriumAPIToken            = "/.pomerium/api/v1/token"
+	PathPomeriumAPIVerify           = "/.pomerium/api/v1/verify"
+	PathPomeriumBackChannelLogout   = "/.pomerium/backchannel-logout"
 	PathPomeriumCallback            = "/.pomerium/callback"
 	PathPomeriumDashboard           = "/.pomerium"
 	PathPomeriumDeviceEnrolled      = "/.pomerium/device-enrolled"
@@ -26,6 +29,7 @@ const (
 	PathPomeriumMCPConnect          = "/.pomerium/mcp/connect"
 	PathPomeriumMCPRoutes           = "/.pomerium/mcp/routes"
 	PathPomeriumRoutes              = "/.pomerium/routes"
+	PathPomeriumSCIM                = "/.pomerium/scim/v2"
 	PathPomeriumSignedOut           = "/.pomerium/signed_out"
 	PathPomeriumSignIn              = "/.pomerium/sign_in"
 	PathPomeriumSignOut             = "/.pomerium/sign_out"
@@ -43,11 +47,13 @@ const (
 // well known subpaths
 const (
 	SubPathAPI                 = "api"
+	SubPathBackChannelLogout   = "backchannel-logout"
 	SubPathDeviceEnrolled      = "device-enrolled"
 	SubPathJWT                 = "jwt"
 	SubPathMCP                 = "mcp"
 	SubPathRobotsTxt           = "robots.txt"
 	SubPathRoutes              = "routes"
+	SubPathSCIM                = "scim/v2"
 	SubPathSignedOut           = "signed_out"
 	SubPathSignIn              = "sign_in"
 	SubPathSignOut             = "sign_out"
@@ -22,7 +22,8 @@ type Options struct {
 	// InstallationID specifies the installation id for telemetry exposition.
 	InstallationID string
 
-	// ServiceName specifies the service name for telemetry exposition
+	// ServiceName specifies the service name for telemetry exposition. It is
+	// also signed into the JWT to identify the caller to the databroker acl.
 	ServiceName string
 
 	// SignedJWTKey is the JWT key to use for signing a JWT attached to metadata.
@@ -38,8 +39,12 @@ func NewGRPCClientConn(ctx context.Context, opts *Options, other ...grpc.DialOpt
 		requestid.StreamClientInterceptor(),
 	}
 	if opts.SignedJWTKey != nil {
-		unaryClientInterceptors = append(unaryClientInterceptors, grpcutil.WithUnarySignedJWT(func() []byte { return opts.SignedJWTKey }))
-		streamClientInterceptors = append(streamClientInterceptors, grpcutil.WithStreamSignedJWT(func() []byte { return opts.SignedJWTKey }))
+		var jwtOptions []grpcutil.JWTOption
+		if opts.ServiceName != "" {
+			jwtOptions = append(jwtOptions, grpcutil.WithJWTService(opts.ServiceName))
+		}
+		unaryClientInterceptors = append(unaryClientInterceptors, grpcutil.WithUnarySignedJWT(func() []byte { return opts.SignedJWTKey }, jwtOptions...))
+		streamClientInterceptors = append(streamClientInterceptors, grpcutil.WithStreamSignedJWT(func() []byte { return opts.SignedJWTKey }, jwtOptions...))
 	}
 
 	dialOptions := []grpc.DialOption{
@@ -62,7 +67,8 @@ type OutboundOptions struct {
 	// InstallationID specifies the installation id for telemetry exposition.
 	InstallationID string
 
-	// ServiceName specifies the service name for telemetry exposition
+	// ServiceName specifies the service name for telemetry exposition. It is
+	// also signed into the JWT to identify the caller to the databroker acl.
 	ServiceName string
 
 	// SignedJWTKey is the JWT key to use for signing a JWT attached to metadata.
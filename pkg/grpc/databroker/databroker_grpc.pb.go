@@ -31,8 +31,10 @@ const (
 	DataBrokerService_RenewLease_FullMethodName   = "/databroker.DataBrokerService/RenewLease"
 	DataBrokerService_ServerInfo_FullMethodName   = "/databroker.DataBrokerService/ServerInfo"
 	DataBrokerService_SetOptions_FullMethodName   = "/databroker.DataBrokerService/SetOptions"
+	DataBrokerService_Stats_FullMethodName        = "/databroker.DataBrokerService/Stats"
 	DataBrokerService_Sync_FullMethodName         = "/databroker.DataBrokerService/Sync"
 	DataBrokerService_SyncLatest_FullMethodName   = "/databroker.DataBrokerService/SyncLatest"
+	DataBrokerService_WatchRecord_FullMethodName  = "/databroker.DataBrokerService/WatchRecord"
 )
 
 // DataBrokerServiceClient is the client API for DataBrokerService service.
@@ -63,10 +65,15 @@ type DataBrokerServiceClient interface {
 	ServerInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServerInfoResponse, error)
 	// SetOptions sets the options for a type in the databroker.
 	SetOptions(ctx context.Context, in *SetOptionsRequest, opts ...grpc.CallOption) (*SetOptionsResponse, error)
+	// Stats returns statistics for each record type.
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
 	// Sync streams changes to records after the specified version.
 	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncResponse], error)
 	// SyncLatest streams the latest version of every record.
 	SyncLatest(ctx context.Context, in *SyncLatestRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncLatestResponse], error)
+	// WatchRecord streams the current version of a single record followed by
+	// any subsequent changes to it.
+	WatchRecord(ctx context.Context, in *WatchRecordRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchRecordResponse], error)
 }
 
 type dataBrokerServiceClient struct {
@@ -187,6 +194,16 @@ func (c *dataBrokerServiceClient) SetOptions(ctx context.Context, in *SetOptions
 	return out, nil
 }
 
+func (c *dataBrokerServiceClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, DataBrokerService_Stats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataBrokerServiceClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &DataBrokerService_ServiceDesc.Streams[0], DataBrokerService_Sync_FullMethodName, cOpts...)
@@ -225,6 +242,25 @@ func (c *dataBrokerServiceClient) SyncLatest(ctx context.Context, in *SyncLatest
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type DataBrokerService_SyncLatestClient = grpc.ServerStreamingClient[SyncLatestResponse]
 
+func (c *dataBrokerServiceClient) WatchRecord(ctx context.Context, in *WatchRecordRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchRecordResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DataBrokerService_ServiceDesc.Streams[2], DataBrokerService_WatchRecord_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRecordRequest, WatchRecordResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataBrokerService_WatchRecordClient = grpc.ServerStreamingClient[WatchRecordResponse]
+
 // DataBrokerServiceServer is the server API for DataBrokerService service.
 // All implementations should embed UnimplementedDataBrokerServiceServer
 // for forward compatibility.
@@ -253,10 +289,15 @@ type DataBrokerServiceServer interface {
 	ServerInfo(context.Context, *emptypb.Empty) (*ServerInfoResponse, error)
 	// SetOptions sets the options for a type in the databroker.
 	SetOptions(context.Context, *SetOptionsRequest) (*SetOptionsResponse, error)
+	// Stats returns statistics for each record type.
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
 	// Sync streams changes to records after the specified version.
 	Sync(*SyncRequest, grpc.ServerStreamingServer[SyncResponse]) error
 	// SyncLatest streams the latest version of every record.
 	SyncLatest(*SyncLatestRequest, grpc.ServerStreamingServer[SyncLatestResponse]) error
+	// WatchRecord streams the current version of a single record followed by
+	// any subsequent changes to it.
+	WatchRecord(*WatchRecordRequest, grpc.ServerStreamingServer[WatchRecordResponse]) error
 }
 
 // UnimplementedDataBrokerServiceServer should be embedded to have
@@ -299,12 +340,18 @@ func (UnimplementedDataBrokerServiceServer) ServerInfo(context.Context, *emptypb
 func (UnimplementedDataBrokerServiceServer) SetOptions(context.Context, *SetOptionsRequest) (*SetOptionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetOptions not implemented")
 }
+func (UnimplementedDataBrokerServiceServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
 func (UnimplementedDataBrokerServiceServer) Sync(*SyncRequest, grpc.ServerStreamingServer[SyncResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method Sync not implemented")
 }
 func (UnimplementedDataBrokerServiceServer) SyncLatest(*SyncLatestRequest, grpc.ServerStreamingServer[SyncLatestResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method SyncLatest not implemented")
 }
+func (UnimplementedDataBrokerServiceServer) WatchRecord(*WatchRecordRequest, grpc.ServerStreamingServer[WatchRecordResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRecord not implemented")
+}
 func (UnimplementedDataBrokerServiceServer) testEmbeddedByValue() {}
 
 // UnsafeDataBrokerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -523,6 +570,24 @@ func _DataBrokerService_SetOptions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataBrokerService_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataBrokerServiceServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataBrokerService_Stats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataBrokerServiceServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataBrokerService_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SyncRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -545,6 +610,17 @@ func _DataBrokerService_SyncLatest_Handler(srv interface{}, stream grpc.ServerSt
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type DataBrokerService_SyncLatestServer = grpc.ServerStreamingServer[SyncLatestResponse]
 
+func _DataBrokerService_WatchRecord_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRecordRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataBrokerServiceServer).WatchRecord(m, &grpc.GenericServerStream[WatchRecordRequest, WatchRecordResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataBrokerService_WatchRecordServer = grpc.ServerStreamingServer[WatchRecordResponse]
+
 // DataBrokerService_ServiceDesc is the grpc.ServiceDesc for DataBrokerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -596,6 +672,10 @@ var DataBrokerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetOptions",
 			Handler:    _DataBrokerService_SetOptions_Handler,
 		},
+		{
+			MethodName: "Stats",
+			Handler:    _DataBrokerService_Stats_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -608,6 +688,11 @@ var DataBrokerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _DataBrokerService_SyncLatest_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchRecord",
+			Handler:       _DataBrokerService_WatchRecord_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "databroker.proto",
 }
@@ -757,3 +842,193 @@ var CheckpointService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "databroker.proto",
 }
+
+const (
+	DebugService_DumpRecord_FullMethodName        = "/databroker.DebugService/DumpRecord"
+	DebugService_ListLeases_FullMethodName        = "/databroker.DebugService/ListLeases"
+	DebugService_GetChangeLogStats_FullMethodName = "/databroker.DebugService/GetChangeLogStats"
+)
+
+// DebugServiceClient is the client API for DebugService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The DebugService is used to inspect the state of the databroker. It is only
+// available when the databroker_debug_service runtime flag is enabled.
+type DebugServiceClient interface {
+	// DumpRecord returns a record with its data formatted as JSON.
+	DumpRecord(ctx context.Context, in *DumpRecordRequest, opts ...grpc.CallOption) (*DumpRecordResponse, error)
+	// ListLeases lists the current leases.
+	ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesResponse, error)
+	// GetChangeLogStats returns the versions of the change log and statistics
+	// for each record type.
+	GetChangeLogStats(ctx context.Context, in *GetChangeLogStatsRequest, opts ...grpc.CallOption) (*GetChangeLogStatsResponse, error)
+}
+
+type debugServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDebugServiceClient(cc grpc.ClientConnInterface) DebugServiceClient {
+	return &debugServiceClient{cc}
+}
+
+func (c *debugServiceClient) DumpRecord(ctx context.Context, in *DumpRecordRequest, opts ...grpc.CallOption) (*DumpRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DumpRecordResponse)
+	err := c.cc.Invoke(ctx, DebugService_DumpRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugServiceClient) ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLeasesResponse)
+	err := c.cc.Invoke(ctx, DebugService_ListLeases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugServiceClient) GetChangeLogStats(ctx context.Context, in *GetChangeLogStatsRequest, opts ...grpc.CallOption) (*GetChangeLogStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChangeLogStatsResponse)
+	err := c.cc.Invoke(ctx, DebugService_GetChangeLogStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebugServiceServer is the server API for DebugService service.
+// All implementations should embed UnimplementedDebugServiceServer
+// for forward compatibility.
+//
+// The DebugService is used to inspect the state of the databroker. It is only
+// available when the databroker_debug_service runtime flag is enabled.
+type DebugServiceServer interface {
+	// DumpRecord returns a record with its data formatted as JSON.
+	DumpRecord(context.Context, *DumpRecordRequest) (*DumpRecordResponse, error)
+	// ListLeases lists the current leases.
+	ListLeases(context.Context, *ListLeasesRequest) (*ListLeasesResponse, error)
+	// GetChangeLogStats returns the versions of the change log and statistics
+	// for each record type.
+	GetChangeLogStats(context.Context, *GetChangeLogStatsRequest) (*GetChangeLogStatsResponse, error)
+}
+
+// UnimplementedDebugServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDebugServiceServer struct{}
+
+func (UnimplementedDebugServiceServer) DumpRecord(context.Context, *DumpRecordRequest) (*DumpRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpRecord not implemented")
+}
+func (UnimplementedDebugServiceServer) ListLeases(context.Context, *ListLeasesRequest) (*ListLeasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeases not implemented")
+}
+func (UnimplementedDebugServiceServer) GetChangeLogStats(context.Context, *GetChangeLogStatsRequest) (*GetChangeLogStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangeLogStats not implemented")
+}
+func (UnimplementedDebugServiceServer) testEmbeddedByValue() {}
+
+// UnsafeDebugServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DebugServiceServer will
+// result in compilation errors.
+type UnsafeDebugServiceServer interface {
+	mustEmbedUnimplementedDebugServiceServer()
+}
+
+func RegisterDebugServiceServer(s grpc.ServiceRegistrar, srv DebugServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDebugServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DebugService_ServiceDesc, srv)
+}
+
+func _DebugService_DumpRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServiceServer).DumpRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebugService_DumpRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServiceServer).DumpRecord(ctx, req.(*DumpRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugService_ListLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServiceServer).ListLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebugService_ListLeases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServiceServer).ListLeases(ctx, req.(*ListLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugService_GetChangeLogStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangeLogStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServiceServer).GetChangeLogStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebugService_GetChangeLogStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServiceServer).GetChangeLogStats(ctx, req.(*GetChangeLogStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DebugService_ServiceDesc is the grpc.ServiceDesc for DebugService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DebugService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "databroker.DebugService",
+	HandlerType: (*DebugServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DumpRecord",
+			Handler:    _DebugService_DumpRecord_Handler,
+		},
+		{
+			MethodName: "ListLeases",
+			Handler:    _DebugService_ListLeases_Handler,
+		},
+		{
+			MethodName: "GetChangeLogStats",
+			Handler:    _DebugService_GetChangeLogStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "databroker.proto",
+}
@@ -39,7 +39,7 @@ func newFastForwardHandler(ctx context.Context, tracerProvider oteltrace.TracerP
 		id:      id,
 		handler: handler,
 		pending: make(chan ffCmd, 1),
-		c:       telemetry.NewComponent(tracerProvider, zerolog.DebugLevel, "databroker.fastforward", attribute.String(metrics.SyncerIDLabel, id)),
+		c:       telemetry.NewComponent(tracerProvider, zerolog.DebugLevel, "databroker.fastforward", telemetry.WithAttributes(attribute.String(metrics.SyncerIDLabel, id))),
 	}
 	go ff.run(ctx)
 	return ff
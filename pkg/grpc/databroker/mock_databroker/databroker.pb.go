@@ -263,6 +263,26 @@ func (mr *MockDataBrokerServiceClientMockRecorder) SetOptions(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOptions", reflect.TypeOf((*MockDataBrokerServiceClient)(nil).SetOptions), varargs...)
 }
 
+// Stats mocks base method.
+func (m *MockDataBrokerServiceClient) Stats(ctx context.Context, in *databroker.StatsRequest, opts ...grpc.CallOption) (*databroker.StatsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Stats", varargs...)
+	ret0, _ := ret[0].(*databroker.StatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockDataBrokerServiceClientMockRecorder) Stats(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockDataBrokerServiceClient)(nil).Stats), varargs...)
+}
+
 // Sync mocks base method.
 func (m *MockDataBrokerServiceClient) Sync(ctx context.Context, in *databroker.SyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[databroker.SyncResponse], error) {
 	m.ctrl.T.Helper()
@@ -303,6 +323,26 @@ func (mr *MockDataBrokerServiceClientMockRecorder) SyncLatest(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncLatest", reflect.TypeOf((*MockDataBrokerServiceClient)(nil).SyncLatest), varargs...)
 }
 
+// WatchRecord mocks base method.
+func (m *MockDataBrokerServiceClient) WatchRecord(ctx context.Context, in *databroker.WatchRecordRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[databroker.WatchRecordResponse], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchRecord", varargs...)
+	ret0, _ := ret[0].(grpc.ServerStreamingClient[databroker.WatchRecordResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchRecord indicates an expected call of WatchRecord.
+func (mr *MockDataBrokerServiceClientMockRecorder) WatchRecord(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRecord", reflect.TypeOf((*MockDataBrokerServiceClient)(nil).WatchRecord), varargs...)
+}
+
 // MockDataBrokerServiceServer is a mock of DataBrokerServiceServer interface.
 type MockDataBrokerServiceServer struct {
 	ctrl     *gomock.Controller
@@ -492,6 +532,21 @@ func (mr *MockDataBrokerServiceServerMockRecorder) SetOptions(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOptions", reflect.TypeOf((*MockDataBrokerServiceServer)(nil).SetOptions), arg0, arg1)
 }
 
+// Stats mocks base method.
+func (m *MockDataBrokerServiceServer) Stats(arg0 context.Context, arg1 *databroker.StatsRequest) (*databroker.StatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", arg0, arg1)
+	ret0, _ := ret[0].(*databroker.StatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockDataBrokerServiceServerMockRecorder) Stats(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockDataBrokerServiceServer)(nil).Stats), arg0, arg1)
+}
+
 // Sync mocks base method.
 func (m *MockDataBrokerServiceServer) Sync(arg0 *databroker.SyncRequest, arg1 grpc.ServerStreamingServer[databroker.SyncResponse]) error {
 	m.ctrl.T.Helper()
@@ -520,6 +575,20 @@ func (mr *MockDataBrokerServiceServerMockRecorder) SyncLatest(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncLatest", reflect.TypeOf((*MockDataBrokerServiceServer)(nil).SyncLatest), arg0, arg1)
 }
 
+// WatchRecord mocks base method.
+func (m *MockDataBrokerServiceServer) WatchRecord(arg0 *databroker.WatchRecordRequest, arg1 grpc.ServerStreamingServer[databroker.WatchRecordResponse]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchRecord", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchRecord indicates an expected call of WatchRecord.
+func (mr *MockDataBrokerServiceServerMockRecorder) WatchRecord(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRecord", reflect.TypeOf((*MockDataBrokerServiceServer)(nil).WatchRecord), arg0, arg1)
+}
+
 // MockUnsafeDataBrokerServiceServer is a mock of UnsafeDataBrokerServiceServer interface.
 type MockUnsafeDataBrokerServiceServer struct {
 	ctrl     *gomock.Controller
@@ -709,3 +778,192 @@ func (mr *MockUnsafeCheckpointServiceServerMockRecorder) mustEmbedUnimplementedC
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedCheckpointServiceServer", reflect.TypeOf((*MockUnsafeCheckpointServiceServer)(nil).mustEmbedUnimplementedCheckpointServiceServer))
 }
+
+// MockDebugServiceClient is a mock of DebugServiceClient interface.
+type MockDebugServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDebugServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockDebugServiceClientMockRecorder is the mock recorder for MockDebugServiceClient.
+type MockDebugServiceClientMockRecorder struct {
+	mock *MockDebugServiceClient
+}
+
+// NewMockDebugServiceClient creates a new mock instance.
+func NewMockDebugServiceClient(ctrl *gomock.Controller) *MockDebugServiceClient {
+	mock := &MockDebugServiceClient{ctrl: ctrl}
+	mock.recorder = &MockDebugServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDebugServiceClient) EXPECT() *MockDebugServiceClientMockRecorder {
+	return m.recorder
+}
+
+// DumpRecord mocks base method.
+func (m *MockDebugServiceClient) DumpRecord(ctx context.Context, in *databroker.DumpRecordRequest, opts ...grpc.CallOption) (*databroker.DumpRecordResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DumpRecord", varargs...)
+	ret0, _ := ret[0].(*databroker.DumpRecordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DumpRecord indicates an expected call of DumpRecord.
+func (mr *MockDebugServiceClientMockRecorder) DumpRecord(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpRecord", reflect.TypeOf((*MockDebugServiceClient)(nil).DumpRecord), varargs...)
+}
+
+// GetChangeLogStats mocks base method.
+func (m *MockDebugServiceClient) GetChangeLogStats(ctx context.Context, in *databroker.GetChangeLogStatsRequest, opts ...grpc.CallOption) (*databroker.GetChangeLogStatsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChangeLogStats", varargs...)
+	ret0, _ := ret[0].(*databroker.GetChangeLogStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangeLogStats indicates an expected call of GetChangeLogStats.
+func (mr *MockDebugServiceClientMockRecorder) GetChangeLogStats(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeLogStats", reflect.TypeOf((*MockDebugServiceClient)(nil).GetChangeLogStats), varargs...)
+}
+
+// ListLeases mocks base method.
+func (m *MockDebugServiceClient) ListLeases(ctx context.Context, in *databroker.ListLeasesRequest, opts ...grpc.CallOption) (*databroker.ListLeasesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLeases", varargs...)
+	ret0, _ := ret[0].(*databroker.ListLeasesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLeases indicates an expected call of ListLeases.
+func (mr *MockDebugServiceClientMockRecorder) ListLeases(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLeases", reflect.TypeOf((*MockDebugServiceClient)(nil).ListLeases), varargs...)
+}
+
+// MockDebugServiceServer is a mock of DebugServiceServer interface.
+type MockDebugServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDebugServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockDebugServiceServerMockRecorder is the mock recorder for MockDebugServiceServer.
+type MockDebugServiceServerMockRecorder struct {
+	mock *MockDebugServiceServer
+}
+
+// NewMockDebugServiceServer creates a new mock instance.
+func NewMockDebugServiceServer(ctrl *gomock.Controller) *MockDebugServiceServer {
+	mock := &MockDebugServiceServer{ctrl: ctrl}
+	mock.recorder = &MockDebugServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDebugServiceServer) EXPECT() *MockDebugServiceServerMockRecorder {
+	return m.recorder
+}
+
+// DumpRecord mocks base method.
+func (m *MockDebugServiceServer) DumpRecord(arg0 context.Context, arg1 *databroker.DumpRecordRequest) (*databroker.DumpRecordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DumpRecord", arg0, arg1)
+	ret0, _ := ret[0].(*databroker.DumpRecordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DumpRecord indicates an expected call of DumpRecord.
+func (mr *MockDebugServiceServerMockRecorder) DumpRecord(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpRecord", reflect.TypeOf((*MockDebugServiceServer)(nil).DumpRecord), arg0, arg1)
+}
+
+// GetChangeLogStats mocks base method.
+func (m *MockDebugServiceServer) GetChangeLogStats(arg0 context.Context, arg1 *databroker.GetChangeLogStatsRequest) (*databroker.GetChangeLogStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangeLogStats", arg0, arg1)
+	ret0, _ := ret[0].(*databroker.GetChangeLogStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangeLogStats indicates an expected call of GetChangeLogStats.
+func (mr *MockDebugServiceServerMockRecorder) GetChangeLogStats(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeLogStats", reflect.TypeOf((*MockDebugServiceServer)(nil).GetChangeLogStats), arg0, arg1)
+}
+
+// ListLeases mocks base method.
+func (m *MockDebugServiceServer) ListLeases(arg0 context.Context, arg1 *databroker.ListLeasesRequest) (*databroker.ListLeasesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLeases", arg0, arg1)
+	ret0, _ := ret[0].(*databroker.ListLeasesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLeases indicates an expected call of ListLeases.
+func (mr *MockDebugServiceServerMockRecorder) ListLeases(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLeases", reflect.TypeOf((*MockDebugServiceServer)(nil).ListLeases), arg0, arg1)
+}
+
+// MockUnsafeDebugServiceServer is a mock of UnsafeDebugServiceServer interface.
+type MockUnsafeDebugServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeDebugServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockUnsafeDebugServiceServerMockRecorder is the mock recorder for MockUnsafeDebugServiceServer.
+type MockUnsafeDebugServiceServerMockRecorder struct {
+	mock *MockUnsafeDebugServiceServer
+}
+
+// NewMockUnsafeDebugServiceServer creates a new mock instance.
+func NewMockUnsafeDebugServiceServer(ctrl *gomock.Controller) *MockUnsafeDebugServiceServer {
+	mock := &MockUnsafeDebugServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeDebugServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeDebugServiceServer) EXPECT() *MockUnsafeDebugServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedDebugServiceServer mocks base method.
+func (m *MockUnsafeDebugServiceServer) mustEmbedUnimplementedDebugServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedDebugServiceServer")
+}
+
+// mustEmbedUnimplementedDebugServiceServer indicates an expected call of mustEmbedUnimplementedDebugServiceServer.
+func (mr *MockUnsafeDebugServiceServerMockRecorder) mustEmbedUnimplementedDebugServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedDebugServiceServer", reflect.TypeOf((*MockUnsafeDebugServiceServer)(nil).mustEmbedUnimplementedDebugServiceServer))
+}
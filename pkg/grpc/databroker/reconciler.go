@@ -91,7 +91,7 @@ func NewReconciler(
 		targetStateBuilder:  targetStateBuilder,
 		setCurrentState:     setCurrentState,
 		cmpFn:               cmpFn,
-		telemetry:           telemetry.NewComponent(cfg.tracerProvider, zerolog.InfoLevel, "databroker-reconciler", cfg.attributes...),
+		telemetry:           telemetry.NewComponent(cfg.tracerProvider, zerolog.InfoLevel, "databroker-reconciler", telemetry.WithAttributes(cfg.attributes...)),
 	}
 }
 
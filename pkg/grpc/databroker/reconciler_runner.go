@@ -39,7 +39,7 @@ func NewReconcilerRunner(
 		client:           client,
 		name:             fmt.Sprintf("%s-reconciler", leaseName),
 		trigger:          make(chan struct{}, 1),
-		telemetry:        telemetry.NewComponent(cfg.tracerProvider, zerolog.InfoLevel, "databroker-reconciler", cfg.attributes...),
+		telemetry:        telemetry.NewComponent(cfg.tracerProvider, zerolog.InfoLevel, "databroker-reconciler", telemetry.WithAttributes(cfg.attributes...)),
 	}
 }
 
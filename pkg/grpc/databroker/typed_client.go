@@ -0,0 +1,160 @@
+package databroker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+// typedClientPageSize is the number of records queried at a time by List.
+const typedClientPageSize = 100
+
+// A TypedClient is a databroker client for the records of a single protobuf
+// type. It takes care of type URLs and packing and unpacking record data.
+type TypedClient[T any, TMessage interface {
+	*T
+	proto.Message
+}] struct {
+	client  DataBrokerServiceClient
+	typeURL string
+	name    string
+}
+
+// NewTypedClient creates a new TypedClient.
+func NewTypedClient[T any, TMessage interface {
+	*T
+	proto.Message
+}](client DataBrokerServiceClient) TypedClient[T, TMessage] {
+	msg := TMessage(new(T))
+	return TypedClient[T, TMessage]{
+		client:  client,
+		typeURL: protoutil.GetTypeURL(msg),
+		name:    strings.ToLower(string(msg.ProtoReflect().Descriptor().Name())),
+	}
+}
+
+// TypeURL returns the record type used by the client.
+func (c TypedClient[T, TMessage]) TypeURL() string {
+	return c.typeURL
+}
+
+// Get gets a record and unmarshals its data.
+func (c TypedClient[T, TMessage]) Get(ctx context.Context, id string) (TMessage, error) {
+	res, err := c.client.Get(ctx, &GetRequest{
+		Type: c.typeURL,
+		Id:   id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.unmarshal(res.GetRecord())
+}
+
+// Put saves a record with the given id.
+func (c TypedClient[T, TMessage]) Put(ctx context.Context, id string, msg TMessage) (*PutResponse, error) {
+	return c.client.Put(ctx, &PutRequest{
+		Records: []*Record{{
+			Type: c.typeURL,
+			Id:   id,
+			Data: protoutil.NewAny(msg),
+		}},
+	})
+}
+
+// Delete deletes the record with the given id.
+func (c TypedClient[T, TMessage]) Delete(ctx context.Context, id string) error {
+	_, err := c.client.Put(ctx, &PutRequest{
+		Records: []*Record{{
+			Type:      c.typeURL,
+			Id:        id,
+			Data:      protoutil.NewAny(TMessage(new(T))),
+			DeletedAt: timestamppb.Now(),
+		}},
+	})
+	return err
+}
+
+// List lists all the records matching the filter. A nil filter matches every
+// record. Records are queried one page at a time, ordered by id, with each
+// page starting after the last id of the previous one so that concurrent
+// changes don't cause records to be skipped or repeated.
+func (c TypedClient[T, TMessage]) List(ctx context.Context, filter *structpb.Struct) ([]TMessage, error) {
+	var msgs []TMessage
+	pageFilter := filter
+	for {
+		res, err := c.client.Query(ctx, &QueryRequest{
+			Type:    c.typeURL,
+			Filter:  pageFilter,
+			OrderBy: []*QueryOrderBy{{Field: "id"}},
+			Limit:   typedClientPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		records := res.GetRecords()
+		for _, record := range records {
+			msg, err := c.unmarshal(record)
+			if err != nil {
+				return nil, err
+			}
+			msgs = append(msgs, msg)
+		}
+
+		if len(records) < typedClientPageSize {
+			return msgs, nil
+		}
+		pageFilter = afterIDFilter(filter, records[len(records)-1].GetId())
+	}
+}
+
+// SyncLatest calls fn for every current record and returns the versions to
+// pass to Sync to receive subsequent changes.
+func (c TypedClient[T, TMessage]) SyncLatest(
+	ctx context.Context,
+	fn func(TMessage),
+) (serverVersion, latestRecordVersion uint64, err error) {
+	return SyncLatestRecords[T, TMessage](ctx, c.client, fn)
+}
+
+// Sync calls fn for every record changed after the given versions.
+func (c TypedClient[T, TMessage]) Sync(
+	ctx context.Context,
+	serverVersion, latestRecordVersion uint64,
+	fn func(TMessage),
+) error {
+	return SyncRecords[T, TMessage](ctx, c.client, serverVersion, latestRecordVersion, fn)
+}
+
+func (c TypedClient[T, TMessage]) unmarshal(record *Record) (TMessage, error) {
+	var msg TMessage = new(T)
+	err := record.GetData().UnmarshalTo(msg)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling %s from databroker: %w", c.name, err)
+	}
+	return msg, nil
+}
+
+// afterIDFilter restricts filter to the records with an id greater than id.
+func afterIDFilter(filter *structpb.Struct, id string) *structpb.Struct {
+	idFilter := &structpb.Struct{Fields: map[string]*structpb.Value{
+		"id": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"$gt": structpb.NewStringValue(id),
+		}}),
+	}}
+	if filter == nil {
+		return idFilter
+	}
+	return &structpb.Struct{Fields: map[string]*structpb.Value{
+		"$and": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+			structpb.NewStructValue(filter),
+			structpb.NewStructValue(idFilter),
+		}}),
+	}}
+}
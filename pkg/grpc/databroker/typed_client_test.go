@@ -0,0 +1,72 @@
+package databroker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/testutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/user"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestTypedClient(t *testing.T) {
+	t.Parallel()
+
+	ctx, clearTimeout := context.WithTimeout(t.Context(), time.Minute)
+	defer clearTimeout()
+
+	srv := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(srv.Stop)
+
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, srv)
+	})
+
+	c := databrokerpb.NewTypedClient[user.User](databrokerpb.NewDataBrokerServiceClient(cc))
+	assert.Equal(t, protoutil.GetTypeURL(new(user.User)), c.TypeURL())
+
+	var expected []*user.User
+	for i := range 250 {
+		u := &user.User{Id: fmt.Sprintf("u%03d", i), Name: []string{"even", "odd"}[i%2]}
+		_, err := c.Put(ctx, u.GetId(), u)
+		require.NoError(t, err)
+		expected = append(expected, u)
+	}
+
+	actual, err := c.Get(ctx, "u001")
+	require.NoError(t, err)
+	testutil.AssertProtoEqual(t, expected[1], actual)
+
+	all, err := c.List(ctx, nil)
+	require.NoError(t, err)
+	testutil.AssertProtoEqual(t, expected, all, "should list every page")
+
+	even, err := c.List(ctx, &structpb.Struct{Fields: map[string]*structpb.Value{
+		"name": structpb.NewStringValue("even"),
+	}})
+	require.NoError(t, err)
+	assert.Len(t, even, 125, "should combine the filter with the page cursor")
+	for _, u := range even {
+		assert.Equal(t, "even", u.GetName())
+	}
+
+	require.NoError(t, c.Delete(ctx, "u001"))
+	_, err = c.Get(ctx, "u001")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	all, err = c.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, all, 249)
+}
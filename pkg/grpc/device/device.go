@@ -3,11 +3,9 @@ package device
 
 import (
 	"context"
-	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/pomerium/pomerium/pkg/encoding/base58"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
@@ -27,15 +25,7 @@ func DeleteCredential(
 		return nil, err
 	}
 
-	data := protoutil.NewAny(credential)
-	_, err = client.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{{
-			Type:      data.GetTypeUrl(),
-			Id:        credentialID,
-			Data:      data,
-			DeletedAt: timestamppb.Now(),
-		}},
-	})
+	err = databroker.NewTypedClient[Credential](client).Delete(ctx, credentialID)
 	return credential, err
 }
 
@@ -52,15 +42,7 @@ func DeleteEnrollment(
 		return nil, err
 	}
 
-	data := protoutil.NewAny(enrollment)
-	_, err = client.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{{
-			Type:      data.GetTypeUrl(),
-			Id:        enrollmentID,
-			Data:      data,
-			DeletedAt: timestamppb.Now(),
-		}},
-	})
+	err = databroker.NewTypedClient[Enrollment](client).Delete(ctx, enrollmentID)
 	return enrollment, err
 }
 
@@ -70,23 +52,7 @@ func GetCredential(
 	client databroker.DataBrokerServiceClient,
 	credentialID string,
 ) (*Credential, error) {
-	data := protoutil.NewAny(new(Credential))
-
-	res, err := client.Get(ctx, &databroker.GetRequest{
-		Type: data.GetTypeUrl(),
-		Id:   credentialID,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	var obj Credential
-	err = res.GetRecord().GetData().UnmarshalTo(&obj)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshaling device credential from databroker: %w", err)
-	}
-
-	return &obj, nil
+	return databroker.NewTypedClient[Credential](client).Get(ctx, credentialID)
 }
 
 // GetEnrollment gets an enrollment from the databroker.
@@ -95,23 +61,7 @@ func GetEnrollment(
 	client databroker.DataBrokerServiceClient,
 	enrollmentID string,
 ) (*Enrollment, error) {
-	data := protoutil.NewAny(new(Enrollment))
-
-	res, err := client.Get(ctx, &databroker.GetRequest{
-		Type: data.GetTypeUrl(),
-		Id:   enrollmentID,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	var obj Enrollment
-	err = res.GetRecord().GetData().UnmarshalTo(&obj)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshaling device enrollment from databroker: %w", err)
-	}
-
-	return &obj, nil
+	return databroker.NewTypedClient[Enrollment](client).Get(ctx, enrollmentID)
 }
 
 // GetOwnerCredentialRecord gets an OwnerCredentialRecord from the databroker.
@@ -120,23 +70,7 @@ func GetOwnerCredentialRecord(
 	client databroker.DataBrokerServiceClient,
 	credentialID []byte,
 ) (*OwnerCredentialRecord, error) {
-	data := protoutil.NewAny(new(OwnerCredentialRecord))
-
-	res, err := client.Get(ctx, &databroker.GetRequest{
-		Type: data.GetTypeUrl(),
-		Id:   base58.Encode(credentialID),
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	var obj OwnerCredentialRecord
-	err = res.GetRecord().GetData().UnmarshalTo(&obj)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshaling device owner credential record from databroker: %w", err)
-	}
-
-	return &obj, nil
+	return databroker.NewTypedClient[OwnerCredentialRecord](client).Get(ctx, base58.Encode(credentialID))
 }
 
 // GetType gets a type from the databroker.
@@ -145,23 +79,7 @@ func GetType(
 	client databroker.DataBrokerServiceClient,
 	typeID string,
 ) (*Type, error) {
-	data := protoutil.NewAny(new(Type))
-
-	res, err := client.Get(ctx, &databroker.GetRequest{
-		Type: data.GetTypeUrl(),
-		Id:   typeID,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	var obj Type
-	err = res.GetRecord().GetData().UnmarshalTo(&obj)
-	if err != nil {
-		return nil, fmt.Errorf("error unmarshaling device type from databroker: %w", err)
-	}
-
-	return &obj, nil
+	return databroker.NewTypedClient[Type](client).Get(ctx, typeID)
 }
 
 // PutCredential puts a Credential in the databroker.
@@ -172,14 +90,7 @@ func PutCredential(
 ) error {
 	shrinkCredential(credential)
 
-	data := protoutil.NewAny(credential)
-	_, err := client.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{{
-			Type: data.GetTypeUrl(),
-			Id:   credential.GetId(),
-			Data: data,
-		}},
-	})
+	_, err := databroker.NewTypedClient[Credential](client).Put(ctx, credential.GetId(), credential)
 	return err
 }
 
@@ -189,14 +100,7 @@ func PutEnrollment(
 	client databroker.DataBrokerServiceClient,
 	enrollment *Enrollment,
 ) error {
-	data := protoutil.NewAny(enrollment)
-	_, err := client.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{{
-			Type: data.GetTypeUrl(),
-			Id:   enrollment.GetId(),
-			Data: data,
-		}},
-	})
+	_, err := databroker.NewTypedClient[Enrollment](client).Put(ctx, enrollment.GetId(), enrollment)
 	return err
 }
 
@@ -206,14 +110,8 @@ func PutOwnerCredentialRecord(
 	client databroker.DataBrokerServiceClient,
 	ownerCredentialRecord *OwnerCredentialRecord,
 ) error {
-	data := protoutil.NewAny(ownerCredentialRecord)
-	_, err := client.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{{
-			Type: data.GetTypeUrl(),
-			Id:   base58.Encode(ownerCredentialRecord.GetId()),
-			Data: data,
-		}},
-	})
+	_, err := databroker.NewTypedClient[OwnerCredentialRecord](client).Put(ctx,
+		base58.Encode(ownerCredentialRecord.GetId()), ownerCredentialRecord)
 	return err
 }
 
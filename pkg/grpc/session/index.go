@@ -0,0 +1,140 @@
+package session
+
+import (
+	context "context"
+	"fmt"
+	"net/url"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+// IndexRecordType is the record type of session index records. Session index
+// records map identity provider session ids (sid) and subjects (sub) to
+// Pomerium sessions, so that sessions can be found when the identity provider
+// sends a back-channel logout.
+const IndexRecordType = "pomerium.io/SessionIndex"
+
+const indexPageSize = 100
+
+// IndexKeyForSID returns the index key for an identity provider session id.
+func IndexKeyForSID(issuer, sid string) string {
+	return "sid|" + url.QueryEscape(issuer) + "|" + url.QueryEscape(sid)
+}
+
+// IndexKeyForSubject returns the index key for an identity provider subject.
+func IndexKeyForSubject(issuer, sub string) string {
+	return "sub|" + url.QueryEscape(issuer) + "|" + url.QueryEscape(sub)
+}
+
+// NewIndexRecords returns the index records for a session. Sessions without an
+// id token are not indexed.
+func NewIndexRecords(s *Session) []*databroker.Record {
+	issuer := s.GetIdToken().GetIssuer()
+	if issuer == "" {
+		return nil
+	}
+
+	var keys []string
+	if sub := s.GetIdToken().GetSubject(); sub != "" {
+		keys = append(keys, IndexKeyForSubject(issuer, sub))
+	}
+	for _, v := range s.GetClaims()["sid"].GetValues() {
+		if sid := v.GetStringValue(); sid != "" {
+			keys = append(keys, IndexKeyForSID(issuer, sid))
+		}
+	}
+
+	records := make([]*databroker.Record, 0, len(keys))
+	for _, key := range keys {
+		records = append(records, &databroker.Record{
+			Type: IndexRecordType,
+			Id:   key + "|" + s.GetId(),
+			Data: protoutil.NewAny(&structpb.Struct{Fields: map[string]*structpb.Value{
+				"session_id": structpb.NewStringValue(s.GetId()),
+			}}),
+		})
+	}
+	return records
+}
+
+// RevokeByIndex revokes every session found for the given index keys. The
+// matching index records are deleted. The ids of the revoked sessions are
+// returned.
+func RevokeByIndex(ctx context.Context, client databroker.DataBrokerServiceClient, keys ...string) ([]string, error) {
+	var revoked []string
+	for _, key := range keys {
+		indexRecords, err := listIndexRecords(ctx, client, key)
+		if err != nil {
+			return revoked, err
+		}
+
+		for _, indexRecord := range indexRecords {
+			sessionID, err := getIndexRecordSessionID(indexRecord)
+			if err != nil {
+				return revoked, err
+			}
+
+			_, err = Revoke(ctx, client, sessionID)
+			switch {
+			case status.Code(err) == codes.NotFound:
+				// the session was deleted in the meantime
+			case err != nil:
+				return revoked, fmt.Errorf("error revoking session %s: %w", sessionID, err)
+			default:
+				revoked = append(revoked, sessionID)
+			}
+
+			indexRecord.DeletedAt = timestamppb.Now()
+		}
+
+		if len(indexRecords) > 0 {
+			_, err = client.Put(ctx, &databroker.PutRequest{Records: indexRecords})
+			if err != nil {
+				return revoked, fmt.Errorf("error deleting session index records: %w", err)
+			}
+		}
+	}
+	return revoked, nil
+}
+
+func listIndexRecords(ctx context.Context, client databroker.DataBrokerServiceClient, key string) ([]*databroker.Record, error) {
+	filter := &structpb.Struct{Fields: map[string]*structpb.Value{
+		"id": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"$prefix": structpb.NewStringValue(key + "|"),
+		}}),
+	}}
+
+	var records []*databroker.Record
+	for offset := int64(0); ; {
+		res, err := client.Query(ctx, &databroker.QueryRequest{
+			Type:   IndexRecordType,
+			Filter: filter,
+			Offset: offset,
+			Limit:  indexPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error querying session index: %w", err)
+		}
+		records = append(records, res.GetRecords()...)
+
+		offset += int64(len(res.GetRecords()))
+		if len(res.GetRecords()) == 0 || offset >= res.GetTotalCount() {
+			return records, nil
+		}
+	}
+}
+
+func getIndexRecordSessionID(record *databroker.Record) (string, error) {
+	var s structpb.Struct
+	err := record.GetData().UnmarshalTo(&s)
+	if err != nil {
+		return "", fmt.Errorf("error unmarshaling session index record: %w", err)
+	}
+	return s.GetFields()["session_id"].GetStringValue(), nil
+}
@@ -0,0 +1,95 @@
+package session
+
+import (
+	context "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker/mock_databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestNewIndexRecords(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, NewIndexRecords(&Session{Id: "s1"}))
+
+	records := NewIndexRecords(&Session{
+		Id: "s1",
+		IdToken: &IDToken{
+			Issuer:  "https://idp.example.com",
+			Subject: "u1",
+		},
+		Claims: map[string]*structpb.ListValue{
+			"sid": {Values: []*structpb.Value{structpb.NewStringValue("idp-session")}},
+		},
+	})
+	require.Len(t, records, 2)
+	assert.Equal(t, IndexRecordType, records[0].GetType())
+	assert.Equal(t, "sub|https%3A%2F%2Fidp.example.com|u1|s1", records[0].GetId())
+	assert.Equal(t, "sid|https%3A%2F%2Fidp.example.com|idp-session|s1", records[1].GetId())
+
+	sessionID, err := getIndexRecordSessionID(records[1])
+	require.NoError(t, err)
+	assert.Equal(t, "s1", sessionID)
+}
+
+func TestRevokeByIndex(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := mock_databroker.NewMockDataBrokerServiceClient(ctrl)
+
+	ctx := t.Context()
+	indexRecords := NewIndexRecords(&Session{
+		Id:      "s1",
+		IdToken: &IDToken{Issuer: "https://idp.example.com", Subject: "u1"},
+	})
+
+	client.EXPECT().Query(ctx, gomock.Any(), []grpc.CallOption{}).DoAndReturn(
+		func(_ context.Context, r *databroker.QueryRequest, _ ...grpc.CallOption) (*databroker.QueryResponse, error) {
+			assert.Equal(t, IndexRecordType, r.GetType())
+			assert.Equal(t, IndexKeyForSubject("https://idp.example.com", "u1")+"|",
+				r.GetFilter().GetFields()["id"].GetStructValue().GetFields()["$prefix"].GetStringValue())
+			return &databroker.QueryResponse{Records: indexRecords, TotalCount: 1}, nil
+		})
+	client.EXPECT().Get(ctx, gomock.Any(), []grpc.CallOption{}).Return(&databroker.GetResponse{
+		Record: &databroker.Record{
+			Type: "type.googleapis.com/session.Session",
+			Id:   "s1",
+			Data: protoutil.NewAny(&Session{Id: "s1"}),
+		},
+	}, nil)
+	client.EXPECT().Put(ctx, gomock.Any(), []grpc.CallOption{}).DoAndReturn(
+		func(_ context.Context, r *databroker.PutRequest, _ ...grpc.CallOption) (*databroker.PutResponse, error) {
+			require.Len(t, r.Records, 1)
+			assert.Equal(t, "s1", r.GetRecord().GetId())
+			assert.NotNil(t, r.GetRecord().GetDeletedAt())
+			return &databroker.PutResponse{}, nil
+		})
+	client.EXPECT().SetOptions(ctx, gomock.Any(), []grpc.CallOption{}).Return(&databroker.SetOptionsResponse{}, nil)
+	client.EXPECT().Put(ctx, gomock.Any(), []grpc.CallOption{}).DoAndReturn(
+		func(_ context.Context, r *databroker.PutRequest, _ ...grpc.CallOption) (*databroker.PutResponse, error) {
+			require.Len(t, r.Records, 1)
+			assert.Equal(t, RevocationRecordType, r.GetRecord().GetType())
+			assert.Equal(t, "s1", r.GetRecord().GetId())
+			return &databroker.PutResponse{}, nil
+		})
+	client.EXPECT().Put(ctx, gomock.Any(), []grpc.CallOption{}).DoAndReturn(
+		func(_ context.Context, r *databroker.PutRequest, _ ...grpc.CallOption) (*databroker.PutResponse, error) {
+			require.Len(t, r.Records, 1)
+			assert.Equal(t, IndexRecordType, r.GetRecord().GetType())
+			assert.NotNil(t, r.GetRecord().GetDeletedAt())
+			return &databroker.PutResponse{}, nil
+		})
+
+	revoked, err := RevokeByIndex(ctx, client, IndexKeyForSubject("https://idp.example.com", "u1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"s1"}, revoked)
+}
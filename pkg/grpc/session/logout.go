@@ -0,0 +1,55 @@
+package session
+
+import (
+	context "context"
+	"errors"
+	"net/url"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+// LogoutTokenRecordType is the record type used to remember the ids (jti) of
+// the back-channel logout tokens which have already been used.
+const LogoutTokenRecordType = "pomerium.io/LogoutToken"
+
+// maxLogoutTokens is the number of logout token records the databroker keeps.
+// Logout tokens are short-lived, so only the most recent ones need to be
+// remembered.
+const maxLogoutTokens = 10000
+
+// ErrLogoutTokenReplayed indicates that a logout token was already used.
+var ErrLogoutTokenReplayed = errors.New("logout token was already used")
+
+// RecordLogoutToken records the use of a back-channel logout token. If the
+// token was already used ErrLogoutTokenReplayed is returned.
+func RecordLogoutToken(ctx context.Context, client databroker.DataBrokerServiceClient, issuer, jti string) error {
+	_, err := client.SetOptions(ctx, &databroker.SetOptionsRequest{
+		Type: LogoutTokenRecordType,
+		Options: &databroker.Options{
+			Capacity: proto.Uint64(maxLogoutTokens),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// an expected version of 0 only creates the record if it doesn't exist
+	_, err = client.Put(ctx, &databroker.PutRequest{
+		Records: []*databroker.Record{{
+			Type:            LogoutTokenRecordType,
+			Id:              url.QueryEscape(issuer) + "|" + url.QueryEscape(jti),
+			Data:            protoutil.NewAny(timestamppb.Now()),
+			ExpectedVersion: proto.Uint64(0),
+		}},
+	})
+	if status.Code(err) == codes.Aborted {
+		return ErrLogoutTokenReplayed
+	}
+	return err
+}
@@ -0,0 +1,43 @@
+package session
+
+import (
+	context "context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker/mock_databroker"
+)
+
+func TestRecordLogoutToken(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := mock_databroker.NewMockDataBrokerServiceClient(ctrl)
+
+	ctx := t.Context()
+	seen := map[string]bool{}
+	client.EXPECT().SetOptions(ctx, gomock.Any(), []grpc.CallOption{}).Return(&databroker.SetOptionsResponse{}, nil).Times(2)
+	client.EXPECT().Put(ctx, gomock.Any(), []grpc.CallOption{}).DoAndReturn(
+		func(_ context.Context, r *databroker.PutRequest, _ ...grpc.CallOption) (*databroker.PutResponse, error) {
+			require.Len(t, r.Records, 1)
+			record := r.GetRecord()
+			assert.Equal(t, LogoutTokenRecordType, record.Type)
+			assert.Equal(t, "https%3A%2F%2Fidp.example.com|JTI", record.Id)
+			assert.Equal(t, uint64(0), record.GetExpectedVersion(), "should only create the record")
+			if seen[record.Id] {
+				return nil, status.Error(codes.Aborted, "record version mismatch")
+			}
+			seen[record.Id] = true
+			return &databroker.PutResponse{}, nil
+		}).Times(2)
+
+	assert.NoError(t, RecordLogoutToken(ctx, client, "https://idp.example.com", "JTI"))
+	assert.ErrorIs(t, RecordLogoutToken(ctx, client, "https://idp.example.com", "JTI"), ErrLogoutTokenReplayed)
+}
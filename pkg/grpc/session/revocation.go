@@ -0,0 +1,44 @@
+package session
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+// RevocationRecordType is the record type used to announce that sessions were
+// removed. Each record's id is the id of the removed session. Instances watch
+// these small records instead of syncing every session to know which cached
+// sessions to drop.
+const RevocationRecordType = "pomerium.io/SessionRevocation"
+
+// maxRevocations is the number of revocation records the databroker keeps.
+// Older records are removed once the capacity is exceeded.
+const maxRevocations = 1000
+
+func putRevocations(ctx context.Context, client databroker.DataBrokerServiceClient, sessionIDs ...string) error {
+	_, err := client.SetOptions(ctx, &databroker.SetOptionsRequest{
+		Type: RevocationRecordType,
+		Options: &databroker.Options{
+			Capacity: proto.Uint64(maxRevocations),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	records := make([]*databroker.Record, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		records = append(records, &databroker.Record{
+			Type: RevocationRecordType,
+			Id:   sessionID,
+			Data: protoutil.NewAny(timestamppb.Now()),
+		})
+	}
+	_, err = client.Put(ctx, &databroker.PutRequest{Records: records})
+	return err
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/go-jose/go-jose/v3/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -18,51 +20,93 @@ import (
 	"github.com/pomerium/pomerium/pkg/slices"
 )
 
-// Delete deletes a session from the databroker.
+// Delete deletes a session from the databroker and records its revocation.
 func Delete(ctx context.Context, client databroker.DataBrokerServiceClient, sessionID string) error {
-	data := protoutil.NewAny(new(Session))
-	_, err := client.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{{
-			Type:      data.GetTypeUrl(),
-			Id:        sessionID,
-			Data:      data,
-			DeletedAt: timestamppb.Now(),
-		}},
-	})
-	return err
+	err := databroker.NewTypedClient[Session](client).Delete(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	return putRevocations(ctx, client, sessionID)
 }
 
-// Get gets a session from the databroker.
-func Get(ctx context.Context, client databroker.DataBrokerServiceClient, sessionID string) (*Session, error) {
-	data := protoutil.NewAny(new(Session))
+// Revoke revokes a session by deleting its record from the databroker. Unlike
+// Delete, the session data is kept in the deleted record, so that the identity
+// manager can still tell which user the session belonged to. The session's
+// index records are deleted as well. Every Pomerium
+// instance drops the session from its cache once it syncs the revocation
+// record. The revoked session is returned.
+func Revoke(ctx context.Context, client databroker.DataBrokerServiceClient, sessionID string) (*Session, error) {
 	res, err := client.Get(ctx, &databroker.GetRequest{
-		Type: data.GetTypeUrl(),
+		Type: protoutil.GetTypeURL(new(Session)),
 		Id:   sessionID,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	record := res.GetRecord()
 	var s Session
-	err = res.GetRecord().GetData().UnmarshalTo(&s)
+	err = record.GetData().UnmarshalTo(&s)
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshaling session from databroker: %w", err)
 	}
+
+	// the session's index records are deleted along with it
+	record.DeletedAt = timestamppb.Now()
+	records := []*databroker.Record{record}
+	for _, indexRecord := range NewIndexRecords(&s) {
+		indexRecord.DeletedAt = record.DeletedAt
+		records = append(records, indexRecord)
+	}
+	_, err = client.Put(ctx, &databroker.PutRequest{
+		Records: records,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = putRevocations(ctx, client, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("error announcing session revocation: %w", err)
+	}
 	return &s, nil
 }
 
+// RevokeUser revokes every session belonging to the given user. The ids of the
+// revoked sessions are returned.
+func RevokeUser(ctx context.Context, client databroker.DataBrokerServiceClient, userID string) ([]string, error) {
+	var sessionIDs []string
+	_, _, err := databroker.SyncLatestRecords(ctx, client, func(s *Session) {
+		if s.GetUserId() == userID {
+			sessionIDs = append(sessionIDs, s.GetId())
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing sessions: %w", err)
+	}
+
+	revoked := make([]string, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		_, err = Revoke(ctx, client, sessionID)
+		if status.Code(err) == codes.NotFound {
+			// the session was deleted in the meantime
+			continue
+		} else if err != nil {
+			return revoked, fmt.Errorf("error revoking session %s: %w", sessionID, err)
+		}
+		revoked = append(revoked, sessionID)
+	}
+	return revoked, nil
+}
+
+// Get gets a session from the databroker.
+func Get(ctx context.Context, client databroker.DataBrokerServiceClient, sessionID string) (*Session, error) {
+	return databroker.NewTypedClient[Session](client).Get(ctx, sessionID)
+}
+
 // Put sets a session in the databroker.
 func Put(ctx context.Context, client databroker.DataBrokerServiceClient, s *Session) (*databroker.PutResponse, error) {
-	s = proto.Clone(s).(*Session)
-	data := protoutil.NewAny(s)
-	res, err := client.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{{
-			Type: data.GetTypeUrl(),
-			Id:   s.Id,
-			Data: data,
-		}},
-	})
-	return res, err
+	return databroker.NewTypedClient[Session](client).Put(ctx, s.GetId(), s)
 }
 
 // Patch updates specific fields of an existing session in the databroker.
@@ -101,6 +145,54 @@ func (x *Session) AddClaims(claims identity.FlattenedClaims) {
 	}
 }
 
+// AssuranceLevelClaim is the session claim recording the assurance level
+// achieved when the user signed in.
+const AssuranceLevelClaim = "pomerium_assurance_level"
+
+// GetAssuranceLevel returns the assurance level achieved when the user signed in.
+func (x *Session) GetAssuranceLevel() string {
+	vs := x.GetClaims()[AssuranceLevelClaim].GetValues()
+	if len(vs) == 0 {
+		return ""
+	}
+	return vs[0].GetStringValue()
+}
+
+// SetAssuranceLevel records the assurance level achieved when the user signed in.
+func (x *Session) SetAssuranceLevel(level string) {
+	if x.Claims == nil {
+		x.Claims = make(map[string]*structpb.ListValue)
+	}
+	if level == "" {
+		delete(x.Claims, AssuranceLevelClaim)
+		return
+	}
+	x.Claims[AssuranceLevelClaim] = &structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue(level)}}
+}
+
+// TokenVerifiedAtClaim is the session claim recording when the idp token a
+// session was created from was last verified.
+const TokenVerifiedAtClaim = "pomerium_token_verified_at"
+
+// GetTokenVerifiedAt returns when the idp token the session was created from
+// was last verified. The zero time is returned if it was never recorded.
+func (x *Session) GetTokenVerifiedAt() time.Time {
+	vs := x.GetClaims()[TokenVerifiedAtClaim].GetValues()
+	if len(vs) == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(vs[0].GetNumberValue()), 0)
+}
+
+// SetTokenVerifiedAt records when the idp token the session was created from
+// was last verified.
+func (x *Session) SetTokenVerifiedAt(tm time.Time) {
+	if x.Claims == nil {
+		x.Claims = make(map[string]*structpb.ListValue)
+	}
+	x.Claims[TokenVerifiedAtClaim] = &structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(float64(tm.Unix()))}}
+}
+
 // SetRawIDToken sets the raw id token.
 func (x *Session) SetRawIDToken(rawIDToken string) {
 	x.IdToken, _ = ParseIDToken(rawIDToken)
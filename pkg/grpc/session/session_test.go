@@ -47,6 +47,62 @@ func TestDelete(t *testing.T) {
 	assert.Same(t, rpcErr, err)
 }
 
+func TestRevoke(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	client := mock_databroker.NewMockDataBrokerServiceClient(ctrl)
+
+	ctx := t.Context()
+	session := &Session{
+		Id:     "session-id",
+		UserId: "user-id",
+	}
+
+	client.EXPECT().Get(ctx, gomock.Any(), []grpc.CallOption{}).DoAndReturn(
+		func(_ context.Context, r *databroker.GetRequest, _ ...grpc.CallOption) (*databroker.GetResponse, error) {
+			assert.Equal(t, "type.googleapis.com/session.Session", r.Type)
+			assert.Equal(t, "session-id", r.Id)
+			return &databroker.GetResponse{Record: &databroker.Record{
+				Version: 123,
+				Type:    "type.googleapis.com/session.Session",
+				Id:      "session-id",
+				Data:    protoutil.NewAny(session),
+			}}, nil
+		})
+	client.EXPECT().Put(ctx, gomock.Any(), []grpc.CallOption{}).DoAndReturn(
+		func(_ context.Context, r *databroker.PutRequest, _ ...grpc.CallOption) (*databroker.PutResponse, error) {
+			require.Len(t, r.Records, 1)
+			record := r.GetRecord()
+			assert.Equal(t, "session-id", record.Id)
+			assert.Equal(t, uint64(123), record.Version)
+			testutil.AssertProtoEqual(t, protoutil.NewAny(session), record.Data,
+				"should keep the session data")
+			now := time.Now()
+			assert.WithinRange(t, record.DeletedAt.AsTime(), now.Add(-time.Minute), now)
+			return &databroker.PutResponse{}, nil
+		})
+	client.EXPECT().SetOptions(ctx, gomock.Any(), []grpc.CallOption{}).DoAndReturn(
+		func(_ context.Context, r *databroker.SetOptionsRequest, _ ...grpc.CallOption) (*databroker.SetOptionsResponse, error) {
+			assert.Equal(t, RevocationRecordType, r.Type)
+			assert.Equal(t, uint64(maxRevocations), r.GetOptions().GetCapacity())
+			return &databroker.SetOptionsResponse{}, nil
+		})
+	client.EXPECT().Put(ctx, gomock.Any(), []grpc.CallOption{}).DoAndReturn(
+		func(_ context.Context, r *databroker.PutRequest, _ ...grpc.CallOption) (*databroker.PutResponse, error) {
+			require.Len(t, r.Records, 1)
+			record := r.GetRecord()
+			assert.Equal(t, RevocationRecordType, record.Type)
+			assert.Equal(t, "session-id", record.Id)
+			assert.Nil(t, record.DeletedAt, "should announce the revocation")
+			return &databroker.PutResponse{}, nil
+		})
+
+	s, err := Revoke(ctx, client, "session-id")
+	assert.NoError(t, err)
+	testutil.AssertProtoEqual(t, session, s)
+}
+
 func TestGet(t *testing.T) {
 	t.Parallel()
 
@@ -195,6 +251,31 @@ func TestSession_Validate(t *testing.T) {
 	}
 }
 
+func TestSession_AssuranceLevel(t *testing.T) {
+	t.Parallel()
+
+	var s Session
+	assert.Empty(t, s.GetAssuranceLevel())
+
+	s.SetAssuranceLevel("mfa")
+	assert.Equal(t, "mfa", s.GetAssuranceLevel())
+
+	s.SetAssuranceLevel("")
+	assert.Empty(t, s.GetAssuranceLevel())
+	assert.NotContains(t, s.GetClaims(), AssuranceLevelClaim)
+}
+
+func TestSession_TokenVerifiedAt(t *testing.T) {
+	t.Parallel()
+
+	var s Session
+	assert.True(t, s.GetTokenVerifiedAt().IsZero())
+
+	tm := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.SetTokenVerifiedAt(tm)
+	assert.True(t, tm.Equal(s.GetTokenVerifiedAt()))
+}
+
 func TestParseIDToken(t *testing.T) {
 	t.Parallel()
 
@@ -16,8 +16,7 @@ import (
 
 // Get gets a user from the databroker.
 func Get(ctx context.Context, client databroker.DataBrokerServiceClient, userID string) (*User, error) {
-	u := &User{Id: userID}
-	return u, databroker.Get(ctx, client, u)
+	return databroker.NewTypedClient[User](client).Get(ctx, userID)
 }
 
 // GetServiceAccount gets a service account from the databroker.
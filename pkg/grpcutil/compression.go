@@ -0,0 +1,132 @@
+package grpcutil
+
+import (
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+// Compressor names supported by pomerium's gRPC servers and clients.
+const (
+	CompressorGzip = gzip.Name
+	CompressorZstd = "zstd"
+)
+
+func init() {
+	encoding.RegisterCompressor(new(zstdCompressor))
+}
+
+// IsValidCompressor returns true if the compressor name is empty (no
+// compression) or refers to a registered compressor.
+func IsValidCompressor(name string) bool {
+	return name == "" || encoding.GetCompressor(name) != nil
+}
+
+// ServerMessageOptions returns server options that set the maximum size of
+// messages that can be sent and received. A non-positive size uses gRPC's
+// defaults. Servers always accept any registered compressor and respond using
+// the compressor of the request.
+func ServerMessageOptions(maxMessageSize int) []grpc.ServerOption {
+	if maxMessageSize <= 0 {
+		return nil
+	}
+	return []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(maxMessageSize),
+		grpc.MaxSendMsgSize(maxMessageSize),
+	}
+}
+
+// ClientMessageOptions returns dial options that set the maximum size of
+// messages that can be sent and received and the compressor used for
+// requests. A non-positive size uses gRPC's defaults and an empty compressor
+// disables compression.
+func ClientMessageOptions(maxMessageSize int, compressor string) []grpc.DialOption {
+	var callOptions []grpc.CallOption
+	if maxMessageSize > 0 {
+		callOptions = append(callOptions,
+			grpc.MaxCallRecvMsgSize(maxMessageSize),
+			grpc.MaxCallSendMsgSize(maxMessageSize),
+		)
+	}
+	if compressor != "" {
+		callOptions = append(callOptions, grpc.UseCompressor(compressor))
+	}
+	if len(callOptions) == 0 {
+		return nil
+	}
+	return []grpc.DialOption{grpc.WithDefaultCallOptions(callOptions...)}
+}
+
+// zstdCompressor implements the grpc encoding.Compressor interface using zstd.
+type zstdCompressor struct {
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+func (c *zstdCompressor) Name() string {
+	return CompressorZstd
+}
+
+func (c *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	enc, ok := c.encoders.Get().(*zstd.Encoder)
+	if ok {
+		enc.Reset(w)
+	} else {
+		var err error
+		enc, err = zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &zstdWriter{Encoder: enc, pool: &c.encoders}, nil
+}
+
+func (c *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	dec, ok := c.decoders.Get().(*zstd.Decoder)
+	if ok {
+		err := dec.Reset(r)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		dec, err = zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &zstdReader{Decoder: dec, pool: &c.decoders}, nil
+}
+
+type zstdWriter struct {
+	*zstd.Encoder
+	pool *sync.Pool
+}
+
+func (w *zstdWriter) Close() error {
+	err := w.Encoder.Close()
+	w.pool.Put(w.Encoder)
+	return err
+}
+
+type zstdReader struct {
+	*zstd.Decoder
+	pool *sync.Pool
+}
+
+func (r *zstdReader) Read(p []byte) (int, error) {
+	if r.Decoder == nil {
+		return 0, io.EOF
+	}
+	n, err := r.Decoder.Read(p)
+	if err == io.EOF {
+		// return the decoder to the pool once the message has been read
+		r.pool.Put(r.Decoder)
+		r.Decoder = nil
+	}
+	return n, err
+}
@@ -0,0 +1,50 @@
+package grpcutil_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+func TestCompressors(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, grpcutil.IsValidCompressor(""))
+	assert.True(t, grpcutil.IsValidCompressor(grpcutil.CompressorGzip))
+	assert.True(t, grpcutil.IsValidCompressor(grpcutil.CompressorZstd))
+	assert.False(t, grpcutil.IsValidCompressor("brotli"))
+
+	for _, name := range []string{grpcutil.CompressorGzip, grpcutil.CompressorZstd} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := encoding.GetCompressor(name)
+			require.NotNil(t, c)
+
+			data := strings.Repeat("pomerium ", 1000)
+			// run multiple times to exercise pooling
+			for range 3 {
+				var buf bytes.Buffer
+				w, err := c.Compress(&buf)
+				require.NoError(t, err)
+				_, err = io.WriteString(w, data)
+				require.NoError(t, err)
+				require.NoError(t, w.Close())
+				assert.Less(t, buf.Len(), len(data))
+
+				r, err := c.Decompress(&buf)
+				require.NoError(t, err)
+				bs, err := io.ReadAll(r)
+				require.NoError(t, err)
+				assert.Equal(t, data, string(bs))
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package grpcutil
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+type deadlineConfig struct {
+	unary   time.Duration
+	stream  time.Duration
+	methods map[string]time.Duration
+}
+
+// A DeadlineOption customizes the deadline config.
+type DeadlineOption func(cfg *deadlineConfig)
+
+// WithDefaultUnaryDeadline sets the default deadline for unary calls in the
+// deadline config. A non-positive duration means no deadline.
+func WithDefaultUnaryDeadline(deadline time.Duration) DeadlineOption {
+	return func(cfg *deadlineConfig) {
+		cfg.unary = deadline
+	}
+}
+
+// WithDefaultStreamDeadline sets the default deadline for streaming calls in
+// the deadline config. A non-positive duration means no deadline.
+func WithDefaultStreamDeadline(deadline time.Duration) DeadlineOption {
+	return func(cfg *deadlineConfig) {
+		cfg.stream = deadline
+	}
+}
+
+// WithMethodDeadline sets the deadline for the given full method names (e.g.
+// "/databroker.DataBrokerService/Get") in the deadline config, overriding the
+// defaults. A non-positive duration means no deadline.
+func WithMethodDeadline(deadline time.Duration, methods ...string) DeadlineOption {
+	return func(cfg *deadlineConfig) {
+		for _, method := range methods {
+			cfg.methods[method] = deadline
+		}
+	}
+}
+
+func getDeadlineConfig(options ...DeadlineOption) *deadlineConfig {
+	cfg := &deadlineConfig{methods: make(map[string]time.Duration)}
+	WithDefaultUnaryDeadline(0)(cfg)
+	WithDefaultStreamDeadline(0)(cfg)
+	for _, option := range options {
+		option(cfg)
+	}
+	return cfg
+}
+
+func (cfg *deadlineConfig) get(method string, fallback time.Duration) time.Duration {
+	if deadline, ok := cfg.methods[method]; ok {
+		return deadline
+	}
+	return fallback
+}
+
+// UnaryDeadlineInterceptor returns a UnaryClientInterceptor that applies a
+// default deadline to calls when the caller didn't set one.
+func UnaryDeadlineInterceptor(options ...DeadlineOption) grpc.UnaryClientInterceptor {
+	cfg := getDeadlineConfig(options...)
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			if deadline := cfg.get(method, cfg.unary); deadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, deadline)
+				defer cancel()
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamDeadlineInterceptor returns a StreamClientInterceptor that applies a
+// default deadline to streams when the caller didn't set one.
+func StreamDeadlineInterceptor(options ...DeadlineOption) grpc.StreamClientInterceptor {
+	cfg := getDeadlineConfig(options...)
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if _, ok := ctx.Deadline(); ok {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		deadline := cfg.get(method, cfg.stream)
+		if deadline <= 0 {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, deadline)
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		return &deadlineClientStream{ClientStream: cs, cancel: cancel}, nil
+	}
+}
+
+// deadlineClientStream releases the deadline's resources once the stream has
+// finished.
+type deadlineClientStream struct {
+	grpc.ClientStream
+	cancel context.CancelFunc
+}
+
+func (cs *deadlineClientStream) RecvMsg(m any) error {
+	err := cs.ClientStream.RecvMsg(m)
+	if err != nil {
+		cs.cancel()
+	}
+	return err
+}
@@ -0,0 +1,42 @@
+package grpcutil_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+func TestUnaryDeadlineInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptor := grpcutil.UnaryDeadlineInterceptor(
+		grpcutil.WithDefaultUnaryDeadline(time.Minute),
+		grpcutil.WithMethodDeadline(time.Second, "/example.Service/Get"),
+		grpcutil.WithMethodDeadline(0, "/example.Service/Slow"),
+	)
+
+	remaining := func(ctx context.Context, method string) time.Duration {
+		var d time.Duration
+		_ = interceptor(ctx, method, nil, nil, nil, func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			if deadline, ok := ctx.Deadline(); ok {
+				d = time.Until(deadline)
+			}
+			return nil
+		})
+		return d
+	}
+
+	assert.InDelta(t, time.Second, remaining(t.Context(), "/example.Service/Get"), float64(100*time.Millisecond))
+	assert.InDelta(t, time.Minute, remaining(t.Context(), "/example.Service/Put"), float64(100*time.Millisecond))
+	assert.Zero(t, remaining(t.Context(), "/example.Service/Slow"))
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Hour)
+	defer cancel()
+	assert.InDelta(t, time.Hour, remaining(ctx, "/example.Service/Get"), float64(100*time.Millisecond),
+		"should not override an existing deadline")
+}
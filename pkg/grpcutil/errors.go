@@ -11,6 +11,7 @@ import (
 // errors
 var (
 	ErrForwardingCycleDetected = NewError(codes.Internal, "FORWARDING_CYCLE_DETECTED", "forwarding cycle detected")
+	ErrMaxForwardsExceeded     = NewError(codes.FailedPrecondition, "MAX_FORWARDS_EXCEEDED", "maximum number of forwards exceeded")
 	ErrMissingJWT              = NewError(codes.Unauthenticated, "MISSING_JWT", "missing signed jwt")
 	ErrInvalidJWT              = NewError(codes.Unauthenticated, "INVALID_JWT", "invalid signed jwt")
 )
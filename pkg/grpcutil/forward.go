@@ -2,56 +2,187 @@ package grpcutil
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"io"
 	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
 )
 
-const forwarderMetadataKey = "pomerium-forwarder-id"
+const (
+	forwarderMetadataKey          = "pomerium-forwarder-id"
+	forwarderHopBudgetMetadataKey = "pomerium-forwarder-hop-budget"
+)
 
 // A Forwarder forwards gRPC requests from one server to another.
 type Forwarder interface {
 	Forward(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
+type forwarderConfig struct {
+	getSharedKey func() []byte
+	maxForwards  int
+}
+
+// A ForwarderOption customizes the forwarder config.
+type ForwarderOption func(cfg *forwarderConfig)
+
+// WithForwarderSharedKey sets the shared key used to sign and verify
+// forwarder ids. When set, forwarder ids in incoming metadata are only
+// honored if they were signed with the same shared key, which prevents
+// callers from spoofing or bypassing forwarding checks.
+func WithForwarderSharedKey(getSharedKey func() []byte) ForwarderOption {
+	return func(cfg *forwarderConfig) {
+		cfg.getSharedKey = getSharedKey
+	}
+}
+
+// WithForwarderMaxForwards sets the maximum number of times a request may be
+// forwarded. A limit of 0 means there is no limit beyond cycle detection.
+//
+// The remaining hop budget is propagated in the outgoing metadata so that
+// each forwarder in a chain enforces the smallest limit configured by any
+// forwarder before it.
+func WithForwarderMaxForwards(maxForwards int) ForwarderOption {
+	return func(cfg *forwarderConfig) {
+		cfg.maxForwards = maxForwards
+	}
+}
+
+func getForwarderConfig(options ...ForwarderOption) *forwarderConfig {
+	cfg := new(forwarderConfig)
+	WithForwarderSharedKey(func() []byte { return nil })(cfg)
+	for _, option := range options {
+		option(cfg)
+	}
+	return cfg
+}
+
 type forwarder struct {
-	id string
+	id  string
+	cfg *forwarderConfig
 }
 
 // NewForwarder creates a new Forwarder.
-func NewForwarder() Forwarder {
+func NewForwarder(options ...ForwarderOption) Forwarder {
 	return &forwarder{
-		id: uuid.New().String(),
+		id:  uuid.New().String(),
+		cfg: getForwarderConfig(options...),
 	}
 }
 
 // Forward forwards metadata from an incoming request to an outgoing request.
-// Each forwarder has a unique id to detect forwarding cycles.
+// Each forwarder has a unique id to detect forwarding cycles. Forwarder ids
+// in the incoming metadata that cannot be verified are stripped.
 func (f *forwarder) Forward(ctx context.Context, fn func(ctx context.Context) error) error {
-	if slices.Contains(metadata.ValueFromIncomingContext(ctx, forwarderMetadataKey), f.id) {
+	sharedKey := f.cfg.getSharedKey()
+	forwardedFor := ForwardedForFromIncoming(ctx, sharedKey)
+	if slices.Contains(forwardedFor, f.id) {
 		return ErrForwardingCycleDetected
 	}
 
-	if inMD, ok := metadata.FromIncomingContext(ctx); ok {
-		outMD, ok := metadata.FromOutgoingContext(ctx)
-		if !ok {
-			outMD = make(metadata.MD)
+	hopBudget, hasHopBudget := hopBudgetFromIncoming(ctx)
+	if f.cfg.maxForwards > 0 {
+		localBudget := f.cfg.maxForwards - len(forwardedFor)
+		if !hasHopBudget || localBudget < hopBudget {
+			hopBudget, hasHopBudget = localBudget, true
 		}
+	}
+	if hasHopBudget && hopBudget <= 0 {
+		return ErrMaxForwardsExceeded
+	}
+
+	outMD, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		outMD = make(metadata.MD)
+	}
+	if inMD, ok := metadata.FromIncomingContext(ctx); ok {
 		for k, vs := range inMD {
+			if k == forwarderMetadataKey || k == forwarderHopBudgetMetadataKey {
+				continue
+			}
 			outMD.Append(k, vs...)
 		}
-		ctx = metadata.NewOutgoingContext(ctx, outMD)
 	}
-
-	ctx = metadata.AppendToOutgoingContext(ctx, forwarderMetadataKey, f.id)
+	outMD.Delete(forwarderMetadataKey)
+	for _, id := range forwardedFor {
+		outMD.Append(forwarderMetadataKey, signForwarderID(id, sharedKey))
+	}
+	outMD.Append(forwarderMetadataKey, signForwarderID(f.id, sharedKey))
+	outMD.Delete(forwarderHopBudgetMetadataKey)
+	if hasHopBudget {
+		outMD.Set(forwarderHopBudgetMetadataKey, strconv.Itoa(hopBudget-1))
+	}
+	ctx = metadata.NewOutgoingContext(ctx, outMD)
+	// propagate the request id, which may have been generated by this server
+	ctx = WithOutgoingRequestID(ctx)
 
 	return fn(ctx)
 }
 
+// ForwardedForFromIncoming returns the ids of the forwarders an incoming
+// request was forwarded by. If sharedKey is non-empty, only ids signed with
+// the shared key are returned.
+func ForwardedForFromIncoming(ctx context.Context, sharedKey []byte) []string {
+	var ids []string
+	for _, value := range metadata.ValueFromIncomingContext(ctx, forwarderMetadataKey) {
+		if id, ok := verifyForwarderID(value, sharedKey); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// hopBudgetFromIncoming returns the number of forwards remaining for an
+// incoming request. The hop budget is not signed, but since it can only
+// lower the limit enforced by a forwarder it cannot be used to bypass it.
+func hopBudgetFromIncoming(ctx context.Context) (hopBudget int, ok bool) {
+	for _, value := range metadata.ValueFromIncomingContext(ctx, forwarderHopBudgetMetadataKey) {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			continue
+		}
+		if !ok || n < hopBudget {
+			hopBudget, ok = n, true
+		}
+	}
+	return hopBudget, ok
+}
+
+func signForwarderID(id string, sharedKey []byte) string {
+	if len(sharedKey) == 0 {
+		return id
+	}
+	return id + "." + base64.RawURLEncoding.EncodeToString(cryptutil.GenerateHMAC([]byte(id), sharedKey))
+}
+
+func verifyForwarderID(value string, sharedKey []byte) (id string, ok bool) {
+	if len(sharedKey) == 0 {
+		id, _, _ = strings.Cut(value, ".")
+		return id, true
+	}
+
+	id, rawMAC, ok := strings.Cut(value, ".")
+	if !ok {
+		return "", false
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(rawMAC)
+	if err != nil {
+		return "", false
+	}
+	if !cryptutil.CheckHMAC([]byte(id), mac, sharedKey) {
+		return "", false
+	}
+	return id, true
+}
+
 // ForwardStream takes a client stream and copies it to a server stream.
 func ForwardStream[Res any, Req any](
 	forwarder Forwarder,
@@ -15,6 +15,7 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 
 	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpcutil"
 )
 
@@ -172,3 +173,102 @@ func TestForwardUnary(t *testing.T) {
 		assert.ErrorIs(t, err, grpcutil.ErrForwardingCycleDetected)
 	})
 }
+
+func TestForwardMaxForwards(t *testing.T) {
+	t.Parallel()
+
+	cc1 := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		grpc_health_v1.RegisterHealthServer(s, mockHealthServer{
+			list: func(_ context.Context, _ *grpc_health_v1.HealthListRequest) (*grpc_health_v1.HealthListResponse, error) {
+				return &grpc_health_v1.HealthListResponse{}, nil
+			},
+		})
+	})
+
+	newForwardingServer := func(cc grpc.ClientConnInterface, maxForwards int) grpc.ClientConnInterface {
+		return testutil.NewGRPCServer(t, func(s *grpc.Server) {
+			f := grpcutil.NewForwarder(grpcutil.WithForwarderMaxForwards(maxForwards))
+			grpc_health_v1.RegisterHealthServer(s, mockHealthServer{
+				list: func(ctx context.Context, req *grpc_health_v1.HealthListRequest) (*grpc_health_v1.HealthListResponse, error) {
+					return grpcutil.ForwardUnary(ctx, f, grpc_health_v1.NewHealthClient(cc).List, req)
+				},
+			})
+		})
+	}
+
+	for _, tc := range []struct {
+		name string
+		cc   grpc.ClientConnInterface
+		err  error
+	}{
+		{"single hop", newForwardingServer(cc1, 1), nil},
+		{"chain within limit", newForwardingServer(newForwardingServer(cc1, 2), 2), nil},
+		{"chain over limit", newForwardingServer(newForwardingServer(cc1, 1), 1), grpcutil.ErrMaxForwardsExceeded},
+		{"unlimited", newForwardingServer(newForwardingServer(newForwardingServer(cc1, 0), 0), 0), nil},
+		{"budget from first hop", newForwardingServer(newForwardingServer(cc1, 5), 1), grpcutil.ErrMaxForwardsExceeded},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := grpc_health_v1.NewHealthClient(tc.cc).List(t.Context(), &grpc_health_v1.HealthListRequest{})
+			if tc.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+
+	t.Run("ignores raised budget", func(t *testing.T) {
+		t.Parallel()
+
+		cc := newForwardingServer(newForwardingServer(cc1, 1), 1)
+		_, err := grpc_health_v1.NewHealthClient(cc).List(
+			metadata.AppendToOutgoingContext(t.Context(), "pomerium-forwarder-hop-budget", "10"),
+			&grpc_health_v1.HealthListRequest{})
+		assert.ErrorIs(t, err, grpcutil.ErrMaxForwardsExceeded)
+	})
+}
+
+func TestForwardedForFromIncoming(t *testing.T) {
+	t.Parallel()
+
+	sharedKey := cryptutil.NewKey()
+	otherKey := cryptutil.NewKey()
+
+	var forwardedFor []string
+	cc1 := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		grpc_health_v1.RegisterHealthServer(s, mockHealthServer{
+			list: func(ctx context.Context, _ *grpc_health_v1.HealthListRequest) (*grpc_health_v1.HealthListResponse, error) {
+				forwardedFor = grpcutil.ForwardedForFromIncoming(ctx, sharedKey)
+				return &grpc_health_v1.HealthListResponse{}, nil
+			},
+		})
+	})
+
+	newForwardingServer := func(cc grpc.ClientConnInterface, key []byte) grpc.ClientConnInterface {
+		return testutil.NewGRPCServer(t, func(s *grpc.Server) {
+			f := grpcutil.NewForwarder(grpcutil.WithForwarderSharedKey(func() []byte { return key }))
+			grpc_health_v1.RegisterHealthServer(s, mockHealthServer{
+				list: func(ctx context.Context, req *grpc_health_v1.HealthListRequest) (*grpc_health_v1.HealthListResponse, error) {
+					return grpcutil.ForwardUnary(ctx, f, grpc_health_v1.NewHealthClient(cc).List, req)
+				},
+			})
+		})
+	}
+
+	cc2 := newForwardingServer(cc1, sharedKey)
+	cc3 := newForwardingServer(cc2, sharedKey)
+
+	_, err := grpc_health_v1.NewHealthClient(cc3).List(
+		metadata.AppendToOutgoingContext(t.Context(), "pomerium-forwarder-id", "SPOOFED"),
+		&grpc_health_v1.HealthListRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, forwardedFor, 2, "should strip untrusted forwarder ids")
+	assert.NotContains(t, forwardedFor, "SPOOFED")
+
+	cc4 := newForwardingServer(newForwardingServer(cc1, otherKey), sharedKey)
+	_, err = grpc_health_v1.NewHealthClient(cc4).List(t.Context(), &grpc_health_v1.HealthListRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, forwardedFor, "should strip forwarder ids signed with a different key")
+}
@@ -3,7 +3,9 @@ package grpcutil
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/go-jose/go-jose/v3"
@@ -13,10 +15,11 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/protoutil/streams"
 )
 
 // WithStreamSignedJWT returns a StreamClientInterceptor that adds a JWT to requests.
-func WithStreamSignedJWT(getKey func() []byte) grpc.StreamClientInterceptor {
+func WithStreamSignedJWT(getKey func() []byte, options ...JWTOption) grpc.StreamClientInterceptor {
 	return func(
 		ctx context.Context,
 		desc *grpc.StreamDesc,
@@ -24,7 +27,7 @@ func WithStreamSignedJWT(getKey func() []byte) grpc.StreamClientInterceptor {
 		method string, streamer grpc.Streamer,
 		opts ...grpc.CallOption,
 	) (grpc.ClientStream, error) {
-		ctx, err := WithSignedJWT(ctx, getKey())
+		ctx, err := WithSignedJWT(ctx, getKey(), options...)
 		if err != nil {
 			return nil, err
 		}
@@ -34,9 +37,9 @@ func WithStreamSignedJWT(getKey func() []byte) grpc.StreamClientInterceptor {
 }
 
 // WithUnarySignedJWT returns a UnaryClientInterceptor that adds a JWT to requests.
-func WithUnarySignedJWT(getKey func() []byte) grpc.UnaryClientInterceptor {
+func WithUnarySignedJWT(getKey func() []byte, options ...JWTOption) grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
-		ctx, err := WithSignedJWT(ctx, getKey())
+		ctx, err := WithSignedJWT(ctx, getKey(), options...)
 		if err != nil {
 			return err
 		}
@@ -46,7 +49,7 @@ func WithUnarySignedJWT(getKey func() []byte) grpc.UnaryClientInterceptor {
 }
 
 // WithSignedJWT adds a signed JWT to the context.
-func WithSignedJWT(ctx context.Context, key []byte) (context.Context, error) {
+func WithSignedJWT(ctx context.Context, key []byte, options ...JWTOption) (context.Context, error) {
 	if len(key) > 0 {
 		sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: key},
 			(&jose.SignerOptions{}).WithType("JWT"))
@@ -54,8 +57,11 @@ func WithSignedJWT(ctx context.Context, key []byte) (context.Context, error) {
 			return ctx, err
 		}
 
-		rawjwt, err := jwt.Signed(sig).Claims(jwt.Claims{
-			Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		claims := getJWTClaims(options...)
+		rawjwt, err := jwt.Signed(sig).Claims(jwtClaims{
+			Expiry:  jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			Subject: claims.Service,
+			Scopes:  claims.Scopes,
 		}).CompactSerialize()
 		if err != nil {
 			return ctx, err
@@ -67,59 +73,85 @@ func WithSignedJWT(ctx context.Context, key []byte) (context.Context, error) {
 }
 
 // UnaryRequireSignedJWT requires a JWT in the gRPC metadata and that it be signed by the base64-encoded key.
+// The JWT's claims are added to the context.
 func UnaryRequireSignedJWT(key string) grpc.UnaryServerInterceptor {
 	keyBS, _ := base64.StdEncoding.DecodeString(key)
 	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
-		if err := RequireSignedJWT(ctx, keyBS); err != nil {
+		claims, err := VerifySignedJWT(ctx, keyBS)
+		if err != nil {
 			return nil, err
 		}
-		return handler(ctx, req)
+		return handler(NewContextWithJWTClaims(ctx, claims), req)
 	}
 }
 
 // StreamRequireSignedJWT requires a JWT in the gRPC metadata and that it be signed by the base64-encoded key.
+// The JWT's claims are added to the stream's context.
 func StreamRequireSignedJWT(key string) grpc.StreamServerInterceptor {
 	keyBS, _ := base64.StdEncoding.DecodeString(key)
 	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		if err := RequireSignedJWT(ss.Context(), keyBS); err != nil {
+		claims, err := VerifySignedJWT(ss.Context(), keyBS)
+		if err != nil {
 			return err
 		}
-		return handler(srv, ss)
+		s := streams.NewServerStreamWithContext(ss)
+		s.SetContext(NewContextWithJWTClaims(s.Ctx, claims))
+		return handler(srv, s)
 	}
 }
 
 // RequireSignedJWT requires a JWT in the gRPC metadata and that it be signed by the given key.
 func RequireSignedJWT(ctx context.Context, key []byte) error {
-	if len(key) > 0 {
-		rawjwt, ok := JWTFromGRPCRequest(ctx)
-		if !ok {
-			return status.Error(codes.Unauthenticated, "unauthenticated")
-		}
+	_, err := VerifySignedJWT(ctx, key)
+	return err
+}
 
-		if err := validateJWT(rawjwt, key); err != nil {
-			log.Ctx(ctx).Debug().Err(err).Msg("rejected gRPC request due to invalid JWT")
-			return status.Error(codes.Unauthenticated, "invalid JWT")
-		}
+// VerifySignedJWT requires a JWT in the gRPC metadata and that it be signed by the given key. The
+// JWT's claims are returned. If the key is empty, no JWT is required and empty claims are returned.
+func VerifySignedJWT(ctx context.Context, key []byte) (*JWTClaims, error) {
+	if len(key) == 0 {
+		return new(JWTClaims), nil
+	}
+
+	rawjwt, ok := JWTFromGRPCRequest(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+
+	claims, err := validateJWT(rawjwt, key)
+	if err != nil {
+		log.Ctx(ctx).Debug().Err(err).Msg("rejected gRPC request due to invalid JWT")
+		return nil, status.Error(codes.Unauthenticated, "invalid JWT")
 	}
-	return nil
+	return claims, nil
 }
 
-func validateJWT(rawjwt string, key []byte) error {
+func validateJWT(rawjwt string, key []byte) (*JWTClaims, error) {
 	tok, err := jwt.ParseSigned(rawjwt)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var claims map[string]*jwt.NumericDate
-	err = tok.Claims(key, &claims)
+	var raw map[string]json.RawMessage
+	var claims jwtClaims
+	err = tok.Claims(key, &raw, &claims)
 	if err != nil {
-		return err
-	} else if len(claims) != 1 || claims["exp"] == nil {
-		return fmt.Errorf("expected exactly one claim (exp)")
+		return nil, err
+	}
+	for name := range raw {
+		if !slices.Contains(allowedJWTClaims, name) {
+			return nil, fmt.Errorf("unexpected claim (%s)", name)
+		}
+	}
+	if _, ok := raw["exp"]; !ok {
+		return nil, fmt.Errorf("missing claim (exp)")
 	}
 
-	if t := claims["exp"].Time(); time.Now().After(t) {
-		return fmt.Errorf("JWT expired at %s", t.Format(time.DateTime))
+	if t := claims.Expiry.Time(); time.Now().After(t) {
+		return nil, fmt.Errorf("JWT expired at %s", t.Format(time.DateTime))
 	}
-	return nil
+	return &JWTClaims{
+		Service: claims.Subject,
+		Scopes:  claims.Scopes,
+	}, nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	"google.golang.org/grpc/status"
@@ -118,15 +119,15 @@ func TestValidateJWT(t *testing.T) {
 	key := cryptutil.NewKey()
 
 	t.Run("unexpected_format", func(t *testing.T) {
-		err := validateJWT("not a jwt", key)
+		_, err := validateJWT("not a jwt", key)
 		assert.Error(t, err)
 	})
 	t.Run("unexpected_claim_type", func(t *testing.T) {
-		rawjwt := sign(t, key, jwt.Claims{
-			Subject: "subject",
-			Expiry:  jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		rawjwt := sign(t, key, map[string]any{
+			"sub": 1,
+			"exp": time.Now().Add(time.Hour).Unix(),
 		})
-		err := validateJWT(rawjwt, key)
+		_, err := validateJWT(rawjwt, key)
 		assert.Error(t, err)
 	})
 	t.Run("unexpected_claim_name", func(t *testing.T) {
@@ -134,26 +135,26 @@ func TestValidateJWT(t *testing.T) {
 			IssuedAt: jwt.NewNumericDate(time.Now()),
 			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
 		})
-		err := validateJWT(rawjwt, key)
-		assert.ErrorContains(t, err, "expected exactly one claim (exp)")
+		_, err := validateJWT(rawjwt, key)
+		assert.ErrorContains(t, err, "unexpected claim (iat)")
 	})
 	t.Run("no_claims", func(t *testing.T) {
 		rawjwt := sign(t, key, jwt.Claims{})
-		err := validateJWT(rawjwt, key)
-		assert.ErrorContains(t, err, "expected exactly one claim (exp)")
+		_, err := validateJWT(rawjwt, key)
+		assert.ErrorContains(t, err, "missing claim (exp)")
 	})
 	t.Run("unexpected_expiry_type", func(t *testing.T) {
 		rawjwt := sign(t, key, map[string]any{
 			"exp": "foo",
 		})
-		err := validateJWT(rawjwt, key)
+		_, err := validateJWT(rawjwt, key)
 		assert.ErrorContains(t, err, "expected number value")
 	})
 	t.Run("expired", func(t *testing.T) {
 		rawjwt := sign(t, key, jwt.Claims{
 			Expiry: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
 		})
-		err := validateJWT(rawjwt, key)
+		_, err := validateJWT(rawjwt, key)
 		assert.ErrorContains(t, err, "JWT expired")
 	})
 	t.Run("wrong_key", func(t *testing.T) {
@@ -162,14 +163,50 @@ func TestValidateJWT(t *testing.T) {
 			Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour)),
 		})
 
-		err := validateJWT(rawjwt, key)
+		_, err := validateJWT(rawjwt, key)
 		assert.Error(t, err)
 	})
 	t.Run("ok", func(t *testing.T) {
 		rawjwt := sign(t, key, jwt.Claims{
 			Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour)),
 		})
-		err := validateJWT(rawjwt, key)
+		_, err := validateJWT(rawjwt, key)
 		assert.NoError(t, err)
 	})
+	t.Run("scoped", func(t *testing.T) {
+		rawjwt := sign(t, key, map[string]any{
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"sub":    "authorize",
+			"scopes": []string{ScopeReadOnly, RecordTypeScope("type.googleapis.com/session.Session")},
+		})
+		claims, err := validateJWT(rawjwt, key)
+		require.NoError(t, err)
+		assert.Equal(t, "authorize", claims.Service)
+		assert.True(t, claims.IsReadOnly())
+		assert.False(t, claims.HasScope(ScopeAdmin))
+		assert.True(t, claims.AllowsRecordType("type.googleapis.com/session.Session"))
+		assert.False(t, claims.AllowsRecordType("type.googleapis.com/user.User"))
+	})
+}
+
+func TestWithSignedJWTClaims(t *testing.T) {
+	t.Parallel()
+
+	key := cryptutil.NewKey()
+	ctx, err := WithSignedJWT(t.Context(), key, WithJWTService("proxy"), WithJWTScopes(ScopeAdmin))
+	require.NoError(t, err)
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	ctx = metadata.NewIncomingContext(t.Context(), md)
+
+	claims, err := VerifySignedJWT(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, &JWTClaims{Service: "proxy", Scopes: []string{ScopeAdmin}}, claims)
+
+	claims, err = VerifySignedJWT(ctx, cryptutil.NewKey())
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Nil(t, claims)
+
+	claims = new(JWTClaims)
+	assert.True(t, claims.AllowsRecordType("any"), "should allow all record types when unrestricted")
 }
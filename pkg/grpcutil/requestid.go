@@ -0,0 +1,73 @@
+package grpcutil
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/telemetry/requestid"
+	"github.com/pomerium/protoutil/streams"
+)
+
+// MetadataKeyRequestID is the gRPC metadata key used for the request id.
+const MetadataKeyRequestID = "x-request-id"
+
+// RequestIDInterceptors returns unary and server stream interceptors that populate
+// the request id from the incoming metadata, generating one if absent. The request
+// id is added to the context's logger and the current span, and is returned to the
+// caller in the response headers.
+func RequestIDInterceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	ui, si := requestid.UnaryServerInterceptor(), requestid.StreamServerInterceptor()
+
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		return ui(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			ctx = annotateRequestID(ctx)
+			_ = grpc.SetHeader(ctx, metadata.Pairs(MetadataKeyRequestID, requestid.FromContext(ctx)))
+			return handler(ctx, req)
+		})
+	}
+
+	stream := func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return si(srv, ss, info, func(srv any, ss grpc.ServerStream) error {
+			s := streams.NewServerStreamWithContext(ss)
+			s.SetContext(annotateRequestID(s.Ctx))
+			_ = s.SetHeader(metadata.Pairs(MetadataKeyRequestID, requestid.FromContext(s.Ctx)))
+			return handler(srv, s)
+		})
+	}
+
+	return unary, stream
+}
+
+// WithOutgoingRequestID sets the request id in the outgoing metadata to the
+// request id in the context, if there is one.
+func WithOutgoingRequestID(ctx context.Context) context.Context {
+	requestID := requestid.FromContext(ctx)
+	if requestID == "" {
+		return ctx
+	}
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if !ok {
+		md = make(metadata.MD)
+	}
+	md.Set(MetadataKeyRequestID, requestID)
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func annotateRequestID(ctx context.Context) context.Context {
+	requestID := requestid.FromContext(ctx)
+	if requestID == "" {
+		return ctx
+	}
+
+	oteltrace.SpanFromContext(ctx).SetAttributes(attribute.String("request-id", requestID))
+	return log.WithContext(ctx, func(c zerolog.Context) zerolog.Context {
+		return c.Str("request-id", requestID)
+	})
+}
@@ -0,0 +1,60 @@
+package grpcutil_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+	"github.com/pomerium/pomerium/pkg/telemetry/requestid"
+)
+
+func TestRequestIDInterceptors(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(list func(ctx context.Context, req *grpc_health_v1.HealthListRequest) (*grpc_health_v1.HealthListResponse, error)) *grpc.ClientConn {
+		ui, si := grpcutil.RequestIDInterceptors()
+		return testutil.NewGRPCServer(t, func(s *grpc.Server) {
+			grpc_health_v1.RegisterHealthServer(s, mockHealthServer{list: list})
+		}, grpc.ChainUnaryInterceptor(ui), grpc.ChainStreamInterceptor(si))
+	}
+
+	var received []string
+	cc1 := newServer(func(ctx context.Context, _ *grpc_health_v1.HealthListRequest) (*grpc_health_v1.HealthListResponse, error) {
+		received = append(received, requestid.FromContext(ctx))
+		return &grpc_health_v1.HealthListResponse{}, nil
+	})
+	f := grpcutil.NewForwarder()
+	cc2 := newServer(func(ctx context.Context, req *grpc_health_v1.HealthListRequest) (*grpc_health_v1.HealthListResponse, error) {
+		received = append(received, requestid.FromContext(ctx))
+		return grpcutil.ForwardUnary(ctx, f, grpc_health_v1.NewHealthClient(cc1).List, req)
+	})
+
+	t.Run("propagated", func(t *testing.T) {
+		received = nil
+		var header metadata.MD
+		_, err := grpc_health_v1.NewHealthClient(cc2).List(
+			metadata.AppendToOutgoingContext(t.Context(), grpcutil.MetadataKeyRequestID, "REQUEST_ID"),
+			&grpc_health_v1.HealthListRequest{}, grpc.Header(&header))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"REQUEST_ID", "REQUEST_ID"}, received)
+		assert.Equal(t, []string{"REQUEST_ID"}, header.Get(grpcutil.MetadataKeyRequestID))
+	})
+	t.Run("generated", func(t *testing.T) {
+		received = nil
+		var header metadata.MD
+		_, err := grpc_health_v1.NewHealthClient(cc2).List(t.Context(),
+			&grpc_health_v1.HealthListRequest{}, grpc.Header(&header))
+		assert.NoError(t, err)
+		if assert.Len(t, received, 2) {
+			assert.NotEmpty(t, received[0])
+			assert.Equal(t, received[0], received[1])
+			assert.Equal(t, []string{received[0]}, header.Get(grpcutil.MetadataKeyRequestID))
+		}
+	})
+}
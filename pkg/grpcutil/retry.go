@@ -0,0 +1,261 @@
+package grpcutil
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	defaultRetryMaxAttempts = 3
+	defaultRetryCodes       = []codes.Code{codes.Unavailable}
+)
+
+type retryConfig struct {
+	maxAttempts int
+	codes       []codes.Code
+	newBackOff  func() backoff.BackOff
+	idempotent  func(method string) bool
+	budget      *RetryBudget
+	hedgeDelay  time.Duration
+	hedged      func(method string) bool
+}
+
+// A RetryOption customizes the retry config.
+type RetryOption func(cfg *retryConfig)
+
+// WithRetryMaxAttempts sets the maximum number of attempts, including the
+// original request, in the retry config.
+func WithRetryMaxAttempts(maxAttempts int) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.maxAttempts = maxAttempts
+	}
+}
+
+// WithRetryCodes sets the status codes that are retried in the retry config.
+func WithRetryCodes(retryCodes ...codes.Code) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.codes = retryCodes
+	}
+}
+
+// WithRetryBackOff sets the function used to create a back off for every call
+// in the retry config.
+func WithRetryBackOff(newBackOff func() backoff.BackOff) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.newBackOff = newBackOff
+	}
+}
+
+// WithRetryIdempotentMethods sets the full method names (e.g.
+// "/databroker.DataBrokerService/Get") that are safe to retry in the retry
+// config. Methods that are not idempotent are never retried or hedged.
+func WithRetryIdempotentMethods(methods ...string) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.idempotent = func(method string) bool {
+			return slices.Contains(methods, method)
+		}
+	}
+}
+
+// WithRetryBudget sets the retry budget in the retry config.
+func WithRetryBudget(budget *RetryBudget) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.budget = budget
+	}
+}
+
+// WithHedging enables hedged requests for the given full method names in the
+// retry config. If a hedged request hasn't completed after delay, another
+// attempt is started in parallel, up to the maximum number of attempts. The
+// first successful response is used.
+func WithHedging(delay time.Duration, methods ...string) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.hedgeDelay = delay
+		cfg.hedged = func(method string) bool {
+			return slices.Contains(methods, method)
+		}
+	}
+}
+
+func getRetryConfig(options ...RetryOption) *retryConfig {
+	cfg := new(retryConfig)
+	WithRetryMaxAttempts(defaultRetryMaxAttempts)(cfg)
+	WithRetryCodes(defaultRetryCodes...)(cfg)
+	WithRetryBackOff(func() backoff.BackOff {
+		return backoff.NewExponentialBackOff(
+			backoff.WithInitialInterval(50*time.Millisecond),
+			backoff.WithMaxInterval(time.Second),
+		)
+	})(cfg)
+	WithRetryIdempotentMethods()(cfg)
+	WithRetryBudget(nil)(cfg)
+	for _, option := range options {
+		option(cfg)
+	}
+	return cfg
+}
+
+// A RetryBudget limits the number of retries across calls so that retries
+// cannot overload a struggling server. It uses the same token bucket algorithm
+// as gRPC's retry throttling: every failure removes a token, every success
+// adds tokenRatio tokens, and retries are only allowed while more than half of
+// the tokens remain.
+type RetryBudget struct {
+	mu         sync.Mutex
+	maxTokens  float64
+	tokenRatio float64
+	tokens     float64
+}
+
+// NewRetryBudget creates a new RetryBudget.
+func NewRetryBudget(maxTokens, tokenRatio float64) *RetryBudget {
+	return &RetryBudget{
+		maxTokens:  maxTokens,
+		tokenRatio: tokenRatio,
+		tokens:     maxTokens,
+	}
+}
+
+func (b *RetryBudget) allow() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.tokens > b.maxTokens/2
+}
+
+func (b *RetryBudget) onSuccess() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	b.tokens = min(b.maxTokens, b.tokens+b.tokenRatio)
+	b.mu.Unlock()
+}
+
+func (b *RetryBudget) onFailure() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	b.tokens = max(0, b.tokens-1)
+	b.mu.Unlock()
+}
+
+// UnaryRetryInterceptor returns a UnaryClientInterceptor that retries failed
+// calls to idempotent methods, and optionally hedges them.
+func UnaryRetryInterceptor(options ...RetryOption) grpc.UnaryClientInterceptor {
+	cfg := getRetryConfig(options...)
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if cfg.maxAttempts <= 1 || !cfg.idempotent(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		if cfg.hedged != nil && cfg.hedged(method) {
+			if msg, ok := reply.(proto.Message); ok {
+				return cfg.invokeHedged(ctx, method, req, msg, cc, invoker, opts...)
+			}
+		}
+
+		return cfg.invokeWithRetry(ctx, method, req, reply, cc, invoker, opts...)
+	}
+}
+
+func (cfg *retryConfig) invokeWithRetry(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	bo := backoff.WithContext(cfg.newBackOff(), ctx)
+	for attempt := 1; ; attempt++ {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil {
+			cfg.budget.onSuccess()
+			return nil
+		}
+
+		if !cfg.isRetryable(err) {
+			return err
+		}
+		cfg.budget.onFailure()
+		if attempt >= cfg.maxAttempts || !cfg.budget.allow() {
+			return err
+		}
+
+		next := bo.NextBackOff()
+		if next == backoff.Stop {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(next):
+		}
+	}
+}
+
+func (cfg *retryConfig) invokeHedged(ctx context.Context, method string, req any, reply proto.Message, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		reply proto.Message
+		err   error
+	}
+	results := make(chan result, cfg.maxAttempts)
+	start := func() {
+		attemptReply := reply.ProtoReflect().New().Interface()
+		go func() {
+			err := invoker(ctx, method, req, attemptReply, cc, opts...)
+			results <- result{reply: attemptReply, err: err}
+		}()
+	}
+
+	start()
+	started, pending := 1, 1
+	var lastErr error
+	for pending > 0 {
+		var hedge <-chan time.Time
+		if started < cfg.maxAttempts && cfg.budget.allow() {
+			hedge = time.After(cfg.hedgeDelay)
+		}
+
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case <-hedge:
+			start()
+			started++
+			pending++
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				cfg.budget.onSuccess()
+				proto.Reset(reply)
+				proto.Merge(reply, res.reply)
+				return nil
+			}
+			lastErr = res.err
+			if !cfg.isRetryable(res.err) {
+				return res.err
+			}
+			cfg.budget.onFailure()
+			// start another attempt immediately if the hedged attempts have all failed
+			if pending == 0 && started < cfg.maxAttempts && cfg.budget.allow() {
+				start()
+				started++
+				pending++
+			}
+		}
+	}
+	return lastErr
+}
+
+func (cfg *retryConfig) isRetryable(err error) bool {
+	return slices.Contains(cfg.codes, status.Code(err))
+}
@@ -0,0 +1,95 @@
+package grpcutil_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/pomerium/pomerium/pkg/grpcutil"
+)
+
+func TestUnaryRetryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const method = "/grpc.health.v1.Health/Check"
+
+	newInvoker := func(calls *atomic.Int64, failures int64, delay time.Duration) grpc.UnaryInvoker {
+		return func(ctx context.Context, _ string, _, reply any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			n := calls.Add(1)
+			if n <= failures {
+				select {
+				case <-ctx.Done():
+				case <-time.After(delay):
+				}
+				return status.Error(codes.Unavailable, "unavailable")
+			}
+			reply.(*grpc_health_v1.HealthCheckResponse).Status = grpc_health_v1.HealthCheckResponse_SERVING
+			return nil
+		}
+	}
+	noBackOff := grpcutil.WithRetryBackOff(func() backoff.BackOff { return &backoff.ZeroBackOff{} })
+
+	t.Run("retry", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int64
+		interceptor := grpcutil.UnaryRetryInterceptor(noBackOff, grpcutil.WithRetryIdempotentMethods(method))
+		reply := new(grpc_health_v1.HealthCheckResponse)
+		err := interceptor(t.Context(), method, &grpc_health_v1.HealthCheckRequest{}, reply, nil, newInvoker(&calls, 2, 0))
+		assert.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, reply.Status)
+		assert.Equal(t, int64(3), calls.Load())
+	})
+	t.Run("max attempts", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int64
+		interceptor := grpcutil.UnaryRetryInterceptor(noBackOff, grpcutil.WithRetryIdempotentMethods(method))
+		err := interceptor(t.Context(), method, &grpc_health_v1.HealthCheckRequest{}, new(grpc_health_v1.HealthCheckResponse), nil, newInvoker(&calls, 5, 0))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, int64(3), calls.Load())
+	})
+	t.Run("not idempotent", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int64
+		interceptor := grpcutil.UnaryRetryInterceptor(noBackOff)
+		err := interceptor(t.Context(), method, &grpc_health_v1.HealthCheckRequest{}, new(grpc_health_v1.HealthCheckResponse), nil, newInvoker(&calls, 1, 0))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, int64(1), calls.Load())
+	})
+	t.Run("budget", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int64
+		interceptor := grpcutil.UnaryRetryInterceptor(noBackOff,
+			grpcutil.WithRetryIdempotentMethods(method),
+			grpcutil.WithRetryBudget(grpcutil.NewRetryBudget(2, 0.1)))
+		err := interceptor(t.Context(), method, &grpc_health_v1.HealthCheckRequest{}, new(grpc_health_v1.HealthCheckResponse), nil, newInvoker(&calls, 5, 0))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, int64(1), calls.Load(), "should not retry once the budget is exhausted")
+	})
+	t.Run("hedging", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int64
+		interceptor := grpcutil.UnaryRetryInterceptor(noBackOff,
+			grpcutil.WithRetryIdempotentMethods(method),
+			grpcutil.WithHedging(10*time.Millisecond, method))
+		reply := new(grpc_health_v1.HealthCheckResponse)
+		start := time.Now()
+		err := interceptor(t.Context(), method, &grpc_health_v1.HealthCheckRequest{}, reply, nil, newInvoker(&calls, 1, time.Second))
+		assert.NoError(t, err)
+		assert.Less(t, time.Since(start), time.Second, "should return the hedged response")
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, reply.Status)
+		assert.Equal(t, int64(2), calls.Load())
+	})
+}
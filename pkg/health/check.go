@@ -25,6 +25,8 @@ const (
 	StorageBackend = Check("storage.backend")
 	// StorageBackendCleanup checks the storage backend cleanup tasks are healthy
 	StorageBackendCleanup = Check("storage.backend.cleanup")
+	// StorageBackendPing checks that records can be read from the storage backend
+	StorageBackendPing = Check("storage.backend.ping")
 	// StorageBackendNotification checks that the backend is processing notifications
 	StorageBackendNotification = Check("storage.backend.notifications")
 	// XDSCluster checks whether the XDS Cluster resources were applied
@@ -0,0 +1,118 @@
+package identity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// A ClaimsMapper transforms identity provider claims before they are stored
+// in session and user records. For example, a ClaimsMapper could map
+// directory groups to normalized role names.
+type ClaimsMapper interface {
+	MapClaims(ctx context.Context, idpID string, claims Claims) (Claims, error)
+}
+
+// ClaimsMapperFunc is a function that implements the ClaimsMapper interface.
+type ClaimsMapperFunc func(ctx context.Context, idpID string, claims Claims) (Claims, error)
+
+// MapClaims calls f(ctx, idpID, claims).
+func (f ClaimsMapperFunc) MapClaims(ctx context.Context, idpID string, claims Claims) (Claims, error) {
+	return f(ctx, idpID, claims)
+}
+
+var claimsMapperRegistry = map[string]ClaimsMapper{}
+
+// RegisterClaimsMapper registers a ClaimsMapper so it can be referenced by
+// name in the configuration.
+func RegisterClaimsMapper(name string, mapper ClaimsMapper) {
+	claimsMapperRegistry[name] = mapper
+}
+
+// GetClaimsMapper returns the ClaimsMapper registered with the given name.
+func GetClaimsMapper(name string) (ClaimsMapper, bool) {
+	mapper, ok := claimsMapperRegistry[name]
+	return mapper, ok
+}
+
+// ChainClaimsMappers returns a ClaimsMapper which applies each of the given
+// mappers in order.
+func ChainClaimsMappers(mappers ...ClaimsMapper) ClaimsMapper {
+	return ClaimsMapperFunc(func(ctx context.Context, idpID string, claims Claims) (Claims, error) {
+		var err error
+		for _, mapper := range mappers {
+			claims, err = mapper.MapClaims(ctx, idpID, claims)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return claims, nil
+	})
+}
+
+// HTTPClaimsMapperRequest is the request sent to an external claims mapper.
+type HTTPClaimsMapperRequest struct {
+	IdentityProviderID string `json:"idp_id"`
+	Claims             Claims `json:"claims"`
+}
+
+// HTTPClaimsMapperResponse is the response returned by an external claims
+// mapper.
+type HTTPClaimsMapperResponse struct {
+	Claims Claims `json:"claims"`
+}
+
+type httpClaimsMapper struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewHTTPClaimsMapper returns a ClaimsMapper which posts the claims to an
+// external HTTP endpoint as an HTTPClaimsMapperRequest and replaces them with
+// the claims in the HTTPClaimsMapperResponse.
+func NewHTTPClaimsMapper(endpoint string, client *http.Client) ClaimsMapper {
+	return &httpClaimsMapper{endpoint: endpoint, client: client}
+}
+
+func (m *httpClaimsMapper) MapClaims(ctx context.Context, idpID string, claims Claims) (Claims, error) {
+	body, err := json.Marshal(&HTTPClaimsMapperRequest{
+		IdentityProviderID: idpID,
+		Claims:             claims,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("identity: error marshaling claims mapper request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("identity: error creating claims mapper request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := m.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("identity: error executing claims mapper request: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err = io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("identity: error reading claims mapper response: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("identity: unexpected claims mapper response status: %s", res.Status)
+	}
+
+	var response HTTPClaimsMapperResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("identity: error unmarshaling claims mapper response: %w", err)
+	}
+	if response.Claims == nil {
+		response.Claims = make(Claims)
+	}
+	return response.Claims, nil
+}
@@ -0,0 +1,69 @@
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainClaimsMappers(t *testing.T) {
+	t.Parallel()
+
+	addRole := func(role string) ClaimsMapper {
+		return ClaimsMapperFunc(func(_ context.Context, _ string, claims Claims) (Claims, error) {
+			roles, _ := claims["roles"].([]any)
+			claims["roles"] = append(roles, role)
+			return claims, nil
+		})
+	}
+
+	claims, err := ChainClaimsMappers(addRole("a"), addRole("b")).
+		MapClaims(t.Context(), "IDP1", Claims{"sub": "U1"})
+	require.NoError(t, err)
+	assert.Equal(t, Claims{"sub": "U1", "roles": []any{"a", "b"}}, claims)
+}
+
+func TestHTTPClaimsMapper(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		var req HTTPClaimsMapperRequest
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+			return
+		}
+		assert.Equal(t, "IDP1", req.IdentityProviderID)
+
+		roles := []string{}
+		for _, g := range req.Claims["groups"].([]any) {
+			if g == "CN=Admins,OU=Groups" {
+				roles = append(roles, "admin")
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"claims": map[string]any{"sub": req.Claims["sub"], "roles": roles},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	mapper := NewHTTPClaimsMapper(srv.URL, srv.Client())
+	claims, err := mapper.MapClaims(t.Context(), "IDP1", Claims{
+		"sub":    "U1",
+		"groups": []any{"CN=Admins,OU=Groups", "CN=Users,OU=Groups"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, Claims{"sub": "U1", "roles": []any{"admin"}}, claims)
+
+	_, err = NewHTTPClaimsMapper(srv.URL+"/missing", srv.Client()).
+		MapClaims(t.Context(), "IDP1", Claims{"groups": []any{}})
+	assert.Error(t, err)
+}
@@ -6,4 +6,5 @@ import "errors"
 var (
 	ErrVerifyAccessTokenNotSupported   = errors.New("identity: access token verification not supported")
 	ErrVerifyIdentityTokenNotSupported = errors.New("identity: identity token verification not supported")
+	ErrVerifyLogoutTokenNotSupported   = errors.New("identity: logout token verification not supported")
 )
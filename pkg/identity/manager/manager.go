@@ -21,7 +21,6 @@ import (
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/grpc/session"
 	"github.com/pomerium/pomerium/pkg/grpc/user"
-	"github.com/pomerium/pomerium/pkg/grpcutil"
 	"github.com/pomerium/pomerium/pkg/identity/identity"
 	metrics_ids "github.com/pomerium/pomerium/pkg/metrics"
 )
@@ -336,10 +335,9 @@ func (mgr *Manager) deleteSession(ctx context.Context, sessionID string) {
 	}
 	mgr.mu.Unlock()
 
-	res, err := mgr.cfg.Load().dataBrokerClient.Get(ctx, &databroker.GetRequest{
-		Type: grpcutil.GetTypeURL(new(session.Session)),
-		Id:   sessionID,
-	})
+	// revoking keeps the session data in the deleted record and removes the
+	// session's index records
+	_, err := session.Revoke(ctx, mgr.cfg.Load().dataBrokerClient, sessionID)
 	if status.Code(err) == codes.NotFound {
 		return
 	} else if err != nil {
@@ -348,19 +346,6 @@ func (mgr *Manager) deleteSession(ctx context.Context, sessionID string) {
 			Msg("failed to delete session")
 		return
 	}
-
-	record := res.GetRecord()
-	record.DeletedAt = timestamppb.Now()
-
-	_, err = mgr.cfg.Load().dataBrokerClient.Put(ctx, &databroker.PutRequest{
-		Records: []*databroker.Record{record},
-	})
-	if err != nil {
-		log.Ctx(ctx).Error().Err(err).
-			Str("session-id", sessionID).
-			Msg("failed to delete session")
-		return
-	}
 }
 
 func (mgr *Manager) updateSession(ctx context.Context, s *session.Session) {
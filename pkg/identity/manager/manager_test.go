@@ -284,4 +284,8 @@ func expectSessionDelete(client *mock_databroker.MockDataBrokerServiceClient, s
 		Id:   s.Id,
 	})).Return(&databroker.GetResponse{Record: record}, nil)
 	client.EXPECT().Put(gomock.Any(), mock_databroker.DeleteRequestFor(record))
+	client.EXPECT().SetOptions(gomock.Any(), gomock.Any())
+	client.EXPECT().Put(gomock.Any(), gomock.Cond(func(r *databroker.PutRequest) bool {
+		return r.GetRecord().GetType() == session.RevocationRecordType && r.GetRecord().GetId() == s.Id
+	}))
 }
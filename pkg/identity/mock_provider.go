@@ -24,6 +24,8 @@ type MockProvider struct {
 	DeviceAuthError           error
 	DeviceAccessTokenResponse oauth2.Token
 	DeviceAccessTokenError    error
+	VerifyLogoutTokenResponse map[string]any
+	VerifyLogoutTokenError    error
 }
 
 // Authenticate is a mocked providers function.
@@ -80,3 +82,8 @@ func (mp MockProvider) VerifyAccessToken(_ context.Context, _ string) (claims ma
 func (mp MockProvider) VerifyIdentityToken(_ context.Context, _ string) (claims map[string]any, err error) {
 	return nil, fmt.Errorf("VerifyIdentityToken not implemented")
 }
+
+// VerifyLogoutToken verifies a back-channel logout token.
+func (mp MockProvider) VerifyLogoutToken(_ context.Context, _ string) (claims map[string]any, err error) {
+	return mp.VerifyLogoutTokenResponse, mp.VerifyLogoutTokenError
+}
@@ -226,3 +226,9 @@ func (p *Provider) VerifyIdentityToken(ctx context.Context, rawIdentityToken str
 
 	return claims, nil
 }
+
+// VerifyLogoutToken verifies a back-channel logout token.
+func (p *Provider) VerifyLogoutToken(_ context.Context, _ string) (claims map[string]any, err error) {
+	// apple does not support back-channel logout
+	return nil, identity.ErrVerifyLogoutTokenNotSupported
+}
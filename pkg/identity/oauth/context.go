@@ -0,0 +1,21 @@
+package oauth
+
+import (
+	"context"
+)
+
+type authCodeOptionsKey struct{}
+
+// WithAuthCodeOptions returns a context with additional query params to add to
+// the authorization request of a single sign in, for example to request
+// step-up authentication. They take precedence over the provider's options.
+func WithAuthCodeOptions(ctx context.Context, authCodeOptions map[string]string) context.Context {
+	return context.WithValue(ctx, authCodeOptionsKey{}, authCodeOptions)
+}
+
+// AuthCodeOptionsFromContext returns the additional authorization request
+// query params stored in the context.
+func AuthCodeOptionsFromContext(ctx context.Context) map[string]string {
+	authCodeOptions, _ := ctx.Value(authCodeOptionsKey{}).(map[string]string)
+	return authCodeOptions
+}
@@ -293,3 +293,8 @@ func (p *Provider) VerifyAccessToken(ctx context.Context, rawAccessToken string)
 func (p *Provider) VerifyIdentityToken(_ context.Context, _ string) (claims map[string]any, err error) {
 	return nil, identity.ErrVerifyIdentityTokenNotSupported
 }
+
+// VerifyLogoutToken verifies a back-channel logout token.
+func (p *Provider) VerifyLogoutToken(_ context.Context, _ string) (claims map[string]any, err error) {
+	return nil, identity.ErrVerifyLogoutTokenNotSupported
+}
@@ -21,6 +21,10 @@ var ErrDeviceAuthNotImplemented = errors.New("identity/oidc: device auth not imp
 // does not receive one.
 var ErrMissingProviderURL = errors.New("identity/oidc: missing provider url")
 
+// ErrInvalidLogoutToken is returned when a back-channel logout token is not
+// valid.
+var ErrInvalidLogoutToken = errors.New("identity/oidc: invalid logout token")
+
 // ErrMissingIDToken is returned when (usually on refresh) and identity provider
 // failed to include an id_token in a oauth2 token.
 var ErrMissingIDToken = errors.New("identity/oidc: missing id_token")
@@ -32,6 +32,9 @@ var defaultScopes = []string{go_oidc.ScopeOpenID, "profile", "email", "offline_a
 
 var defaultAuthCodeOptions = []oauth2.AuthCodeOption{}
 
+// backChannelLogoutEvent is the event a logout token must contain.
+const backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
 // Provider provides a standard, OpenID Connect implementation
 // of an authorization identity provider.
 // https://openid.net/specs/openid-connect-core-1_0.html
@@ -126,6 +129,9 @@ func (p *Provider) SignIn(w http.ResponseWriter, r *http.Request, state string)
 	for k, v := range p.AuthCodeOptions {
 		opts = append(opts, oauth2.SetAuthURLParam(k, v))
 	}
+	for k, v := range oauth.AuthCodeOptionsFromContext(r.Context()) {
+		opts = append(opts, oauth2.SetAuthURLParam(k, v))
+	}
 	signInURL := oa.AuthCodeURL(state, opts...)
 	httputil.Redirect(w, r, signInURL, http.StatusFound)
 	return nil
@@ -455,6 +461,29 @@ func (p *Provider) VerifyIdentityToken(ctx context.Context, rawIdentityToken str
 	return claims, nil
 }
 
+// VerifyLogoutToken verifies a back-channel logout token.
+//
+// https://openid.net/specs/openid-connect-backchannel-1_0.html#Validation
+func (p *Provider) VerifyLogoutToken(ctx context.Context, rawLogoutToken string) (claims map[string]any, err error) {
+	logoutToken, err := p.verifyIDToken(ctx, rawLogoutToken)
+	if err != nil {
+		return nil, err
+	}
+
+	claims = jwtutil.Claims(map[string]any{})
+	err = logoutToken.Claims(&claims)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling logout token claims: %w", err)
+	}
+
+	err = validateLogoutTokenClaims(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
 // GetRawIDToken returns the raw jwt payload for `id_token` from the oauth2 token
 // returned following OIDC code flow.
 //
@@ -463,3 +492,26 @@ func GetRawIDToken(t *oauth2.Token) string {
 	rawIDToken, _ := t.Extra("id_token").(string)
 	return rawIDToken
 }
+
+func validateLogoutTokenClaims(claims map[string]any) error {
+	events, _ := claims["events"].(map[string]any)
+	if _, ok := events[backChannelLogoutEvent]; !ok {
+		return fmt.Errorf("%w: missing back-channel logout event", ErrInvalidLogoutToken)
+	}
+
+	if jti, _ := claims["jti"].(string); jti == "" {
+		return fmt.Errorf("%w: missing jti", ErrInvalidLogoutToken)
+	}
+
+	sid, _ := claims["sid"].(string)
+	sub, _ := claims["sub"].(string)
+	if sid == "" && sub == "" {
+		return fmt.Errorf("%w: missing sid or sub", ErrInvalidLogoutToken)
+	}
+
+	if _, ok := claims["nonce"]; ok {
+		return fmt.Errorf("%w: unexpected nonce", ErrInvalidLogoutToken)
+	}
+
+	return nil
+}
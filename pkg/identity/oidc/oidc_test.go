@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/json"
+	"maps"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -670,3 +671,81 @@ func TestVerifyIdentityToken(t *testing.T) {
 		"sub": "subject",
 	}, claims)
 }
+
+func TestVerifyLogoutToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.GetContext(t, time.Minute)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwtSigner, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: privateKey}, nil)
+	require.NoError(t, err)
+	iat := time.Now().Unix()
+	exp := iat + 3600
+
+	var srv *httptest.Server
+	m := http.NewServeMux()
+	m.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		baseURL, err := url.Parse(srv.URL)
+		require.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer": baseURL.String(),
+			"jwks_uri": baseURL.ResolveReference(&url.URL{
+				Path: "/jwks",
+			}).String(),
+		})
+	})
+	m.HandleFunc("GET /jwks", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{
+				{Key: privateKey.Public(), Use: "sig", Algorithm: "RS256"},
+			},
+		})
+	})
+	srv = httptest.NewServer(m)
+
+	p, err := oidc.New(ctx, &oauth.Options{
+		ProviderURL:  srv.URL,
+		ClientID:     "CLIENT_ID",
+		ClientSecret: "CLIENT_SECRET",
+		RedirectURL:  urlutil.MustParseAndValidateURL("https://www.example.com"),
+	})
+	require.NoError(t, err)
+
+	sign := func(extra map[string]any) string {
+		claims := map[string]any{
+			"iss": srv.URL,
+			"aud": "CLIENT_ID",
+			"exp": exp,
+			"iat": iat,
+			"jti": uuid.NewString(),
+		}
+		maps.Copy(claims, extra)
+		rawLogoutToken, err := jwt.Signed(jwtSigner).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+		return rawLogoutToken
+	}
+	events := map[string]any{
+		"http://schemas.openid.net/event/backchannel-logout": map[string]any{},
+	}
+
+	claims, err := p.VerifyLogoutToken(ctx, sign(map[string]any{"events": events, "sid": "SID"}))
+	require.NoError(t, err)
+	assert.Equal(t, "SID", claims["sid"])
+
+	_, err = p.VerifyLogoutToken(ctx, sign(map[string]any{"sid": "SID"}))
+	assert.ErrorIs(t, err, oidc.ErrInvalidLogoutToken)
+
+	_, err = p.VerifyLogoutToken(ctx, sign(map[string]any{"events": events}))
+	assert.ErrorIs(t, err, oidc.ErrInvalidLogoutToken)
+
+	_, err = p.VerifyLogoutToken(ctx, sign(map[string]any{"events": events, "sid": "SID", "nonce": "NONCE"}))
+	assert.ErrorIs(t, err, oidc.ErrInvalidLogoutToken)
+
+	_, err = p.VerifyLogoutToken(ctx, sign(map[string]any{"events": events, "sid": "SID", "jti": ""}))
+	assert.ErrorIs(t, err, oidc.ErrInvalidLogoutToken)
+}
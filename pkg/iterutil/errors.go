@@ -35,6 +35,35 @@ func ApplyWithError[E any](seq ErrorSeq[E], transform func(seq iter.Seq[E]) iter
 	}
 }
 
+// ChunkWithError implements Chunk for an ErrorSeq. If the ErrorSeq yields an error,
+// iteration stops immediately, any partially accumulated chunk is discarded and
+// the error is yielded.
+func ChunkWithError[E any](seq ErrorSeq[E], n int) ErrorSeq[[]E] {
+	return func(yield func([]E, error) bool) {
+		if n <= 0 {
+			panic("chunk size must be > 0")
+		}
+
+		s := make([]E, 0, n)
+		for e, err := range seq {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			s = append(s, e)
+			if len(s) == n {
+				if !yield(s, nil) {
+					return
+				}
+				s = make([]E, 0, n)
+			}
+		}
+		if len(s) > 0 {
+			yield(s, nil)
+		}
+	}
+}
+
 // CollectWithError takes a sequence of values and errors and turns it
 // into a slice or error.
 func CollectWithError[E any](seq ErrorSeq[E]) ([]E, error) {
@@ -48,6 +77,14 @@ func CollectWithError[E any](seq ErrorSeq[E]) ([]E, error) {
 	return s, nil
 }
 
+// Error returns an ErrorSeq that only yields the given error.
+func Error[E any](err error) ErrorSeq[E] {
+	return func(yield func(E, error) bool) {
+		var zero E
+		yield(zero, err)
+	}
+}
+
 // FilterWithError implements Filter for an ErrorSeq.
 func FilterWithError[E any](seq ErrorSeq[E], include func(e E) bool) ErrorSeq[E] {
 	return ApplyWithError(seq, func(seq iter.Seq[E]) iter.Seq[E] {
@@ -55,6 +92,20 @@ func FilterWithError[E any](seq ErrorSeq[E], include func(e E) bool) ErrorSeq[E]
 	})
 }
 
+// PaginateWithError implements Paginate for an ErrorSeq.
+func PaginateWithError[E any](seq ErrorSeq[E], offset, limit int) ErrorSeq[E] {
+	return ApplyWithError(seq, func(seq iter.Seq[E]) iter.Seq[E] {
+		return Paginate(seq, offset, limit)
+	})
+}
+
+// SkipWithError implements Skip for an ErrorSeq.
+func SkipWithError[E any](seq ErrorSeq[E], n int) ErrorSeq[E] {
+	return ApplyWithError(seq, func(seq iter.Seq[E]) iter.Seq[E] {
+		return Skip(seq, n)
+	})
+}
+
 // SkipLastWithError implements SkipLast for an ErrorSeq.
 func SkipLastWithError[E any](seq ErrorSeq[E], n int) ErrorSeq[E] {
 	return ApplyWithError(seq, func(seq iter.Seq[E]) iter.Seq[E] {
@@ -108,8 +159,8 @@ func SortedIntersectionWithError[E any](compare func(a, b E) int, seqs ...ErrorS
 	}
 }
 
-// SortedUnionWithError implements SortedUnion for an ErrorSeq.
-func SortedUnionWithError[E any](compare func(a, b E) int, seqs ...ErrorSeq[E]) ErrorSeq[E] {
+// SortedMergeWithError implements SortedMerge for an ErrorSeq.
+func SortedMergeWithError[E any](compare func(a, b E) int, merge func(a, b E) E, seqs ...ErrorSeq[E]) ErrorSeq[E] {
 	switch len(seqs) {
 	case 0:
 		return func(_ func(E, error) bool) {}
@@ -133,6 +184,7 @@ func SortedUnionWithError[E any](compare func(a, b E) int, seqs ...ErrorSeq[E])
 					yield(value2, err2)
 					return
 				}
+
 				switch {
 				case !ok1:
 					if !yield(value2, nil) {
@@ -152,7 +204,7 @@ func SortedUnionWithError[E any](compare func(a, b E) int, seqs ...ErrorSeq[E])
 						}
 						value1, err1, ok1 = next1()
 					case 0:
-						if !yield(value1, nil) {
+						if !yield(merge(value1, value2), nil) {
 							return
 						}
 						value1, err1, ok1 = next1()
@@ -167,8 +219,45 @@ func SortedUnionWithError[E any](compare func(a, b E) int, seqs ...ErrorSeq[E])
 			}
 		}
 	default:
-		return SortedUnionWithError(compare,
-			SortedUnionWithError(compare, seqs[:len(seqs)/2]...),
-			SortedUnionWithError(compare, seqs[len(seqs)/2:]...))
+		return SortedMergeWithError(compare, merge,
+			SortedMergeWithError(compare, merge, seqs[:len(seqs)/2]...),
+			SortedMergeWithError(compare, merge, seqs[len(seqs)/2:]...))
+	}
+}
+
+// SortedUnionWithError implements SortedUnion for an ErrorSeq.
+func SortedUnionWithError[E any](compare func(a, b E) int, seqs ...ErrorSeq[E]) ErrorSeq[E] {
+	return SortedMergeWithError(compare, func(a, _ E) E { return a }, seqs...)
+}
+
+// TryMap creates a new ErrorSeq that applies a fallible transformation function to
+// every element of an ErrorSeq. If either the ErrorSeq or the transformation function
+// returns an error, iteration stops immediately and the error is yielded.
+func TryMap[E, F any](seq ErrorSeq[E], f func(E) (F, error)) ErrorSeq[F] {
+	return func(yield func(F, error) bool) {
+		var zero F
+		for e, err := range seq {
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			v, err := f(e)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			if !yield(v, nil) {
+				return
+			}
+		}
 	}
 }
+
+// TakeWithError implements Take for an ErrorSeq.
+func TakeWithError[E any](seq ErrorSeq[E], n int) ErrorSeq[E] {
+	return ApplyWithError(seq, func(seq iter.Seq[E]) iter.Seq[E] {
+		return Take(seq, n)
+	})
+}
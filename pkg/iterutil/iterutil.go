@@ -12,6 +12,7 @@ type (
 	Seq2[K, V any] = iter.Seq2[K, V]
 )
 
+// Convert lazily maps every element of an iterator using f.
 func Convert[E any, F any](seq iter.Seq[E], f func(E) F) iter.Seq[F] {
 	return func(yield func(F) bool) {
 		for e := range seq {
@@ -57,6 +58,57 @@ func Chunk[E any](seq Seq[E], n int) Seq[[]E] {
 	}
 }
 
+// DistinctFunc removes duplicate elements from an iterator, where two elements
+// are considered duplicates if key returns the same value for both. Only the
+// first element for a given key is yielded. If window is greater than 0 only
+// the keys of the last window yielded elements are remembered, bounding memory usage
+// for iterators that are mostly, but not fully, sorted.
+func DistinctFunc[E any, K comparable](seq Seq[E], key func(E) K, window int) Seq[E] {
+	return func(yield func(E) bool) {
+		seen := make(map[K]struct{})
+		var ring []K
+		idx := 0
+		for e := range seq {
+			k := key(e)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+
+			if window > 0 {
+				if len(ring) < window {
+					ring = append(ring, k)
+				} else {
+					delete(seen, ring[idx])
+					ring[idx] = k
+					idx = (idx + 1) % window
+				}
+			}
+			seen[k] = struct{}{}
+
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhile skips elements of an iterator while drop returns true and yields
+// all of the remaining elements.
+func DropWhile[E any](seq Seq[E], drop func(e E) bool) Seq[E] {
+	return func(yield func(E) bool) {
+		dropping := true
+		for e := range seq {
+			if dropping && drop(e) {
+				continue
+			}
+			dropping = false
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
 // Filter filters an iterator to only those values for which include returns true.
 func Filter[E any](seq Seq[E], include func(e E) bool) Seq[E] {
 	return func(yield func(E) bool) {
@@ -71,6 +123,34 @@ func Filter[E any](seq Seq[E], include func(e E) bool) Seq[E] {
 	}
 }
 
+// Filter2 filters an iterator of pairs to only those pairs for which include returns true.
+func Filter2[K, V any](seq Seq2[K, V], include func(k K, v V) bool) Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if !include(k, v) {
+				continue
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// FlatMap maps every element of an iterator to an iterator and yields the
+// elements of each of those iterators in order.
+func FlatMap[E any, F any](seq Seq[E], f func(E) Seq[F]) Seq[F] {
+	return func(yield func(F) bool) {
+		for e := range seq {
+			for ee := range f(e) {
+				if !yield(ee) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // Keys returns the keys of an iterator over keys and values.
 func Keys[K, V any](seq Seq2[K, V]) Seq[K] {
 	return func(yield func(K) bool) {
@@ -82,6 +162,17 @@ func Keys[K, V any](seq Seq2[K, V]) Seq[K] {
 	}
 }
 
+// Map2 lazily maps every pair of an iterator of pairs using f.
+func Map2[K1, V1, K2, V2 any](seq Seq2[K1, V1], f func(K1, V1) (K2, V2)) Seq2[K2, V2] {
+	return func(yield func(K2, V2) bool) {
+		for k, v := range seq {
+			if !yield(f(k, v)) {
+				return
+			}
+		}
+	}
+}
+
 // Repeat endlessly repeats an element as an iterator.
 func Repeat[E any](e E) Seq[E] {
 	return func(yield func(E) bool) {
@@ -90,6 +181,35 @@ func Repeat[E any](e E) Seq[E] {
 	}
 }
 
+// Paginate returns at most limit elements of an iterator after skipping the
+// first offset elements. A limit <= 0 means no limit.
+func Paginate[E any](seq Seq[E], offset, limit int) Seq[E] {
+	seq = Skip(seq, offset)
+	if limit > 0 {
+		seq = Take(seq, limit)
+	}
+	return seq
+}
+
+// Skip skips the first n elements of an iterator.
+func Skip[E any](seq Seq[E], n int) Seq[E] {
+	if n <= 0 {
+		return seq
+	}
+	return func(yield func(E) bool) {
+		i := 0
+		for e := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
 // SkipLast skips the last n elements of an iterator.
 func SkipLast[E any](seq Seq[E], n int) Seq[E] {
 	if n <= 0 {
@@ -119,6 +239,18 @@ func SortedIntersection[E any](compare func(a, b E) int, seqs ...Seq[E]) Seq[E]
 	return Keys(SortedIntersectionWithError(compare, seqsWithError...))
 }
 
+// SortedMerge computes the merge of zero or more sorted iterators. Unlike SortedUnion,
+// when equal elements are found in multiple sequences, merge is called to produce
+// the element that is returned (for example to keep the record with the highest
+// version). Values are assumed to be sorted.
+func SortedMerge[E any](compare func(a, b E) int, merge func(a, b E) E, seqs ...Seq[E]) Seq[E] {
+	seqsWithError := make([]Seq2[E, error], len(seqs))
+	for i, seq := range seqs {
+		seqsWithError[i] = Zip(seq, Repeat(error(nil)))
+	}
+	return Keys(SortedMergeWithError(compare, merge, seqsWithError...))
+}
+
 // SortedUnion computes the set-union of zero or more sorted iterators.
 // For an element to be returned, it must be found in at least one of the sequences.
 // Values are assumed to be sorted and only duplicates are removed.
@@ -146,6 +278,17 @@ func Take[E any](seq Seq[E], n int) Seq[E] {
 	}
 }
 
+// TakeWhile yields elements of an iterator until take returns false.
+func TakeWhile[E any](seq Seq[E], take func(e E) bool) Seq[E] {
+	return func(yield func(E) bool) {
+		for e := range seq {
+			if !take(e) || !yield(e) {
+				return
+			}
+		}
+	}
+}
+
 // Zip combines two iterators. The combined iterator will stop when either iterator stops.
 func Zip[K, V any](seqK Seq[K], seqV Seq[V]) Seq2[K, V] {
 	return func(yield func(K, V) bool) {
@@ -2,8 +2,11 @@ package iterutil_test
 
 import (
 	"cmp"
+	"errors"
 	"iter"
+	"maps"
 	"slices"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -22,6 +25,52 @@ func TestChunk(t *testing.T) {
 		slices.Collect(iterutil.Chunk(iterutil.Count(5), 3)))
 }
 
+func TestChunkWithError(t *testing.T) {
+	t.Parallel()
+
+	chunks, err := iterutil.CollectWithError(iterutil.ChunkWithError(
+		iterutil.Zip(iterutil.Count(5), iterutil.Repeat(error(nil))), 2))
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{0, 1}, {2, 3}, {4}}, chunks)
+
+	errTest := errors.New("test")
+	seq := func(yield func(int, error) bool) {
+		_ = yield(1, nil) && yield(2, nil) && yield(3, nil) && yield(0, errTest)
+	}
+	var actual [][]int
+	for chunk, err := range iterutil.ChunkWithError(seq, 2) {
+		if err != nil {
+			assert.ErrorIs(t, err, errTest)
+			break
+		}
+		actual = append(actual, chunk)
+	}
+	assert.Equal(t, [][]int{{1, 2}}, actual)
+}
+
+func TestDistinctFunc(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		id      string
+		version int
+	}
+	key := func(r record) string { return r.id }
+	input := []record{{"a", 1}, {"b", 1}, {"a", 2}, {"c", 1}, {"d", 1}, {"a", 3}, {"b", 2}}
+
+	assert.Equal(t, []record{{"a", 1}, {"b", 1}, {"c", 1}, {"d", 1}},
+		slices.Collect(iterutil.DistinctFunc(slices.Values(input), key, 0)))
+	assert.Equal(t, []record{{"a", 1}, {"b", 1}, {"c", 1}, {"d", 1}, {"a", 3}, {"b", 2}},
+		slices.Collect(iterutil.DistinctFunc(slices.Values(input), key, 3)))
+}
+
+func TestDropWhile(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{3, 4, 1},
+		slices.Collect(iterutil.DropWhile(slices.Values([]int{1, 2, 3, 4, 1}), func(i int) bool { return i < 3 })))
+}
+
 func TestFilter(t *testing.T) {
 	t.Parallel()
 
@@ -46,6 +95,62 @@ func TestFilter(t *testing.T) {
 	}
 }
 
+func TestFilter2(t *testing.T) {
+	t.Parallel()
+
+	actual := maps.Collect(iterutil.Filter2(maps.All(map[string]int{"a": 1, "b": 2, "c": 3}), func(_ string, v int) bool {
+		return v%2 == 1
+	}))
+	assert.Equal(t, map[string]int{"a": 1, "c": 3}, actual)
+}
+
+func TestFlatMap(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{0, 0, 1, 0, 1, 2},
+		slices.Collect(iterutil.FlatMap(slices.Values([]int{1, 2, 3}), iterutil.Count[int])))
+	assert.Equal(t, []int{0, 0, 1},
+		slices.Collect(iterutil.Take(iterutil.FlatMap(slices.Values([]int{1, 2, 3}), iterutil.Count[int]), 3)))
+}
+
+func TestMap2(t *testing.T) {
+	t.Parallel()
+
+	actual := maps.Collect(iterutil.Map2(maps.All(map[string]int{"a": 1, "b": 2}), func(k string, v int) (int, string) {
+		return v, k
+	}))
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, actual)
+}
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{3, 4, 5},
+		slices.Collect(iterutil.Paginate(iterutil.Count(10), 3, 3)))
+	assert.Equal(t, []int{8, 9},
+		slices.Collect(iterutil.Paginate(iterutil.Count(10), 8, 3)))
+	assert.Equal(t, []int{7, 8, 9},
+		slices.Collect(iterutil.Paginate(iterutil.Count(10), 7, 0)))
+
+	errTest := errors.New("test")
+	seq := func(yield func(int, error) bool) {
+		_ = yield(1, nil) && yield(2, nil) && yield(3, nil) && yield(0, errTest)
+	}
+	actual, err := iterutil.CollectWithError(iterutil.PaginateWithError(seq, 1, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2}, actual)
+	_, err = iterutil.CollectWithError(iterutil.PaginateWithError(seq, 1, 5))
+	assert.ErrorIs(t, err, errTest)
+}
+
+func TestSkip(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{0, 1, 2}, slices.Collect(iterutil.Skip(iterutil.Count(3), 0)))
+	assert.Equal(t, []int{2}, slices.Collect(iterutil.Skip(iterutil.Count(3), 2)))
+	assert.Empty(t, slices.Collect(iterutil.Skip(iterutil.Count(3), 5)))
+}
+
 func TestSkipLast(t *testing.T) {
 	t.Parallel()
 
@@ -88,6 +193,47 @@ func TestSortedIntersection(t *testing.T) {
 	}
 }
 
+func TestSortedMerge(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		id      string
+		version int
+	}
+	compare := func(a, b record) int { return cmp.Compare(a.id, b.id) }
+	merge := func(a, b record) record {
+		if b.version > a.version {
+			return b
+		}
+		return a
+	}
+
+	for _, tc := range []struct {
+		input  [][]record
+		expect []record
+	}{
+		{
+			input:  [][]record{},
+			expect: nil,
+		},
+		{
+			input:  [][]record{{{"a", 1}}, {{"a", 2}}},
+			expect: []record{{"a", 2}},
+		},
+		{
+			input:  [][]record{{{"a", 3}, {"c", 1}}, {{"a", 2}, {"b", 1}}, {{"c", 4}, {"d", 1}}},
+			expect: []record{{"a", 3}, {"b", 1}, {"c", 4}, {"d", 1}},
+		},
+	} {
+		seqs := make([]iter.Seq[record], len(tc.input))
+		for i, input := range tc.input {
+			seqs[i] = slices.Values(input)
+		}
+		actual := slices.Collect(iterutil.SortedMerge(compare, merge, seqs...))
+		assert.Equal(t, tc.expect, actual)
+	}
+}
+
 func TestSortedUnion(t *testing.T) {
 	t.Parallel()
 
@@ -150,6 +296,31 @@ func TestTake(t *testing.T) {
 	}
 }
 
+func TestTakeWhile(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{1, 2},
+		slices.Collect(iterutil.TakeWhile(slices.Values([]int{1, 2, 3, 4, 1}), func(i int) bool { return i < 3 })))
+}
+
+func TestTryMap(t *testing.T) {
+	t.Parallel()
+
+	seq := iterutil.Zip(slices.Values([]string{"1", "2", "3"}), iterutil.Repeat(error(nil)))
+	actual, err := iterutil.CollectWithError(iterutil.TryMap(seq, strconv.Atoi))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, actual)
+
+	seq = iterutil.Zip(slices.Values([]string{"1", "x", "3"}), iterutil.Repeat(error(nil)))
+	actual, err = iterutil.CollectWithError(iterutil.TryMap(seq, strconv.Atoi))
+	assert.Error(t, err)
+	assert.Nil(t, actual)
+
+	errTest := errors.New("test")
+	_, err = iterutil.CollectWithError(iterutil.TryMap(iterutil.Error[string](errTest), strconv.Atoi))
+	assert.ErrorIs(t, err, errTest)
+}
+
 func TestZip(t *testing.T) {
 	t.Parallel()
 
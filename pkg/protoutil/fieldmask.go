@@ -8,6 +8,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // OverwriteMasked copies values from src to dst subject to a field mask. It
@@ -95,3 +96,65 @@ func (t fieldMaskTree) overwrite(dst, src protoreflect.Message) error {
 
 	return nil
 }
+
+// RetainMasked clears every field of msg that is not in the field mask. For a
+// google.protobuf.Struct the paths refer to the keys of the struct. It will
+// return an error if some path in the field mask is not valid for the message
+// type.
+func RetainMasked(msg proto.Message, m *fieldmaskpb.FieldMask) error {
+	return newFieldMaskTree(m).retain(msg.ProtoReflect())
+}
+
+func (t fieldMaskTree) retain(msg protoreflect.Message) error {
+	if s, ok := msg.Interface().(*structpb.Struct); ok {
+		t.retainStruct(s)
+		return nil
+	}
+
+	md := msg.Descriptor()
+	fields := md.Fields()
+
+	for p, subTree := range t {
+		f := fields.ByName(protoreflect.Name(p))
+		if f == nil {
+			return fmt.Errorf("cannot retain unknown field %q in message %v", p, md.FullName())
+		}
+		if len(subTree) > 0 && (f.Cardinality() == protoreflect.Repeated || f.Kind() != protoreflect.MessageKind) {
+			return fmt.Errorf("cannot retain sub-fields of field %q in message %v",
+				f.TextName(), md.FullName())
+		}
+	}
+
+	// the message shouldn't be modified while ranging over it
+	var populated []protoreflect.FieldDescriptor
+	msg.Range(func(f protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		populated = append(populated, f)
+		return true
+	})
+
+	for _, f := range populated {
+		subTree, ok := t[string(f.Name())]
+		switch {
+		case !ok:
+			msg.Clear(f)
+		case len(subTree) > 0:
+			err := subTree.retain(msg.Mutable(f).Message())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (t fieldMaskTree) retainStruct(s *structpb.Struct) {
+	for k, v := range s.GetFields() {
+		subTree, ok := t[k]
+		switch {
+		case !ok:
+			delete(s.Fields, k)
+		case len(subTree) > 0 && v.GetStructValue() != nil:
+			subTree.retainStruct(v.GetStructValue())
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/pomerium/pomerium/internal/testutil"
@@ -71,3 +72,59 @@ func TestOverwriteMaskedErrors(t *testing.T) {
 	err = protoutil.OverwriteMasked(&s1, &o, m)
 	assert.Equal(t, "descriptor mismatch: session.Session, session.OAuthToken", err.Error())
 }
+
+func TestRetainMasked(t *testing.T) {
+	t.Parallel()
+
+	s := &session.Session{
+		Id:       "session-id",
+		UserId:   "user-id",
+		IssuedAt: timestamppb.New(time.Date(2023, 10, 25, 10, 0, 0, 0, time.UTC)),
+		OauthToken: &session.OAuthToken{
+			AccessToken: "access-token",
+			TokenType:   "bearer",
+		},
+		Claims: map[string]*structpb.ListValue{
+			"email": {Values: []*structpb.Value{structpb.NewStringValue("user@example.com")}},
+		},
+	}
+
+	m, err := fieldmaskpb.New(s, "id", "user_id", "oauth_token.token_type")
+	require.NoError(t, err)
+
+	err = protoutil.RetainMasked(s, m)
+	require.NoError(t, err)
+
+	testutil.AssertProtoJSONEqual(t, `{
+		"id": "session-id",
+		"userId": "user-id",
+		"oauthToken": {
+			"tokenType": "bearer"
+		}
+	}`, s)
+
+	err = protoutil.RetainMasked(s, &fieldmaskpb.FieldMask{Paths: []string{"unknown"}})
+	assert.ErrorContains(t, err, `cannot retain unknown field "unknown"`)
+
+	err = protoutil.RetainMasked(s, &fieldmaskpb.FieldMask{Paths: []string{"id.value"}})
+	assert.ErrorContains(t, err, `cannot retain sub-fields of field "id"`)
+}
+
+func TestRetainMaskedStruct(t *testing.T) {
+	t.Parallel()
+
+	s, err := structpb.NewStruct(map[string]any{
+		"name":   "name",
+		"email":  "email",
+		"claims": map[string]any{"a": "1", "b": "2"},
+	})
+	require.NoError(t, err)
+
+	err = protoutil.RetainMasked(s, &fieldmaskpb.FieldMask{Paths: []string{"name", "claims.a", "missing"}})
+	require.NoError(t, err)
+
+	testutil.AssertProtoJSONEqual(t, `{
+		"name": "name",
+		"claims": { "a": "1" }
+	}`, s)
+}
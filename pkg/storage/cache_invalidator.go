@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+type cacheInvalidatorHandler struct {
+	recordType  string
+	getClient   func() databroker.DataBrokerServiceClient
+	withQuerier func(ctx context.Context) context.Context
+}
+
+// NewCacheInvalidator creates a new syncer which watches for event records of
+// the given event type and invalidates any cached queries for the records of
+// the given record type with the same id. This makes deletions, like revoked
+// sessions, take effect as soon as the event is synced instead of when the
+// cached results expire, without having to sync every record of the record
+// type. The querier to invalidate is taken from the context returned by
+// withQuerier.
+func NewCacheInvalidator(
+	ctx context.Context,
+	id string,
+	eventType string,
+	recordType string,
+	getClient func() databroker.DataBrokerServiceClient,
+	withQuerier func(ctx context.Context) context.Context,
+	options ...databroker.SyncerOption,
+) *databroker.Syncer {
+	options = append([]databroker.SyncerOption{databroker.WithTypeURL(eventType)}, options...)
+	return databroker.NewSyncer(ctx, id, cacheInvalidatorHandler{
+		recordType:  recordType,
+		getClient:   getClient,
+		withQuerier: withQuerier,
+	}, options...)
+}
+
+func (h cacheInvalidatorHandler) GetDataBrokerServiceClient() databroker.DataBrokerServiceClient {
+	return h.getClient()
+}
+
+// ClearRecords does nothing. Events missed while the server version changed
+// are dropped from the cache when the cached results expire.
+func (h cacheInvalidatorHandler) ClearRecords(_ context.Context) {}
+
+func (h cacheInvalidatorHandler) UpdateRecords(ctx context.Context, _ uint64, events []*databroker.Record) {
+	var records []*databroker.Record
+	for _, event := range events {
+		// events removed because of the capacity limit don't need handling
+		if event.GetDeletedAt() != nil {
+			continue
+		}
+		records = append(records, &databroker.Record{
+			Type:    h.recordType,
+			Id:      event.GetId(),
+			Version: event.GetVersion(),
+		})
+	}
+	if len(records) > 0 {
+		InvalidateCacheForDataBrokerRecords(h.withQuerier(ctx), records...)
+	}
+}
@@ -0,0 +1,67 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/testutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+func TestCacheInvalidator(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.GetContext(t, time.Minute)
+
+	srv := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(srv.Stop)
+
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, srv)
+	})
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+
+	cache := storage.NewGlobalCache(time.Hour)
+	withQuerier := func(ctx context.Context) context.Context {
+		return storage.WithQuerier(ctx, storage.NewCachingQuerier(storage.NewQuerier(client), cache))
+	}
+
+	record := &databrokerpb.Record{Type: "t1", Id: "r1", Data: protoutil.ToAny("v1")}
+	_, err := client.Put(ctx, &databrokerpb.PutRequest{Records: []*databrokerpb.Record{record}})
+	require.NoError(t, err)
+
+	_, err = storage.GetDataBrokerRecord(withQuerier(ctx), "t1", "r1", 0)
+	require.NoError(t, err, "should cache the record")
+
+	syncer := storage.NewCacheInvalidator(ctx, "test", "e1", "t1",
+		func() databrokerpb.DataBrokerServiceClient { return client }, withQuerier)
+	go syncer.Run(ctx)
+	t.Cleanup(func() { _ = syncer.Close() })
+
+	record.DeletedAt = timestamppb.Now()
+	_, err = client.Put(ctx, &databrokerpb.PutRequest{Records: []*databrokerpb.Record{record}})
+	require.NoError(t, err)
+
+	_, err = storage.GetDataBrokerRecord(withQuerier(ctx), "t1", "r1", 0)
+	require.NoError(t, err, "should not invalidate the cached record without an event")
+
+	_, err = client.Put(ctx, &databrokerpb.PutRequest{Records: []*databrokerpb.Record{
+		{Type: "e1", Id: "r1", Data: protoutil.ToAny("revoked")},
+	}})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		_, err := storage.GetDataBrokerRecord(withQuerier(ctx), "t1", "r1", 0)
+		return storage.IsNotFound(err)
+	}, 10*time.Second, 50*time.Millisecond, "should invalidate the cached record once the event is synced")
+}
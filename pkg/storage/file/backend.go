@@ -21,6 +21,7 @@ import (
 
 	"github.com/pomerium/pomerium/internal/signal"
 	"github.com/pomerium/pomerium/internal/telemetry"
+	"github.com/pomerium/pomerium/internal/telemetry/metrics"
 	"github.com/pomerium/pomerium/pkg/contextutil"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
@@ -48,6 +49,19 @@ type Backend struct {
 	registryServiceIndex  *registryServiceIndex
 	metricRegistration    metric.Registration
 	metricAttributes      []attribute.KeyValue
+	retention             retentionConfig
+	expiration            expirationConfig
+	replication           replicationConfig
+	indexedFields         map[string][]string
+	searchFields          map[string][]string
+	commitOptions         commitOptions
+	commitRequests        chan *commitRequest
+	encryptionKeys        [][]byte
+	cipher                *recordCipher
+	recordCounts          map[string]int64
+	stateChanges          uint64
+	commitDuration        metric.Float64Histogram
+	syncWaitDuration      metric.Float64Histogram
 
 	initOnce sync.Once
 	initErr  error
@@ -77,6 +91,13 @@ func New(tracerProvider oteltrace.TracerProvider, dsn string, opts ...Option) *B
 		onRecordChange:   signal.New(),
 		onServiceChange:  signal.New(),
 		iteratorCanceler: contextutil.NewCanceler(),
+		commitRequests:   make(chan *commitRequest),
+		commitDuration: metrics.Float64Histogram("storage.pebble.commit.duration",
+			metric.WithDescription("The time it takes to commit a batch."),
+			metric.WithUnit("s")),
+		syncWaitDuration: metrics.Float64Histogram("storage.pebble.sync.wait.duration",
+			metric.WithDescription("The time a sync waited for new record changes."),
+			metric.WithUnit("s")),
 	}
 	backend.closeCtx, backend.close = context.WithCancel(context.Background())
 	for _, opt := range opts {
@@ -234,6 +255,25 @@ func (backend *Backend) Lease(
 	return acquired, nil
 }
 
+// ListLeases lists all the unexpired leases, sorted by name.
+func (backend *Backend) ListLeases(
+	ctx context.Context,
+) (leases []storage.Lease, err error) {
+	_, op := backend.telemetry.Start(ctx, "ListLeases")
+	defer op.Complete()
+
+	err = backend.withReadOnlyTransaction(func(tx readOnlyTransaction) error {
+		var err error
+		leases, err = backend.listLeasesLocked(tx, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, op.Failure(err)
+	}
+
+	return leases, nil
+}
+
 // ListTypes lists all the known record types.
 func (backend *Backend) ListTypes(
 	ctx context.Context,
@@ -261,7 +301,7 @@ func (backend *Backend) Put(
 	ctx, op := backend.telemetry.Start(ctx, "Put")
 	defer op.Complete()
 
-	err = backend.withReadWriteTransaction(func(tx *readWriteTransaction) error {
+	err = backend.withGroupCommit(ctx, func(tx *readWriteTransaction) error {
 		tx.onCommit(func() { backend.onRecordChange.Broadcast(ctx) })
 		var err error
 		serverVersion = backend.serverVersion
@@ -284,7 +324,7 @@ func (backend *Backend) Patch(
 	ctx, op := backend.telemetry.Start(ctx, "Patch")
 	defer op.Complete()
 
-	err = backend.withReadWriteTransaction(func(tx *readWriteTransaction) error {
+	err = backend.withGroupCommit(ctx, func(tx *readWriteTransaction) error {
 		tx.onCommit(func() { backend.onRecordChange.Broadcast(ctx) })
 		var err error
 		serverVersion = backend.serverVersion
@@ -353,15 +393,73 @@ func (backend *Backend) SyncLatest(
 	ctx context.Context,
 	recordType string,
 	filter storage.FilterExpression,
+) (serverVersion, recordVersion uint64, seq storage.RecordIterator, err error) {
+	return backend.SyncLatestAfter(ctx, recordType, filter, "", "")
+}
+
+// SyncLatestAfter syncs all the records that sort after the record with the
+// given type and id.
+func (backend *Backend) SyncLatestAfter(
+	ctx context.Context,
+	recordType string,
+	filter storage.FilterExpression,
+	afterRecordType, afterRecordID string,
 ) (serverVersion, recordVersion uint64, seq storage.RecordIterator, err error) {
 	err = backend.withReadOnlyTransaction(func(_ readOnlyTransaction) error {
 		var err error
-		serverVersion, recordVersion, seq, err = backend.syncLatestLocked(ctx, recordType, filter)
+		serverVersion, recordVersion, seq, err = backend.syncLatestLocked(ctx, recordType, filter, afterRecordType, afterRecordID)
 		return err
 	})
 	return serverVersion, recordVersion, seq, err
 }
 
+// SyncLatestOrdered syncs all the records sorted by the given order. Records
+// of a single type ordered by when they were modified are read from the
+// version index instead of being sorted.
+func (backend *Backend) SyncLatestOrdered(
+	ctx context.Context,
+	recordType string,
+	filter storage.FilterExpression,
+	orderBy storage.OrderBy,
+) (serverVersion, recordVersion uint64, seq storage.RecordIterator, err error) {
+	descending, ok := orderBy.IsModifiedAt()
+	if !ok || recordType == "" {
+		serverVersion, recordVersion, seq, err = backend.SyncLatest(ctx, recordType, filter)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		return serverVersion, recordVersion, storage.SortRecordIterator(seq, orderBy), nil
+	}
+
+	err = backend.withReadOnlyTransaction(func(_ readOnlyTransaction) error {
+		serverVersion, recordVersion = backend.serverVersion, backend.latestRecordVersion
+		return nil
+	})
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return serverVersion, recordVersion, backend.iterateLatestRecordsByVersion(ctx, recordType, filter, descending), nil
+}
+
+// Stats returns statistics for each record type.
+func (backend *Backend) Stats(
+	ctx context.Context,
+) (stats []storage.RecordTypeStats, err error) {
+	_, op := backend.telemetry.Start(ctx, "Stats")
+	defer op.Complete()
+
+	err = backend.withReadOnlyTransaction(func(tx readOnlyTransaction) error {
+		var err error
+		stats, err = backend.statsLocked(tx)
+		return err
+	})
+	if err != nil {
+		return nil, op.Failure(err)
+	}
+
+	return stats, nil
+}
+
 // Versions returns the storage backend versions.
 func (backend *Backend) Versions(
 	ctx context.Context,
@@ -385,31 +483,11 @@ func (backend *Backend) cleanLocked(
 	rw readerWriter,
 	options storage.CleanOptions,
 ) error {
-	// iterate over the record changes, deleting any before RemoveRecordChangesBefore
-	// always keep at least one record at the end so that we can track version numbers
-	for record, err := range iterutil.SkipLastWithError(recordChangeKeySpace.iterate(rw, 0), 1) {
-		if err != nil {
-			return fmt.Errorf("pebble: error iterating over record changes: %w", err)
-		}
-
-		if !record.GetModifiedAt().AsTime().Before(options.RemoveRecordChangesBefore) {
-			break
-		}
-
-		err = recordChangeKeySpace.delete(rw, record.GetVersion())
-		if err != nil {
-			return fmt.Errorf("pebble: error deleting record change: %w", err)
-		}
-		err = recordChangeIndexByTypeKeySpace.delete(rw, record.GetType(), record.GetVersion())
-		if err != nil {
-			return fmt.Errorf("pebble: error deleting record change index by type: %w", err)
-		}
-
-		// this record was deleted, so only allow queries for records with larger version numbers
-		backend.earliestRecordVersion = max(backend.earliestRecordVersion, record.GetVersion()+1)
-	}
-
-	return nil
+	// remove any record changes before RemoveRecordChangesBefore
+	_, err := backend.removeRecordChangesLocked(rw, func(record *databrokerpb.Record) bool {
+		return record.GetModifiedAt().AsTime().Before(options.RemoveRecordChangesBefore)
+	})
+	return err
 }
 
 func (backend *Backend) clearLocked(
@@ -422,6 +500,9 @@ func (backend *Backend) clearLocked(
 		recordIndexByTypeVersionKeySpace.deleteAll(rw),
 		recordChangeKeySpace.deleteAll(rw),
 		recordChangeIndexByTypeKeySpace.deleteAll(rw),
+		recordIndexByCIDRKeySpace.deleteAll(rw),
+		recordIndexByFieldKeySpace.deleteAll(rw),
+		recordIndexBySearchTokenKeySpace.deleteAll(rw),
 		metadataKeySpace.setServerVersion(rw, newServerVersion),
 		metadataKeySpace.setCheckpointServerVersion(rw, 0),
 		metadataKeySpace.setCheckpointRecordVersion(rw, 0),
@@ -431,7 +512,9 @@ func (backend *Backend) clearLocked(
 	}
 
 	backend.serverVersion = newServerVersion
+	backend.stateChanges++
 	clear(backend.options)
+	clear(backend.recordCounts)
 	backend.earliestRecordVersion = 0
 	backend.latestRecordVersion = 0
 	backend.recordCIDRIndex.table = bart.Table[[]recordCIDRNode]{}
@@ -440,6 +523,61 @@ func (backend *Backend) clearLocked(
 	return nil
 }
 
+func (backend *Backend) addRecordCIDRIndexLocked(
+	w writer,
+	record *databrokerpb.Record,
+) error {
+	prefixes := storage.GetRecordIndexCIDRs(record.GetData())
+	if len(prefixes) == 0 {
+		return nil
+	}
+
+	err := recordIndexByCIDRKeySpace.set(w, record.GetType(), record.GetId(), prefixes)
+	if err != nil {
+		return fmt.Errorf("pebble: error setting record index by cidr: %w", err)
+	}
+	backend.stateChanges++
+	for _, prefix := range prefixes {
+		backend.recordCIDRIndex.add(recordCIDRNode{recordType: record.GetType(), recordID: record.GetId(), prefix: prefix})
+	}
+
+	return nil
+}
+
+func (backend *Backend) deleteRecordCIDRIndexLocked(
+	w writer,
+	record *databrokerpb.Record,
+) error {
+	prefixes := storage.GetRecordIndexCIDRs(record.GetData())
+	if len(prefixes) == 0 {
+		return nil
+	}
+
+	err := recordIndexByCIDRKeySpace.delete(w, record.GetType(), record.GetId())
+	if err != nil {
+		return fmt.Errorf("pebble: error deleting record index by cidr: %w", err)
+	}
+	backend.stateChanges++
+	for _, prefix := range prefixes {
+		backend.recordCIDRIndex.delete(recordCIDRNode{recordType: record.GetType(), recordID: record.GetId(), prefix: prefix})
+	}
+
+	return nil
+}
+
+func (backend *Backend) incrementRecordCountLocked(recordType string) {
+	backend.stateChanges++
+	backend.recordCounts[recordType]++
+}
+
+func (backend *Backend) decrementRecordCountLocked(recordType string) {
+	backend.stateChanges++
+	backend.recordCounts[recordType]--
+	if backend.recordCounts[recordType] <= 0 {
+		delete(backend.recordCounts, recordType)
+	}
+}
+
 func (backend *Backend) deleteRecordLocked(
 	rw readerWriter,
 	recordType, recordID string,
@@ -463,9 +601,21 @@ func (backend *Backend) deleteRecordLocked(
 		return fmt.Errorf("pebble: error deleting record index by type version: %w", err)
 	}
 
-	if prefix := storage.GetRecordIndexCIDR(record.GetData()); prefix != nil {
-		backend.recordCIDRIndex.delete(recordCIDRNode{recordType: record.GetType(), recordID: record.GetId(), prefix: *prefix})
+	err = backend.deleteRecordCIDRIndexLocked(rw, record)
+	if err != nil {
+		return err
+	}
+
+	err = backend.deleteRecordFieldIndexLocked(rw, record)
+	if err != nil {
+		return err
+	}
+
+	err = backend.deleteRecordSearchIndexLocked(rw, record)
+	if err != nil {
+		return err
 	}
+	backend.decrementRecordCountLocked(recordType)
 
 	backend.latestRecordVersion++
 	record.ModifiedAt = timestamppb.Now()
@@ -490,6 +640,11 @@ func (backend *Backend) enforceOptionsLocked(
 	rw readerWriter,
 	recordType string,
 ) error {
+	_, err := backend.expireRecordsLocked(rw, recordType, time.Now())
+	if err != nil {
+		return err
+	}
+
 	options, ok := backend.options[recordType]
 	if !ok {
 		// no options defined, nothing to do
@@ -573,6 +728,16 @@ func (backend *Backend) leaseLocked(
 		// don't acquire the lease because someone else has it
 		return false, nil
 	}
+
+	// release the lease
+	if ttl <= 0 {
+		err = leaseKeySpace.delete(rw, leaseName)
+		if err != nil {
+			return false, fmt.Errorf("pebble: error deleting lease: %w", err)
+		}
+		return false, nil
+	}
+
 	err = leaseKeySpace.set(rw, leaseName, leaseID, time.Now().Add(ttl))
 	if err != nil {
 		return false, fmt.Errorf("pebble: error setting lease: %w", err)
@@ -585,13 +750,59 @@ func (backend *Backend) listLatestRecordsLocked(
 	r reader,
 	recordType string,
 	filter storage.FilterExpression,
+	afterRecordType, afterRecordID string,
 ) ([]*databrokerpb.Record, error) {
-	var records []*databrokerpb.Record
-	for record, err := range backend.iterateRecordsForFilterLocked(r, recordType, filter) {
+	// filters which can't use an index are applied while seeking past the
+	// last record
+	seq := backend.iterateRecordsAfterLocked(r, recordType, afterRecordType, afterRecordID)
+	if filter != nil && backend.usesIndex(recordType, filter) {
+		after := &databrokerpb.Record{Type: afterRecordType, Id: afterRecordID}
+		seq = iterutil.FilterWithError(backend.iterateRecordsForFilterLocked(r, recordType, filter),
+			func(record *databrokerpb.Record) bool {
+				return compareRecords(record, after) > 0
+			})
+	}
+
+	records := make([]*databrokerpb.Record, 0, batchSize)
+	for record, err := range seq {
 		if err != nil {
 			return nil, fmt.Errorf("pebble: error iterating over records: %w", err)
 		}
-		if recordMatches(record, filter) {
+		if backend.recordMatches(record, filter) {
+			records = append(records, record)
+			if len(records) >= batchSize {
+				break
+			}
+		}
+	}
+	return records, nil
+}
+
+func (backend *Backend) listLatestRecordsByVersionLocked(
+	r reader,
+	recordType string,
+	filter storage.FilterExpression,
+	descending bool,
+) ([]*databrokerpb.Record, error) {
+	seq := recordIndexByTypeVersionKeySpace.iterateIDs(r, recordType)
+	if descending {
+		seq = recordIndexByTypeVersionKeySpace.iterateIDsReversed(r, recordType)
+	}
+
+	var records []*databrokerpb.Record
+	for recordID, err := range seq {
+		if err != nil {
+			return nil, fmt.Errorf("pebble: error iterating over record index by version: %w", err)
+		}
+
+		record, err := recordKeySpace.get(r, recordType, recordID)
+		if isNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("pebble: error getting record: %w", err)
+		}
+
+		if backend.recordMatches(record, filter) {
 			records = append(records, record)
 		}
 	}
@@ -667,12 +878,28 @@ func (backend *Backend) putRecordsLocked(
 	rw readerWriter,
 	records []*databrokerpb.Record,
 ) (err error) {
+	// check expected versions before updating any records
+	for _, record := range records {
+		if record.ExpectedVersion == nil {
+			continue
+		}
+		current, err := backend.getRecordLocked(rw, record.GetType(), record.GetId())
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		err = storage.CheckExpectedVersion(record, current)
+		if err != nil {
+			return err
+		}
+	}
+
 	// update records
 	// keep track of each record type in the list so we can enforce options
 	recordTypes := set.New[string](len(records))
 	for i := range records {
 		recordTypes.Insert(records[i].GetType())
 		records[i] = proto.CloneOf(records[i])
+		records[i].ExpectedVersion = nil
 		err = backend.updateRecordLocked(rw, records[i])
 		if err != nil {
 			return fmt.Errorf("pebble: error updating record (type=%s id=%s): %w",
@@ -709,6 +936,7 @@ func (backend *Backend) setOptionsLocked(
 	options *databrokerpb.Options,
 ) error {
 	var err error
+	backend.stateChanges++
 	// if the options are empty, just delete them since we will return empty options on not found
 	if proto.Equal(options, new(databrokerpb.Options)) {
 		err = optionsKeySpace.delete(rw, recordType)
@@ -733,7 +961,7 @@ func (backend *Backend) updateRecordLocked(
 
 	existing, err := recordKeySpace.get(rw, record.GetType(), record.GetId())
 	if isNotFound(err) {
-		// nothing to do
+		backend.incrementRecordCountLocked(record.GetType())
 	} else if err != nil {
 		return fmt.Errorf("pebble: error getting existing record: %w", err)
 	} else {
@@ -742,8 +970,19 @@ func (backend *Backend) updateRecordLocked(
 			return fmt.Errorf("pebble: error updating record index by type version: %w", err)
 		}
 
-		if prefix := storage.GetRecordIndexCIDR(existing.GetData()); prefix != nil {
-			backend.recordCIDRIndex.delete(recordCIDRNode{recordType: existing.GetType(), recordID: existing.GetId(), prefix: *prefix})
+		err = backend.deleteRecordCIDRIndexLocked(rw, existing)
+		if err != nil {
+			return err
+		}
+
+		err = backend.deleteRecordFieldIndexLocked(rw, existing)
+		if err != nil {
+			return err
+		}
+
+		err = backend.deleteRecordSearchIndexLocked(rw, existing)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -771,19 +1010,50 @@ func (backend *Backend) updateRecordLocked(
 		return fmt.Errorf("pebble: error setting record index by type version: %w", err)
 	}
 
-	if prefix := storage.GetRecordIndexCIDR(record.GetData()); prefix != nil {
-		backend.recordCIDRIndex.add(recordCIDRNode{recordType: record.GetType(), recordID: record.GetId(), prefix: *prefix})
+	err = backend.addRecordCIDRIndexLocked(rw, record)
+	if err != nil {
+		return err
+	}
+
+	err = backend.addRecordFieldIndexLocked(rw, record)
+	if err != nil {
+		return err
+	}
+
+	err = backend.addRecordSearchIndexLocked(rw, record)
+	if err != nil {
+		return err
 	}
 
 	return nil
 }
 
+func (backend *Backend) statsLocked(
+	r reader,
+) ([]storage.RecordTypeStats, error) {
+	var stats []storage.RecordTypeStats
+	for record, err := range recordKeySpace.iterateAll(r) {
+		if err != nil {
+			return nil, fmt.Errorf("pebble: error iterating over records: %w", err)
+		}
+
+		// records are sorted by type
+		if len(stats) == 0 || stats[len(stats)-1].RecordType != record.GetType() {
+			stats = append(stats, storage.RecordTypeStats{RecordType: record.GetType()})
+		}
+		stats[len(stats)-1].Add(record, proto.Size(record))
+	}
+	return stats, nil
+}
+
 func (backend *Backend) syncLatestLocked(
 	ctx context.Context,
 	recordType string,
 	filter storage.FilterExpression,
+	afterRecordType, afterRecordID string,
 ) (serverVersion, recordVersion uint64, seq storage.RecordIterator, err error) {
-	return backend.serverVersion, backend.latestRecordVersion, backend.iterateLatestRecords(ctx, recordType, filter), nil
+	return backend.serverVersion, backend.latestRecordVersion,
+		backend.iterateLatestRecords(ctx, recordType, filter, afterRecordType, afterRecordID), nil
 }
 
 func (backend *Backend) versionsLocked(
@@ -45,6 +45,87 @@ func TestClear(t *testing.T) {
 	storagetest.TestClear(t, backend)
 }
 
+func TestCIDRIndexPersistence(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	data, err := structpb.NewStruct(map[string]any{
+		"$index": map[string]any{
+			"cidr": "192.168.0.0/16",
+		},
+	})
+	require.NoError(t, err)
+
+	backend1 := file.New(noop.NewTracerProvider(), "file://"+dir)
+	_, err = backend1.Put(t.Context(), []*databrokerpb.Record{
+		{Type: "example", Id: "id-1", Data: protoutil.NewAny(data)},
+		{Type: "example", Id: "id-2", Data: protoutil.NewAnyString("x")},
+	})
+	require.NoError(t, err)
+	require.NoError(t, backend1.Close())
+
+	backend2 := file.New(noop.NewTracerProvider(), "file://"+dir)
+	t.Cleanup(func() { _ = backend2.Close() })
+
+	_, _, seq, err := backend2.SyncLatest(t.Context(), "example",
+		storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "192.168.1.1"})
+	require.NoError(t, err)
+	records, err := iterutil.CollectWithError(seq)
+	require.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, "id-1", records[0].GetId())
+	}
+}
+
+func TestCIDRIndexMultiplePrefixes(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	data, err := structpb.NewStruct(map[string]any{
+		"$index": map[string]any{
+			"cidr": []any{"10.0.0.0/8", "192.168.0.0/16"},
+		},
+	})
+	require.NoError(t, err)
+
+	backend1 := file.New(noop.NewTracerProvider(), "file://"+dir)
+	_, err = backend1.Put(t.Context(), []*databrokerpb.Record{
+		{Type: "example", Id: "id-1", Data: protoutil.NewAny(data)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, backend1.Close())
+
+	backend2 := file.New(noop.NewTracerProvider(), "file://"+dir)
+	t.Cleanup(func() { _ = backend2.Close() })
+
+	syncLatest := func(value string) []*databrokerpb.Record {
+		_, _, seq, err := backend2.SyncLatest(t.Context(), "example",
+			storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: value})
+		require.NoError(t, err)
+		records, err := iterutil.CollectWithError(seq)
+		require.NoError(t, err)
+		return records
+	}
+
+	assert.Len(t, syncLatest("10.1.2.3"), 1)
+	assert.Len(t, syncLatest("192.168.1.1"), 1)
+	assert.Len(t, syncLatest("172.16.0.1"), 0)
+
+	data, err = structpb.NewStruct(map[string]any{
+		"$index": map[string]any{
+			"cidr": "10.0.0.0/8",
+		},
+	})
+	require.NoError(t, err)
+	_, err = backend2.Put(t.Context(), []*databrokerpb.Record{
+		{Type: "example", Id: "id-1", Data: protoutil.NewAny(data)},
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, syncLatest("10.1.2.3"), 1)
+	assert.Len(t, syncLatest("192.168.1.1"), 0, "should remove the old prefix from the index")
+}
+
 func BenchmarkGet(b *testing.B) {
 	dir := b.TempDir()
 	backend := file.New(noop.NewTracerProvider(), "file://"+dir)
@@ -0,0 +1,227 @@
+package file
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// BuildDatabrokerCommand builds the databroker maintenance command.
+func BuildDatabrokerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "databroker",
+		Short: "databroker storage maintenance",
+	}
+	cmd.AddCommand(buildExportCommand())
+	cmd.AddCommand(buildImportCommand())
+	cmd.AddCommand(buildMigrateCommand())
+	cmd.AddCommand(buildRestoreCommand())
+	cmd.AddCommand(buildSnapshotCommand())
+	cmd.AddCommand(buildVacuumCommand())
+	return cmd
+}
+
+func buildExportCommand() *cobra.Command {
+	var dsn, recordType, format string
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export records from file storage to stdout",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			exportFormat, err := ParseExportFormat(format)
+			if err != nil {
+				return err
+			}
+
+			backend := New(noop.NewTracerProvider(), dsn)
+			defer backend.Close()
+
+			return backend.Export(cmd.Context(), recordType, cmd.OutOrStdout(), exportFormat)
+		},
+	}
+	cmd.Flags().StringVar(&dsn, "dsn", "", "the databroker storage connection string, defaults to the data directory")
+	cmd.Flags().StringVar(&recordType, "type", "", "only export records of this type")
+	cmd.Flags().StringVar(&format, "format", "jsonl", "the export format, either jsonl or proto")
+	return cmd
+}
+
+func buildImportCommand() *cobra.Command {
+	var dsn, format string
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "import records from stdin into file storage",
+		Long: "Imports records from stdin into the databroker file storage. " +
+			"The database cannot be in use by a running pomerium instance.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			exportFormat, err := ParseExportFormat(format)
+			if err != nil {
+				return err
+			}
+
+			backend := New(noop.NewTracerProvider(), dsn)
+			defer backend.Close()
+
+			imported, err := backend.Import(cmd.Context(), cmd.InOrStdin(), exportFormat)
+			if err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("imported %d records\n", imported)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&dsn, "dsn", "", "the databroker storage connection string, defaults to the data directory")
+	cmd.Flags().StringVar(&format, "format", "jsonl", "the import format, either jsonl or proto")
+	return cmd
+}
+
+func buildMigrateCommand() *cobra.Command {
+	var dsn string
+	var dryRun bool
+	var encryptionKeys []string
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "apply any pending migrations to file storage",
+		Long: "Applies any pending migrations to the databroker file storage. " +
+			"Interrupted migrations resume where they left off. " +
+			"The database cannot be in use by a running pomerium instance.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			keys := make([][]byte, 0, len(encryptionKeys))
+			for _, encryptionKey := range encryptionKeys {
+				key, err := base64.StdEncoding.DecodeString(encryptionKey)
+				if err != nil {
+					return fmt.Errorf("invalid encryption key: %w", err)
+				}
+				keys = append(keys, key)
+			}
+			cipher, err := newRecordCipher(keys...)
+			if err != nil {
+				return err
+			}
+
+			db, err := OpenPebbleDB(dsn)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			return migrate(db,
+				withMigrateCipher(cipher),
+				withMigrateDryRun(dryRun),
+				withMigrateProgress(func(progress migrationProgress) {
+					switch {
+					case dryRun:
+						cmd.Printf("pending migration %d: %s\n", progress.version, progress.name)
+					case progress.done:
+						cmd.Printf("applied migration %d: %s (%d records)\n", progress.version, progress.name, progress.processed)
+					default:
+						cmd.Printf("migration %d: %s: processed %d records\n", progress.version, progress.name, progress.processed)
+					}
+				}))
+		},
+	}
+	cmd.Flags().StringVar(&dsn, "dsn", "", "the databroker storage connection string, defaults to the data directory")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only report the pending migrations")
+	cmd.Flags().StringSliceVar(&encryptionKeys, "encryption-key", nil, "a base64-encoded key used to decrypt record values, may be repeated")
+	return cmd
+}
+
+func buildVacuumCommand() *cobra.Command {
+	var dsn string
+	cmd := &cobra.Command{
+		Use:   "vacuum",
+		Short: "remove deleted records and orphaned index entries from file storage and compact it",
+		Long: "Removes deleted records and orphaned index entries from the databroker file storage and compacts it. " +
+			"The database cannot be in use by a running pomerium instance.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			backend := New(noop.NewTracerProvider(), dsn)
+			defer backend.Close()
+
+			stats, err := backend.Vacuum(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("removed %d records and %d index entries, reclaimed %d bytes\n",
+				stats.RemovedRecords, stats.RemovedIndexEntries, stats.ReclaimedBytes)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&dsn, "dsn", "", "the databroker storage connection string, defaults to the data directory")
+	return cmd
+}
+
+func buildRestoreCommand() *cobra.Command {
+	var dsn, store string
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "restore file storage from the latest snapshot",
+		Long: "Restores the databroker file storage from the latest snapshot in the snapshot store. " +
+			"The database directory must not exist or be empty.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			u, err := parsePebbleDSN(dsn)
+			if err != nil {
+				return err
+			} else if u.Scheme != "file" {
+				return fmt.Errorf("snapshots are only supported for file databases")
+			}
+
+			entries, err := os.ReadDir(u.Path)
+			if err == nil && len(entries) > 0 {
+				return fmt.Errorf("database directory is not empty: %s", u.Path)
+			}
+
+			snapshotStore, err := NewSnapshotStore(cmd.Context(), store)
+			if err != nil {
+				return err
+			}
+
+			name, err := RestoreSnapshot(cmd.Context(), snapshotStore, u.Path)
+			if err != nil {
+				return err
+			} else if name == "" {
+				return fmt.Errorf("no snapshots found")
+			}
+
+			cmd.Printf("restored snapshot %s\n", name)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&dsn, "dsn", "", "the databroker storage connection string, defaults to the data directory")
+	cmd.Flags().StringVar(&store, "store", "", "the snapshot store url, for example s3://bucket/prefix")
+	_ = cmd.MarkFlagRequired("store")
+	return cmd
+}
+
+func buildSnapshotCommand() *cobra.Command {
+	var dsn, store string
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "upload a snapshot of file storage",
+		Long: "Uploads a snapshot of the databroker file storage to the snapshot store. " +
+			"The database cannot be in use by a running pomerium instance.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			snapshotStore, err := NewSnapshotStore(cmd.Context(), store)
+			if err != nil {
+				return err
+			}
+
+			backend := New(noop.NewTracerProvider(), dsn, WithSnapshotStore(snapshotStore))
+			defer backend.Close()
+
+			name, err := backend.UploadSnapshot(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("uploaded snapshot %s\n", name)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&dsn, "dsn", "", "the databroker storage connection string, defaults to the data directory")
+	cmd.Flags().StringVar(&store, "store", "", "the snapshot store url, for example s3://bucket/prefix")
+	_ = cmd.MarkFlagRequired("store")
+	return cmd
+}
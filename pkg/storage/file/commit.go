@@ -0,0 +1,154 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const defaultCommitMaxBatchSize = 64
+
+// commitOptions configure group commit. They are set via DSN query params,
+// e.g. file:///path?commit_interval=5ms&commit_max_batch_size=128.
+type commitOptions struct {
+	// interval is the maximum amount of time a write will wait for other
+	// writes to join its batch. Group commit is disabled if it's zero.
+	interval time.Duration
+	// maxBatchSize is the maximum number of writes committed in a single batch.
+	maxBatchSize int
+}
+
+func parseCommitOptions(dsn string) (commitOptions, error) {
+	opts := commitOptions{maxBatchSize: defaultCommitMaxBatchSize}
+
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return opts, fmt.Errorf("pebble: invalid dsn, expected url: %w", err)
+	}
+
+	q := u.Query()
+	if v := q.Get("commit_interval"); v != "" {
+		opts.interval, err = time.ParseDuration(v)
+		if err != nil || opts.interval < 0 {
+			return opts, fmt.Errorf("pebble: invalid commit_interval: %s", v)
+		}
+	}
+	if v := q.Get("commit_max_batch_size"); v != "" {
+		opts.maxBatchSize, err = strconv.Atoi(v)
+		if err != nil || opts.maxBatchSize < 1 {
+			return opts, fmt.Errorf("pebble: invalid commit_max_batch_size: %s", v)
+		}
+	}
+
+	return opts, nil
+}
+
+type commitRequest struct {
+	ctx  context.Context
+	fn   func(tx *readWriteTransaction) error
+	done chan error
+}
+
+// withGroupCommit runs fn in a read-write transaction. If group commit is
+// enabled, concurrent calls are coalesced into a single batch commit.
+//
+// If ctx is cancelled while waiting for the batch to be committed, the
+// context error is returned. The write may still be committed in that case.
+func (backend *Backend) withGroupCommit(ctx context.Context, fn func(tx *readWriteTransaction) error) error {
+	err := backend.init()
+	if err != nil {
+		return fmt.Errorf("pebble: error initializing: %w", err)
+	}
+
+	if backend.commitOptions.interval <= 0 {
+		return backend.withReadWriteTransaction(fn)
+	}
+
+	req := &commitRequest{ctx: ctx, fn: fn, done: make(chan error, 1)}
+	select {
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	case <-backend.closeCtx.Done():
+		return context.Cause(backend.closeCtx)
+	case backend.commitRequests <- req:
+	}
+
+	select {
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	case err := <-req.done:
+		return err
+	}
+}
+
+func (backend *Backend) runGroupCommit() {
+	for {
+		var reqs []*commitRequest
+		select {
+		case <-backend.closeCtx.Done():
+			return
+		case req := <-backend.commitRequests:
+			reqs = append(reqs, req)
+		}
+
+		timer := time.NewTimer(backend.commitOptions.interval)
+	collect:
+		for len(reqs) < backend.commitOptions.maxBatchSize {
+			select {
+			case <-backend.closeCtx.Done():
+				break collect
+			case <-timer.C:
+				break collect
+			case req := <-backend.commitRequests:
+				reqs = append(reqs, req)
+			}
+		}
+		timer.Stop()
+
+		backend.commitGroup(reqs)
+	}
+}
+
+func (backend *Backend) commitGroup(reqs []*commitRequest) {
+	// skip any requests whose callers have given up waiting
+	reqs = slices.DeleteFunc(reqs, func(req *commitRequest) bool {
+		if err := context.Cause(req.ctx); err != nil {
+			req.done <- err
+			return true
+		}
+		return false
+	})
+	if len(reqs) == 0 {
+		return
+	}
+
+	// the in-memory state changed by a failed group is restored by
+	// withReadWriteTransaction
+	failed := false
+	err := backend.withReadWriteTransaction(func(tx *readWriteTransaction) error {
+		for _, req := range reqs {
+			err := req.fn(tx)
+			if err != nil {
+				failed = true
+				return err
+			}
+		}
+		return nil
+	})
+
+	// if one of the requests failed, retry each request in its own
+	// transaction so that the other requests in the group still succeed
+	if failed {
+		for _, req := range reqs {
+			req.done <- backend.withReadWriteTransaction(req.fn)
+		}
+		return
+	}
+
+	for _, req := range reqs {
+		req.done <- err
+	}
+}
@@ -0,0 +1,162 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestParseCommitOptions(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		dsn    string
+		expect commitOptions
+		err    string
+	}{
+		{"memory://", commitOptions{maxBatchSize: defaultCommitMaxBatchSize}, ""},
+		{"file:///tmp/db?commit_interval=5ms", commitOptions{interval: 5 * time.Millisecond, maxBatchSize: defaultCommitMaxBatchSize}, ""},
+		{"/tmp/db?commit_interval=1ms&commit_max_batch_size=8", commitOptions{interval: time.Millisecond, maxBatchSize: 8}, ""},
+		{"memory://?commit_interval=x", commitOptions{}, "pebble: invalid commit_interval: x"},
+		{"memory://?commit_max_batch_size=0", commitOptions{}, "pebble: invalid commit_max_batch_size: 0"},
+	} {
+		actual, err := parseCommitOptions(tc.dsn)
+		if tc.err != "" {
+			assert.EqualError(t, err, tc.err, tc.dsn)
+		} else if assert.NoError(t, err, tc.dsn) {
+			assert.Equal(t, tc.expect, actual, tc.dsn)
+		}
+	}
+}
+
+func TestGroupCommit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("concurrent", func(t *testing.T) {
+		t.Parallel()
+
+		backend := New(noop.NewTracerProvider(), "memory://?commit_interval=5ms")
+		t.Cleanup(func() { _ = backend.Close() })
+
+		var wg sync.WaitGroup
+		for i := range 100 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := backend.Put(t.Context(), []*databrokerpb.Record{
+					{Type: "example", Id: fmt.Sprintf("id-%d", i), Data: protoutil.NewAnyString("x")},
+				})
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		_, _, latestRecordVersion, err := backend.Versions(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), latestRecordVersion)
+		for i := range 100 {
+			_, err := backend.Get(t.Context(), "example", fmt.Sprintf("id-%d", i))
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		backend := New(noop.NewTracerProvider(), "memory://?commit_interval=5ms")
+		t.Cleanup(func() { _ = backend.Close() })
+		require.NoError(t, backend.init())
+
+		errFailed := errors.New("failed")
+		put := func(id string) *commitRequest {
+			return &commitRequest{
+				ctx: t.Context(),
+				fn: func(tx *readWriteTransaction) error {
+					return backend.putRecordsLocked(tx, []*databrokerpb.Record{
+						{Type: "example", Id: id, Data: protoutil.NewAnyString("x")},
+					})
+				},
+				done: make(chan error, 1),
+			}
+		}
+		reqs := []*commitRequest{
+			put("id-1"),
+			{ctx: t.Context(), fn: func(_ *readWriteTransaction) error { return errFailed }, done: make(chan error, 1)},
+			put("id-2"),
+		}
+		backend.commitGroup(reqs)
+
+		assert.NoError(t, <-reqs[0].done)
+		assert.ErrorIs(t, <-reqs[1].done, errFailed)
+		assert.NoError(t, <-reqs[2].done)
+
+		_, _, latestRecordVersion, err := backend.Versions(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), latestRecordVersion,
+			"should not skip versions for the failed group")
+	})
+
+	t.Run("failure restores state", func(t *testing.T) {
+		t.Parallel()
+
+		backend := New(noop.NewTracerProvider(), "memory://?commit_interval=5ms")
+		t.Cleanup(func() { _ = backend.Close() })
+		require.NoError(t, backend.init())
+
+		data, err := structpb.NewStruct(map[string]any{
+			"$index": map[string]any{
+				"cidr": "10.0.0.0/8",
+			},
+		})
+		require.NoError(t, err)
+
+		errFailed := errors.New("failed")
+		reqs := []*commitRequest{{
+			ctx: t.Context(),
+			fn: func(tx *readWriteTransaction) error {
+				err := backend.putRecordsLocked(tx, []*databrokerpb.Record{
+					{Type: "example", Id: "id-1", Data: protoutil.NewAny(data)},
+				})
+				require.NoError(t, err)
+				return errFailed
+			},
+			done: make(chan error, 1),
+		}}
+		backend.commitGroup(reqs)
+		assert.ErrorIs(t, <-reqs[0].done, errFailed)
+
+		backend.mu.RLock()
+		assert.Empty(t, backend.recordCounts,
+			"should restore the record counts")
+		assert.Empty(t, backend.recordCIDRIndex.lookupAddr("example", netip.MustParseAddr("10.0.0.1")),
+			"should restore the cidr index")
+		backend.mu.RUnlock()
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		backend := New(noop.NewTracerProvider(), "memory://?commit_interval=5ms")
+		t.Cleanup(func() { _ = backend.Close() })
+
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		_, err := backend.Put(ctx, []*databrokerpb.Record{
+			{Type: "example", Id: "id-1", Data: protoutil.NewAnyString("x")},
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
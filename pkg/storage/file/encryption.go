@@ -0,0 +1,130 @@
+package file
+
+import (
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// encrypted record values are stored in an envelope:
+//   0xff | {keyID as uint32} | {ciphertext} | {nonce}
+//
+// 0xff is never the first byte of an encoded protobuf message because it
+// has an invalid wire type, so unencrypted values can still be read.
+
+const encryptedValuePrefix = 0xff
+
+var (
+	errMissingEncryptionKey = errors.New("pebble: missing encryption key")
+	errDecryptingRecord     = errors.New("pebble: error decrypting record")
+)
+
+// WithEncryptionKeys configures the backend to encrypt record values at rest
+// using XChaCha20-Poly1305. The first key is used to encrypt new values. Any
+// additional keys are only used to decrypt existing values, which allows keys
+// to be rotated. Keys must be 32 bytes.
+func WithEncryptionKeys(keys ...[]byte) Option {
+	return func(b *Backend) {
+		b.encryptionKeys = keys
+	}
+}
+
+type recordCipher struct {
+	currentKeyID uint32
+	aeads        map[uint32]cipher.AEAD
+}
+
+func newRecordCipher(keys ...[]byte) (*recordCipher, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	c := &recordCipher{
+		currentKeyID: encryptionKeyID(keys[0]),
+		aeads:        make(map[uint32]cipher.AEAD, len(keys)),
+	}
+	for _, key := range keys {
+		aead, err := cryptutil.NewAEADCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("pebble: invalid encryption key: %w", err)
+		}
+		c.aeads[encryptionKeyID(key)] = aead
+	}
+	return c, nil
+}
+
+func (c *recordCipher) decrypt(data []byte) ([]byte, error) {
+	if len(data) < 5 || data[0] != encryptedValuePrefix {
+		return nil, fmt.Errorf("pebble: invalid encrypted value")
+	}
+
+	keyID := binary.BigEndian.Uint32(data[1:5])
+	aead, ok := c.aeads[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w (id=%08x)", errMissingEncryptionKey, keyID)
+	}
+
+	return cryptutil.Decrypt(aead, data[5:], nil)
+}
+
+func (c *recordCipher) encrypt(data []byte) []byte {
+	header := binary.BigEndian.AppendUint32([]byte{encryptedValuePrefix}, c.currentKeyID)
+	return append(header, cryptutil.Encrypt(c.aeads[c.currentKeyID], data, nil)...)
+}
+
+// encryptionKeyID returns an identifier for a key so that the key used to
+// encrypt a value can be found without storing the key itself.
+func encryptionKeyID(key []byte) uint32 {
+	h := sha256.Sum256(key)
+	return binary.BigEndian.Uint32(h[:4])
+}
+
+// transactions carry the record cipher so that keyspaces can encrypt and
+// decrypt record values
+type recordCipherProvider interface {
+	recordCipher() *recordCipher
+}
+
+func getRecordCipher(v any) *recordCipher {
+	if p, ok := v.(recordCipherProvider); ok {
+		return p.recordCipher()
+	}
+	return nil
+}
+
+func decodeRecordValue(r reader, data []byte) (*databrokerpb.Record, error) {
+	if len(data) > 0 && data[0] == encryptedValuePrefix {
+		c := getRecordCipher(r)
+		if c == nil {
+			return nil, errMissingEncryptionKey
+		}
+
+		var err error
+		data, err = c.decrypt(data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errDecryptingRecord, err)
+		}
+	}
+	return decodeProto[databrokerpb.Record](data)
+}
+
+// isRecordDecryptionError returns true if a record value couldn't be decoded
+// because it couldn't be decrypted. Unlike invalid records, these errors are
+// returned to callers so that records don't silently disappear when an
+// encryption key is missing.
+func isRecordDecryptionError(err error) bool {
+	return errors.Is(err, errMissingEncryptionKey) || errors.Is(err, errDecryptingRecord)
+}
+
+func encodeRecordValue(w writer, record *databrokerpb.Record) []byte {
+	data := encodeProto(record)
+	if c := getRecordCipher(w); c != nil {
+		data = c.encrypt(data)
+	}
+	return data
+}
@@ -0,0 +1,74 @@
+package file
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/iterutil"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestEncryption(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	key1 := cryptutil.NewKey()
+	key2 := cryptutil.NewKey()
+
+	backend1 := New(noop.NewTracerProvider(), "file://"+dir, WithEncryptionKeys(key1))
+	_, err := backend1.Put(t.Context(), []*databrokerpb.Record{
+		{Type: "example", Id: "id-1", Data: protoutil.NewAnyString("SECRET-1")},
+	})
+	require.NoError(t, err)
+
+	for _, kv := range dumpDatabase(t, backend1.db) {
+		assert.False(t, bytes.Contains(kv[1], []byte("SECRET-1")),
+			"should not store plaintext values")
+	}
+	raw, err := pebbleGet(backend1.db, recordKeySpace.encodeKey("example", "id-1"), func(data []byte) ([]byte, error) {
+		return bytes.Clone(data), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, byte(encryptedValuePrefix), raw[0])
+	require.NoError(t, backend1.Close())
+
+	// rotate keys
+	backend2 := New(noop.NewTracerProvider(), "file://"+dir, WithEncryptionKeys(key2, key1))
+	record, err := backend2.Get(t.Context(), "example", "id-1")
+	require.NoError(t, err)
+	assert.Equal(t, protoutil.NewAnyString("SECRET-1").GetValue(), record.GetData().GetValue())
+	_, err = backend2.Put(t.Context(), []*databrokerpb.Record{
+		{Type: "example", Id: "id-2", Data: protoutil.NewAnyString("SECRET-2")},
+	})
+	require.NoError(t, err)
+	require.NoError(t, backend2.Close())
+
+	// the old key is no longer needed for new values
+	backend3 := New(noop.NewTracerProvider(), "file://"+dir, WithEncryptionKeys(key2))
+	t.Cleanup(func() { _ = backend3.Close() })
+	_, err = backend3.Get(t.Context(), "example", "id-2")
+	assert.NoError(t, err)
+	_, err = backend3.Get(t.Context(), "example", "id-1")
+	assert.ErrorIs(t, err, errMissingEncryptionKey)
+
+	// records encrypted with a missing key are not silently skipped
+	_, _, seq, err := backend3.SyncLatest(t.Context(), "example", nil)
+	if err == nil {
+		_, err = iterutil.CollectWithError(seq)
+	}
+	assert.ErrorIs(t, err, errMissingEncryptionKey)
+}
+
+func TestEncryptionInvalidKey(t *testing.T) {
+	t.Parallel()
+
+	backend := New(noop.NewTracerProvider(), "memory://", WithEncryptionKeys([]byte("short")))
+	_, err := backend.Get(t.Context(), "example", "id-1")
+	assert.ErrorContains(t, err, "pebble: invalid encryption key")
+}
@@ -0,0 +1,160 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+	"time"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/iterutil"
+)
+
+const defaultExpirationInterval = time.Minute
+
+type expirationConfig struct {
+	ttls     map[string]time.Duration
+	interval time.Duration
+}
+
+func (cfg expirationConfig) enabled() bool {
+	return len(cfg.ttls) > 0
+}
+
+// WithRecordTTL configures the backend to delete records of the given type
+// once they haven't been modified for ttl. A deletion record change is added
+// for each expired record so that syncers see the removal.
+func WithRecordTTL(recordType string, ttl time.Duration) Option {
+	return func(b *Backend) {
+		if b.expiration.ttls == nil {
+			b.expiration.ttls = make(map[string]time.Duration)
+		}
+		if ttl > 0 {
+			b.expiration.ttls[recordType] = ttl
+		} else {
+			delete(b.expiration.ttls, recordType)
+		}
+	}
+}
+
+// WithExpirationInterval configures how often expired records are removed. It
+// defaults to one minute.
+func WithExpirationInterval(interval time.Duration) Option {
+	return func(b *Backend) {
+		b.expiration.interval = interval
+	}
+}
+
+func (backend *Backend) runExpiration() {
+	interval := backend.expiration.interval
+	if interval <= 0 {
+		interval = defaultExpirationInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-backend.closeCtx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := backend.expireRecords(backend.closeCtx, time.Now())
+		if err != nil && backend.closeCtx.Err() == nil {
+			log.Ctx(backend.closeCtx).Error().Err(err).Msg("pebble: error removing expired records")
+		}
+	}
+}
+
+func (backend *Backend) expireRecords(ctx context.Context, now time.Time) error {
+	ctx, op := backend.telemetry.Start(ctx, "ExpireRecords")
+	defer op.Complete()
+
+	var removed int
+	err := backend.withReadWriteTransaction(func(tx *readWriteTransaction) error {
+		for recordType := range maps.Keys(backend.expiration.ttls) {
+			n, err := backend.expireRecordsLocked(tx, recordType, now)
+			if err != nil {
+				return err
+			}
+			removed += n
+		}
+		if removed > 0 {
+			tx.onCommit(func() { backend.onRecordChange.Broadcast(ctx) })
+		}
+		return nil
+	})
+	if err != nil {
+		return op.Failure(err)
+	}
+
+	if removed > 0 {
+		log.Ctx(ctx).Debug().
+			Int("removed", removed).
+			Msg("pebble: removed expired records")
+	}
+
+	return nil
+}
+
+// expireRecordsLocked deletes any records of the given type that haven't been
+// modified within the type's ttl.
+func (backend *Backend) expireRecordsLocked(
+	rw readerWriter,
+	recordType string,
+	now time.Time,
+) (int, error) {
+	ttl, ok := backend.expiration.ttls[recordType]
+	if !ok {
+		return 0, nil
+	}
+	expireBefore := now.Add(-ttl)
+
+	// records are indexed in version order, which is also modified-at order,
+	// so collect the ids up front since deleting modifies the index
+	recordIDs, err := iterutil.CollectWithError(backend.iterateExpiredRecordIDsLocked(rw, recordType, expireBefore))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, recordID := range recordIDs {
+		err = backend.deleteRecordLocked(rw, recordType, recordID)
+		if err != nil {
+			return 0, fmt.Errorf("pebble: error deleting expired record: %w", err)
+		}
+	}
+
+	return len(recordIDs), nil
+}
+
+func (backend *Backend) iterateExpiredRecordIDsLocked(
+	r reader,
+	recordType string,
+	expireBefore time.Time,
+) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for recordID, err := range recordIndexByTypeVersionKeySpace.iterateIDs(r, recordType) {
+			if err != nil {
+				yield("", fmt.Errorf("pebble: error iterating over record index by type version: %w", err))
+				return
+			}
+
+			record, err := recordKeySpace.get(r, recordType, recordID)
+			if err != nil {
+				yield("", fmt.Errorf("pebble: error getting record: %w", err))
+				return
+			}
+
+			if !record.GetModifiedAt().AsTime().Before(expireBefore) {
+				return
+			}
+
+			if !yield(recordID, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package file
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/iterutil"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+func TestExpiration(t *testing.T) {
+	t.Parallel()
+
+	backend := New(noop.NewTracerProvider(), "memory://", WithRecordTTL("t1", time.Hour))
+	t.Cleanup(func() { _ = backend.Close() })
+
+	_, err := backend.Put(t.Context(), []*databrokerpb.Record{
+		{Type: "t1", Id: "r1", Data: protoutil.NewAnyString("x")},
+		{Type: "t1", Id: "r2", Data: protoutil.NewAnyString("x")},
+		{Type: "t2", Id: "r1", Data: protoutil.NewAnyString("x")},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, backend.expireRecords(t.Context(), time.Now()))
+	_, err = backend.Get(t.Context(), "t1", "r1")
+	assert.NoError(t, err, "should not remove records before the ttl")
+
+	require.NoError(t, backend.expireRecords(t.Context(), time.Now().Add(2*time.Hour)))
+	_, err = backend.Get(t.Context(), "t1", "r1")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = backend.Get(t.Context(), "t1", "r2")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = backend.Get(t.Context(), "t2", "r1")
+	assert.NoError(t, err, "should not remove records without a ttl")
+
+	serverVersion, _, latestRecordVersion, err := backend.Versions(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), latestRecordVersion)
+
+	records, err := iterutil.CollectWithError(backend.Sync(t.Context(), "t1", serverVersion, 3, false))
+	require.NoError(t, err)
+	if assert.Len(t, records, 2) {
+		for _, record := range records {
+			assert.NotNil(t, record.GetDeletedAt(),
+				"should add a deletion record change")
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cockroachdb/pebble/v2"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/iterutil"
+)
+
+// ExportFormat is the format used to export and import records.
+type ExportFormat int
+
+const (
+	// ExportFormatJSONL writes each record as protojson on its own line.
+	ExportFormatJSONL ExportFormat = iota
+	// ExportFormatProto writes each record as binary proto prefixed with its
+	// length as a varint.
+	ExportFormatProto
+)
+
+// ParseExportFormat parses an export format.
+func ParseExportFormat(raw string) (ExportFormat, error) {
+	switch raw {
+	case "jsonl", "json":
+		return ExportFormatJSONL, nil
+	case "proto", "binary":
+		return ExportFormatProto, nil
+	}
+	return 0, fmt.Errorf("pebble: unknown export format: %s", raw)
+}
+
+// Export writes the latest records to w. If recordType is empty, records of
+// all types are exported. Records are read from a snapshot, so writes are not
+// blocked during the export.
+func (backend *Backend) Export(ctx context.Context, recordType string, w io.Writer, format ExportFormat) error {
+	ctx, op := backend.telemetry.Start(ctx, "Export")
+	defer op.Complete()
+
+	var snapshot *pebble.Snapshot
+	err := backend.withReadOnlyTransaction(func(_ readOnlyTransaction) error {
+		snapshot = backend.db.NewSnapshot()
+		return nil
+	})
+	if err != nil {
+		return op.Failure(err)
+	}
+	defer snapshot.Close()
+
+	bw := bufio.NewWriter(w)
+	for record, err := range backend.iterateRecordsLocked(readOnlyTransaction{reader: snapshot, cipher: backend.cipher}, recordType) {
+		if err != nil {
+			return op.Failure(fmt.Errorf("pebble: error iterating over records: %w", err))
+		}
+
+		err = writeExportRecord(bw, record, format)
+		if err != nil {
+			return op.Failure(fmt.Errorf("pebble: error exporting record (type=%s id=%s): %w",
+				record.GetType(), record.GetId(), err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return op.Failure(context.Cause(ctx))
+		default:
+		}
+	}
+
+	err = bw.Flush()
+	if err != nil {
+		return op.Failure(fmt.Errorf("pebble: error writing export: %w", err))
+	}
+
+	return nil
+}
+
+// Import reads records from r and stores them. Imported records are assigned
+// new versions. The number of imported records is returned.
+func (backend *Backend) Import(ctx context.Context, r io.Reader, format ExportFormat) (int, error) {
+	ctx, op := backend.telemetry.Start(ctx, "Import")
+	defer op.Complete()
+
+	br := bufio.NewReader(r)
+	imported := 0
+	for records, err := range iterutil.ChunkWithError(readExportRecords(br, format), batchSize) {
+		if err != nil {
+			return imported, op.Failure(fmt.Errorf("pebble: error reading import: %w", err))
+		}
+
+		err = backend.withReadWriteTransaction(func(tx *readWriteTransaction) error {
+			err := backend.putRecordsLocked(tx, records)
+			if err != nil {
+				return err
+			}
+			tx.onCommit(func() { backend.onRecordChange.Broadcast(ctx) })
+			return nil
+		})
+		if err != nil {
+			return imported, op.Failure(err)
+		}
+		imported += len(records)
+
+		select {
+		case <-ctx.Done():
+			return imported, op.Failure(context.Cause(ctx))
+		default:
+		}
+	}
+
+	return imported, nil
+}
+
+func writeExportRecord(w *bufio.Writer, record *databrokerpb.Record, format ExportFormat) error {
+	switch format {
+	case ExportFormatJSONL:
+		data, err := protojson.Marshal(record)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(data, '\n'))
+		return err
+	case ExportFormatProto:
+		_, err := protodelim.MarshalTo(w, record)
+		return err
+	}
+	return fmt.Errorf("unknown export format: %d", format)
+}
+
+// readExportRecords returns an iterator over the records read from r.
+func readExportRecords(r *bufio.Reader, format ExportFormat) iterutil.ErrorSeq[*databrokerpb.Record] {
+	return func(yield func(*databrokerpb.Record, error) bool) {
+		for {
+			record := new(databrokerpb.Record)
+			switch format {
+			case ExportFormatJSONL:
+				line, err := r.ReadBytes('\n')
+				if errors.Is(err, io.EOF) && len(bytes.TrimSpace(line)) == 0 {
+					return
+				} else if err != nil && !errors.Is(err, io.EOF) {
+					yield(nil, err)
+					return
+				}
+
+				line = bytes.TrimSpace(line)
+				if len(line) == 0 {
+					continue
+				}
+
+				err = protojson.Unmarshal(line, record)
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+			case ExportFormatProto:
+				err := protodelim.UnmarshalFrom(r, record)
+				if errors.Is(err, io.EOF) {
+					return
+				} else if err != nil {
+					yield(nil, err)
+					return
+				}
+			default:
+				yield(nil, fmt.Errorf("unknown export format: %d", format))
+				return
+			}
+			if !yield(record, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package file
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/iterutil"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestExportImport(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []ExportFormat{ExportFormatJSONL, ExportFormatProto} {
+		src := New(noop.NewTracerProvider(), "memory://")
+		t.Cleanup(func() { _ = src.Close() })
+		_, err := src.Put(t.Context(), []*databrokerpb.Record{
+			{Type: "t1", Id: "r1", Data: protoutil.NewAnyString("v1")},
+			{Type: "t1", Id: "r2", Data: protoutil.NewAnyString("v2")},
+			{Type: "t2", Id: "r1", Data: protoutil.NewAnyString("v3")},
+		})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, src.Export(t.Context(), "t1", &buf, format))
+		if format == ExportFormatJSONL {
+			assert.Equal(t, 2, strings.Count(buf.String(), "\n"),
+				"should write a line per record")
+		}
+
+		dst := New(noop.NewTracerProvider(), "memory://")
+		t.Cleanup(func() { _ = dst.Close() })
+		imported, err := dst.Import(t.Context(), &buf, format)
+		require.NoError(t, err)
+		assert.Equal(t, 2, imported)
+
+		_, _, seq, err := dst.SyncLatest(t.Context(), "", nil)
+		require.NoError(t, err)
+		records, err := iterutil.CollectWithError(seq)
+		require.NoError(t, err)
+		if assert.Len(t, records, 2) {
+			assert.Equal(t, "r1", records[0].GetId())
+			assert.Equal(t, protoutil.NewAnyString("v1").GetValue(), records[0].GetData().GetValue())
+			assert.Equal(t, "r2", records[1].GetId())
+		}
+	}
+}
+
+func TestParseExportFormat(t *testing.T) {
+	t.Parallel()
+
+	format, err := ParseExportFormat("jsonl")
+	assert.NoError(t, err)
+	assert.Equal(t, ExportFormatJSONL, format)
+
+	format, err = ParseExportFormat("proto")
+	assert.NoError(t, err)
+	assert.Equal(t, ExportFormatProto, format)
+
+	_, err = ParseExportFormat("xml")
+	assert.EqualError(t, err, "pebble: unknown export format: xml")
+}
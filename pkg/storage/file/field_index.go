@@ -0,0 +1,206 @@
+package file
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// WithIndexedFields configures the backend to index the given fields of
+// records of the given type. Fields are dot-separated paths into the JSON
+// representation of the record data (e.g. "user_id"). Equals filters on an
+// indexed field are satisfied by the index instead of scanning every record.
+func WithIndexedFields(recordType string, fields ...string) Option {
+	return func(b *Backend) {
+		if b.indexedFields == nil {
+			b.indexedFields = make(map[string][]string)
+		}
+		indexedFields := append(b.indexedFields[recordType], fields...)
+		slices.Sort(indexedFields)
+		b.indexedFields[recordType] = slices.Compact(indexedFields)
+	}
+}
+
+func (backend *Backend) isIndexedField(recordType string, fields []string) bool {
+	return slices.Contains(backend.indexedFields[recordType], strings.Join(fields, "."))
+}
+
+func (backend *Backend) addRecordFieldIndexLocked(
+	w writer,
+	record *databrokerpb.Record,
+) error {
+	for _, field := range backend.indexedFields[record.GetType()] {
+		for _, value := range getRecordFieldValues(record, strings.Split(field, ".")) {
+			err := recordIndexByFieldKeySpace.set(w, record.GetType(), field, value, record.GetId())
+			if err != nil {
+				return fmt.Errorf("pebble: error setting record index by field: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (backend *Backend) deleteRecordFieldIndexLocked(
+	w writer,
+	record *databrokerpb.Record,
+) error {
+	for _, field := range backend.indexedFields[record.GetType()] {
+		for _, value := range getRecordFieldValues(record, strings.Split(field, ".")) {
+			err := recordIndexByFieldKeySpace.delete(w, record.GetType(), field, value, record.GetId())
+			if err != nil {
+				return fmt.Errorf("pebble: error deleting record index by field: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// initRecordFieldIndexLocked rebuilds the record field index if the indexed
+// fields have changed since the index was last built.
+func (backend *Backend) initRecordFieldIndexLocked(rw readerWriter) error {
+	indexedFields, err := json.Marshal(backend.indexedFields)
+	if err != nil {
+		return fmt.Errorf("pebble: error encoding indexed fields: %w", err)
+	}
+
+	existing, err := metadataKeySpace.getIndexedFields(rw)
+	if err == nil && bytes.Equal(existing, indexedFields) {
+		return nil
+	} else if err != nil && !isNotFound(err) {
+		return fmt.Errorf("pebble: error getting indexed fields: %w", err)
+	}
+
+	err = recordIndexByFieldKeySpace.deleteAll(rw)
+	if err != nil {
+		return fmt.Errorf("pebble: error deleting record index by field: %w", err)
+	}
+
+	for recordType := range backend.indexedFields {
+		for record, err := range recordKeySpace.iterate(rw, recordType) {
+			if err != nil {
+				return fmt.Errorf("pebble: error iterating over records: %w", err)
+			}
+
+			err = backend.addRecordFieldIndexLocked(rw, record)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = metadataKeySpace.setIndexedFields(rw, indexedFields)
+	if err != nil {
+		return fmt.Errorf("pebble: error setting indexed fields: %w", err)
+	}
+
+	return nil
+}
+
+func (backend *Backend) iterateRecordsForFieldLocked(
+	r reader,
+	recordType string,
+	fields []string,
+	value string,
+) iter.Seq2[*databrokerpb.Record, error] {
+	return func(yield func(*databrokerpb.Record, error) bool) {
+		for recordID, err := range recordIndexByFieldKeySpace.iterateIDs(r, recordType, strings.Join(fields, "."), value) {
+			if err != nil {
+				yield(nil, fmt.Errorf("pebble: error iterating over record index by field: %w", err))
+				return
+			}
+
+			record, err := recordKeySpace.get(r, recordType, recordID)
+			if isNotFound(err) {
+				continue
+			}
+			if !yield(record, err) {
+				return
+			}
+		}
+	}
+}
+
+// getRecordFieldValues returns the values at the given path in the record
+// data. Values are formatted the same way as equals filter expressions. If the
+// path refers to a list, a value is returned for each element.
+func getRecordFieldValues(record *databrokerpb.Record, fields []string) []string {
+	var msg proto.Message = record.GetData()
+	for {
+		data, ok := msg.(*anypb.Any)
+		if !ok {
+			break
+		}
+		var err error
+		msg, err = data.UnmarshalNew()
+		if err != nil {
+			return nil
+		}
+	}
+	if msg == nil {
+		return nil
+	}
+
+	var v *structpb.Value
+	switch msg := msg.(type) {
+	case *structpb.Value:
+		v = msg
+	case *structpb.Struct:
+		v = structpb.NewStructValue(msg)
+	default:
+		bs, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+		if err != nil {
+			return nil
+		}
+		v = new(structpb.Value)
+		err = protojson.Unmarshal(bs, v)
+		if err != nil {
+			return nil
+		}
+	}
+
+	for _, field := range fields {
+		v = v.GetStructValue().GetFields()[field]
+		if v == nil {
+			return nil
+		}
+	}
+
+	if vs := v.GetListValue(); vs != nil {
+		values := make([]string, 0, len(vs.GetValues()))
+		for _, v := range vs.GetValues() {
+			if value, ok := formatFieldValue(v); ok {
+				values = append(values, value)
+			}
+		}
+		return values
+	}
+
+	if value, ok := formatFieldValue(v); ok {
+		return []string{value}
+	}
+	return nil
+}
+
+func formatFieldValue(v *structpb.Value) (string, bool) {
+	switch vv := v.GetKind().(type) {
+	case *structpb.Value_BoolValue:
+		return fmt.Sprintf("%v", vv.BoolValue), true
+	case *structpb.Value_NullValue:
+		return fmt.Sprintf("%v", vv.NullValue), true
+	case *structpb.Value_NumberValue:
+		return fmt.Sprintf("%v", vv.NumberValue), true
+	case *structpb.Value_StringValue:
+		return vv.StringValue, true
+	}
+	return "", false
+}
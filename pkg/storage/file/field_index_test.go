@@ -0,0 +1,96 @@
+package file
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/iterutil"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+func TestGetRecordFieldValues(t *testing.T) {
+	t.Parallel()
+
+	data, err := structpb.NewStruct(map[string]any{
+		"user_id": "u1",
+		"nested":  map[string]any{"count": 3, "enabled": true},
+		"groups":  []any{"g1", "g2"},
+	})
+	require.NoError(t, err)
+	record := &databrokerpb.Record{Data: protoutil.NewAny(data)}
+
+	assert.Equal(t, []string{"u1"}, getRecordFieldValues(record, []string{"user_id"}))
+	assert.Equal(t, []string{"3"}, getRecordFieldValues(record, []string{"nested", "count"}))
+	assert.Equal(t, []string{"true"}, getRecordFieldValues(record, []string{"nested", "enabled"}))
+	assert.Equal(t, []string{"g1", "g2"}, getRecordFieldValues(record, []string{"groups"}))
+	assert.Empty(t, getRecordFieldValues(record, []string{"missing"}))
+	assert.Empty(t, getRecordFieldValues(&databrokerpb.Record{}, []string{"user_id"}))
+}
+
+func TestFieldIndex(t *testing.T) {
+	t.Parallel()
+
+	put := func(t *testing.T, backend *Backend, id, userID string) {
+		t.Helper()
+		data, err := structpb.NewStruct(map[string]any{"user_id": userID})
+		require.NoError(t, err)
+		_, err = backend.Put(t.Context(), []*databrokerpb.Record{
+			{Type: "session", Id: id, Data: protoutil.NewAny(data)},
+		})
+		require.NoError(t, err)
+	}
+	list := func(t *testing.T, backend *Backend, userID string) []string {
+		t.Helper()
+		_, _, seq, err := backend.SyncLatest(t.Context(), "session",
+			storage.EqualsFilterExpression{Fields: []string{"user_id"}, Value: userID})
+		require.NoError(t, err)
+		records, err := iterutil.CollectWithError(seq)
+		require.NoError(t, err)
+		var ids []string
+		for _, record := range records {
+			ids = append(ids, record.GetId())
+		}
+		return ids
+	}
+	indexedIDs := func(t *testing.T, backend *Backend, userID string) []string {
+		t.Helper()
+		ids, err := iterutil.CollectWithError(recordIndexByFieldKeySpace.iterateIDs(backend.db, "session", "user_id", userID))
+		require.NoError(t, err)
+		return ids
+	}
+
+	dir := t.TempDir()
+
+	// without an index, records are scanned
+	backend1 := New(noop.NewTracerProvider(), "file://"+dir)
+	put(t, backend1, "s1", "u1")
+	put(t, backend1, "s2", "u2")
+	assert.Equal(t, []string{"s1"}, list(t, backend1, "u1"))
+	require.NoError(t, backend1.Close())
+
+	// adding an index builds it from the existing records
+	backend2 := New(noop.NewTracerProvider(), "file://"+dir, WithIndexedFields("session", "user_id"))
+	t.Cleanup(func() { _ = backend2.Close() })
+	assert.Equal(t, []string{"s1"}, list(t, backend2, "u1"))
+	assert.Equal(t, []string{"s1"}, indexedIDs(t, backend2, "u1"))
+
+	// the index is maintained on writes
+	put(t, backend2, "s3", "u1")
+	put(t, backend2, "s2", "u1")
+	assert.Equal(t, []string{"s1", "s2", "s3"}, list(t, backend2, "u1"))
+	assert.Empty(t, indexedIDs(t, backend2, "u2"))
+
+	_, err := backend2.Put(t.Context(), []*databrokerpb.Record{
+		{Type: "session", Id: "s1", DeletedAt: timestamppb.Now()},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"s2", "s3"}, list(t, backend2, "u1"))
+	assert.Equal(t, []string{"s2", "s3"}, indexedIDs(t, backend2, "u1"))
+}
@@ -2,15 +2,14 @@ package file
 
 import (
 	"net/netip"
+	"slices"
 	"strings"
 
-	"github.com/gaissmai/bart"
-
 	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
 	"github.com/pomerium/pomerium/pkg/storage"
 )
 
-func recordMatches(record *databrokerpb.Record, filter storage.FilterExpression) bool {
+func (backend *Backend) recordMatches(record *databrokerpb.Record, filter storage.FilterExpression) bool {
 	if filter == nil {
 		return true
 	}
@@ -18,58 +17,83 @@ func recordMatches(record *databrokerpb.Record, filter storage.FilterExpression)
 	switch filter := filter.(type) {
 	case storage.AndFilterExpression:
 		for _, f := range filter {
-			if !recordMatches(record, f) {
+			if !backend.recordMatches(record, f) {
 				return false
 			}
 		}
 		return len(filter) > 0
 	case storage.OrFilterExpression:
 		for _, f := range filter {
-			if recordMatches(record, f) {
+			if backend.recordMatches(record, f) {
 				return true
 			}
 		}
 		return false
 	case storage.EqualsFilterExpression:
 		switch strings.Join(filter.Fields, ".") {
-		case "type":
-			return record.GetType() == filter.Value
-		case "id":
-			return record.GetId() == filter.Value
+		case "type", "id", "modified_at":
+			value, _ := storage.GetRecordFieldValue(record, filter.Fields)
+			return value == filter.Value
 		case "$index":
-			if prefix, err := netip.ParsePrefix(filter.Value); err == nil {
-				return recordMatchesIPPrefix(record, prefix)
-			} else if addr, err := netip.ParseAddr(filter.Value); err == nil {
-				return recordMatchesIPAddr(record, addr)
-			}
-			return false
+			return recordMatchesIndex(record, filter.Value)
 		default:
-			return false
+			return slices.Contains(getRecordFieldValues(record, filter.Fields), filter.Value)
+		}
+	case storage.NotEqualsFilterExpression:
+		if value, ok := storage.GetRecordFieldValue(record, filter.Fields); ok {
+			return storage.FilterExpressionMatchesValue(filter, value)
 		}
+		return !slices.Contains(getRecordFieldValues(record, filter.Fields), filter.Value)
+	case storage.PrefixFilterExpression, storage.ComparisonFilterExpression:
+		fields, _ := storage.FilterExpressionFields(filter)
+		if value, ok := storage.GetRecordFieldValue(record, fields); ok {
+			return storage.FilterExpressionMatchesValue(filter, value)
+		}
+		return slices.ContainsFunc(getRecordFieldValues(record, fields), func(value string) bool {
+			return storage.FilterExpressionMatchesValue(filter, value)
+		})
+	case storage.SearchFilterExpression:
+		return backend.recordMatchesSearch(record, filter.Query)
 	default:
 		return false
 	}
 }
 
-func recordMatchesIPPrefix(record *databrokerpb.Record, prefix netip.Prefix) bool {
-	cidr := storage.GetRecordIndexCIDR(record.GetData())
-	if cidr == nil {
-		return false
+// recordMatchesIndex returns true if one of the record's $index.cidr prefixes
+// contains the IP address or prefix value.
+func recordMatchesIndex(record *databrokerpb.Record, value string) bool {
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		prefix = prefix.Masked()
+		return slices.ContainsFunc(storage.GetRecordIndexCIDRs(record.GetData()), func(cidr netip.Prefix) bool {
+			return cidr.Bits() <= prefix.Bits() && cidr.Contains(prefix.Addr())
+		})
+	} else if addr, err := netip.ParseAddr(value); err == nil {
+		return slices.ContainsFunc(storage.GetRecordIndexCIDRs(record.GetData()), func(cidr netip.Prefix) bool {
+			return cidr.Contains(addr)
+		})
 	}
-
-	var tbl bart.Table[struct{}]
-	tbl.Insert(*cidr, struct{}{})
-	_, ok := tbl.LookupPrefix(prefix)
-	return ok
+	return false
 }
 
-func recordMatchesIPAddr(record *databrokerpb.Record, addr netip.Addr) bool {
-	cidr := storage.GetRecordIndexCIDR(record.GetData())
-	if cidr == nil {
-		return false
+// usesIndex returns true if iterating over the records matching the filter
+// uses an index rather than scanning every record of the type.
+func (backend *Backend) usesIndex(recordType string, filter storage.FilterExpression) bool {
+	switch filter := filter.(type) {
+	case storage.AndFilterExpression:
+		return slices.ContainsFunc(filter, func(f storage.FilterExpression) bool {
+			return backend.usesIndex(recordType, f)
+		})
+	case storage.OrFilterExpression:
+		return len(filter) > 0 && !slices.ContainsFunc(filter, func(f storage.FilterExpression) bool {
+			return !backend.usesIndex(recordType, f)
+		})
+	case storage.EqualsFilterExpression:
+		return slices.Equal(filter.Fields, []string{"id"}) ||
+			slices.Equal(filter.Fields, []string{"$index"}) ||
+			(recordType != "" && backend.isIndexedField(recordType, filter.Fields))
+	case storage.SearchFilterExpression:
+		_, ok := backend.searchFields[recordType]
+		return ok && len(storage.TokenizeSearchText(filter.Query)) > 0
 	}
-	var tbl bart.Table[struct{}]
-	tbl.Insert(*cidr, struct{}{})
-	_, ok := tbl.Lookup(addr)
-	return ok
+	return false
 }
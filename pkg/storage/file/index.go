@@ -26,6 +26,13 @@ type recordCIDRNode struct {
 	prefix     netip.Prefix
 }
 
+func compareRecordCIDRNodes(a, b recordCIDRNode) int {
+	return cmp.Or(
+		cmp.Compare(a.recordType, b.recordType),
+		cmp.Compare(a.recordID, b.recordID),
+	)
+}
+
 type recordCIDRIndex struct {
 	table bart.Table[[]recordCIDRNode]
 }
@@ -36,6 +43,9 @@ func newRecordCIDRIndex() *recordCIDRIndex {
 
 func (idx *recordCIDRIndex) add(node recordCIDRNode) {
 	idx.table.Update(node.prefix, func(nodes []recordCIDRNode, _ bool) []recordCIDRNode {
+		if slices.Contains(nodes, node) {
+			return nodes
+		}
 		return append(nodes, node)
 	})
 }
@@ -2,10 +2,12 @@ package file
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"iter"
 	"net/netip"
 	"slices"
+	"time"
 
 	"github.com/pomerium/pomerium/pkg/contextutil"
 	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
@@ -48,11 +50,20 @@ func (backend *Backend) iterateChangedRecords(
 		for {
 			var records []*databrokerpb.Record
 			err = backend.withReadOnlyTransaction(func(tx readOnlyTransaction) error {
+				// record changes may have been removed by the retention policy
+				// since the last batch was read
+				if backend.earliestRecordVersion > 0 && afterRecordVersion < (backend.earliestRecordVersion-1) {
+					return storage.ErrInvalidRecordVersion
+				}
+
 				var err error
 				records, err = listChangedRecordsAfter(tx, recordType, afterRecordVersion)
 				return err
 			})
-			if err != nil {
+			if errors.Is(err, storage.ErrInvalidRecordVersion) {
+				yield(nil, err)
+				return
+			} else if err != nil {
 				yield(nil, fmt.Errorf("pebble: error listing changed records: %w", err))
 				return
 			}
@@ -78,12 +89,14 @@ func (backend *Backend) iterateChangedRecords(
 				break
 			}
 
+			start := time.Now()
 			select {
 			case <-ctx.Done():
 				yield(nil, context.Cause(ctx))
 				return
 			case <-changed:
 			}
+			backend.syncWaitDuration.Record(ctx, time.Since(start).Seconds())
 		}
 	}
 }
@@ -92,6 +105,51 @@ func (backend *Backend) iterateLatestRecords(
 	ctx context.Context,
 	recordType string,
 	filter storage.FilterExpression,
+	afterRecordType, afterRecordID string,
+) storage.RecordIterator {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx, backend.iteratorCanceler.Context())
+	return func(yield func(*databrokerpb.Record, error) bool) {
+		defer cancel(nil)
+
+		for {
+			var records []*databrokerpb.Record
+			err := backend.withReadOnlyTransaction(func(tx readOnlyTransaction) error {
+				var err error
+				records, err = backend.listLatestRecordsLocked(tx, recordType, filter, afterRecordType, afterRecordID)
+				return err
+			})
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, record := range records {
+				if !yield(record, nil) {
+					return
+				}
+
+				select {
+				case <-ctx.Done():
+					yield(nil, context.Cause(ctx))
+					return
+				default:
+				}
+			}
+
+			// a partial batch means there are no more records
+			if len(records) < batchSize {
+				return
+			}
+			afterRecordType, afterRecordID = records[len(records)-1].GetType(), records[len(records)-1].GetId()
+		}
+	}
+}
+
+func (backend *Backend) iterateLatestRecordsByVersion(
+	ctx context.Context,
+	recordType string,
+	filter storage.FilterExpression,
+	descending bool,
 ) storage.RecordIterator {
 	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx, backend.iteratorCanceler.Context())
 	return func(yield func(*databrokerpb.Record, error) bool) {
@@ -100,7 +158,7 @@ func (backend *Backend) iterateLatestRecords(
 		var records []*databrokerpb.Record
 		err := backend.withReadOnlyTransaction(func(tx readOnlyTransaction) error {
 			var err error
-			records, err = backend.listLatestRecordsLocked(tx, recordType, filter)
+			records, err = backend.listLatestRecordsByVersionLocked(tx, recordType, filter, descending)
 			return err
 		})
 		if err != nil {
@@ -133,6 +191,17 @@ func (backend *Backend) iterateRecordsLocked(
 	return recordKeySpace.iterateAll(r)
 }
 
+func (backend *Backend) iterateRecordsAfterLocked(
+	r reader,
+	recordType string,
+	afterRecordType, afterRecordID string,
+) iter.Seq2[*databrokerpb.Record, error] {
+	if afterRecordType == "" && afterRecordID == "" {
+		return backend.iterateRecordsLocked(r, recordType)
+	}
+	return recordKeySpace.iterateAfter(r, recordType, afterRecordType, afterRecordID)
+}
+
 func (backend *Backend) iterateRecordsForIDLocked(
 	r reader,
 	recordType string,
@@ -153,9 +222,7 @@ func (backend *Backend) iterateRecordsForIDLocked(
 	var seqs []iter.Seq2[*databrokerpb.Record, error]
 	for recordType, err := range recordKeySpace.iterateTypes(r) {
 		if err != nil {
-			return func(yield func(*databrokerpb.Record, error) bool) {
-				yield(nil, err)
-			}
+			return iterutil.Error[*databrokerpb.Record](err)
 		}
 		seqs = append(seqs, backend.iterateRecordsForIDLocked(r, recordType, recordID))
 	}
@@ -171,6 +238,8 @@ func (backend *Backend) iterateRecordsForIndexLocked(
 	if prefix, err := netip.ParsePrefix(indexValue); err == nil {
 		return func(yield func(*databrokerpb.Record, error) bool) {
 			nodes := backend.recordCIDRIndex.lookupPrefix(recordType, prefix)
+			// records must be sorted for intersections and unions
+			slices.SortFunc(nodes, compareRecordCIDRNodes)
 			for _, node := range nodes {
 				record, err := recordKeySpace.get(r, node.recordType, node.recordID)
 				if isNotFound(err) {
@@ -184,6 +253,8 @@ func (backend *Backend) iterateRecordsForIndexLocked(
 	} else if addr, err := netip.ParseAddr(indexValue); err == nil {
 		return func(yield func(*databrokerpb.Record, error) bool) {
 			nodes := backend.recordCIDRIndex.lookupAddr(recordType, addr)
+			// records must be sorted for intersections and unions
+			slices.SortFunc(nodes, compareRecordCIDRNodes)
 			for _, node := range nodes {
 				record, err := recordKeySpace.get(r, node.recordType, node.recordID)
 				if isNotFound(err) {
@@ -226,15 +297,31 @@ func (backend *Backend) iterateRecordsForFilterLocked(
 			return backend.iterateRecordsForIDLocked(r, recordType, filter.Value)
 		case slices.Equal(filter.Fields, []string{"$index"}):
 			return backend.iterateRecordsForIndexLocked(r, recordType, filter.Value)
+		case recordType != "" && backend.isIndexedField(recordType, filter.Fields):
+			return backend.iterateRecordsForFieldLocked(r, recordType, filter.Fields, filter.Value)
 		default:
 			return iterutil.FilterWithError(backend.iterateRecordsLocked(r, recordType),
 				func(record *databrokerpb.Record) bool {
-					return recordMatches(record, filter)
+					return backend.recordMatches(record, filter)
 				})
 		}
-	default:
-		return func(yield func(*databrokerpb.Record, error) bool) {
-			yield(nil, fmt.Errorf("unsupported filter type: %T", filter))
+	case storage.NotEqualsFilterExpression, storage.PrefixFilterExpression, storage.ComparisonFilterExpression:
+		return iterutil.FilterWithError(backend.iterateRecordsLocked(r, recordType),
+			func(record *databrokerpb.Record) bool {
+				return backend.recordMatches(record, filter)
+			})
+	case storage.SearchFilterExpression:
+		seq := backend.iterateRecordsLocked(r, recordType)
+		if _, ok := backend.searchFields[recordType]; ok {
+			if tokens := storage.TokenizeSearchText(filter.Query); len(tokens) > 0 {
+				seq = backend.iterateRecordsForSearchLocked(r, recordType, tokens)
+			}
 		}
+		return iterutil.FilterWithError(seq,
+			func(record *databrokerpb.Record) bool {
+				return backend.recordMatches(record, filter)
+			})
+	default:
+		return iterutil.Error[*databrokerpb.Record](fmt.Errorf("unsupported filter type: %T", filter))
 	}
 }
@@ -0,0 +1,50 @@
+package file
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/pebbleutil"
+)
+
+func TestListChangedRecordsAfter(t *testing.T) {
+	t.Parallel()
+
+	db := pebbleutil.MustOpenMemory(nil)
+	for i := range 3 * batchSize {
+		record := &databrokerpb.Record{
+			Version: uint64(i + 1),
+			Type:    fmt.Sprintf("t%d", i%2),
+			Id:      fmt.Sprintf("r%d", i),
+		}
+		require.NoError(t, recordChangeKeySpace.set(db, record))
+		require.NoError(t, recordChangeIndexByTypeKeySpace.set(db, record.GetType(), record.GetVersion()))
+	}
+
+	records, err := listChangedRecordsAfter(db, "", 0)
+	require.NoError(t, err)
+	assert.Len(t, records, batchSize, "should limit the page size")
+	assert.Equal(t, uint64(1), records[0].GetVersion())
+
+	var versions []uint64
+	for after := uint64(0); ; {
+		records, err := listChangedRecordsAfter(db, "t1", after)
+		require.NoError(t, err)
+		if len(records) == 0 {
+			break
+		}
+		assert.LessOrEqual(t, len(records), batchSize)
+		for _, record := range records {
+			assert.Equal(t, "t1", record.GetType())
+			versions = append(versions, record.GetVersion())
+			after = record.GetVersion()
+		}
+	}
+	assert.Len(t, versions, 3*batchSize/2)
+	assert.Equal(t, uint64(2), versions[0])
+	assert.Equal(t, uint64(3*batchSize), versions[len(versions)-1])
+}
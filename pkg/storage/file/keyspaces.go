@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"iter"
+	"net/netip"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/pebble/v2"
@@ -26,6 +28,9 @@ const (
 	prefixRecordKeySpace
 	prefixRecordIndexByTypeVersionKeySpace
 	prefixRegistryServiceKeySpace
+	prefixRecordIndexByCIDRKeySpace
+	prefixRecordIndexByFieldKeySpace
+	prefixRecordIndexBySearchTokenKeySpace
 )
 
 // lease:
@@ -36,6 +41,28 @@ type leaseKeySpaceType struct{}
 
 var leaseKeySpace leaseKeySpaceType
 
+type leaseNode struct {
+	leaseName string
+	leaseID   string
+	expiresAt time.Time
+}
+
+func (ks leaseKeySpaceType) bounds() (lowerBound, upperBound []byte) {
+	prefix := []byte{prefixLeaseKeySpace}
+	return prefix, pebbleutil.PrefixToUpperBound(prefix)
+}
+
+func (ks leaseKeySpaceType) decodeKey(data []byte) (string, error) {
+	if !bytes.HasPrefix(data, []byte{prefixLeaseKeySpace}) {
+		return "", fmt.Errorf("invalid key, missing lease prefix")
+	}
+	return string(data[1:]), nil
+}
+
+func (ks leaseKeySpaceType) delete(w writer, leaseName string) error {
+	return pebbleDelete(w, ks.encodeKey(leaseName))
+}
+
 func (ks leaseKeySpaceType) encodeKey(leaseName string) []byte {
 	return encodeSimpleKey(prefixLeaseKeySpace, []byte(leaseName))
 }
@@ -52,6 +79,30 @@ func (ks leaseKeySpaceType) get(r reader, leaseName string) (leaseID string, exp
 	return value.id, value.expiresAt, nil
 }
 
+func (ks leaseKeySpaceType) iterate(r reader) iter.Seq2[leaseNode, error] {
+	return func(yield func(leaseNode, error) bool) {
+		opts := &pebble.IterOptions{}
+		opts.LowerBound, opts.UpperBound = ks.bounds()
+
+		for node, err := range pebbleutil.Iterate(r, opts, func(it *pebble.Iterator) (node leaseNode, err error) {
+			node.leaseName, err = ks.decodeKey(it.Key())
+			if err != nil {
+				return node, err
+			}
+			value, err := decodeLeaseValue(it.Value())
+			if err != nil {
+				return node, err
+			}
+			node.leaseID, node.expiresAt = value.id, value.expiresAt
+			return node, nil
+		}) {
+			if !yield(node, err) {
+				return
+			}
+		}
+	}
+}
+
 func (ks leaseKeySpaceType) set(w writer, leaseName, leaseID string, expiresAt time.Time) error {
 	return pebbleSet(w, ks.encodeKey(leaseName), ks.encodeValue(leaseID, expiresAt))
 }
@@ -69,6 +120,15 @@ func (ks leaseKeySpaceType) set(w writer, leaseName, leaseID string, expiresAt t
 //   checkpointRecordVersion:
 //     key: prefix-metadata | 0x04
 //     value: {checkpointRecordVersion as uint64}
+//   migrationCursor:
+//     key: prefix-metadata | 0x05
+//     value: {migration as uint64} | {cursor as bytes}
+//   indexedFields:
+//     key: prefix-metadata | 0x06
+//     value: {indexedFields as json}
+//   searchFields:
+//     key: prefix-metadata | 0x07
+//     value: {searchFields as json}
 
 type metadataKeySpaceType struct{}
 
@@ -90,6 +150,55 @@ func (ks metadataKeySpaceType) encodeCheckpointRecordVersionKey() []byte {
 	return encodeSimpleKey(prefixMetadataKeySpace, []byte{0x04})
 }
 
+func (ks metadataKeySpaceType) encodeMigrationCursorKey() []byte {
+	return encodeSimpleKey(prefixMetadataKeySpace, []byte{0x05})
+}
+
+func (ks metadataKeySpaceType) encodeIndexedFieldsKey() []byte {
+	return encodeSimpleKey(prefixMetadataKeySpace, []byte{0x06})
+}
+
+func (ks metadataKeySpaceType) encodeSearchFieldsKey() []byte {
+	return encodeSimpleKey(prefixMetadataKeySpace, []byte{0x07})
+}
+
+func (ks metadataKeySpaceType) deleteMigrationCursor(w writer) error {
+	return pebbleDelete(w, ks.encodeMigrationCursorKey())
+}
+
+func (ks metadataKeySpaceType) getMigrationCursor(r reader) (migration uint64, cursor []byte, err error) {
+	value, err := pebbleGet(r, ks.encodeMigrationCursorKey(), func(data []byte) ([]byte, error) {
+		if len(data) < 8 {
+			return nil, fmt.Errorf("invalid migration cursor")
+		}
+		return bytes.Clone(data), nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	migration, err = decodeUint64(value[:8])
+	if err != nil {
+		return 0, nil, err
+	}
+	return migration, value[8:], nil
+}
+
+func (ks metadataKeySpaceType) setMigrationCursor(w writer, migration uint64, cursor []byte) error {
+	return pebbleSet(w, ks.encodeMigrationCursorKey(), append(encodeUint64(migration), cursor...))
+}
+
+func (ks metadataKeySpaceType) getIndexedFields(r reader) ([]byte, error) {
+	return pebbleGet(r, ks.encodeIndexedFieldsKey(), func(data []byte) ([]byte, error) {
+		return bytes.Clone(data), nil
+	})
+}
+
+func (ks metadataKeySpaceType) getSearchFields(r reader) ([]byte, error) {
+	return pebbleGet(r, ks.encodeSearchFieldsKey(), func(data []byte) ([]byte, error) {
+		return bytes.Clone(data), nil
+	})
+}
+
 func (ks metadataKeySpaceType) getServerVersion(r reader) (uint64, error) {
 	return pebbleGet(r, ks.encodeServerVersionKey(), decodeUint64)
 }
@@ -106,6 +215,14 @@ func (ks metadataKeySpaceType) getCheckpointRecordVersion(r reader) (uint64, err
 	return pebbleGet(r, ks.encodeCheckpointRecordVersionKey(), decodeUint64)
 }
 
+func (ks metadataKeySpaceType) setIndexedFields(w writer, indexedFields []byte) error {
+	return pebbleSet(w, ks.encodeIndexedFieldsKey(), indexedFields)
+}
+
+func (ks metadataKeySpaceType) setSearchFields(w writer, searchFields []byte) error {
+	return pebbleSet(w, ks.encodeSearchFieldsKey(), searchFields)
+}
+
 func (ks metadataKeySpaceType) setServerVersion(w writer, serverVersion uint64) error {
 	return pebbleSet(w, ks.encodeServerVersionKey(), encodeUint64(serverVersion))
 }
@@ -218,8 +335,8 @@ func (ks recordKeySpaceType) decodeKey(data []byte) (recordType, recordID string
 	return string(segments[0]), string(segments[1]), nil
 }
 
-func (ks recordKeySpaceType) decodeValue(data []byte) (*databrokerpb.Record, error) {
-	return decodeProto[databrokerpb.Record](data)
+func (ks recordKeySpaceType) decodeValue(r reader, data []byte) (*databrokerpb.Record, error) {
+	return decodeRecordValue(r, data)
 }
 
 func (ks recordKeySpaceType) delete(w writer, recordType, recordID string) error {
@@ -234,12 +351,14 @@ func (ks recordKeySpaceType) encodeKey(recordType, recordID string) []byte {
 	return encodeJoinedKey(prefixRecordKeySpace, []byte(recordType), []byte(recordID))
 }
 
-func (ks recordKeySpaceType) encodeValue(record *databrokerpb.Record) []byte {
-	return encodeProto(record)
+func (ks recordKeySpaceType) encodeValue(w writer, record *databrokerpb.Record) []byte {
+	return encodeRecordValue(w, record)
 }
 
 func (ks recordKeySpaceType) get(r reader, recordType, recordID string) (*databrokerpb.Record, error) {
-	return pebbleGet(r, ks.encodeKey(recordType, recordID), ks.decodeValue)
+	return pebbleGet(r, ks.encodeKey(recordType, recordID), func(data []byte) (*databrokerpb.Record, error) {
+		return ks.decodeValue(r, data)
+	})
 }
 
 func (ks recordKeySpaceType) iterate(r reader, recordType string) iter.Seq2[*databrokerpb.Record, error] {
@@ -252,8 +371,52 @@ func (ks recordKeySpaceType) iterate(r reader, recordType string) iter.Seq2[*dat
 				return
 			}
 
-			record, err := ks.decodeValue(value)
+			record, err := ks.decodeValue(r, value)
+			if isRecordDecryptionError(err) {
+				yield(nil, err)
+				return
+			} else if err != nil {
+				// skip invalid records
+				continue
+			}
+			if !yield(record, nil) {
+				return
+			}
+		}
+	}
+}
+
+// iterateAfter iterates over the records that sort after the record with the
+// given type and id. If recordType is not empty, only records of that type are
+// returned.
+func (ks recordKeySpaceType) iterateAfter(r reader, recordType, afterRecordType, afterRecordID string) iter.Seq2[*databrokerpb.Record, error] {
+	return func(yield func(*databrokerpb.Record, error) bool) {
+		opts := new(pebble.IterOptions)
+		if recordType != "" {
+			opts.LowerBound, opts.UpperBound = ks.boundsForRecordType(recordType)
+		} else {
+			opts.LowerBound, opts.UpperBound = ks.bounds()
+		}
+		// the smallest key following the key of the last record
+		after := append(ks.encodeKey(afterRecordType, afterRecordID), 0x00)
+		if bytes.Compare(after, opts.LowerBound) > 0 {
+			opts.LowerBound = after
+		}
+		if bytes.Compare(opts.LowerBound, opts.UpperBound) >= 0 {
+			return
+		}
+
+		for value, err := range pebbleutil.IterateValues(r, opts) {
 			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			record, err := ks.decodeValue(r, value)
+			if isRecordDecryptionError(err) {
+				yield(nil, err)
+				return
+			} else if err != nil {
 				// skip invalid records
 				continue
 			}
@@ -274,8 +437,11 @@ func (ks recordKeySpaceType) iterateAll(r reader) iter.Seq2[*databrokerpb.Record
 				return
 			}
 
-			record, err := ks.decodeValue(value)
-			if err != nil {
+			record, err := ks.decodeValue(r, value)
+			if isRecordDecryptionError(err) {
+				yield(nil, err)
+				return
+			} else if err != nil {
 				// skip invalid records
 				continue
 			}
@@ -286,6 +452,26 @@ func (ks recordKeySpaceType) iterateAll(r reader) iter.Seq2[*databrokerpb.Record
 	}
 }
 
+func (ks recordKeySpaceType) countByType(r reader) (map[string]int64, error) {
+	opts := new(pebble.IterOptions)
+	opts.LowerBound, opts.UpperBound = ks.bounds()
+
+	counts := make(map[string]int64)
+	for key, err := range pebbleutil.IterateKeys(r, opts) {
+		if err != nil {
+			return nil, err
+		}
+
+		recordType, _, err := ks.decodeKey(key)
+		if err != nil {
+			// skip invalid keys
+			continue
+		}
+		counts[recordType]++
+	}
+	return counts, nil
+}
+
 func (ks recordKeySpaceType) iterateTypes(r reader) iter.Seq2[string, error] {
 	return func(yield func(string, error) bool) {
 		opts := new(pebble.IterOptions)
@@ -318,7 +504,204 @@ func (ks recordKeySpaceType) iterateTypes(r reader) iter.Seq2[string, error] {
 }
 
 func (ks recordKeySpaceType) set(w writer, record *databrokerpb.Record) error {
-	return pebbleSet(w, ks.encodeKey(record.GetType(), record.GetId()), ks.encodeValue(record))
+	return pebbleSet(w, ks.encodeKey(record.GetType(), record.GetId()), ks.encodeValue(w, record))
+}
+
+// record-index-by-cidr:
+//   keys: prefix-record-index-by-cidr | {recordType as bytes} | 0x00 | {recordID as bytes}
+//   values: {prefixes as comma-separated bytes}
+
+type recordIndexByCIDRKeySpaceType struct{}
+
+var recordIndexByCIDRKeySpace recordIndexByCIDRKeySpaceType
+
+func (ks recordIndexByCIDRKeySpaceType) bounds() ([]byte, []byte) {
+	prefix := []byte{prefixRecordIndexByCIDRKeySpace}
+	return prefix, pebbleutil.PrefixToUpperBound(prefix)
+}
+
+func (ks recordIndexByCIDRKeySpaceType) decodeKey(data []byte) (recordType, recordID string, err error) {
+	segments, err := decodeJoinedKey(data, prefixRecordIndexByCIDRKeySpace, 2)
+	if err != nil {
+		return "", "", err
+	}
+	return string(segments[0]), string(segments[1]), nil
+}
+
+func (ks recordIndexByCIDRKeySpaceType) decodeValue(data []byte) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for value := range strings.SplitSeq(string(data), ",") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes, nil
+}
+
+func (ks recordIndexByCIDRKeySpaceType) encodeKey(recordType, recordID string) []byte {
+	return encodeJoinedKey(prefixRecordIndexByCIDRKeySpace,
+		[]byte(recordType),
+		[]byte(recordID))
+}
+
+func (ks recordIndexByCIDRKeySpaceType) encodeValue(prefixes []netip.Prefix) []byte {
+	var b []byte
+	for i, prefix := range prefixes {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = prefix.AppendTo(b)
+	}
+	return b
+}
+
+func (ks recordIndexByCIDRKeySpaceType) delete(w writer, recordType, recordID string) error {
+	return pebbleDelete(w, ks.encodeKey(recordType, recordID))
+}
+
+func (recordIndexByCIDRKeySpaceType) deleteAll(w writer) error {
+	return pebbleDeletePrefix(w, []byte{prefixRecordIndexByCIDRKeySpace})
+}
+
+// iterate iterates over every node in the index. Records with multiple
+// prefixes have a node for each prefix.
+func (ks recordIndexByCIDRKeySpaceType) iterate(r reader) iter.Seq2[recordCIDRNode, error] {
+	return func(yield func(recordCIDRNode, error) bool) {
+		opts := &pebble.IterOptions{}
+		opts.LowerBound, opts.UpperBound = ks.bounds()
+
+		for nodes, err := range pebbleutil.Iterate(r, opts, func(it *pebble.Iterator) ([]recordCIDRNode, error) {
+			recordType, recordID, err := ks.decodeKey(it.Key())
+			if err != nil {
+				return nil, err
+			}
+			prefixes, err := ks.decodeValue(it.Value())
+			if err != nil {
+				return nil, err
+			}
+			nodes := make([]recordCIDRNode, len(prefixes))
+			for i, prefix := range prefixes {
+				nodes[i] = recordCIDRNode{recordType: recordType, recordID: recordID, prefix: prefix}
+			}
+			return nodes, nil
+		}) {
+			if err != nil {
+				yield(recordCIDRNode{}, err)
+				return
+			}
+			for _, node := range nodes {
+				if !yield(node, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (ks recordIndexByCIDRKeySpaceType) set(w writer, recordType, recordID string, prefixes []netip.Prefix) error {
+	return pebbleSet(w, ks.encodeKey(recordType, recordID), ks.encodeValue(prefixes))
+}
+
+// record-index-by-field:
+//   keys: prefix-record-index-by-field | {recordType as bytes} | 0x00 | {field as bytes} | 0x00 | {value as bytes} | 0x00 | {recordID as bytes}
+//   values: empty
+
+type recordIndexByFieldKeySpaceType struct{}
+
+var recordIndexByFieldKeySpace recordIndexByFieldKeySpaceType
+
+func (ks recordIndexByFieldKeySpaceType) bounds(recordType, field, value string) ([]byte, []byte) {
+	prefix := encodeJoinedKey(prefixRecordIndexByFieldKeySpace,
+		[]byte(recordType),
+		[]byte(field),
+		[]byte(value),
+		[]byte{})
+	return prefix, pebbleutil.PrefixToUpperBound(prefix)
+}
+
+func (ks recordIndexByFieldKeySpaceType) encodeKey(recordType, field, value, recordID string) []byte {
+	return encodeJoinedKey(prefixRecordIndexByFieldKeySpace,
+		[]byte(recordType),
+		[]byte(field),
+		[]byte(value),
+		[]byte(recordID))
+}
+
+func (ks recordIndexByFieldKeySpaceType) delete(w writer, recordType, field, value, recordID string) error {
+	return pebbleDelete(w, ks.encodeKey(recordType, field, value, recordID))
+}
+
+func (recordIndexByFieldKeySpaceType) deleteAll(w writer) error {
+	return pebbleDeletePrefix(w, []byte{prefixRecordIndexByFieldKeySpace})
+}
+
+// iterateIDs iterates over the ids of the records with the given field value,
+// in id order.
+func (ks recordIndexByFieldKeySpaceType) iterateIDs(r reader, recordType, field, value string) iter.Seq2[string, error] {
+	opts := &pebble.IterOptions{}
+	opts.LowerBound, opts.UpperBound = ks.bounds(recordType, field, value)
+	return pebbleutil.Iterate(r, opts, func(it *pebble.Iterator) (string, error) {
+		return string(it.Key()[len(opts.LowerBound):]), nil
+	})
+}
+
+func (ks recordIndexByFieldKeySpaceType) set(w writer, recordType, field, value, recordID string) error {
+	return pebbleSet(w, ks.encodeKey(recordType, field, value, recordID), nil)
+}
+
+// record-index-by-search-token:
+//   keys: prefix-record-index-by-search-token | {recordType as bytes} | 0x00 | {token suffix as bytes} | 0x00 | {recordID as bytes}
+//   values: empty
+
+type recordIndexBySearchTokenKeySpaceType struct{}
+
+var recordIndexBySearchTokenKeySpace recordIndexBySearchTokenKeySpaceType
+
+func (ks recordIndexBySearchTokenKeySpaceType) bounds(recordType, tokenPrefix string) ([]byte, []byte) {
+	prefix := encodeJoinedKey(prefixRecordIndexBySearchTokenKeySpace,
+		[]byte(recordType),
+		[]byte(tokenPrefix))
+	return prefix, pebbleutil.PrefixToUpperBound(prefix)
+}
+
+func (ks recordIndexBySearchTokenKeySpaceType) decodeKey(data []byte) (recordType, token, recordID string, err error) {
+	segments, err := decodeJoinedKey(data, prefixRecordIndexBySearchTokenKeySpace, 3)
+	if err != nil {
+		return "", "", "", err
+	}
+	return string(segments[0]), string(segments[1]), string(segments[2]), nil
+}
+
+func (ks recordIndexBySearchTokenKeySpaceType) encodeKey(recordType, token, recordID string) []byte {
+	return encodeJoinedKey(prefixRecordIndexBySearchTokenKeySpace,
+		[]byte(recordType),
+		[]byte(token),
+		[]byte(recordID))
+}
+
+func (ks recordIndexBySearchTokenKeySpaceType) delete(w writer, recordType, token, recordID string) error {
+	return pebbleDelete(w, ks.encodeKey(recordType, token, recordID))
+}
+
+func (recordIndexBySearchTokenKeySpaceType) deleteAll(w writer) error {
+	return pebbleDeletePrefix(w, []byte{prefixRecordIndexBySearchTokenKeySpace})
+}
+
+// iterateIDs iterates over the ids of the records with a token that starts
+// with the given prefix. Ids are not sorted and may be repeated.
+func (ks recordIndexBySearchTokenKeySpaceType) iterateIDs(r reader, recordType, tokenPrefix string) iter.Seq2[string, error] {
+	opts := &pebble.IterOptions{}
+	opts.LowerBound, opts.UpperBound = ks.bounds(recordType, tokenPrefix)
+	return pebbleutil.Iterate(r, opts, func(it *pebble.Iterator) (string, error) {
+		_, _, recordID, err := ks.decodeKey(it.Key())
+		return recordID, err
+	})
+}
+
+func (ks recordIndexBySearchTokenKeySpaceType) set(w writer, recordType, token, recordID string) error {
+	return pebbleSet(w, ks.encodeKey(recordType, token, recordID), nil)
 }
 
 // record-index-by-type-version:
@@ -334,6 +717,21 @@ func (ks recordIndexByTypeVersionKeySpaceType) bounds(recordType string) ([]byte
 	return prefix, pebbleutil.PrefixToUpperBound(prefix)
 }
 
+func (ks recordIndexByTypeVersionKeySpaceType) decodeKey(data []byte) (recordType string, version uint64, err error) {
+	segments, err := decodeJoinedKey(data, prefixRecordIndexByTypeVersionKeySpace, 2)
+	if err != nil {
+		return "", 0, err
+	}
+
+	recordType = string(segments[0])
+	version, err = decodeUint64(segments[1])
+	if err != nil {
+		return "", 0, err
+	}
+
+	return recordType, version, nil
+}
+
 func (ks recordIndexByTypeVersionKeySpaceType) decodeValue(data []byte) string {
 	return string(data)
 }
@@ -356,6 +754,34 @@ func (recordIndexByTypeVersionKeySpaceType) deleteAll(w writer) error {
 	return pebbleDeletePrefix(w, []byte{prefixRecordIndexByTypeVersionKeySpace})
 }
 
+func (ks recordIndexByTypeVersionKeySpaceType) iterateAll(r reader) iter.Seq2[*databrokerpb.Record, error] {
+	return func(yield func(*databrokerpb.Record, error) bool) {
+		opts := &pebble.IterOptions{}
+		opts.LowerBound = []byte{prefixRecordIndexByTypeVersionKeySpace}
+		opts.UpperBound = pebbleutil.PrefixToUpperBound(opts.LowerBound)
+
+		for record, err := range pebbleutil.Iterate(r, opts, func(it *pebble.Iterator) (*databrokerpb.Record, error) {
+			recordType, version, err := ks.decodeKey(it.Key())
+			if err != nil {
+				return nil, err
+			}
+			return &databrokerpb.Record{Type: recordType, Id: ks.decodeValue(it.Value()), Version: version}, nil
+		}) {
+			if !yield(record, err) {
+				return
+			}
+		}
+	}
+}
+
+func (ks recordIndexByTypeVersionKeySpaceType) iterateIDs(r reader, recordType string) iter.Seq2[string, error] {
+	opts := &pebble.IterOptions{}
+	opts.LowerBound, opts.UpperBound = ks.bounds(recordType)
+	return pebbleutil.Iterate(r, opts, func(it *pebble.Iterator) (string, error) {
+		return ks.decodeValue(it.Value()), nil
+	})
+}
+
 func (ks recordIndexByTypeVersionKeySpaceType) iterateIDsReversed(r reader, recordType string) iter.Seq2[string, error] {
 	return func(yield func(string, error) bool) {
 		opts := &pebble.IterOptions{}
@@ -397,8 +823,8 @@ func (ks recordChangeKeySpaceType) bounds(afterRecordVersion uint64) (lowerBound
 		pebbleutil.PrefixToUpperBound(encodeSimpleKey(prefixRecordChangeKeySpace, nil))
 }
 
-func (ks recordChangeKeySpaceType) decodeValue(data []byte) (*databrokerpb.Record, error) {
-	return decodeProto[databrokerpb.Record](data)
+func (ks recordChangeKeySpaceType) decodeValue(r reader, data []byte) (*databrokerpb.Record, error) {
+	return decodeRecordValue(r, data)
 }
 
 func (ks recordChangeKeySpaceType) delete(w writer, version uint64) error {
@@ -413,12 +839,14 @@ func (ks recordChangeKeySpaceType) encodeKey(version uint64) []byte {
 	return encodeSimpleKey(prefixRecordChangeKeySpace, encodeUint64(version))
 }
 
-func (ks recordChangeKeySpaceType) encodeValue(record *databrokerpb.Record) []byte {
-	return encodeProto(record)
+func (ks recordChangeKeySpaceType) encodeValue(w writer, record *databrokerpb.Record) []byte {
+	return encodeRecordValue(w, record)
 }
 
 func (ks recordChangeKeySpaceType) get(r reader, version uint64) (*databrokerpb.Record, error) {
-	return pebbleGet(r, ks.encodeKey(version), ks.decodeValue)
+	return pebbleGet(r, ks.encodeKey(version), func(data []byte) (*databrokerpb.Record, error) {
+		return ks.decodeValue(r, data)
+	})
 }
 
 func (ks recordChangeKeySpaceType) getFirstVersion(r reader) (uint64, error) {
@@ -434,7 +862,7 @@ func (ks recordChangeKeySpaceType) getFirstVersion(r reader) (uint64, error) {
 		return 0, it.Close()
 	}
 
-	record, err := ks.decodeValue(it.Value())
+	record, err := ks.decodeValue(r, it.Value())
 	if err != nil {
 		_ = it.Close()
 		return 0, err
@@ -456,7 +884,7 @@ func (ks recordChangeKeySpaceType) getLastVersion(r reader) (uint64, error) {
 		return 0, it.Close()
 	}
 
-	record, err := ks.decodeValue(it.Value())
+	record, err := ks.decodeValue(r, it.Value())
 	if err != nil {
 		_ = it.Close()
 		return 0, err
@@ -475,8 +903,12 @@ func (ks recordChangeKeySpaceType) iterate(r reader, afterRecordVersion uint64)
 				return
 			}
 
-			record, err := ks.decodeValue(value)
-			if err != nil {
+			record, err := ks.decodeValue(r, value)
+			if isRecordDecryptionError(err) {
+				yield(nil, err)
+				return
+			} else if err != nil {
+				// skip invalid records
 				continue
 			}
 			if !yield(record, nil) {
@@ -487,7 +919,7 @@ func (ks recordChangeKeySpaceType) iterate(r reader, afterRecordVersion uint64)
 }
 
 func (ks recordChangeKeySpaceType) set(w writer, record *databrokerpb.Record) error {
-	return pebbleSet(w, ks.encodeKey(record.GetVersion()), ks.encodeValue(record))
+	return pebbleSet(w, ks.encodeKey(record.GetVersion()), ks.encodeValue(w, record))
 }
 
 // record-change-index-by-type:
@@ -532,6 +964,26 @@ func (recordChangeIndexByTypeKeySpaceType) deleteAll(w writer) error {
 	return pebbleDeletePrefix(w, []byte{prefixRecordChangeIndexByTypeKeySpace})
 }
 
+func (ks recordChangeIndexByTypeKeySpaceType) iterateAllKeys(r reader) iter.Seq2[*databrokerpb.Record, error] {
+	return func(yield func(*databrokerpb.Record, error) bool) {
+		opts := new(pebble.IterOptions)
+		opts.LowerBound = []byte{prefixRecordChangeIndexByTypeKeySpace}
+		opts.UpperBound = pebbleutil.PrefixToUpperBound(opts.LowerBound)
+
+		for record, err := range pebbleutil.Iterate(r, opts, func(it *pebble.Iterator) (*databrokerpb.Record, error) {
+			recordType, version, err := ks.decodeKey(it.Key())
+			if err != nil {
+				return nil, err
+			}
+			return &databrokerpb.Record{Type: recordType, Version: version}, nil
+		}) {
+			if !yield(record, err) {
+				return
+			}
+		}
+	}
+}
+
 func (ks recordChangeIndexByTypeKeySpaceType) iterate(r reader, recordType string, afterRecordVersion uint64) iter.Seq2[*databrokerpb.Record, error] {
 	return func(yield func(*databrokerpb.Record, error) bool) {
 		opts := new(pebble.IterOptions)
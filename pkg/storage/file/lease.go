@@ -0,0 +1,82 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+const leaseCleanupInterval = time.Minute
+
+func (backend *Backend) runLeaseCleanup() {
+	ticker := time.NewTicker(leaseCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-backend.closeCtx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := backend.cleanupLeases(backend.closeCtx, time.Now())
+		if err != nil && backend.closeCtx.Err() == nil {
+			log.Ctx(backend.closeCtx).Error().Err(err).Msg("pebble: error removing expired leases")
+		}
+	}
+}
+
+func (backend *Backend) cleanupLeases(ctx context.Context, now time.Time) error {
+	_, op := backend.telemetry.Start(ctx, "CleanupLeases")
+	defer op.Complete()
+
+	err := backend.withReadWriteTransaction(func(tx *readWriteTransaction) error {
+		return backend.cleanupLeasesLocked(tx, now)
+	})
+	if err != nil {
+		return op.Failure(err)
+	}
+
+	return nil
+}
+
+func (backend *Backend) cleanupLeasesLocked(rw readerWriter, now time.Time) error {
+	for node, err := range leaseKeySpace.iterate(rw) {
+		if err != nil {
+			return fmt.Errorf("pebble: error iterating over leases: %w", err)
+		}
+
+		if !node.expiresAt.Before(now) {
+			continue
+		}
+
+		err = leaseKeySpace.delete(rw, node.leaseName)
+		if err != nil {
+			return fmt.Errorf("pebble: error deleting expired lease: %w", err)
+		}
+	}
+	return nil
+}
+
+func (backend *Backend) listLeasesLocked(r reader, now time.Time) ([]storage.Lease, error) {
+	var leases []storage.Lease
+	for node, err := range leaseKeySpace.iterate(r) {
+		if err != nil {
+			return nil, fmt.Errorf("pebble: error iterating over leases: %w", err)
+		}
+
+		if node.expiresAt.Before(now) {
+			continue
+		}
+
+		leases = append(leases, storage.Lease{
+			Name:      node.leaseName,
+			ID:        node.leaseID,
+			ExpiresAt: node.expiresAt,
+		})
+	}
+	return leases, nil
+}
@@ -0,0 +1,47 @@
+package file
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/pomerium/pomerium/pkg/iterutil"
+)
+
+func TestLeases(t *testing.T) {
+	t.Parallel()
+
+	backend := New(noop.NewTracerProvider(), "memory://")
+	t.Cleanup(func() { _ = backend.Close() })
+
+	acquired, err := backend.Lease(t.Context(), "lease-1", "id-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	acquired, err = backend.Lease(t.Context(), "lease-2", "id-2", time.Hour)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = backend.Lease(t.Context(), "lease-2", "id-3", 0)
+	require.NoError(t, err)
+	assert.False(t, acquired, "should not release a lease held by someone else")
+
+	require.NoError(t, backend.cleanupLeases(t.Context(), time.Now().Add(2*time.Minute)))
+
+	nodes, err := iterutil.CollectWithError(leaseKeySpace.iterate(backend.db))
+	require.NoError(t, err)
+	if assert.Len(t, nodes, 1, "should remove expired leases") {
+		assert.Equal(t, "lease-2", nodes[0].leaseName)
+		assert.Equal(t, "id-2", nodes[0].leaseID)
+	}
+
+	acquired, err = backend.Lease(t.Context(), "lease-2", "id-2", 0)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+
+	nodes, err = iterutil.CollectWithError(leaseKeySpace.iterate(backend.db))
+	require.NoError(t, err)
+	assert.Empty(t, nodes, "should delete released leases")
+}
@@ -2,8 +2,11 @@ package file
 
 import (
 	"context"
+	"maps"
+	"slices"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 
 	"github.com/pomerium/pomerium/internal/log"
@@ -158,6 +161,48 @@ func (backend *Backend) registerMetrics() (metric.Registration, error) {
 		return nil, err
 	}
 
+	compactionEstimatedDebt, err := m.Int64ObservableGauge("storage.pebble.compaction.estimated_debt",
+		metric.WithDescription("An estimate of the number of bytes that need to be compacted for the LSM to reach a stable state."),
+		metric.WithUnit("By"))
+	if err != nil {
+		return nil, err
+	}
+
+	compactionInProgressBytes, err := m.Int64ObservableGauge("storage.pebble.compaction.in_progress_bytes",
+		metric.WithDescription("Number of bytes present in sstables being written by in-progress compactions."),
+		metric.WithUnit("By"))
+	if err != nil {
+		return nil, err
+	}
+
+	compactionsInProgress, err := m.Int64ObservableGauge("storage.pebble.compaction.in_progress",
+		metric.WithDescription("Number of compactions that are in-progress."),
+		metric.WithUnit("{compaction}"))
+	if err != nil {
+		return nil, err
+	}
+
+	recordCount, err := m.Int64ObservableGauge("storage.pebble.records",
+		metric.WithDescription("The number of records, per record type."),
+		metric.WithUnit("{record}"))
+	if err != nil {
+		return nil, err
+	}
+
+	recordChangeCount, err := m.Int64ObservableGauge("storage.pebble.record_changes",
+		metric.WithDescription("The number of record changes retained in the change log."),
+		metric.WithUnit("{record_change}"))
+	if err != nil {
+		return nil, err
+	}
+
+	recordChangeSize, err := m.Int64ObservableGauge("storage.pebble.record_changes.size",
+		metric.WithDescription("An estimate of the disk space used by the change log."),
+		metric.WithUnit("By"))
+	if err != nil {
+		return nil, err
+	}
+
 	checkpointServerVersion, err := m.Float64ObservableGauge("storage.pebble.checkpoint.server_version",
 		metric.WithDescription("The last checkpoint server version recorded by the local databroker storage."),
 		metric.WithUnit("{version}"))
@@ -177,8 +222,19 @@ func (backend *Backend) registerMetrics() (metric.Registration, error) {
 		pm := backend.db.Metrics()
 		serverVersion, recordVersion, err := backend.getCheckpointLocked(backend.db)
 		attrs := backend.metricAttributes
+		recordCounts := maps.Clone(backend.recordCounts)
+		var recordChanges uint64
+		if backend.earliestRecordVersion > 0 {
+			recordChanges = backend.latestRecordVersion - backend.earliestRecordVersion + 1
+		}
+		lowerBound, upperBound := recordChangeKeySpace.bounds(0)
+		recordChangesSize, sizeErr := backend.db.EstimateDiskUsage(lowerBound, upperBound)
 		backend.mu.RUnlock()
 
+		if sizeErr != nil {
+			log.Ctx(ctx).Err(sizeErr).Msg("error estimating record change disk usage")
+		}
+
 		if err != nil {
 			serverVersion = 0
 			recordVersion = 0
@@ -194,6 +250,15 @@ func (backend *Backend) registerMetrics() (metric.Registration, error) {
 			o.ObserveFloat64(checkpointServerVersion, float64(serverVersion))
 			o.ObserveFloat64(checkpointRecordVersion, float64(recordVersion))
 		}
+		for recordType, cnt := range recordCounts {
+			o.ObserveInt64(recordCount, cnt, metric.WithAttributes(
+				append(slices.Clone(attrs), attribute.String("record_type", recordType))...))
+		}
+		o.ObserveInt64(recordChangeCount, int64(recordChanges), metric.WithAttributes(attrs...))
+		o.ObserveInt64(recordChangeSize, int64(recordChangesSize), metric.WithAttributes(attrs...))
+		o.ObserveInt64(compactionEstimatedDebt, int64(pm.Compact.EstimatedDebt))
+		o.ObserveInt64(compactionInProgressBytes, pm.Compact.InProgressBytes)
+		o.ObserveInt64(compactionsInProgress, pm.Compact.NumInProgress)
 		o.ObserveInt64(blockCacheSize, pm.BlockCache.Size)
 		o.ObserveInt64(blockCacheCount, pm.BlockCache.Count)
 		o.ObserveInt64(blockCacheHits, pm.BlockCache.Hits)
@@ -242,5 +307,11 @@ func (backend *Backend) registerMetrics() (metric.Registration, error) {
 		totalTableSize,
 		checkpointServerVersion,
 		checkpointRecordVersion,
+		compactionEstimatedDebt,
+		compactionInProgressBytes,
+		compactionsInProgress,
+		recordCount,
+		recordChangeCount,
+		recordChangeSize,
 	)
 }
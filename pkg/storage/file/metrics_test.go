@@ -0,0 +1,36 @@
+package file
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestRecordCounts(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	backend1 := New(noop.NewTracerProvider(), "file://"+dir)
+	_, err := backend1.Put(t.Context(), []*databrokerpb.Record{
+		{Type: "t1", Id: "r1", Data: protoutil.NewAnyString("x")},
+		{Type: "t1", Id: "r2", Data: protoutil.NewAnyString("x")},
+		{Type: "t1", Id: "r2", Data: protoutil.NewAnyString("y")},
+		{Type: "t2", Id: "r1", Data: protoutil.NewAnyString("x")},
+		{Type: "t2", Id: "r1", DeletedAt: timestamppb.Now()},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"t1": 2}, backend1.recordCounts)
+	require.NoError(t, backend1.Close())
+
+	backend2 := New(noop.NewTracerProvider(), "file://"+dir)
+	t.Cleanup(func() { _ = backend2.Close() })
+	require.NoError(t, backend2.init())
+	assert.Equal(t, map[string]int64{"t1": 2}, backend2.recordCounts,
+		"should count records on startup")
+}
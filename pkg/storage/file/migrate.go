@@ -1,28 +1,129 @@
 package file
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
 	"github.com/cockroachdb/pebble/v2"
 
+	"github.com/pomerium/pomerium/internal/log"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/pebbleutil"
+	"github.com/pomerium/pomerium/pkg/storage"
 )
 
-func migrate(db *pebble.DB) error {
-	migrations := []func() error{
-		func() error {
-			return errors.Join(
-				metadataKeySpace.setServerVersion(db, cryptutil.NewRandomUInt64()),
-			)
+// migrationBatchSize is the number of keys a streaming migration processes
+// before committing its progress.
+const migrationBatchSize = 1024
+
+// A migrationStep changes the keyspace layout of the database. Steps are
+// applied in order and the migration version is stored after each step
+// completes. Steps must be idempotent, as a step may be re-run if the process
+// crashes before the migration version is stored.
+type migrationStep struct {
+	name string
+	run  func(m *migrator) error
+}
+
+// migrationSteps are all the registered migrations. New steps must be appended
+// to the end.
+var migrationSteps = []migrationStep{
+	{
+		name: "set server version",
+		run: func(m *migrator) error {
+			return metadataKeySpace.setServerVersion(m.db, cryptutil.NewRandomUInt64())
 		},
-		func() error {
+	},
+	{
+		name: "set checkpoint versions",
+		run: func(m *migrator) error {
 			return errors.Join(
-				metadataKeySpace.setCheckpointServerVersion(db, 0),
-				metadataKeySpace.setCheckpointRecordVersion(db, 0),
+				metadataKeySpace.setCheckpointServerVersion(m.db, 0),
+				metadataKeySpace.setCheckpointRecordVersion(m.db, 0),
 			)
 		},
+	},
+	{
+		name: "build record index by cidr",
+		run: func(m *migrator) error {
+			return m.streamRecords(func(w writer, record *databrokerpb.Record) error {
+				prefixes := storage.GetRecordIndexCIDRs(record.GetData())
+				if len(prefixes) == 0 {
+					return nil
+				}
+				return recordIndexByCIDRKeySpace.set(w, record.GetType(), record.GetId(), prefixes)
+			})
+		},
+	},
+}
+
+type migrationProgress struct {
+	version   uint64
+	name      string
+	processed int
+	done      bool
+}
+
+type migrateConfig struct {
+	cipher     *recordCipher
+	dryRun     bool
+	onProgress func(progress migrationProgress)
+}
+
+type migrateOption func(cfg *migrateConfig)
+
+// withMigrateCipher sets the cipher used to decrypt record values.
+func withMigrateCipher(cipher *recordCipher) migrateOption {
+	return func(cfg *migrateConfig) {
+		cfg.cipher = cipher
+	}
+}
+
+// withMigrateDryRun reports the pending migrations without applying them.
+func withMigrateDryRun(dryRun bool) migrateOption {
+	return func(cfg *migrateConfig) {
+		cfg.dryRun = dryRun
+	}
+}
+
+// withMigrateProgress sets a callback that is called as migrations progress.
+func withMigrateProgress(onProgress func(progress migrationProgress)) migrateOption {
+	return func(cfg *migrateConfig) {
+		cfg.onProgress = onProgress
+	}
+}
+
+func getMigrateConfig(options ...migrateOption) *migrateConfig {
+	cfg := new(migrateConfig)
+	withMigrateCipher(nil)(cfg)
+	withMigrateDryRun(false)(cfg)
+	withMigrateProgress(func(progress migrationProgress) {
+		if progress.done {
+			log.Info().
+				Uint64("version", progress.version).
+				Str("name", progress.name).
+				Int("processed", progress.processed).
+				Msg("pebble: applied migration")
+		}
+	})(cfg)
+	for _, option := range options {
+		option(cfg)
 	}
+	return cfg
+}
+
+type migrator struct {
+	db        *pebble.DB
+	cfg       *migrateConfig
+	version   uint64
+	name      string
+	processed int
+}
+
+func migrate(db *pebble.DB, options ...migrateOption) error {
+	cfg := getMigrateConfig(options...)
 
 	current, err := metadataKeySpace.getMigration(db)
 	if errors.Is(err, pebble.ErrNotFound) {
@@ -31,17 +132,88 @@ func migrate(db *pebble.DB) error {
 		return fmt.Errorf("pebble: error getting migration version: %w", err)
 	}
 
-	for i := current; i < uint64(len(migrations)); i++ {
-		err = migrations[i]()
+	for i := current; i < uint64(len(migrationSteps)); i++ {
+		step := migrationSteps[i]
+		m := &migrator{db: db, cfg: cfg, version: i + 1, name: step.name}
+		if cfg.dryRun {
+			cfg.onProgress(migrationProgress{version: m.version, name: m.name})
+			continue
+		}
+
+		err = step.run(m)
 		if err != nil {
-			return fmt.Errorf("pebble: error migrating to version %d: %w", i+1, err)
+			return fmt.Errorf("pebble: error migrating to version %d (%s): %w", m.version, m.name, err)
 		}
 
-		err = metadataKeySpace.setMigration(db, i+1)
+		err = metadataKeySpace.setMigration(db, m.version)
 		if err != nil {
-			return fmt.Errorf("pebble: error setting migration version %d: %w", i+1, err)
+			return fmt.Errorf("pebble: error setting migration version %d: %w", m.version, err)
 		}
+		cfg.onProgress(migrationProgress{version: m.version, name: m.name, processed: m.processed, done: true})
 	}
 
 	return nil
 }
+
+// streamRecords calls fn for every record in batches. Progress is committed
+// along with each batch so the migration resumes where it left off after a
+// crash. A record which can't be decoded fails the migration, so that records
+// are never silently left out of a migration.
+func (m *migrator) streamRecords(fn func(w writer, record *databrokerpb.Record) error) error {
+	opts := new(pebble.IterOptions)
+	opts.LowerBound, opts.UpperBound = recordKeySpace.bounds()
+
+	// resume from the last committed key
+	version, cursor, err := metadataKeySpace.getMigrationCursor(m.db)
+	if err == nil && version == m.version && bytes.Compare(cursor, opts.LowerBound) >= 0 {
+		opts.LowerBound = append(bytes.Clone(cursor), 0x00)
+	} else if err != nil && !isNotFound(err) {
+		return fmt.Errorf("error getting migration cursor: %w", err)
+	}
+
+	type kv struct {
+		key    []byte
+		record *databrokerpb.Record
+	}
+
+	r := readOnlyTransaction{reader: m.db, cipher: m.cfg.cipher}
+	batch := m.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	for e, err := range pebbleutil.Iterate(m.db, opts, func(it *pebble.Iterator) (kv, error) {
+		record, err := recordKeySpace.decodeValue(r, it.Value())
+		if err != nil {
+			return kv{}, fmt.Errorf("error decoding record %q: %w", it.Key(), err)
+		}
+		return kv{key: bytes.Clone(it.Key()), record: record}, nil
+	}) {
+		if err != nil {
+			return err
+		}
+
+		err = fn(batch, e.record)
+		if err != nil {
+			return err
+		}
+		m.processed++
+
+		if m.processed%migrationBatchSize == 0 {
+			err = metadataKeySpace.setMigrationCursor(batch, m.version, e.key)
+			if err != nil {
+				return err
+			}
+			err = batch.Commit(nil)
+			if err != nil {
+				return err
+			}
+			_ = batch.Close()
+			batch = m.db.NewBatch()
+			m.cfg.onProgress(migrationProgress{version: m.version, name: m.name, processed: m.processed})
+		}
+	}
+
+	err = metadataKeySpace.deleteMigrationCursor(batch)
+	if err != nil {
+		return err
+	}
+	return batch.Commit(nil)
+}
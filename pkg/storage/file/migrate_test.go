@@ -1,12 +1,19 @@
 package file
 
 import (
+	"net/netip"
 	"testing"
 
+	"github.com/cockroachdb/pebble/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
 
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/iterutil"
 	"github.com/pomerium/pomerium/pkg/pebbleutil"
+	"github.com/pomerium/pomerium/pkg/protoutil"
 )
 
 func TestMigrate(t *testing.T) {
@@ -22,7 +29,7 @@ func TestMigrate(t *testing.T) {
 			assert.Greater(t, serverVersion, uint64(0),
 				"should set server version to non-zero uint64")
 		}
-		assert.Equal(t, [2][]byte{{0x02, 0x02}, {0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02}}, kvs[1],
+		assert.Equal(t, [2][]byte{{0x02, 0x02}, {0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03}}, kvs[1],
 			"should set migration")
 		assert.Equal(t, []byte{0x02, 0x03}, kvs[2][0],
 			"should set checkpoint server version")
@@ -38,3 +45,120 @@ func TestMigrate(t *testing.T) {
 		}
 	}
 }
+
+func TestMigrateRecordIndexByCIDR(t *testing.T) {
+	t.Parallel()
+
+	data, err := structpb.NewStruct(map[string]any{
+		"$index": map[string]any{
+			"cidr": "192.168.0.0/16",
+		},
+	})
+	require.NoError(t, err)
+
+	db := pebbleutil.MustOpenMemory(nil)
+	require.NoError(t, metadataKeySpace.setMigration(db, 2))
+	require.NoError(t, recordKeySpace.set(db, &databrokerpb.Record{Type: "t1", Id: "r1", Data: protoutil.NewAny(data)}))
+	require.NoError(t, recordKeySpace.set(db, &databrokerpb.Record{Type: "t1", Id: "r2", Data: protoutil.NewAnyString("x")}))
+	require.NoError(t, migrate(db))
+
+	nodes, err := iterutil.CollectWithError(recordIndexByCIDRKeySpace.iterate(db))
+	require.NoError(t, err)
+	assert.Equal(t, []recordCIDRNode{
+		{recordType: "t1", recordID: "r1", prefix: netip.MustParsePrefix("192.168.0.0/16")},
+	}, nodes)
+}
+
+func TestMigrateEncrypted(t *testing.T) {
+	t.Parallel()
+
+	data, err := structpb.NewStruct(map[string]any{
+		"$index": map[string]any{
+			"cidr": "192.168.0.0/16",
+		},
+	})
+	require.NoError(t, err)
+
+	cipher, err := newRecordCipher(cryptutil.NewKey())
+	require.NoError(t, err)
+
+	db := pebbleutil.MustOpenMemory(nil)
+	require.NoError(t, metadataKeySpace.setMigration(db, 2))
+	batch := db.NewBatch()
+	require.NoError(t, recordKeySpace.set(&readWriteTransaction{Batch: batch, cipher: cipher},
+		&databrokerpb.Record{Type: "t1", Id: "r1", Data: protoutil.NewAny(data)}))
+	require.NoError(t, batch.Commit(nil))
+
+	assert.ErrorIs(t, migrate(db), errMissingEncryptionKey,
+		"should fail the migration without the cipher")
+	migration, err := metadataKeySpace.getMigration(db)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), migration,
+		"should not apply the failed migration")
+
+	require.NoError(t, migrate(db, withMigrateCipher(cipher)))
+	nodes, err := iterutil.CollectWithError(recordIndexByCIDRKeySpace.iterate(db))
+	require.NoError(t, err)
+	assert.Equal(t, []recordCIDRNode{
+		{recordType: "t1", recordID: "r1", prefix: netip.MustParsePrefix("192.168.0.0/16")},
+	}, nodes)
+}
+
+func TestMigrateDryRun(t *testing.T) {
+	t.Parallel()
+
+	db := pebbleutil.MustOpenMemory(nil)
+	require.NoError(t, metadataKeySpace.setMigration(db, 1))
+
+	var progress []migrationProgress
+	require.NoError(t, migrate(db, withMigrateDryRun(true), withMigrateProgress(func(p migrationProgress) {
+		progress = append(progress, p)
+	})))
+	assert.Equal(t, []migrationProgress{
+		{version: 2, name: "set checkpoint versions"},
+		{version: 3, name: "build record index by cidr"},
+	}, progress)
+
+	migration, err := metadataKeySpace.getMigration(db)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), migration,
+		"should not apply migrations")
+}
+
+func TestMigrateResume(t *testing.T) {
+	t.Parallel()
+
+	data, err := structpb.NewStruct(map[string]any{
+		"$index": map[string]any{
+			"cidr": "192.168.0.0/16",
+		},
+	})
+	require.NoError(t, err)
+
+	db := pebbleutil.MustOpenMemory(nil)
+	require.NoError(t, metadataKeySpace.setMigration(db, 2))
+	for _, id := range []string{"r1", "r2", "r3"} {
+		require.NoError(t, recordKeySpace.set(db, &databrokerpb.Record{Type: "t1", Id: id, Data: protoutil.NewAny(data)}))
+	}
+	// simulate a crash after r1 was processed
+	require.NoError(t, metadataKeySpace.setMigrationCursor(db, 3, recordKeySpace.encodeKey("t1", "r1")))
+
+	var progress []migrationProgress
+	require.NoError(t, migrate(db, withMigrateProgress(func(p migrationProgress) {
+		progress = append(progress, p)
+	})))
+	assert.Equal(t, []migrationProgress{
+		{version: 3, name: "build record index by cidr", processed: 2, done: true},
+	}, progress)
+
+	nodes, err := iterutil.CollectWithError(recordIndexByCIDRKeySpace.iterate(db))
+	require.NoError(t, err)
+	assert.Equal(t, []recordCIDRNode{
+		{recordType: "t1", recordID: "r2", prefix: netip.MustParsePrefix("192.168.0.0/16")},
+		{recordType: "t1", recordID: "r3", prefix: netip.MustParsePrefix("192.168.0.0/16")},
+	}, nodes, "should resume after the cursor")
+
+	_, _, err = metadataKeySpace.getMigrationCursor(db)
+	assert.ErrorIs(t, err, pebble.ErrNotFound,
+		"should remove the cursor")
+}
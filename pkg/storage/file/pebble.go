@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/pebble/v2"
+	"go.opentelemetry.io/otel/metric"
 
 	"github.com/pomerium/pomerium/internal/fileutil"
 	"github.com/pomerium/pomerium/internal/log"
@@ -20,24 +21,13 @@ import (
 	"github.com/pomerium/pomerium/pkg/grpc/registry"
 	"github.com/pomerium/pomerium/pkg/health"
 	"github.com/pomerium/pomerium/pkg/pebbleutil"
-	"github.com/pomerium/pomerium/pkg/storage"
 )
 
 // OpenPebbleDB opens a pebble db for the given dsn string.
 func OpenPebbleDB(dsn string) (*pebble.DB, error) {
-	// pick a default location
-	if dsn == "" || dsn == "file:" || dsn == "file://" {
-		dsn = "file://" + filepath.Join(fileutil.DataDir(), "databroker")
-	}
-
-	// support bare paths
-	if strings.HasPrefix(dsn, "/") {
-		dsn = "file://" + dsn
-	}
-
-	u, err := url.Parse(dsn)
+	u, err := parsePebbleDSN(dsn)
 	if err != nil {
-		return nil, fmt.Errorf("pebble: invalid dsn, expected url: %w", err)
+		return nil, err
 	}
 
 	switch u.Scheme {
@@ -55,6 +45,30 @@ func OpenPebbleDB(dsn string) (*pebble.DB, error) {
 	}
 }
 
+func parsePebbleDSN(dsn string) (*url.URL, error) {
+	// pick a default location
+	if dsn == "" || dsn == "file:" || dsn == "file://" {
+		dsn = "file://" + filepath.Join(fileutil.DataDir(), "databroker")
+	}
+
+	// support bare paths
+	if strings.HasPrefix(dsn, "/") {
+		dsn = "file://" + dsn
+	}
+
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("pebble: invalid dsn, expected url: %w", err)
+	}
+
+	// support default locations with query params
+	if u.Scheme == "file" && u.Path == "" {
+		u.Path = filepath.Join(fileutil.DataDir(), "databroker")
+	}
+
+	return u, nil
+}
+
 type (
 	reader interface {
 		pebble.Reader
@@ -70,12 +84,29 @@ type (
 
 func (backend *Backend) init() error {
 	backend.initOnce.Do(func() {
+		backend.commitOptions, backend.initErr = parseCommitOptions(backend.dsn)
+		if backend.initErr != nil {
+			return
+		}
+
+		backend.cipher, backend.initErr = newRecordCipher(backend.encryptionKeys...)
+		if backend.initErr != nil {
+			return
+		}
+
+		if backend.replication.enabled() {
+			backend.initErr = backend.restoreSnapshotIfEmpty()
+			if backend.initErr != nil {
+				return
+			}
+		}
+
 		backend.db, backend.initErr = OpenPebbleDB(backend.dsn)
 		if backend.initErr != nil {
 			return
 		}
 
-		err := migrate(backend.db)
+		err := migrate(backend.db, withMigrateCipher(backend.cipher))
 		if err != nil {
 			backend.initErr = fmt.Errorf("pebble: error migrating database: %w", err)
 			return
@@ -87,13 +118,13 @@ func (backend *Backend) init() error {
 			return
 		}
 
-		backend.earliestRecordVersion, err = recordChangeKeySpace.getFirstVersion(backend.db)
+		backend.earliestRecordVersion, err = recordChangeKeySpace.getFirstVersion(readOnlyTransaction{reader: backend.db, cipher: backend.cipher})
 		if err != nil {
 			backend.initErr = fmt.Errorf("pebble: error getting earliest record version: %w", err)
 			return
 		}
 
-		backend.latestRecordVersion, err = recordChangeKeySpace.getLastVersion(backend.db)
+		backend.latestRecordVersion, err = recordChangeKeySpace.getLastVersion(readOnlyTransaction{reader: backend.db, cipher: backend.cipher})
 		if err != nil {
 			backend.initErr = fmt.Errorf("pebble: error getting earliest record version: %w", err)
 			return
@@ -110,6 +141,24 @@ func (backend *Backend) init() error {
 			backend.initErr = fmt.Errorf("pebble: error registering metrics: %w", err)
 			return
 		}
+
+		go backend.runLeaseCleanup()
+
+		if backend.retention.enabled() {
+			go backend.runRetention()
+		}
+
+		if backend.expiration.enabled() {
+			go backend.runExpiration()
+		}
+
+		if backend.replication.enabled() {
+			go backend.runReplication()
+		}
+
+		if backend.commitOptions.interval > 0 {
+			go backend.runGroupCommit()
+		}
 	})
 	if backend.initErr != nil {
 		health.ReportError(
@@ -139,14 +188,27 @@ func (backend *Backend) initIndices() error {
 	}
 
 	backend.recordCIDRIndex = newRecordCIDRIndex()
-	for record, err := range recordKeySpace.iterateAll(backend.db) {
+	for node, err := range recordIndexByCIDRKeySpace.iterate(backend.db) {
 		if err != nil {
-			return fmt.Errorf("pebble: error iterating over records: %w", err)
+			return fmt.Errorf("pebble: error iterating over record index by cidr: %w", err)
 		}
+		backend.recordCIDRIndex.add(node)
+	}
 
-		if prefix := storage.GetRecordIndexCIDR(record.GetData()); prefix != nil {
-			backend.recordCIDRIndex.add(recordCIDRNode{recordType: record.GetType(), recordID: record.GetId(), prefix: *prefix})
-		}
+	var err error
+	backend.recordCounts, err = recordKeySpace.countByType(backend.db)
+	if err != nil {
+		return fmt.Errorf("pebble: error counting records: %w", err)
+	}
+
+	err = backend.initRecordFieldIndexLocked(&readWriteTransaction{Batch: batch, cipher: backend.cipher})
+	if err != nil {
+		return err
+	}
+
+	err = backend.initRecordSearchIndexLocked(&readWriteTransaction{Batch: batch, cipher: backend.cipher})
+	if err != nil {
+		return err
 	}
 
 	backend.registryServiceIndex = newRegistryServiceIndex()
@@ -164,7 +226,7 @@ func (backend *Backend) initIndices() error {
 		}
 	}
 
-	err := batch.Commit(nil)
+	err = batch.Commit(nil)
 	if err != nil {
 		return fmt.Errorf("pebble: error committing changes: %w", err)
 	}
@@ -172,8 +234,74 @@ func (backend *Backend) initIndices() error {
 	return nil
 }
 
+// A backendSnapshot holds the in-memory versions of the backend before a
+// batch is started. stateChanges counts changes to the in-memory options,
+// indices and record counts, so that they're only rebuilt if a failed batch
+// changed them.
+type backendSnapshot struct {
+	serverVersion         uint64
+	earliestRecordVersion uint64
+	latestRecordVersion   uint64
+	stateChanges          uint64
+}
+
+func (backend *Backend) snapshotLocked() backendSnapshot {
+	return backendSnapshot{
+		serverVersion:         backend.serverVersion,
+		earliestRecordVersion: backend.earliestRecordVersion,
+		latestRecordVersion:   backend.latestRecordVersion,
+		stateChanges:          backend.stateChanges,
+	}
+}
+
+// restoreLocked restores the in-memory state of the backend after a batch
+// failed to commit. The versions are restored from the snapshot taken before
+// the batch was started. If the batch changed the options, indices or record
+// counts, they are rebuilt from the database, which doesn't contain any of the
+// changes in the batch.
+func (backend *Backend) restoreLocked(snapshot backendSnapshot) error {
+	backend.serverVersion = snapshot.serverVersion
+	backend.earliestRecordVersion = snapshot.earliestRecordVersion
+	backend.latestRecordVersion = snapshot.latestRecordVersion
+	if backend.stateChanges == snapshot.stateChanges {
+		return nil
+	}
+
+	options := make(map[string]*databrokerpb.Options)
+	for node, err := range optionsKeySpace.iterate(backend.db) {
+		if err != nil {
+			return fmt.Errorf("pebble: error restoring options: %w", err)
+		}
+		options[node.recordType] = node.options
+	}
+
+	recordCIDRIndex := newRecordCIDRIndex()
+	for node, err := range recordIndexByCIDRKeySpace.iterate(backend.db) {
+		if err != nil {
+			return fmt.Errorf("pebble: error restoring record index by cidr: %w", err)
+		}
+		recordCIDRIndex.add(node)
+	}
+
+	recordCounts, err := recordKeySpace.countByType(backend.db)
+	if err != nil {
+		return fmt.Errorf("pebble: error restoring record counts: %w", err)
+	}
+
+	backend.options = options
+	backend.recordCIDRIndex = recordCIDRIndex
+	backend.recordCounts = recordCounts
+	return nil
+}
+
 type readOnlyTransaction struct {
 	reader
+
+	cipher *recordCipher
+}
+
+func (tx readOnlyTransaction) recordCipher() *recordCipher {
+	return tx.cipher
 }
 
 func (backend *Backend) withReadOnlyTransaction(fn func(tx readOnlyTransaction) error) error {
@@ -191,7 +319,7 @@ func (backend *Backend) withReadOnlyTransaction(fn func(tx readOnlyTransaction)
 	default:
 	}
 
-	err = fn(readOnlyTransaction{backend.db})
+	err = fn(readOnlyTransaction{reader: backend.db, cipher: backend.cipher})
 
 	return err
 }
@@ -199,9 +327,14 @@ func (backend *Backend) withReadOnlyTransaction(fn func(tx readOnlyTransaction)
 type readWriteTransaction struct {
 	*pebble.Batch
 
+	cipher            *recordCipher
 	onCommitCallbacks []func()
 }
 
+func (tx *readWriteTransaction) recordCipher() *recordCipher {
+	return tx.cipher
+}
+
 func (tx *readWriteTransaction) onCommit(callback func()) {
 	tx.onCommitCallbacks = append(tx.onCommitCallbacks, callback)
 }
@@ -223,16 +356,27 @@ func (backend *Backend) withReadWriteTransaction(fn func(tx *readWriteTransactio
 
 	batch := backend.db.NewIndexedBatch()
 
-	tx := &readWriteTransaction{Batch: batch}
+	snapshot := backend.snapshotLocked()
+	tx := &readWriteTransaction{Batch: batch, cipher: backend.cipher}
 	err = fn(tx)
 	if err != nil {
 		_ = batch.Close()
+		if restoreErr := backend.restoreLocked(snapshot); restoreErr != nil {
+			err = errors.Join(err, restoreErr)
+		}
 		return err
 	}
 
+	start := time.Now()
 	err = batch.Commit(nil)
+	backend.commitDuration.Record(backend.closeCtx, time.Since(start).Seconds(),
+		metric.WithAttributes(backend.metricAttributes...))
 	if err != nil {
-		return fmt.Errorf("pebble: error committing: %w", err)
+		err = fmt.Errorf("pebble: error committing: %w", err)
+		if restoreErr := backend.restoreLocked(snapshot); restoreErr != nil {
+			err = errors.Join(err, restoreErr)
+		}
+		return err
 	}
 
 	for _, f := range slices.Backward(tx.onCommitCallbacks) {
@@ -266,7 +410,7 @@ func listChangedRecordsAfter(
 			return nil, fmt.Errorf("pebble: error iterating over record changes by type: %w", err)
 		}
 		records = append(records, record)
-		if len(records) > batchSize {
+		if len(records) >= batchSize {
 			break
 		}
 	}
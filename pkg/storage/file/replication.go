@@ -0,0 +1,377 @@
+package file
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pomerium/pomerium/internal/log"
+)
+
+const (
+	defaultSnapshotInterval    = time.Hour
+	defaultSnapshotRetainCount = 3
+
+	snapshotNamePrefix = "snapshot-"
+	snapshotNameSuffix = ".tar.gz"
+	// the timestamp has a fixed width so that names sort chronologically
+	snapshotTimeFormat = "20060102T150405.000000000Z"
+)
+
+type replicationConfig struct {
+	store       SnapshotStore
+	interval    time.Duration
+	retainCount int
+}
+
+func (cfg replicationConfig) enabled() bool {
+	return cfg.store != nil
+}
+
+// WithSnapshotStore configures the backend to periodically upload snapshots of
+// the database to the given store. When the database directory is missing or
+// empty at startup, the latest snapshot is restored from the store before the
+// database is opened.
+func WithSnapshotStore(store SnapshotStore) Option {
+	return func(b *Backend) {
+		b.replication.store = store
+	}
+}
+
+// WithSnapshotInterval configures how often snapshots are uploaded. It
+// defaults to one hour.
+func WithSnapshotInterval(interval time.Duration) Option {
+	return func(b *Backend) {
+		b.replication.interval = interval
+	}
+}
+
+// WithSnapshotRetainCount configures how many snapshots are kept in the store.
+// Older snapshots are deleted after a new snapshot is uploaded. It defaults to
+// three.
+func WithSnapshotRetainCount(count int) Option {
+	return func(b *Backend) {
+		b.replication.retainCount = count
+	}
+}
+
+func (backend *Backend) runReplication() {
+	interval := backend.replication.interval
+	if interval <= 0 {
+		interval = defaultSnapshotInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-backend.closeCtx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		_, err := backend.UploadSnapshot(backend.closeCtx)
+		if err != nil && backend.closeCtx.Err() == nil {
+			log.Ctx(backend.closeCtx).Error().Err(err).Msg("pebble: error uploading snapshot")
+		}
+	}
+}
+
+// UploadSnapshot creates a checkpoint of the database and uploads it to the
+// configured snapshot store. It returns the name of the new snapshot.
+func (backend *Backend) UploadSnapshot(ctx context.Context) (name string, err error) {
+	ctx, op := backend.telemetry.Start(ctx, "UploadSnapshot")
+	defer op.Complete()
+
+	store := backend.replication.store
+	if store == nil {
+		return "", op.Failure(fmt.Errorf("pebble: no snapshot store configured"))
+	}
+
+	u, err := parsePebbleDSN(backend.dsn)
+	if err != nil {
+		return "", op.Failure(err)
+	} else if u.Scheme != "file" {
+		return "", op.Failure(fmt.Errorf("pebble: snapshots are only supported for file databases"))
+	}
+
+	tmpDir, err := os.MkdirTemp("", "pomerium-databroker-snapshot-*")
+	if err != nil {
+		return "", op.Failure(fmt.Errorf("pebble: error creating temporary directory: %w", err))
+	}
+	defer os.RemoveAll(tmpDir)
+
+	checkpointDir := filepath.Join(tmpDir, "checkpoint")
+	err = backend.withReadOnlyTransaction(func(_ readOnlyTransaction) error {
+		return backend.db.Checkpoint(checkpointDir)
+	})
+	if err != nil {
+		return "", op.Failure(fmt.Errorf("pebble: error creating checkpoint: %w", err))
+	}
+
+	// the archive is written to a file rather than streamed so that object
+	// stores which need a seekable body can upload it
+	f, err := os.CreateTemp(tmpDir, "*"+snapshotNameSuffix)
+	if err != nil {
+		return "", op.Failure(fmt.Errorf("pebble: error creating snapshot archive: %w", err))
+	}
+	defer f.Close()
+
+	err = writeSnapshotArchive(f, checkpointDir)
+	if err != nil {
+		return "", op.Failure(fmt.Errorf("pebble: error writing snapshot archive: %w", err))
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return "", op.Failure(fmt.Errorf("pebble: error writing snapshot archive: %w", err))
+	}
+
+	name = snapshotNamePrefix + time.Now().UTC().Format(snapshotTimeFormat) + snapshotNameSuffix
+	err = store.Put(ctx, name, f)
+	if err != nil {
+		return "", op.Failure(fmt.Errorf("pebble: error uploading snapshot %s: %w", name, err))
+	}
+
+	log.Ctx(ctx).Info().Str("snapshot", name).Msg("pebble: uploaded snapshot")
+
+	err = backend.pruneSnapshots(ctx)
+	if err != nil {
+		return name, op.Failure(err)
+	}
+
+	return name, nil
+}
+
+func (backend *Backend) pruneSnapshots(ctx context.Context) error {
+	retainCount := backend.replication.retainCount
+	if retainCount <= 0 {
+		retainCount = defaultSnapshotRetainCount
+	}
+
+	names, err := listSnapshots(ctx, backend.replication.store)
+	if err != nil {
+		return err
+	}
+
+	for len(names) > retainCount {
+		err = backend.replication.store.Delete(ctx, names[0])
+		if err != nil {
+			return fmt.Errorf("pebble: error deleting snapshot %s: %w", names[0], err)
+		}
+		names = names[1:]
+	}
+	return nil
+}
+
+// restoreSnapshotIfEmpty restores the latest snapshot if the database directory
+// for the dsn is missing or empty.
+func (backend *Backend) restoreSnapshotIfEmpty() error {
+	u, err := parsePebbleDSN(backend.dsn)
+	if err != nil {
+		return err
+	} else if u.Scheme != "file" {
+		return nil
+	}
+
+	entries, err := os.ReadDir(u.Path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("pebble: error reading database directory: %w", err)
+	} else if len(entries) > 0 {
+		return nil
+	}
+
+	name, err := RestoreSnapshot(backend.closeCtx, backend.replication.store, u.Path)
+	if err != nil {
+		return err
+	}
+
+	if name != "" {
+		log.Info().Str("snapshot", name).Str("path", u.Path).Msg("pebble: restored database from snapshot")
+	}
+	return nil
+}
+
+// RestoreSnapshot downloads the latest snapshot from the store and extracts it
+// into dir, which must not exist or be empty. It returns the name of the
+// restored snapshot, or an empty string if the store has no snapshots.
+func RestoreSnapshot(ctx context.Context, store SnapshotStore, dir string) (name string, err error) {
+	names, err := listSnapshots(ctx, store)
+	if err != nil {
+		return "", err
+	} else if len(names) == 0 {
+		return "", nil
+	}
+	name = names[len(names)-1]
+
+	r, err := store.Get(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("pebble: error downloading snapshot %s: %w", name, err)
+	}
+	defer r.Close()
+
+	// extract into a sibling directory first so that a failed restore never
+	// leaves a partial database behind
+	err = os.MkdirAll(filepath.Dir(dir), 0o700)
+	if err != nil {
+		return "", fmt.Errorf("pebble: error creating database directory: %w", err)
+	}
+	tmpDir, err := os.MkdirTemp(filepath.Dir(dir), "."+filepath.Base(dir)+"-restore-*")
+	if err != nil {
+		return "", fmt.Errorf("pebble: error creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	err = readSnapshotArchive(r, tmpDir)
+	if err != nil {
+		return "", fmt.Errorf("pebble: error extracting snapshot %s: %w", name, err)
+	}
+
+	// remove the empty database directory, if it exists, so it can be replaced
+	err = os.Remove(dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("pebble: error replacing database directory: %w", err)
+	}
+
+	err = os.Rename(tmpDir, dir)
+	if err != nil {
+		return "", fmt.Errorf("pebble: error replacing database directory: %w", err)
+	}
+
+	return name, nil
+}
+
+// listSnapshots lists the snapshots in the store, oldest first. Any other
+// objects in the store are ignored.
+func listSnapshots(ctx context.Context, store SnapshotStore) ([]string, error) {
+	all, err := store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pebble: error listing snapshots: %w", err)
+	}
+
+	var names []string
+	for _, name := range all {
+		if strings.HasPrefix(name, snapshotNamePrefix) && strings.HasSuffix(name, snapshotNameSuffix) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// writeSnapshotArchive writes the files in dir to w as a gzipped tarball.
+func writeSnapshotArchive(w io.Writer, dir string) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == dir {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+
+		err = tw.WriteHeader(hdr)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// readSnapshotArchive extracts a gzipped tarball into dir.
+func readSnapshotArchive(r io.Reader, dir string) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		name := filepath.FromSlash(hdr.Name)
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("invalid file name in snapshot: %s", hdr.Name)
+		}
+		path := filepath.Join(dir, name)
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(path, 0o700)
+		case tar.TypeReg:
+			err = extractSnapshotFile(tr, path)
+		default:
+			err = fmt.Errorf("unsupported file type in snapshot: %s", hdr.Name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func extractSnapshotFile(r io.Reader, path string) error {
+	err := os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, r)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,83 @@
+package file
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+func TestReplication(t *testing.T) {
+	t.Parallel()
+
+	store := NewDirectorySnapshotStore(filepath.Join(t.TempDir(), "snapshots"))
+
+	backend1 := New(noop.NewTracerProvider(), filepath.Join(t.TempDir(), "db"),
+		WithSnapshotStore(store), WithSnapshotRetainCount(2))
+	t.Cleanup(func() { _ = backend1.Close() })
+
+	_, err := backend1.Put(t.Context(), []*databrokerpb.Record{
+		{Type: "example", Id: "r1", Data: protoutil.NewAnyString("v1")},
+	})
+	require.NoError(t, err)
+
+	var last string
+	for range 3 {
+		last, err = backend1.UploadSnapshot(t.Context())
+		require.NoError(t, err)
+	}
+
+	names, err := store.List(t.Context())
+	require.NoError(t, err)
+	assert.Len(t, names, 2, "should only retain the configured number of snapshots")
+	assert.Equal(t, last, names[len(names)-1])
+
+	// a new node with an empty directory bootstraps from the latest snapshot
+	backend2 := New(noop.NewTracerProvider(), filepath.Join(t.TempDir(), "db"),
+		WithSnapshotStore(store))
+	t.Cleanup(func() { _ = backend2.Close() })
+
+	record, err := backend2.Get(t.Context(), "example", "r1")
+	require.NoError(t, err)
+	assert.Empty(t, cmp.Diff(protoutil.NewAnyString("v1"), record.GetData(), protocmp.Transform()))
+
+	// without any snapshots a new node starts empty
+	backend3 := New(noop.NewTracerProvider(), filepath.Join(t.TempDir(), "db"),
+		WithSnapshotStore(NewDirectorySnapshotStore(t.TempDir())))
+	t.Cleanup(func() { _ = backend3.Close() })
+
+	_, err = backend3.Get(t.Context(), "example", "r1")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func TestReadSnapshotArchive(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     "../escape",
+		Typeflag: tar.TypeReg,
+		Mode:     0o600,
+		Size:     1,
+	}))
+	_, err := tw.Write([]byte("x"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	err = readSnapshotArchive(&buf, t.TempDir())
+	assert.ErrorContains(t, err, "invalid file name")
+}
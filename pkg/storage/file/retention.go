@@ -0,0 +1,147 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pomerium/pomerium/internal/log"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/iterutil"
+)
+
+const defaultRetentionInterval = time.Minute
+
+type retentionConfig struct {
+	maxAge   time.Duration
+	maxCount uint64
+	interval time.Duration
+}
+
+func (cfg retentionConfig) enabled() bool {
+	return cfg.maxAge > 0 || cfg.maxCount > 0
+}
+
+// WithRecordChangeMaxAge configures the backend to periodically remove record
+// changes older than maxAge. Syncs from a removed version will fail with
+// storage.ErrInvalidRecordVersion.
+func WithRecordChangeMaxAge(maxAge time.Duration) Option {
+	return func(b *Backend) {
+		b.retention.maxAge = maxAge
+	}
+}
+
+// WithRecordChangeMaxCount configures the backend to periodically remove
+// record changes so that at most maxCount are retained. Syncs from a removed
+// version will fail with storage.ErrInvalidRecordVersion.
+func WithRecordChangeMaxCount(maxCount uint64) Option {
+	return func(b *Backend) {
+		b.retention.maxCount = maxCount
+	}
+}
+
+// WithRetentionInterval configures how often the record change retention
+// policy is enforced. It defaults to one minute.
+func WithRetentionInterval(interval time.Duration) Option {
+	return func(b *Backend) {
+		b.retention.interval = interval
+	}
+}
+
+func (backend *Backend) runRetention() {
+	interval := backend.retention.interval
+	if interval <= 0 {
+		interval = defaultRetentionInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-backend.closeCtx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := backend.enforceRetention(backend.closeCtx, time.Now())
+		if err != nil && backend.closeCtx.Err() == nil {
+			log.Ctx(backend.closeCtx).Error().Err(err).Msg("pebble: error enforcing record change retention")
+		}
+	}
+}
+
+func (backend *Backend) enforceRetention(ctx context.Context, now time.Time) error {
+	ctx, op := backend.telemetry.Start(ctx, "EnforceRetention")
+	defer op.Complete()
+
+	var removed int
+	err := backend.withReadWriteTransaction(func(tx *readWriteTransaction) error {
+		var err error
+		removed, err = backend.enforceRetentionLocked(tx, now)
+		return err
+	})
+	if err != nil {
+		return op.Failure(err)
+	}
+
+	if removed > 0 {
+		log.Ctx(ctx).Debug().
+			Int("removed", removed).
+			Msg("pebble: removed record changes")
+	}
+
+	return nil
+}
+
+func (backend *Backend) enforceRetentionLocked(
+	rw readerWriter,
+	now time.Time,
+) (int, error) {
+	var removeBeforeVersion uint64
+	if backend.retention.maxCount > 0 && backend.latestRecordVersion > backend.retention.maxCount {
+		removeBeforeVersion = backend.latestRecordVersion - backend.retention.maxCount + 1
+	}
+	var removeBefore time.Time
+	if backend.retention.maxAge > 0 {
+		removeBefore = now.Add(-backend.retention.maxAge)
+	}
+
+	return backend.removeRecordChangesLocked(rw, func(record *databrokerpb.Record) bool {
+		return record.GetVersion() < removeBeforeVersion ||
+			record.GetModifiedAt().AsTime().Before(removeBefore)
+	})
+}
+
+// removeRecordChangesLocked removes record changes, in version order, until
+// shouldRemove returns false. The last record change is always kept so that
+// version numbers can be tracked.
+func (backend *Backend) removeRecordChangesLocked(
+	rw readerWriter,
+	shouldRemove func(record *databrokerpb.Record) bool,
+) (int, error) {
+	removed := 0
+	for record, err := range iterutil.SkipLastWithError(recordChangeKeySpace.iterate(rw, 0), 1) {
+		if err != nil {
+			return removed, fmt.Errorf("pebble: error iterating over record changes: %w", err)
+		}
+
+		if !shouldRemove(record) {
+			break
+		}
+
+		err = recordChangeKeySpace.delete(rw, record.GetVersion())
+		if err != nil {
+			return removed, fmt.Errorf("pebble: error deleting record change: %w", err)
+		}
+		err = recordChangeIndexByTypeKeySpace.delete(rw, record.GetType(), record.GetVersion())
+		if err != nil {
+			return removed, fmt.Errorf("pebble: error deleting record change index by type: %w", err)
+		}
+
+		// this record was deleted, so only allow queries for records with larger version numbers
+		backend.earliestRecordVersion = max(backend.earliestRecordVersion, record.GetVersion()+1)
+		removed++
+	}
+	return removed, nil
+}
@@ -0,0 +1,89 @@
+package file
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/iterutil"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+func TestRetention(t *testing.T) {
+	t.Parallel()
+
+	put := func(t *testing.T, backend *Backend, n int) {
+		t.Helper()
+		for i := range n {
+			_, err := backend.Put(t.Context(), []*databrokerpb.Record{
+				{Type: "example", Id: fmt.Sprintf("id-%d", i), Data: protoutil.NewAnyString("x")},
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("max count", func(t *testing.T) {
+		t.Parallel()
+
+		backend := New(noop.NewTracerProvider(), "memory://", WithRecordChangeMaxCount(3))
+		t.Cleanup(func() { _ = backend.Close() })
+		put(t, backend, 10)
+
+		require.NoError(t, backend.enforceRetention(t.Context(), time.Now()))
+
+		serverVersion, earliestRecordVersion, latestRecordVersion, err := backend.Versions(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8), earliestRecordVersion)
+		assert.Equal(t, uint64(10), latestRecordVersion)
+
+		_, err = iterutil.CollectWithError(backend.Sync(t.Context(), "", serverVersion, 1, false))
+		assert.ErrorIs(t, err, storage.ErrInvalidRecordVersion)
+
+		records, err := iterutil.CollectWithError(backend.Sync(t.Context(), "", serverVersion, 7, false))
+		assert.NoError(t, err)
+		assert.Len(t, records, 3)
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		t.Parallel()
+
+		backend := New(noop.NewTracerProvider(), "memory://", WithRecordChangeMaxAge(time.Hour))
+		t.Cleanup(func() { _ = backend.Close() })
+		put(t, backend, 10)
+
+		require.NoError(t, backend.enforceRetention(t.Context(), time.Now()))
+		_, earliestRecordVersion, _, err := backend.Versions(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), earliestRecordVersion, "should keep recent changes")
+
+		require.NoError(t, backend.enforceRetention(t.Context(), time.Now().Add(2*time.Hour)))
+		serverVersion, earliestRecordVersion, latestRecordVersion, err := backend.Versions(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(10), earliestRecordVersion, "should keep the last change")
+		assert.Equal(t, uint64(10), latestRecordVersion)
+
+		_, err = iterutil.CollectWithError(backend.Sync(t.Context(), "", serverVersion, 8, false))
+		assert.ErrorIs(t, err, storage.ErrInvalidRecordVersion)
+	})
+
+	t.Run("background", func(t *testing.T) {
+		t.Parallel()
+
+		backend := New(noop.NewTracerProvider(), "memory://",
+			WithRecordChangeMaxCount(1),
+			WithRetentionInterval(10*time.Millisecond))
+		t.Cleanup(func() { _ = backend.Close() })
+		put(t, backend, 5)
+
+		assert.Eventually(t, func() bool {
+			_, earliestRecordVersion, _, err := backend.Versions(t.Context())
+			return err == nil && earliestRecordVersion == 5
+		}, time.Second, 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,184 @@
+package file
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// maxSearchTokenLength is the maximum length of a token stored in the search
+// index. Longer tokens are truncated.
+const maxSearchTokenLength = 64
+
+// WithSearchFields configures the backend to build a full-text search index
+// over the given string fields of records of the given type. Fields are
+// dot-separated paths into the JSON representation of the record data (e.g.
+// "email"). Searches on records of the type only match these fields and are
+// satisfied by the index instead of scanning every record.
+func WithSearchFields(recordType string, fields ...string) Option {
+	return func(b *Backend) {
+		if b.searchFields == nil {
+			b.searchFields = make(map[string][]string)
+		}
+		searchFields := append(b.searchFields[recordType], fields...)
+		slices.Sort(searchFields)
+		b.searchFields[recordType] = slices.Compact(searchFields)
+	}
+}
+
+// recordMatchesSearch returns true if the record matches the search query. If
+// search fields are configured for the record type only those fields are
+// searched.
+func (backend *Backend) recordMatchesSearch(record *databrokerpb.Record, query string) bool {
+	fields, ok := backend.searchFields[record.GetType()]
+	if !ok {
+		return storage.MatchSearch(record, query)
+	}
+
+	query = strings.ToLower(query)
+	for _, field := range fields {
+		for _, value := range getRecordFieldValues(record, strings.Split(field, ".")) {
+			if strings.Contains(strings.ToLower(value), query) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getRecordSearchTokens returns the search index tokens for a record. Every
+// suffix of every token in the search fields is indexed so that substring
+// searches can be satisfied with a prefix scan.
+func (backend *Backend) getRecordSearchTokens(record *databrokerpb.Record) []string {
+	tokens := make(map[string]struct{})
+	for _, field := range backend.searchFields[record.GetType()] {
+		for _, value := range getRecordFieldValues(record, strings.Split(field, ".")) {
+			for _, token := range storage.TokenizeSearchText(value) {
+				for i := range token {
+					tokens[truncateSearchToken(token[i:])] = struct{}{}
+				}
+			}
+		}
+	}
+	return slices.Sorted(maps.Keys(tokens))
+}
+
+func (backend *Backend) addRecordSearchIndexLocked(
+	w writer,
+	record *databrokerpb.Record,
+) error {
+	for _, token := range backend.getRecordSearchTokens(record) {
+		err := recordIndexBySearchTokenKeySpace.set(w, record.GetType(), token, record.GetId())
+		if err != nil {
+			return fmt.Errorf("pebble: error setting record index by search token: %w", err)
+		}
+	}
+	return nil
+}
+
+func (backend *Backend) deleteRecordSearchIndexLocked(
+	w writer,
+	record *databrokerpb.Record,
+) error {
+	for _, token := range backend.getRecordSearchTokens(record) {
+		err := recordIndexBySearchTokenKeySpace.delete(w, record.GetType(), token, record.GetId())
+		if err != nil {
+			return fmt.Errorf("pebble: error deleting record index by search token: %w", err)
+		}
+	}
+	return nil
+}
+
+// initRecordSearchIndexLocked rebuilds the record search index if the search
+// fields have changed since the index was last built.
+func (backend *Backend) initRecordSearchIndexLocked(rw readerWriter) error {
+	searchFields, err := json.Marshal(backend.searchFields)
+	if err != nil {
+		return fmt.Errorf("pebble: error encoding search fields: %w", err)
+	}
+
+	existing, err := metadataKeySpace.getSearchFields(rw)
+	if err == nil && bytes.Equal(existing, searchFields) {
+		return nil
+	} else if err != nil && !isNotFound(err) {
+		return fmt.Errorf("pebble: error getting search fields: %w", err)
+	}
+
+	err = recordIndexBySearchTokenKeySpace.deleteAll(rw)
+	if err != nil {
+		return fmt.Errorf("pebble: error deleting record index by search token: %w", err)
+	}
+
+	for recordType := range backend.searchFields {
+		for record, err := range recordKeySpace.iterate(rw, recordType) {
+			if err != nil {
+				return fmt.Errorf("pebble: error iterating over records: %w", err)
+			}
+
+			err = backend.addRecordSearchIndexLocked(rw, record)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = metadataKeySpace.setSearchFields(rw, searchFields)
+	if err != nil {
+		return fmt.Errorf("pebble: error setting search fields: %w", err)
+	}
+
+	return nil
+}
+
+// iterateRecordsForSearchLocked iterates over the records which contain every
+// token of the search query, in id order. The records must still be checked
+// against the query, as the tokens may appear in a different order.
+func (backend *Backend) iterateRecordsForSearchLocked(
+	r reader,
+	recordType string,
+	queryTokens []string,
+) iter.Seq2[*databrokerpb.Record, error] {
+	return func(yield func(*databrokerpb.Record, error) bool) {
+		var recordIDs map[string]struct{}
+		for _, token := range queryTokens {
+			matches := make(map[string]struct{})
+			for recordID, err := range recordIndexBySearchTokenKeySpace.iterateIDs(r, recordType, truncateSearchToken(token)) {
+				if err != nil {
+					yield(nil, fmt.Errorf("pebble: error iterating over record index by search token: %w", err))
+					return
+				}
+				if _, ok := recordIDs[recordID]; ok || recordIDs == nil {
+					matches[recordID] = struct{}{}
+				}
+			}
+			recordIDs = matches
+			if len(recordIDs) == 0 {
+				return
+			}
+		}
+
+		for _, recordID := range slices.Sorted(maps.Keys(recordIDs)) {
+			record, err := recordKeySpace.get(r, recordType, recordID)
+			if isNotFound(err) {
+				continue
+			}
+			if !yield(record, err) {
+				return
+			}
+		}
+	}
+}
+
+func truncateSearchToken(token string) string {
+	if len(token) > maxSearchTokenLength {
+		return token[:maxSearchTokenLength]
+	}
+	return token
+}
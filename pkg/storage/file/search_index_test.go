@@ -0,0 +1,80 @@
+package file
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/iterutil"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+func TestSearchIndex(t *testing.T) {
+	t.Parallel()
+
+	put := func(t *testing.T, backend *Backend, id, name, email string) {
+		t.Helper()
+		data, err := structpb.NewStruct(map[string]any{"name": name, "email": email})
+		require.NoError(t, err)
+		_, err = backend.Put(t.Context(), []*databrokerpb.Record{
+			{Type: "user", Id: id, Data: protoutil.NewAny(data)},
+		})
+		require.NoError(t, err)
+	}
+	search := func(t *testing.T, backend *Backend, query string) []string {
+		t.Helper()
+		_, _, seq, err := backend.SyncLatest(t.Context(), "user", storage.SearchFilterExpression{Query: query})
+		require.NoError(t, err)
+		records, err := iterutil.CollectWithError(seq)
+		require.NoError(t, err)
+		var ids []string
+		for _, record := range records {
+			ids = append(ids, record.GetId())
+		}
+		return ids
+	}
+	indexedIDs := func(t *testing.T, backend *Backend, token string) []string {
+		t.Helper()
+		ids, err := iterutil.CollectWithError(recordIndexBySearchTokenKeySpace.iterateIDs(backend.db, "user", token))
+		require.NoError(t, err)
+		return ids
+	}
+
+	dir := t.TempDir()
+
+	// without an index, every string field is searched
+	backend1 := New(noop.NewTracerProvider(), "file://"+dir)
+	put(t, backend1, "u1", "Alice Smith", "alice@example.com")
+	put(t, backend1, "u2", "Bob Jones", "bob@example.org")
+	assert.Equal(t, []string{"u1", "u2"}, search(t, backend1, "EXAMPLE"))
+	assert.Equal(t, []string{"u2"}, search(t, backend1, "example.org"))
+	require.NoError(t, backend1.Close())
+
+	// adding an index builds it from the existing records
+	backend2 := New(noop.NewTracerProvider(), "file://"+dir, WithSearchFields("user", "name"))
+	t.Cleanup(func() { _ = backend2.Close() })
+	assert.Equal(t, []string{"u1"}, search(t, backend2, "ice sm"))
+	assert.Equal(t, []string{"u1"}, indexedIDs(t, backend2, "mith"))
+	assert.Equal(t, []string{"u2"}, search(t, backend2, "jo"))
+	assert.Empty(t, search(t, backend2, "smith alice"), "tokens must appear in order")
+	assert.Empty(t, search(t, backend2, "example"), "only the search fields should be searched")
+
+	// the index is maintained on writes
+	put(t, backend2, "u3", "Carol Smithers", "carol@example.com")
+	put(t, backend2, "u2", "Bob Smith", "bob@example.org")
+	assert.Equal(t, []string{"u1", "u2", "u3"}, search(t, backend2, "smith"))
+	assert.Empty(t, indexedIDs(t, backend2, "jones"))
+
+	_, err := backend2.Put(t.Context(), []*databrokerpb.Record{
+		{Type: "user", Id: "u1", DeletedAt: timestamppb.Now()},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2", "u3"}, search(t, backend2, "smith"))
+	assert.Empty(t, indexedIDs(t, backend2, "alice"))
+}
@@ -0,0 +1,282 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	gcs "cloud.google.com/go/storage"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"google.golang.org/api/iterator"
+)
+
+// ErrSnapshotNotFound indicates that a snapshot does not exist in a
+// SnapshotStore.
+var ErrSnapshotNotFound = errors.New("pebble: snapshot not found")
+
+// A SnapshotStore stores database snapshots, typically in an object store.
+type SnapshotStore interface {
+	// List returns the names of all the stored snapshots, sorted in ascending
+	// order.
+	List(ctx context.Context) ([]string, error)
+	// Get opens the snapshot with the given name. If it does not exist
+	// ErrSnapshotNotFound is returned.
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+	// Put stores a snapshot with the given name.
+	Put(ctx context.Context, name string, r io.Reader) error
+	// Delete removes the snapshot with the given name.
+	Delete(ctx context.Context, name string) error
+}
+
+// NewSnapshotStore creates a new SnapshotStore from a URL. The supported
+// schemes are:
+//
+//	s3://{bucket}/{prefix}
+//	gs://{bucket}/{prefix}
+//	file:///{directory}
+//
+// S3 and GCS credentials are read from the environment.
+func NewSnapshotStore(ctx context.Context, rawURL string) (SnapshotStore, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("pebble: invalid snapshot store url: %w", err)
+	}
+
+	prefix := strings.TrimPrefix(u.Path, "/")
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	switch u.Scheme {
+	case "s3":
+		if u.Host == "" {
+			return nil, fmt.Errorf("pebble: invalid snapshot store url, missing s3 bucket")
+		}
+		cfg, err := awsconfig.LoadDefaultConfig(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("pebble: error creating aws config: %w", err)
+		}
+		return NewS3SnapshotStore(s3.NewFromConfig(cfg), u.Host, prefix), nil
+	case "gs":
+		if u.Host == "" {
+			return nil, fmt.Errorf("pebble: invalid snapshot store url, missing gcs bucket")
+		}
+		client, err := gcs.NewClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("pebble: error creating gcs client: %w", err)
+		}
+		return NewGCSSnapshotStore(client, u.Host, prefix), nil
+	case "file":
+		if u.Path == "" {
+			return nil, fmt.Errorf("pebble: invalid snapshot store url, missing directory")
+		}
+		return NewDirectorySnapshotStore(u.Path), nil
+	default:
+		return nil, fmt.Errorf("pebble: unknown snapshot store scheme: %s", u.Scheme)
+	}
+}
+
+type directorySnapshotStore struct {
+	dir string
+}
+
+// NewDirectorySnapshotStore creates a new SnapshotStore that stores snapshots
+// as files in a directory.
+func NewDirectorySnapshotStore(dir string) SnapshotStore {
+	return &directorySnapshotStore{dir: dir}
+}
+
+func (s *directorySnapshotStore) List(_ context.Context) ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && !strings.HasPrefix(entry.Name(), ".") {
+			names = append(names, entry.Name())
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (s *directorySnapshotStore) Get(_ context.Context, name string) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.Join(s.dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrSnapshotNotFound
+	}
+	return f, err
+}
+
+func (s *directorySnapshotStore) Put(_ context.Context, name string, r io.Reader) error {
+	err := os.MkdirAll(s.dir, 0o700)
+	if err != nil {
+		return err
+	}
+
+	// write to a hidden temporary file first so a partial snapshot is never
+	// listed
+	f, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = io.Copy(f, r)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), filepath.Join(s.dir, name))
+}
+
+func (s *directorySnapshotStore) Delete(_ context.Context, name string) error {
+	err := os.Remove(filepath.Join(s.dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+type s3SnapshotStore struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// NewS3SnapshotStore creates a new SnapshotStore that stores snapshots in an
+// S3 bucket.
+func NewS3SnapshotStore(client *s3.Client, bucket, prefix string) SnapshotStore {
+	return &s3SnapshotStore{client: client, bucket: bucket, prefix: prefix}
+}
+
+func (s *s3SnapshotStore) List(ctx context.Context) ([]string, error) {
+	var names []string
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(s.bucket),
+		Prefix:    aws.String(s.prefix),
+		Delimiter: aws.String("/"),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range output.Contents {
+			names = append(names, strings.TrimPrefix(aws.ToString(object.Key), s.prefix))
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (s *s3SnapshotStore) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + name),
+	})
+	var nsk *types.NoSuchKey
+	if errors.As(err, &nsk) {
+		return nil, ErrSnapshotNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return output.Body, nil
+}
+
+func (s *s3SnapshotStore) Put(ctx context.Context, name string, r io.Reader) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + name),
+		Body:   r,
+	})
+	return err
+}
+
+func (s *s3SnapshotStore) Delete(ctx context.Context, name string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.prefix + name),
+	})
+	return err
+}
+
+type gcsSnapshotStore struct {
+	client *gcs.Client
+	bucket string
+	prefix string
+}
+
+// NewGCSSnapshotStore creates a new SnapshotStore that stores snapshots in a
+// GCS bucket.
+func NewGCSSnapshotStore(client *gcs.Client, bucket, prefix string) SnapshotStore {
+	return &gcsSnapshotStore{client: client, bucket: bucket, prefix: prefix}
+}
+
+func (s *gcsSnapshotStore) List(ctx context.Context) ([]string, error) {
+	var names []string
+	it := s.client.Bucket(s.bucket).Objects(ctx, &gcs.Query{
+		Prefix:    s.prefix,
+		Delimiter: "/",
+	})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if attrs.Prefix != "" {
+			continue
+		}
+		names = append(names, strings.TrimPrefix(attrs.Name, s.prefix))
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (s *gcsSnapshotStore) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	r, err := s.client.Bucket(s.bucket).Object(s.prefix + name).NewReader(ctx)
+	if errors.Is(err, gcs.ErrObjectNotExist) {
+		return nil, ErrSnapshotNotFound
+	}
+	return r, err
+}
+
+func (s *gcsSnapshotStore) Put(ctx context.Context, name string, r io.Reader) error {
+	w := s.client.Bucket(s.bucket).Object(s.prefix + name).NewWriter(ctx)
+	_, err := io.Copy(w, r)
+	if err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (s *gcsSnapshotStore) Delete(ctx context.Context, name string) error {
+	err := s.client.Bucket(s.bucket).Object(s.prefix + name).Delete(ctx)
+	if errors.Is(err, gcs.ErrObjectNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,168 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// VacuumStats are the statistics from a call to Vacuum.
+type VacuumStats struct {
+	// RemovedRecords is the number of deleted records that were removed.
+	RemovedRecords int
+	// RemovedIndexEntries is the number of orphaned index entries that were removed.
+	RemovedIndexEntries int
+	// ReclaimedBytes is the reduction in disk space used by the database.
+	ReclaimedBytes uint64
+}
+
+// Vacuum removes deleted records and orphaned index entries and then compacts
+// the database. Writes are blocked while the database is being compacted.
+func (backend *Backend) Vacuum(ctx context.Context) (stats VacuumStats, err error) {
+	ctx, op := backend.telemetry.Start(ctx, "Vacuum")
+	defer op.Complete()
+
+	err = backend.withReadWriteTransaction(func(tx *readWriteTransaction) error {
+		var err error
+		stats, err = backend.vacuumLocked(tx)
+		return err
+	})
+	if err != nil {
+		return stats, op.Failure(err)
+	}
+
+	err = backend.withReadOnlyTransaction(func(_ readOnlyTransaction) error {
+		before := backend.db.Metrics().DiskSpaceUsage()
+
+		err := backend.db.Compact(ctx, []byte{0x00}, []byte{0xff}, true)
+		if err != nil {
+			return fmt.Errorf("pebble: error compacting database: %w", err)
+		}
+
+		after := backend.db.Metrics().DiskSpaceUsage()
+		if after < before {
+			stats.ReclaimedBytes = before - after
+		}
+		return nil
+	})
+	if err != nil {
+		return stats, op.Failure(err)
+	}
+
+	return stats, nil
+}
+
+func (backend *Backend) vacuumLocked(rw readerWriter) (VacuumStats, error) {
+	var stats VacuumStats
+
+	// remove any deleted records, these should've been removed when they were deleted
+	for record, err := range recordKeySpace.iterateAll(rw) {
+		if err != nil {
+			return stats, fmt.Errorf("pebble: error iterating over records: %w", err)
+		}
+
+		if record.GetDeletedAt() == nil {
+			continue
+		}
+
+		err = recordKeySpace.delete(rw, record.GetType(), record.GetId())
+		if err != nil {
+			return stats, fmt.Errorf("pebble: error deleting record: %w", err)
+		}
+		err = backend.deleteRecordCIDRIndexLocked(rw, record)
+		if err != nil {
+			return stats, err
+		}
+		err = backend.deleteRecordFieldIndexLocked(rw, record)
+		if err != nil {
+			return stats, err
+		}
+		err = backend.deleteRecordSearchIndexLocked(rw, record)
+		if err != nil {
+			return stats, err
+		}
+		backend.decrementRecordCountLocked(record.GetType())
+		stats.RemovedRecords++
+	}
+
+	// remove any record index by type version entries that don't point to the current version of a record
+	for node, err := range recordIndexByTypeVersionKeySpace.iterateAll(rw) {
+		if err != nil {
+			return stats, fmt.Errorf("pebble: error iterating over record index by type version: %w", err)
+		}
+
+		record, err := recordKeySpace.get(rw, node.GetType(), node.GetId())
+		if err == nil && record.GetDeletedAt() == nil && record.GetVersion() == node.GetVersion() {
+			continue
+		} else if err != nil && !isNotFound(err) {
+			return stats, fmt.Errorf("pebble: error getting record: %w", err)
+		}
+
+		err = recordIndexByTypeVersionKeySpace.delete(rw, node.GetType(), node.GetVersion())
+		if err != nil {
+			return stats, fmt.Errorf("pebble: error deleting record index by type version: %w", err)
+		}
+		stats.RemovedIndexEntries++
+	}
+
+	// remove any record change index by type entries that don't point to a record change
+	for node, err := range recordChangeIndexByTypeKeySpace.iterateAllKeys(rw) {
+		if err != nil {
+			return stats, fmt.Errorf("pebble: error iterating over record change index by type: %w", err)
+		}
+
+		_, err := recordChangeKeySpace.get(rw, node.GetVersion())
+		if err == nil {
+			continue
+		} else if !isNotFound(err) {
+			return stats, fmt.Errorf("pebble: error getting record change: %w", err)
+		}
+
+		err = recordChangeIndexByTypeKeySpace.delete(rw, node.GetType(), node.GetVersion())
+		if err != nil {
+			return stats, fmt.Errorf("pebble: error deleting record change index by type: %w", err)
+		}
+		stats.RemovedIndexEntries++
+	}
+
+	// remove any record index by cidr entries that don't match a record
+	for node, err := range recordIndexByCIDRKeySpace.iterate(rw) {
+		if err != nil {
+			return stats, fmt.Errorf("pebble: error iterating over record index by cidr: %w", err)
+		}
+
+		var prefixes []netip.Prefix
+		record, err := recordKeySpace.get(rw, node.recordType, node.recordID)
+		if err == nil {
+			prefixes = storage.GetRecordIndexCIDRs(record.GetData())
+			if slices.Contains(prefixes, node.prefix) {
+				continue
+			}
+		} else if !isNotFound(err) {
+			return stats, fmt.Errorf("pebble: error getting record: %w", err)
+		}
+
+		backend.stateChanges++
+
+		// if the record still has other prefixes, rewrite the entry with the
+		// current ones instead of removing it
+		if len(prefixes) > 0 {
+			err = recordIndexByCIDRKeySpace.set(rw, node.recordType, node.recordID, prefixes)
+			for _, prefix := range prefixes {
+				backend.recordCIDRIndex.add(recordCIDRNode{recordType: node.recordType, recordID: node.recordID, prefix: prefix})
+			}
+		} else {
+			err = recordIndexByCIDRKeySpace.delete(rw, node.recordType, node.recordID)
+		}
+		if err != nil {
+			return stats, fmt.Errorf("pebble: error deleting record index by cidr: %w", err)
+		}
+		backend.recordCIDRIndex.delete(node)
+		stats.RemovedIndexEntries++
+	}
+
+	return stats, nil
+}
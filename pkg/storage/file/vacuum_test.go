@@ -0,0 +1,55 @@
+package file
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestVacuum(t *testing.T) {
+	t.Parallel()
+
+	backend := New(noop.NewTracerProvider(), "memory://")
+	t.Cleanup(func() { _ = backend.Close() })
+
+	_, err := backend.Put(t.Context(), []*databrokerpb.Record{
+		{Type: "t1", Id: "r1", Data: protoutil.NewAnyString("x")},
+		{Type: "t1", Id: "r2", Data: protoutil.NewAnyString("x")},
+	})
+	require.NoError(t, err)
+
+	// add a deleted record and orphaned index entries
+	require.NoError(t, backend.withReadWriteTransaction(func(tx *readWriteTransaction) error {
+		require.NoError(t, recordKeySpace.set(tx, &databrokerpb.Record{
+			Type: "t1", Id: "r3", Version: 100, DeletedAt: timestamppb.Now(),
+		}))
+		require.NoError(t, recordIndexByTypeVersionKeySpace.set(tx, "t1", "r1", 101))
+		require.NoError(t, recordChangeIndexByTypeKeySpace.set(tx, "t1", 102))
+		require.NoError(t, recordIndexByCIDRKeySpace.set(tx, "t1", "r4", []netip.Prefix{
+			netip.MustParsePrefix("10.0.0.0/8"),
+		}))
+		return nil
+	}))
+
+	stats, err := backend.Vacuum(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.RemovedRecords)
+	assert.Equal(t, 3, stats.RemovedIndexEntries)
+
+	stats, err = backend.Vacuum(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 0, stats.RemovedRecords)
+	assert.Equal(t, 0, stats.RemovedIndexEntries)
+
+	for _, id := range []string{"r1", "r2"} {
+		_, err = backend.Get(t.Context(), "t1", id)
+		assert.NoError(t, err, "should keep existing records")
+	}
+}
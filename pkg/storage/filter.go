@@ -2,9 +2,15 @@ package storage
 
 import (
 	"fmt"
+	"math"
+	"slices"
 	"sort"
+	"strings"
+	"time"
 
 	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 )
 
 // A FilterExpression describes an AST for record stream filters.
@@ -83,6 +89,47 @@ func filterExpressionFromStruct(path []string, s *structpb.Struct) (FilterExpres
 				return nil, err
 			}
 			and = append(and, expr)
+		case "$ne":
+			value, err := filterValue(path, v)
+			if err != nil {
+				return nil, fmt.Errorf("$ne: %w", err)
+			}
+			and = append(and, NotEqualsFilterExpression{Fields: path, Value: value})
+		case "$in":
+			list := v.GetListValue()
+			if list == nil {
+				return nil, fmt.Errorf("$in must be an array")
+			}
+			var or OrFilterExpression
+			for _, vv := range list.GetValues() {
+				expr, err := filterExpressionFromEq(path, vv)
+				if err != nil {
+					return nil, fmt.Errorf("$in: %w", err)
+				}
+				or = append(or, expr)
+			}
+			if len(or) == 1 {
+				and = append(and, or[0])
+			} else {
+				and = append(and, or)
+			}
+		case "$prefix":
+			prefix, ok := v.GetKind().(*structpb.Value_StringValue)
+			if !ok {
+				return nil, fmt.Errorf("$prefix must be a string")
+			}
+			and = append(and, PrefixFilterExpression{Fields: path, Value: prefix.StringValue})
+		case string(ComparisonLessThan), string(ComparisonLessThanOrEqual),
+			string(ComparisonGreaterThan), string(ComparisonGreaterThanOrEqual):
+			value, err := filterValue(path, v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", f, err)
+			}
+			and = append(and, ComparisonFilterExpression{
+				Fields:   path,
+				Operator: ComparisonOperator(f),
+				Value:    value,
+			})
 		default:
 			expr, err := filterExpressionFromValue(append(path, f), v)
 			if err != nil {
@@ -99,29 +146,55 @@ func filterExpressionFromStruct(path []string, s *structpb.Struct) (FilterExpres
 }
 
 func filterExpressionFromEq(path []string, v *structpb.Value) (FilterExpression, error) {
+	value, err := filterValue(path, v)
+	if err != nil {
+		return nil, fmt.Errorf("eq: %w", err)
+	}
+	return EqualsFilterExpression{
+		Fields: path,
+		Value:  value,
+	}, nil
+}
+
+// filterValue converts a struct value to the string used in filter
+// expressions. Values for modified_at are normalized with FormatFilterTime so
+// that they can be compared as strings.
+func filterValue(path []string, v *structpb.Value) (string, error) {
+	if slices.Equal(path, []string{"modified_at"}) {
+		switch vv := v.GetKind().(type) {
+		case *structpb.Value_NumberValue:
+			sec, frac := math.Modf(vv.NumberValue)
+			return FormatFilterTime(time.Unix(int64(sec), int64(frac*1e9))), nil
+		case *structpb.Value_StringValue:
+			tm, err := time.Parse(time.RFC3339Nano, vv.StringValue)
+			if err != nil {
+				return "", fmt.Errorf("invalid modified_at timestamp: %w", err)
+			}
+			return FormatFilterTime(tm), nil
+		}
+		return "", fmt.Errorf("unsupported struct value type for modified_at: %T", v.GetKind())
+	}
+
 	switch vv := v.GetKind().(type) {
 	case *structpb.Value_BoolValue:
-		return EqualsFilterExpression{
-			Fields: path,
-			Value:  fmt.Sprintf("%v", vv.BoolValue),
-		}, nil
+		return fmt.Sprintf("%v", vv.BoolValue), nil
 	case *structpb.Value_NullValue:
-		return EqualsFilterExpression{
-			Fields: path,
-			Value:  fmt.Sprintf("%v", vv.NullValue),
-		}, nil
+		return fmt.Sprintf("%v", vv.NullValue), nil
 	case *structpb.Value_NumberValue:
-		return EqualsFilterExpression{
-			Fields: path,
-			Value:  fmt.Sprintf("%v", vv.NumberValue),
-		}, nil
+		return fmt.Sprintf("%v", vv.NumberValue), nil
 	case *structpb.Value_StringValue:
-		return EqualsFilterExpression{
-			Fields: path,
-			Value:  vv.StringValue,
-		}, nil
+		return vv.StringValue, nil
 	}
-	return nil, fmt.Errorf("unsupported struct value type for eq: %T", v.GetKind())
+	return "", fmt.Errorf("unsupported struct value type: %T", v.GetKind())
+}
+
+// filterTimeFormat is the format of modified_at filter values.
+const filterTimeFormat = "2006-01-02T15:04:05.000000000Z"
+
+// FormatFilterTime formats a time as a filter expression value. The format
+// has a fixed width so that times compare correctly as strings.
+func FormatFilterTime(tm time.Time) string {
+	return tm.UTC().Format(filterTimeFormat)
 }
 
 // An OrFilterExpression represents a logical-or comparison operator.
@@ -141,3 +214,139 @@ type EqualsFilterExpression struct {
 }
 
 func (EqualsFilterExpression) isFilterExpression() {}
+
+// A NotEqualsFilterExpression represents a field not-equals comparison
+// operator.
+type NotEqualsFilterExpression struct {
+	Fields []string
+	Value  string
+}
+
+func (NotEqualsFilterExpression) isFilterExpression() {}
+
+// A PrefixFilterExpression represents a string prefix comparison operator.
+type PrefixFilterExpression struct {
+	Fields []string
+	Value  string
+}
+
+func (PrefixFilterExpression) isFilterExpression() {}
+
+// A ComparisonOperator is an ordered comparison operator.
+type ComparisonOperator string
+
+// ComparisonOperators
+const (
+	ComparisonLessThan           ComparisonOperator = "$lt"
+	ComparisonLessThanOrEqual    ComparisonOperator = "$lte"
+	ComparisonGreaterThan        ComparisonOperator = "$gt"
+	ComparisonGreaterThanOrEqual ComparisonOperator = "$gte"
+)
+
+// A ComparisonFilterExpression represents an ordered comparison operator.
+// Values are compared as strings.
+type ComparisonFilterExpression struct {
+	Fields   []string
+	Operator ComparisonOperator
+	Value    string
+}
+
+func (ComparisonFilterExpression) isFilterExpression() {}
+
+// A SearchFilterExpression represents a full-text search of the record data.
+// A record matches if the query is a case-insensitive substring of one of the
+// string fields of its data.
+type SearchFilterExpression struct {
+	Query string
+}
+
+func (SearchFilterExpression) isFilterExpression() {}
+
+// FilterExpressionFields returns the fields of a field comparison expression.
+// False is returned for and and or expressions.
+func FilterExpressionFields(expr FilterExpression) ([]string, bool) {
+	switch expr := expr.(type) {
+	case EqualsFilterExpression:
+		return expr.Fields, true
+	case NotEqualsFilterExpression:
+		return expr.Fields, true
+	case PrefixFilterExpression:
+		return expr.Fields, true
+	case ComparisonFilterExpression:
+		return expr.Fields, true
+	}
+	return nil, false
+}
+
+// GetRecordFieldValue returns the value of one of the built-in record fields:
+// type, id or modified_at. If fields does not refer to a built-in field false
+// is returned.
+func GetRecordFieldValue(record *databroker.Record, fields []string) (string, bool) {
+	switch strings.Join(fields, ".") {
+	case "type":
+		return record.GetType(), true
+	case "id":
+		return record.GetId(), true
+	case "modified_at":
+		return FormatFilterTime(record.GetModifiedAt().AsTime()), true
+	}
+	return "", false
+}
+
+// FilterExpressionMatchesValue reports whether a field value matches a
+// field comparison expression. And and or expressions never match.
+func FilterExpressionMatchesValue(expr FilterExpression, value string) bool {
+	switch expr := expr.(type) {
+	case EqualsFilterExpression:
+		return value == expr.Value
+	case NotEqualsFilterExpression:
+		return value != expr.Value
+	case PrefixFilterExpression:
+		return strings.HasPrefix(value, expr.Value)
+	case ComparisonFilterExpression:
+		c := strings.Compare(value, expr.Value)
+		switch expr.Operator {
+		case ComparisonLessThan:
+			return c < 0
+		case ComparisonLessThanOrEqual:
+			return c <= 0
+		case ComparisonGreaterThan:
+			return c > 0
+		case ComparisonGreaterThanOrEqual:
+			return c >= 0
+		}
+	}
+	return false
+}
+
+// FilterExpressionToDNF converts a filter expression to disjunctive normal
+// form: an or of ands, where each and only contains field comparisons.
+func FilterExpressionToDNF(expr FilterExpression) OrFilterExpression {
+	switch expr := expr.(type) {
+	case nil:
+		return nil
+	case OrFilterExpression:
+		var or OrFilterExpression
+		for _, e := range expr {
+			or = append(or, FilterExpressionToDNF(e)...)
+		}
+		return or
+	case AndFilterExpression:
+		// distribute the and over each of the ors
+		or := OrFilterExpression{AndFilterExpression{}}
+		for _, e := range expr {
+			var next OrFilterExpression
+			for _, conjunction := range or {
+				for _, term := range FilterExpressionToDNF(e) {
+					and := slices.Clone(conjunction.(AndFilterExpression))
+					and = append(and, term.(AndFilterExpression)...)
+					next = append(next, and)
+				}
+			}
+			or = next
+		}
+		return or
+	default:
+		return OrFilterExpression{AndFilterExpression{expr}}
+	}
+}
@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// A RecordMatcher reports whether a record matches a compiled filter
+// expression.
+type RecordMatcher func(record *databroker.Record) bool
+
+// CompileFilter compiles a filter expression into a RecordMatcher. Field paths
+// and values are resolved once, so the matcher is much cheaper to apply to many
+// records than evaluating the expression for each one. A nil expression
+// matches every record.
+//
+// Only the built-in record fields (type, id and modified_at), $index and
+// search expressions are supported.
+func CompileFilter(expr FilterExpression) (RecordMatcher, error) {
+	switch expr := expr.(type) {
+	case nil:
+		return func(_ *databroker.Record) bool { return true }, nil
+	case AndFilterExpression:
+		matchers, err := compileFilters(expr)
+		if err != nil {
+			return nil, err
+		}
+		return func(record *databroker.Record) bool {
+			for _, match := range matchers {
+				if !match(record) {
+					return false
+				}
+			}
+			return len(matchers) > 0
+		}, nil
+	case OrFilterExpression:
+		// $index values are combined into a single matcher so that each
+		// record's prefixes are only looked up once
+		var index *IndexCIDRMatcher
+		var rest []FilterExpression
+		for _, e := range expr {
+			if eq, ok := e.(EqualsFilterExpression); ok && strings.Join(eq.Fields, ".") == indexField {
+				if index == nil {
+					index = NewIndexCIDRMatcher()
+				}
+				index.Add(eq.Value)
+				continue
+			}
+			rest = append(rest, e)
+		}
+		matchers, err := compileFilters(rest)
+		if err != nil {
+			return nil, err
+		}
+		if index != nil {
+			matchers = append(matchers, index.MatchRecord)
+		}
+		return func(record *databroker.Record) bool {
+			for _, match := range matchers {
+				if match(record) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case EqualsFilterExpression:
+		if strings.Join(expr.Fields, ".") == indexField {
+			return NewIndexCIDRMatcher(expr.Value).MatchRecord, nil
+		}
+		return compileFieldMatcher(expr.Fields, expr)
+	case NotEqualsFilterExpression:
+		return compileFieldMatcher(expr.Fields, expr)
+	case PrefixFilterExpression:
+		return compileFieldMatcher(expr.Fields, expr)
+	case ComparisonFilterExpression:
+		return compileFieldMatcher(expr.Fields, expr)
+	case SearchFilterExpression:
+		query := strings.ToLower(expr.Query)
+		if query == "" {
+			return func(_ *databroker.Record) bool { return true }, nil
+		}
+		return func(record *databroker.Record) bool {
+			return MatchAny(record.GetData(), query)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown expression type: %T", expr)
+	}
+}
+
+func compileFilters(exprs []FilterExpression) ([]RecordMatcher, error) {
+	matchers := make([]RecordMatcher, len(exprs))
+	for i, expr := range exprs {
+		var err error
+		matchers[i], err = CompileFilter(expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return matchers, nil
+}
+
+func compileFieldMatcher(fields []string, expr FilterExpression) (RecordMatcher, error) {
+	switch strings.Join(fields, ".") {
+	case "type":
+		matchValue := compileValueMatcher(expr)
+		return func(record *databroker.Record) bool {
+			return matchValue(record.GetType())
+		}, nil
+	case "id":
+		matchValue := compileValueMatcher(expr)
+		return func(record *databroker.Record) bool {
+			return matchValue(record.GetId())
+		}, nil
+	case "modified_at":
+		return compileModifiedAtMatcher(expr), nil
+	}
+	return nil, fmt.Errorf("unknown field: %s", strings.Join(fields, "."))
+}
+
+// compileModifiedAtMatcher compares timestamps directly, rather than
+// formatting the modified at timestamp of every record.
+func compileModifiedAtMatcher(expr FilterExpression) RecordMatcher {
+	var value string
+	var matchCompare func(c int) bool
+	switch expr := expr.(type) {
+	case EqualsFilterExpression:
+		value = expr.Value
+		matchCompare = func(c int) bool { return c == 0 }
+	case NotEqualsFilterExpression:
+		value = expr.Value
+		matchCompare = func(c int) bool { return c != 0 }
+	case ComparisonFilterExpression:
+		value = expr.Value
+		matchCompare = compileComparison(expr.Operator)
+	}
+
+	tm, err := time.Parse(filterTimeFormat, value)
+	if matchCompare == nil || err != nil || FormatFilterTime(tm) != value {
+		matchValue := compileValueMatcher(expr)
+		return func(record *databroker.Record) bool {
+			return matchValue(FormatFilterTime(record.GetModifiedAt().AsTime()))
+		}
+	}
+
+	return func(record *databroker.Record) bool {
+		return matchCompare(record.GetModifiedAt().AsTime().Compare(tm))
+	}
+}
+
+func compileValueMatcher(expr FilterExpression) func(value string) bool {
+	switch expr := expr.(type) {
+	case EqualsFilterExpression:
+		return func(value string) bool { return value == expr.Value }
+	case NotEqualsFilterExpression:
+		return func(value string) bool { return value != expr.Value }
+	case PrefixFilterExpression:
+		return func(value string) bool { return strings.HasPrefix(value, expr.Value) }
+	case ComparisonFilterExpression:
+		matchCompare := compileComparison(expr.Operator)
+		return func(value string) bool { return matchCompare(strings.Compare(value, expr.Value)) }
+	}
+	return func(_ string) bool { return false }
+}
+
+func compileComparison(op ComparisonOperator) func(c int) bool {
+	switch op {
+	case ComparisonLessThan:
+		return func(c int) bool { return c < 0 }
+	case ComparisonLessThanOrEqual:
+		return func(c int) bool { return c <= 0 }
+	case ComparisonGreaterThan:
+		return func(c int) bool { return c > 0 }
+	case ComparisonGreaterThanOrEqual:
+		return func(c int) bool { return c >= 0 }
+	}
+	return func(_ int) bool { return false }
+}
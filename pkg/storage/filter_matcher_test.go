@@ -0,0 +1,156 @@
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+func TestCompileFilter(t *testing.T) {
+	t.Parallel()
+
+	modifiedAt := time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC)
+	record := &databroker.Record{
+		Type:       "example",
+		Id:         "id-2",
+		ModifiedAt: timestamppb.New(modifiedAt),
+		Data: newStructAny(t, map[string]any{
+			"name": "Alice",
+			"$index": map[string]any{
+				"cidr": "10.0.0.0/16",
+			},
+		}),
+	}
+
+	for _, tc := range []struct {
+		expr   storage.FilterExpression
+		expect bool
+	}{
+		{nil, true},
+		{storage.AndFilterExpression{}, false},
+		{storage.OrFilterExpression{}, false},
+		{storage.EqualsFilterExpression{Fields: []string{"type"}, Value: "example"}, true},
+		{storage.EqualsFilterExpression{Fields: []string{"id"}, Value: "id-1"}, false},
+		{storage.NotEqualsFilterExpression{Fields: []string{"id"}, Value: "id-1"}, true},
+		{storage.PrefixFilterExpression{Fields: []string{"id"}, Value: "id-"}, true},
+		{storage.ComparisonFilterExpression{Fields: []string{"id"}, Operator: storage.ComparisonGreaterThan, Value: "id-1"}, true},
+		{storage.ComparisonFilterExpression{Fields: []string{"id"}, Operator: storage.ComparisonLessThan, Value: "id-2"}, false},
+		{storage.EqualsFilterExpression{Fields: []string{"modified_at"}, Value: storage.FormatFilterTime(modifiedAt)}, true},
+		{storage.ComparisonFilterExpression{
+			Fields:   []string{"modified_at"},
+			Operator: storage.ComparisonGreaterThanOrEqual,
+			Value:    storage.FormatFilterTime(modifiedAt.Add(time.Nanosecond)),
+		}, false},
+		{storage.ComparisonFilterExpression{
+			Fields:   []string{"modified_at"},
+			Operator: storage.ComparisonLessThan,
+			Value:    storage.FormatFilterTime(modifiedAt.Add(time.Nanosecond)),
+		}, true},
+		{storage.PrefixFilterExpression{Fields: []string{"modified_at"}, Value: "2025-01-02"}, true},
+		{storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "10.0.1.1"}, true},
+		{storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "10.0.1.0/24"}, true},
+		{storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "10.0.0.0/8"}, false},
+		{storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "192.168.0.1"}, false},
+		{storage.SearchFilterExpression{Query: "ALI"}, true},
+		{storage.SearchFilterExpression{Query: "bob"}, false},
+		{storage.AndFilterExpression{
+			storage.EqualsFilterExpression{Fields: []string{"type"}, Value: "example"},
+			storage.OrFilterExpression{
+				storage.EqualsFilterExpression{Fields: []string{"id"}, Value: "id-1"},
+				storage.EqualsFilterExpression{Fields: []string{"id"}, Value: "id-2"},
+			},
+		}, true},
+	} {
+		match, err := storage.CompileFilter(tc.expr)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expect, match(record), "%#v", tc.expr)
+	}
+
+	multi := &databroker.Record{
+		Type: "example",
+		Id:   "id-3",
+		Data: newStructAny(t, map[string]any{
+			"$index": map[string]any{
+				"cidr": []any{"10.0.0.0/16", "192.168.0.0/16"},
+			},
+		}),
+	}
+	for _, tc := range []struct {
+		expr   storage.FilterExpression
+		expect bool
+	}{
+		{storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "192.168.1.1"}, true},
+		{storage.OrFilterExpression{
+			storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "172.16.0.1"},
+			storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "10.0.1.0/24"},
+		}, true},
+		{storage.OrFilterExpression{
+			storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "172.16.0.1"},
+			storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "10.0.0.0/8"},
+		}, false},
+		{storage.OrFilterExpression{
+			storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "172.16.0.1"},
+			storage.EqualsFilterExpression{Fields: []string{"id"}, Value: "id-3"},
+		}, true},
+	} {
+		match, err := storage.CompileFilter(tc.expr)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expect, match(multi), "%#v", tc.expr)
+	}
+
+	_, err := storage.CompileFilter(storage.EqualsFilterExpression{Fields: []string{"name"}, Value: "Alice"})
+	assert.ErrorContains(t, err, "unknown field: name")
+}
+
+func BenchmarkCompileFilter(b *testing.B) {
+	records := make([]*databroker.Record, 1000)
+	for i := range records {
+		records[i] = &databroker.Record{
+			Type:       "example",
+			Id:         fmt.Sprintf("id-%04d", i),
+			ModifiedAt: timestamppb.New(time.Unix(int64(i), 0)),
+			Data:       protoutil.NewAnyString(fmt.Sprintf("value-%d", i)),
+		}
+	}
+	expr := storage.AndFilterExpression{
+		storage.PrefixFilterExpression{Fields: []string{"id"}, Value: "id-0"},
+		storage.ComparisonFilterExpression{
+			Fields:   []string{"modified_at"},
+			Operator: storage.ComparisonGreaterThanOrEqual,
+			Value:    storage.FormatFilterTime(time.Unix(500, 0)),
+		},
+	}
+
+	b.Run("compiled", func(b *testing.B) {
+		for b.Loop() {
+			match, err := storage.CompileFilter(expr)
+			if err != nil {
+				b.Fatal(err)
+			}
+			for _, record := range records {
+				match(record)
+			}
+		}
+	})
+	b.Run("uncompiled", func(b *testing.B) {
+		for b.Loop() {
+			for _, record := range records {
+				for _, e := range expr {
+					fields, _ := storage.FilterExpressionFields(e)
+					value, _ := storage.GetRecordFieldValue(record, fields)
+					if !storage.FilterExpressionMatchesValue(e, value) {
+						break
+					}
+				}
+			}
+		}
+	})
+}
@@ -73,3 +73,114 @@ func TestFilterExpressionFromStruct(t *testing.T) {
 		},
 		expr)
 }
+
+func TestFilterExpressionFromStructOperators(t *testing.T) {
+	t.Parallel()
+
+	type M = map[string]any
+	type A = []any
+
+	s, err := structpb.NewStruct(M{
+		"id": M{
+			"$ne":     "a",
+			"$prefix": "b",
+		},
+		"type": M{
+			"$in": A{"c", "d"},
+		},
+		"modified_at": M{
+			"$gte": "2024-01-02T03:04:05+01:00",
+			"$lt":  1704164645.5,
+		},
+	})
+	require.NoError(t, err)
+	expr, err := FilterExpressionFromStruct(s)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		AndFilterExpression{
+			AndFilterExpression{
+				NotEqualsFilterExpression{Fields: []string{"id"}, Value: "a"},
+				PrefixFilterExpression{Fields: []string{"id"}, Value: "b"},
+			},
+			AndFilterExpression{
+				ComparisonFilterExpression{
+					Fields:   []string{"modified_at"},
+					Operator: ComparisonGreaterThanOrEqual,
+					Value:    "2024-01-02T02:04:05.000000000Z",
+				},
+				ComparisonFilterExpression{
+					Fields:   []string{"modified_at"},
+					Operator: ComparisonLessThan,
+					Value:    "2024-01-02T03:04:05.500000000Z",
+				},
+			},
+			OrFilterExpression{
+				EqualsFilterExpression{Fields: []string{"type"}, Value: "c"},
+				EqualsFilterExpression{Fields: []string{"type"}, Value: "d"},
+			},
+		},
+		expr)
+
+	for _, v := range []M{
+		{"id": M{"$prefix": 1}},
+		{"id": M{"$in": "x"}},
+		{"modified_at": M{"$gt": "yesterday"}},
+	} {
+		s, err := structpb.NewStruct(v)
+		require.NoError(t, err)
+		_, err = FilterExpressionFromStruct(s)
+		assert.Error(t, err, "should reject %v", v)
+	}
+}
+
+func TestFilterExpressionMatchesValue(t *testing.T) {
+	t.Parallel()
+
+	fields := []string{"id"}
+	for _, tc := range []struct {
+		expr   FilterExpression
+		value  string
+		expect bool
+	}{
+		{EqualsFilterExpression{Fields: fields, Value: "a"}, "a", true},
+		{EqualsFilterExpression{Fields: fields, Value: "a"}, "b", false},
+		{NotEqualsFilterExpression{Fields: fields, Value: "a"}, "b", true},
+		{NotEqualsFilterExpression{Fields: fields, Value: "a"}, "a", false},
+		{PrefixFilterExpression{Fields: fields, Value: "ab"}, "abc", true},
+		{PrefixFilterExpression{Fields: fields, Value: "ab"}, "bc", false},
+		{ComparisonFilterExpression{Fields: fields, Operator: ComparisonLessThan, Value: "b"}, "a", true},
+		{ComparisonFilterExpression{Fields: fields, Operator: ComparisonLessThan, Value: "b"}, "b", false},
+		{ComparisonFilterExpression{Fields: fields, Operator: ComparisonLessThanOrEqual, Value: "b"}, "b", true},
+		{ComparisonFilterExpression{Fields: fields, Operator: ComparisonGreaterThan, Value: "b"}, "b", false},
+		{ComparisonFilterExpression{Fields: fields, Operator: ComparisonGreaterThanOrEqual, Value: "b"}, "c", true},
+		{AndFilterExpression{}, "a", false},
+	} {
+		assert.Equal(t, tc.expect, FilterExpressionMatchesValue(tc.expr, tc.value),
+			"%#v should match %q: %v", tc.expr, tc.value, tc.expect)
+	}
+}
+
+func TestFilterExpressionToDNF(t *testing.T) {
+	t.Parallel()
+
+	a := EqualsFilterExpression{Fields: []string{"a"}, Value: "1"}
+	b := NotEqualsFilterExpression{Fields: []string{"b"}, Value: "2"}
+	c := PrefixFilterExpression{Fields: []string{"c"}, Value: "3"}
+
+	assert.Nil(t, FilterExpressionToDNF(nil))
+	assert.Equal(t,
+		OrFilterExpression{AndFilterExpression{a}},
+		FilterExpressionToDNF(a))
+	assert.Equal(t,
+		OrFilterExpression{
+			AndFilterExpression{a, b},
+			AndFilterExpression{a, c},
+		},
+		FilterExpressionToDNF(AndFilterExpression{a, OrFilterExpression{b, c}}))
+	assert.Equal(t,
+		OrFilterExpression{
+			AndFilterExpression{a},
+			AndFilterExpression{b, c},
+		},
+		FilterExpressionToDNF(OrFilterExpression{a, AndFilterExpression{b, c}}))
+}
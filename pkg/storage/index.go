@@ -2,10 +2,14 @@ package storage
 
 import (
 	"net/netip"
+	"slices"
 
+	"github.com/gaissmai/bart"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
 )
 
 const (
@@ -40,8 +44,10 @@ func GetRecordIndex(msg proto.Message) *structpb.Struct {
 	return f.GetStructValue()
 }
 
-// GetRecordIndexCIDR returns the $index.cidr for a record's data. If none is available nil is returned.
-func GetRecordIndexCIDR(msg proto.Message) *netip.Prefix {
+// GetRecordIndexCIDRs returns the $index.cidr prefixes for a record's data.
+// The cidr may either be a single string or a list of strings. Invalid
+// prefixes are ignored. If none are available nil is returned.
+func GetRecordIndexCIDRs(msg proto.Message) []netip.Prefix {
 	obj := GetRecordIndex(msg)
 	if obj == nil {
 		return nil
@@ -52,14 +58,83 @@ func GetRecordIndexCIDR(msg proto.Message) *netip.Prefix {
 		return nil
 	}
 
-	c := cf.GetStringValue()
-	if c == "" {
-		return nil
+	var values []*structpb.Value
+	if lv := cf.GetListValue(); lv != nil {
+		values = lv.GetValues()
+	} else {
+		values = []*structpb.Value{cf}
 	}
 
-	prefix, err := netip.ParsePrefix(c)
-	if err != nil {
-		return nil
+	var prefixes []netip.Prefix
+	for _, v := range values {
+		c := v.GetStringValue()
+		if c == "" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(c)
+		if err != nil {
+			continue
+		}
+		prefix = prefix.Masked()
+		if !slices.Contains(prefixes, prefix) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// An IndexCIDRMatcher matches $index.cidr prefixes against a set of IP
+// addresses and prefixes. The set is stored in a single table so that a query
+// with many values is evaluated with one lookup per record prefix.
+type IndexCIDRMatcher struct {
+	table bart.Table[struct{}]
+	size  int
+}
+
+// NewIndexCIDRMatcher creates a new IndexCIDRMatcher from $index filter
+// values. Values which are neither an IP address nor a prefix are ignored.
+func NewIndexCIDRMatcher(values ...string) *IndexCIDRMatcher {
+	m := new(IndexCIDRMatcher)
+	for _, value := range values {
+		m.Add(value)
+	}
+	return m
+}
+
+// Add adds an $index filter value to the matcher. False is returned if the
+// value is neither an IP address nor a prefix.
+func (m *IndexCIDRMatcher) Add(value string) bool {
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		m.table.Insert(prefix.Masked(), struct{}{})
+	} else if addr, err := netip.ParseAddr(value); err == nil {
+		m.table.Insert(netip.PrefixFrom(addr, addr.BitLen()), struct{}{})
+	} else {
+		return false
+	}
+	m.size++
+	return true
+}
+
+// Match returns true if any of the prefixes contain one of the matcher's
+// addresses or prefixes.
+func (m *IndexCIDRMatcher) Match(prefixes []netip.Prefix) bool {
+	if m.size == 0 {
+		return false
+	}
+	for _, prefix := range prefixes {
+		for range m.table.Subnets(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchRecord returns true if the $index.cidr of the record's data contains
+// one of the matcher's addresses or prefixes.
+func (m *IndexCIDRMatcher) MatchRecord(record *databroker.Record) bool {
+	if m.size == 0 {
+		return false
 	}
-	return &prefix
+	return m.Match(GetRecordIndexCIDRs(record.GetData()))
 }
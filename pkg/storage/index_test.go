@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -62,3 +63,63 @@ func TestGetRecordIndex(t *testing.T) {
 		}, GetRecordIndex(data))
 	})
 }
+
+func TestGetRecordIndexCIDRs(t *testing.T) {
+	t.Parallel()
+
+	type M = map[string]any
+	for _, tc := range []struct {
+		name   string
+		cidr   any
+		expect []netip.Prefix
+	}{
+		{"string", "192.168.0.0/16", []netip.Prefix{netip.MustParsePrefix("192.168.0.0/16")}},
+		{"list", []any{"10.0.0.0/8", "192.168.0.0/16"}, []netip.Prefix{
+			netip.MustParsePrefix("10.0.0.0/8"),
+			netip.MustParsePrefix("192.168.0.0/16"),
+		}},
+		{"duplicates", []any{"10.0.0.0/8", "10.1.0.0/8"}, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}},
+		{"invalid", []any{"", "invalid", 1, "10.0.0.0/8"}, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}},
+		{"empty", []any{}, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := structpb.NewStruct(M{
+				"$index": M{
+					"cidr": tc.cidr,
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, GetRecordIndexCIDRs(v))
+		})
+	}
+}
+
+func TestIndexCIDRMatcher(t *testing.T) {
+	t.Parallel()
+
+	m := NewIndexCIDRMatcher("10.1.2.3", "192.168.1.0/24", "invalid")
+	for _, tc := range []struct {
+		prefixes []string
+		expect   bool
+	}{
+		{nil, false},
+		{[]string{"10.0.0.0/8"}, true},
+		{[]string{"10.1.2.3/32"}, true},
+		{[]string{"10.1.2.4/32"}, false},
+		{[]string{"192.168.0.0/16"}, true},
+		{[]string{"192.168.1.0/24"}, true},
+		{[]string{"192.168.1.0/25"}, false},
+		{[]string{"172.16.0.0/12", "192.168.0.0/16"}, true},
+		{[]string{"172.16.0.0/12"}, false},
+	} {
+		var prefixes []netip.Prefix
+		for _, prefix := range tc.prefixes {
+			prefixes = append(prefixes, netip.MustParsePrefix(prefix))
+		}
+		assert.Equal(t, tc.expect, m.Match(prefixes), "%v", tc.prefixes)
+	}
+
+	assert.False(t, NewIndexCIDRMatcher().Match([]netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")}))
+}
@@ -43,6 +43,18 @@ func (change recordChange) Less(item btree.Item) bool {
 	return change.record.GetVersion() < that.record.GetVersion()
 }
 
+// A recordKey identifies a record. Record keys are ordered by type and then
+// by id.
+type recordKey struct {
+	recordType string
+	recordID   string
+}
+
+func lessRecordKey(a, b recordKey) bool {
+	return a.recordType < b.recordType ||
+		(a.recordType == b.recordType && a.recordID < b.recordID)
+}
+
 // A Backend stores data in-memory.
 type Backend struct {
 	cfg              *config
@@ -52,11 +64,12 @@ type Backend struct {
 	closeCtx context.Context
 	close    context.CancelFunc
 
-	mu       sync.RWMutex
-	lookup   map[string]storage.RecordCollection
-	capacity map[string]*uint64
-	changes  *btree.BTree
-	leases   map[string]*lease
+	mu         sync.RWMutex
+	lookup     map[string]storage.RecordCollection
+	recordKeys *btree.BTreeG[recordKey]
+	capacity   map[string]*uint64
+	changes    *btree.BTree
+	leases     map[string]*lease
 
 	serverVersion           uint64
 	earliestRecordVersion   uint64
@@ -74,6 +87,7 @@ func New(options ...Option) *Backend {
 		serverVersion:    cryptutil.NewRandomUInt64(),
 		iteratorCanceler: contextutil.NewCanceler(),
 		lookup:           make(map[string]storage.RecordCollection),
+		recordKeys:       btree.NewG(cfg.degree, lessRecordKey),
 		capacity:         map[string]*uint64{},
 		changes:          btree.New(cfg.degree),
 		leases:           make(map[string]*lease),
@@ -137,6 +151,7 @@ func (backend *Backend) Clear(_ context.Context) error {
 	backend.checkpointServerVersion = 0
 	backend.checkpointRecordVersion = 0
 	clear(backend.lookup)
+	backend.recordKeys.Clear(false)
 	clear(backend.capacity)
 	backend.changes.Clear(false)
 	backend.iteratorCanceler.Cancel(nil)
@@ -222,6 +237,23 @@ func (backend *Backend) Lease(_ context.Context, leaseName, leaseID string, ttl
 	return true, nil
 }
 
+// ListLeases lists all the unexpired leases, sorted by name.
+func (backend *Backend) ListLeases(_ context.Context) ([]storage.Lease, error) {
+	backend.mu.Lock()
+	defer backend.mu.Unlock()
+
+	now := time.Now()
+	var leases []storage.Lease
+	for _, name := range slices.Sorted(maps.Keys(backend.leases)) {
+		l := backend.leases[name]
+		if l.expiry.Before(now) {
+			continue
+		}
+		leases = append(leases, storage.Lease{Name: name, ID: l.id, ExpiresAt: l.expiry})
+	}
+	return leases, nil
+}
+
 // ListTypes lists the record types.
 func (backend *Backend) ListTypes(_ context.Context) ([]string, error) {
 	backend.mu.Lock()
@@ -237,11 +269,19 @@ func (backend *Backend) Put(ctx context.Context, records []*databroker.Record) (
 	defer backend.mu.Unlock()
 	defer backend.onRecordChange.Broadcast(ctx)
 
-	recordTypes := map[string]struct{}{}
 	for _, record := range records {
 		if record == nil {
 			return backend.serverVersion, fmt.Errorf("records cannot be nil")
 		}
+		err = storage.CheckExpectedVersion(record, backend.get(record.GetType(), record.GetId()))
+		if err != nil {
+			return backend.serverVersion, err
+		}
+	}
+
+	recordTypes := map[string]struct{}{}
+	for _, record := range records {
+		record.ExpectedVersion = nil
 
 		ctx = log.WithContext(ctx, func(c zerolog.Context) zerolog.Context {
 			return c.Str("db-op", "put").
@@ -271,6 +311,18 @@ func (backend *Backend) update(record *databroker.Record) {
 	}
 
 	c.Put(record)
+	backend.updateRecordKey(record)
+}
+
+// updateRecordKey adds or removes the record's key from the ordered record
+// keys, assuming the RWMutex is held.
+func (backend *Backend) updateRecordKey(record *databroker.Record) {
+	key := recordKey{recordType: record.GetType(), recordID: record.GetId()}
+	if record.GetDeletedAt() != nil {
+		backend.recordKeys.Delete(key)
+	} else {
+		backend.recordKeys.ReplaceOrInsert(key)
+	}
 }
 
 // Patch updates the specified fields of existing record(s).
@@ -357,7 +409,56 @@ func (backend *Backend) SyncLatest(
 	serverVersion = backend.serverVersion
 	recordVersion = backend.latestRecordVersion
 	backend.mu.RUnlock()
-	return serverVersion, recordVersion, backend.iterateLatestRecords(ctx, recordType, expr), nil
+	return serverVersion, recordVersion, backend.iterateLatestRecords(ctx, recordType, expr, nil), nil
+}
+
+// SyncLatestAfter returns a record iterator for all the records that sort
+// after the record with the given type and id.
+func (backend *Backend) SyncLatestAfter(
+	ctx context.Context,
+	recordType string,
+	expr storage.FilterExpression,
+	afterRecordType, afterRecordID string,
+) (serverVersion, recordVersion uint64, seq storage.RecordIterator, err error) {
+	backend.mu.RLock()
+	serverVersion = backend.serverVersion
+	recordVersion = backend.latestRecordVersion
+	backend.mu.RUnlock()
+	after := &databroker.Record{Type: afterRecordType, Id: afterRecordID}
+	return serverVersion, recordVersion, backend.iterateLatestRecords(ctx, recordType, expr, after), nil
+}
+
+// SyncLatestOrdered returns a record iterator for all the records sorted by
+// the given order.
+func (backend *Backend) SyncLatestOrdered(
+	ctx context.Context,
+	recordType string,
+	expr storage.FilterExpression,
+	orderBy storage.OrderBy,
+) (serverVersion, recordVersion uint64, seq storage.RecordIterator, err error) {
+	serverVersion, recordVersion, seq, err = backend.SyncLatest(ctx, recordType, expr)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return serverVersion, recordVersion, storage.SortRecordIterator(seq, orderBy), nil
+}
+
+// Stats returns statistics for each record type.
+func (backend *Backend) Stats(_ context.Context) ([]storage.RecordTypeStats, error) {
+	backend.mu.RLock()
+	defer backend.mu.RUnlock()
+
+	var stats []storage.RecordTypeStats
+	for _, recordType := range slices.Sorted(maps.Keys(backend.lookup)) {
+		s := storage.RecordTypeStats{RecordType: recordType}
+		for _, record := range backend.lookup[recordType].All() {
+			s.Add(record, proto.Size(record))
+		}
+		if s.Count > 0 {
+			stats = append(stats, s)
+		}
+	}
+	return stats, nil
 }
 
 // Versions returns the versions of the storage backend.
@@ -396,6 +497,7 @@ func (backend *Backend) enforceCapacity(recordType string) {
 		r.DeletedAt = timestamppb.Now()
 		backend.recordChange(r)
 		collection.Put(r)
+		backend.updateRecordKey(r)
 	}
 }
 
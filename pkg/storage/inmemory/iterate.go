@@ -1,6 +1,7 @@
 package inmemory
 
 import (
+	"cmp"
 	"context"
 	"maps"
 	"slices"
@@ -10,6 +11,10 @@ import (
 	"github.com/pomerium/pomerium/pkg/storage"
 )
 
+// batchSize is the maximum number of records read while holding the lock
+// when iterating over the latest records.
+const batchSize = 64
+
 func (backend *Backend) iterateChangedRecords(
 	ctx context.Context,
 	recordType string,
@@ -62,11 +67,19 @@ func (backend *Backend) iterateChangedRecords(
 	}
 }
 
+// iterateLatestRecords iterates over the latest records. If after is set,
+// records are ordered by id and only records that sort after it are returned.
+// Otherwise records are returned in insertion order.
 func (backend *Backend) iterateLatestRecords(
 	ctx context.Context,
 	recordType string,
 	expr storage.FilterExpression,
+	after *databroker.Record,
 ) storage.RecordIterator {
+	if after != nil {
+		return backend.iterateLatestRecordsAfter(ctx, recordType, expr, after)
+	}
+
 	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx, backend.iteratorCanceler.Context())
 	return func(yield func(*databroker.Record, error) bool) {
 		defer cancel(nil)
@@ -113,3 +126,96 @@ func (backend *Backend) iterateLatestRecords(
 		}
 	}
 }
+
+// iterateLatestRecordsAfter iterates over the latest records that sort after
+// the given record, ordered by type and id. Records are read in batches which
+// start at the last record returned, so each batch only visits the records
+// after the cursor.
+func (backend *Backend) iterateLatestRecordsAfter(
+	ctx context.Context,
+	recordType string,
+	expr storage.FilterExpression,
+	after *databroker.Record,
+) storage.RecordIterator {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx, backend.iteratorCanceler.Context())
+	return func(yield func(*databroker.Record, error) bool) {
+		defer cancel(nil)
+
+		match, err := storage.CompileFilter(expr)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		cursor := recordKey{recordType: after.GetType(), recordID: after.GetId()}
+		for {
+			backend.mu.RLock()
+			records, done := backend.listLatestRecordsAfterLocked(recordType, match, cursor)
+			backend.mu.RUnlock()
+
+			for _, record := range records {
+				if !yield(record, nil) {
+					return
+				}
+
+				select {
+				case <-ctx.Done():
+					yield(nil, context.Cause(ctx))
+					return
+				default:
+				}
+			}
+
+			if done {
+				return
+			}
+			cursor = recordKey{recordType: records[len(records)-1].GetType(), recordID: records[len(records)-1].GetId()}
+		}
+	}
+}
+
+// listLatestRecordsAfterLocked lists up to batchSize matching records that
+// sort after the cursor, assuming the RWMutex is held. done is true if there
+// are no more records after the returned ones.
+func (backend *Backend) listLatestRecordsAfterLocked(
+	recordType string,
+	match storage.RecordMatcher,
+	cursor recordKey,
+) (records []*databroker.Record, done bool) {
+	pivot := cursor
+	if recordType != "" && recordType > cursor.recordType {
+		pivot = recordKey{recordType: recordType}
+	}
+
+	done = true
+	backend.recordKeys.AscendGreaterOrEqual(pivot, func(key recordKey) bool {
+		if recordType != "" && key.recordType != recordType {
+			return false
+		}
+		if key == cursor {
+			return true
+		}
+		if len(records) >= batchSize {
+			done = false
+			return false
+		}
+
+		co, ok := backend.lookup[key.recordType]
+		if !ok {
+			return true
+		}
+		record, ok := co.Get(key.recordID)
+		if ok && match(record) {
+			records = append(records, record)
+		}
+		return true
+	})
+	return records, done
+}
+
+func compareRecords(a, b *databroker.Record) int {
+	return cmp.Or(
+		cmp.Compare(a.GetType(), b.GetType()),
+		cmp.Compare(a.GetId(), b.GetId()),
+	)
+}
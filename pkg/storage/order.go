@@ -0,0 +1,232 @@
+package storage
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// An OrderByField is a key used to sort records.
+type OrderByField struct {
+	Fields     []string
+	Descending bool
+}
+
+// OrderBy is a list of keys used to sort records. Later keys break ties in
+// earlier keys. Records that are equal for every key are sorted by type and
+// then by id.
+type OrderBy []OrderByField
+
+// OrderByFromProto converts a list of protobuf order by keys into an OrderBy.
+func OrderByFromProto(pbs []*databroker.QueryOrderBy) (OrderBy, error) {
+	orderBy := make(OrderBy, 0, len(pbs))
+	for _, pb := range pbs {
+		if pb.GetField() == "" {
+			return nil, fmt.Errorf("order by field is required")
+		}
+		fields := strings.Split(pb.GetField(), ".")
+		if slices.Contains(fields, "") {
+			return nil, fmt.Errorf("invalid order by field: %s", pb.GetField())
+		}
+		orderBy = append(orderBy, OrderByField{
+			Fields:     fields,
+			Descending: pb.GetDescending(),
+		})
+	}
+	return orderBy, nil
+}
+
+// IsModifiedAt returns true if the records are only ordered by when they were
+// modified. Backends which index records by version can use this to avoid
+// sorting.
+func (orderBy OrderBy) IsModifiedAt() (descending, ok bool) {
+	if len(orderBy) != 1 {
+		return false, false
+	}
+	switch strings.Join(orderBy[0].Fields, ".") {
+	case "modified_at", "version":
+		return orderBy[0].Descending, true
+	}
+	return false, false
+}
+
+// SortRecords sorts records in place.
+func SortRecords(records []*databroker.Record, orderBy OrderBy) {
+	if len(orderBy) == 0 {
+		return
+	}
+
+	// look up the values for each record once instead of on every comparison
+	type entry struct {
+		record *databroker.Record
+		values []*structpb.Value
+	}
+	entries := make([]entry, len(records))
+	for i, record := range records {
+		entries[i].record = record
+		entries[i].values = make([]*structpb.Value, len(orderBy))
+		for j, field := range orderBy {
+			entries[i].values[j] = getOrderByValue(record, field.Fields)
+		}
+	}
+
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		for i, field := range orderBy {
+			c := compareOrderByValues(a.values[i], b.values[i])
+			if c == 0 && isModifiedAtField(field.Fields) {
+				// records modified at the same time are ordered by version
+				c = cmp.Compare(a.record.GetVersion(), b.record.GetVersion())
+			}
+			if field.Descending {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return cmp.Or(
+			cmp.Compare(a.record.GetType(), b.record.GetType()),
+			cmp.Compare(a.record.GetId(), b.record.GetId()),
+		)
+	})
+
+	for i := range entries {
+		records[i] = entries[i].record
+	}
+}
+
+// SortRecordIterator returns a RecordIterator that yields the records from
+// seq sorted by orderBy. All the records are read before any are yielded.
+func SortRecordIterator(seq RecordIterator, orderBy OrderBy) RecordIterator {
+	if len(orderBy) == 0 {
+		return seq
+	}
+
+	return func(yield func(*databroker.Record, error) bool) {
+		var records []*databroker.Record
+		for record, err := range seq {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			records = append(records, record)
+		}
+
+		SortRecords(records, orderBy)
+
+		for _, record := range records {
+			if !yield(record, nil) {
+				return
+			}
+		}
+	}
+}
+
+func isModifiedAtField(fields []string) bool {
+	return len(fields) == 1 && fields[0] == "modified_at"
+}
+
+func getOrderByValue(record *databroker.Record, fields []string) *structpb.Value {
+	switch strings.Join(fields, ".") {
+	case "type":
+		return structpb.NewStringValue(record.GetType())
+	case "id":
+		return structpb.NewStringValue(record.GetId())
+	case "version":
+		return structpb.NewNumberValue(float64(record.GetVersion()))
+	case "modified_at":
+		// RFC 3339 timestamps in UTC sort lexically
+		return structpb.NewStringValue(record.GetModifiedAt().AsTime().UTC().Format("2006-01-02T15:04:05.000000000Z"))
+	}
+
+	var msg proto.Message = record.GetData()
+	for {
+		data, ok := msg.(*anypb.Any)
+		if !ok {
+			break
+		}
+		var err error
+		msg, err = data.UnmarshalNew()
+		if err != nil {
+			return nil
+		}
+	}
+	if msg == nil {
+		return nil
+	}
+
+	var v *structpb.Value
+	switch msg := msg.(type) {
+	case *structpb.Value:
+		v = msg
+	case *structpb.Struct:
+		v = structpb.NewStructValue(msg)
+	default:
+		bs, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+		if err != nil {
+			return nil
+		}
+		v = new(structpb.Value)
+		err = protojson.Unmarshal(bs, v)
+		if err != nil {
+			return nil
+		}
+	}
+
+	for _, field := range fields {
+		v = v.GetStructValue().GetFields()[field]
+		if v == nil {
+			return nil
+		}
+	}
+	return v
+}
+
+// compareOrderByValues compares two values. Missing and null values sort
+// first, followed by booleans, numbers and strings. Lists and objects sort last
+// and are considered equal to each other.
+func compareOrderByValues(a, b *structpb.Value) int {
+	if c := cmp.Compare(orderByValueRank(a), orderByValueRank(b)); c != 0 {
+		return c
+	}
+
+	switch a.GetKind().(type) {
+	case *structpb.Value_BoolValue:
+		switch {
+		case a.GetBoolValue() == b.GetBoolValue():
+			return 0
+		case a.GetBoolValue():
+			return 1
+		default:
+			return -1
+		}
+	case *structpb.Value_NumberValue:
+		return cmp.Compare(a.GetNumberValue(), b.GetNumberValue())
+	case *structpb.Value_StringValue:
+		return cmp.Compare(a.GetStringValue(), b.GetStringValue())
+	}
+	return 0
+}
+
+func orderByValueRank(v *structpb.Value) int {
+	switch v.GetKind().(type) {
+	case nil, *structpb.Value_NullValue:
+		return 0
+	case *structpb.Value_BoolValue:
+		return 1
+	case *structpb.Value_NumberValue:
+		return 2
+	case *structpb.Value_StringValue:
+		return 3
+	default:
+		return 4
+	}
+}
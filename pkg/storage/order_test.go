@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/protoutil"
+)
+
+func TestOrderByFromProto(t *testing.T) {
+	t.Parallel()
+
+	orderBy, err := OrderByFromProto([]*databroker.QueryOrderBy{
+		{Field: "a.b", Descending: true},
+		{Field: "id"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, OrderBy{
+		{Fields: []string{"a", "b"}, Descending: true},
+		{Fields: []string{"id"}},
+	}, orderBy)
+
+	_, err = OrderByFromProto([]*databroker.QueryOrderBy{{Field: ""}})
+	assert.Error(t, err)
+	_, err = OrderByFromProto([]*databroker.QueryOrderBy{{Field: "a..b"}})
+	assert.Error(t, err)
+}
+
+func TestSortRecords(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	newRecord := func(id string, version uint64, modifiedAt time.Time, fields map[string]*structpb.Value) *databroker.Record {
+		return &databroker.Record{
+			Type:       "example",
+			Id:         id,
+			Version:    version,
+			ModifiedAt: timestamppb.New(modifiedAt),
+			Data:       protoutil.NewAny(protoutil.NewStructMap(fields)),
+		}
+	}
+	records := []*databroker.Record{
+		newRecord("1", 4, now.Add(time.Second), map[string]*structpb.Value{
+			"name": protoutil.NewStructString("b"), "count": protoutil.NewStructNumber(2),
+		}),
+		newRecord("2", 2, now, map[string]*structpb.Value{
+			"name": protoutil.NewStructString("a"), "count": protoutil.NewStructNumber(10),
+		}),
+		newRecord("3", 3, now, map[string]*structpb.Value{
+			"name": protoutil.NewStructString("b"),
+		}),
+		newRecord("4", 1, now.Add(-time.Second), map[string]*structpb.Value{
+			"name": protoutil.NewStructString("c"), "count": protoutil.NewStructNumber(1),
+		}),
+	}
+
+	ids := func(orderBy OrderBy) []string {
+		sorted := append([]*databroker.Record(nil), records...)
+		SortRecords(sorted, orderBy)
+		var ids []string
+		for _, record := range sorted {
+			ids = append(ids, record.GetId())
+		}
+		return ids
+	}
+
+	for _, tc := range []struct {
+		name    string
+		orderBy OrderBy
+		expect  []string
+	}{
+		{"none", nil, []string{"1", "2", "3", "4"}},
+		{"modified at", OrderBy{{Fields: []string{"modified_at"}}}, []string{"4", "2", "3", "1"}},
+		{"modified at descending", OrderBy{{Fields: []string{"modified_at"}, Descending: true}}, []string{"1", "3", "2", "4"}},
+		{"number", OrderBy{{Fields: []string{"count"}}}, []string{"3", "4", "1", "2"}},
+		{"multiple keys", OrderBy{{Fields: []string{"name"}}, {Fields: []string{"version"}, Descending: true}}, []string{"2", "1", "3", "4"}},
+		{"ties by id", OrderBy{{Fields: []string{"name"}, Descending: true}}, []string{"4", "1", "3", "2"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expect, ids(tc.orderBy))
+		})
+	}
+}
@@ -34,3 +34,42 @@ func PatchRecord(existing, record *databroker.Record, fields *fieldmaskpb.FieldM
 	}
 	return nil
 }
+
+// MaskRecords returns copies of the records with only the fields of their data
+// in the field mask. The records are not modified. If the field mask is empty,
+// the records are returned as-is.
+func MaskRecords(records []*databroker.Record, fields *fieldmaskpb.FieldMask) ([]*databroker.Record, error) {
+	if len(fields.GetPaths()) == 0 {
+		return records, nil
+	}
+
+	masked := make([]*databroker.Record, len(records))
+	for i, record := range records {
+		masked[i] = &databroker.Record{
+			Version:    record.GetVersion(),
+			Type:       record.GetType(),
+			Id:         record.GetId(),
+			ModifiedAt: record.GetModifiedAt(),
+			DeletedAt:  record.GetDeletedAt(),
+		}
+		if record.GetData() == nil {
+			continue
+		}
+
+		data, err := record.GetData().UnmarshalNew()
+		if err != nil {
+			return nil, fmt.Errorf("could not unmarshal record data: %w", err)
+		}
+
+		err = protoutil.RetainMasked(data, fields)
+		if err != nil {
+			return nil, fmt.Errorf("cannot mask record: %w", err)
+		}
+
+		masked[i].Data, err = anypb.New(data)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal masked record data: %w", err)
+		}
+	}
+	return masked, nil
+}
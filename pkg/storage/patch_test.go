@@ -4,9 +4,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/pomerium/pomerium/internal/testutil"
@@ -45,3 +48,41 @@ func TestPatchRecord(t *testing.T) {
 	// The existing record should not be modified.
 	testutil.AssertProtoEqual(t, originalR1, r1)
 }
+
+func TestMaskRecords(t *testing.T) {
+	t.Parallel()
+
+	s := &session.Session{Id: "session-id", UserId: "user-id", Claims: map[string]*structpb.ListValue{
+		"groups": {Values: []*structpb.Value{structpb.NewStringValue("group-1")}},
+	}}
+	a, _ := anypb.New(s)
+	records := []*databroker.Record{
+		{Type: "session", Id: "session-id", Version: 1, Data: a},
+		{Type: "session", Id: "deleted", Version: 2},
+	}
+
+	masked, err := storage.MaskRecords(records, &fieldmaskpb.FieldMask{Paths: []string{"user_id"}})
+	require.NoError(t, err)
+
+	testutil.AssertProtoJSONEqual(t, `[{
+		"type": "session",
+		"id": "session-id",
+		"version": "1",
+		"data": {
+			"@type": "type.googleapis.com/session.Session",
+			"userId": "user-id"
+		}
+	}, {
+		"type": "session",
+		"id": "deleted",
+		"version": "2"
+	}]`, masked)
+	testutil.AssertProtoEqual(t, a, records[0].Data, "the original record should not be modified")
+
+	unmasked, err := storage.MaskRecords(records, nil)
+	require.NoError(t, err)
+	assert.Equal(t, records, unmasked)
+
+	_, err = storage.MaskRecords(records, &fieldmaskpb.FieldMask{Paths: []string{"unknown"}})
+	assert.Error(t, err)
+}
@@ -210,6 +210,19 @@ func (backend *Backend) Lease(
 	return leaseHolderID == leaseID, nil
 }
 
+// ListLeases lists all the unexpired leases, sorted by name.
+func (backend *Backend) ListLeases(ctx context.Context) ([]storage.Lease, error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	_, conn, err := backend.init(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return listLeases(ctx, conn)
+}
+
 // ListTypes lists the record types.
 func (backend *Backend) ListTypes(ctx context.Context) ([]string, error) {
 	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
@@ -238,32 +251,51 @@ func (backend *Backend) Put(
 
 	now := timestamppb.Now()
 
+	// all the records are saved in a single transaction, so if any of them
+	// fails none of them are saved
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return serverVersion, fmt.Errorf("storage/postgres: error beginning transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
 	// add all the records
 	recordTypes := map[string]struct{}{}
+	saved := make([]*databroker.Record, len(records))
 	for i, record := range records {
 		recordTypes[record.GetType()] = struct{}{}
 
 		record = dup(record)
 		record.ModifiedAt = now
-		err := putRecordAndChange(ctx, pool, record)
+		if record.ExpectedVersion != nil {
+			err = putRecordAndChangeIfVersion(ctx, tx, record)
+		} else {
+			err = putRecordAndChange(ctx, tx, record)
+		}
 		if err != nil {
 			return serverVersion, fmt.Errorf("storage/postgres: error saving record: %w", err)
 		}
-		records[i] = record
+		saved[i] = record
 	}
 
 	// enforce options for each record type
 	for recordType := range recordTypes {
-		options, err := getOptions(ctx, pool, recordType)
+		options, err := getOptions(ctx, tx, recordType)
 		if err != nil {
 			return serverVersion, fmt.Errorf("storage/postgres: error getting options: %w", err)
 		}
-		err = enforceOptions(ctx, pool, recordType, options)
+		err = enforceOptions(ctx, tx, recordType, options)
 		if err != nil {
 			return serverVersion, fmt.Errorf("storage/postgres: error enforcing options: %w", err)
 		}
 	}
 
+	err = tx.Commit(ctx)
+	if err != nil {
+		return serverVersion, fmt.Errorf("storage/postgres: error committing transaction: %w", err)
+	}
+	copy(records, saved)
+
 	err = signalRecordChange(ctx, pool)
 	return serverVersion, err
 }
@@ -348,6 +380,17 @@ func (backend *Backend) SyncLatest(
 	ctx context.Context,
 	recordType string,
 	expr storage.FilterExpression,
+) (serverVersion, recordVersion uint64, seq storage.RecordIterator, err error) {
+	return backend.SyncLatestAfter(ctx, recordType, expr, "", "")
+}
+
+// SyncLatestAfter syncs the latest version of each record that sorts after the
+// record with the given type and id.
+func (backend *Backend) SyncLatestAfter(
+	ctx context.Context,
+	recordType string,
+	expr storage.FilterExpression,
+	afterRecordType, afterRecordID string,
 ) (serverVersion, recordVersion uint64, seq storage.RecordIterator, err error) {
 	// the original ctx will be used for the stream, this ctx used for pre-stream calls
 	callCtx, cancel := contextutil.Merge(ctx, backend.closeCtx)
@@ -374,7 +417,35 @@ func (backend *Backend) SyncLatest(
 			expr = f
 		}
 	}
-	return serverVersion, recordVersion, backend.iterateLatestRecords(ctx, expr), nil
+	return serverVersion, recordVersion, backend.iterateLatestRecords(ctx, expr, afterRecordType, afterRecordID), nil
+}
+
+// SyncLatestOrdered syncs the latest version of each record sorted by the
+// given order.
+func (backend *Backend) SyncLatestOrdered(
+	ctx context.Context,
+	recordType string,
+	expr storage.FilterExpression,
+	orderBy storage.OrderBy,
+) (serverVersion, recordVersion uint64, seq storage.RecordIterator, err error) {
+	serverVersion, recordVersion, seq, err = backend.SyncLatest(ctx, recordType, expr)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return serverVersion, recordVersion, storage.SortRecordIterator(seq, orderBy), nil
+}
+
+// Stats returns statistics for each record type.
+func (backend *Backend) Stats(ctx context.Context) ([]storage.RecordTypeStats, error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	_, pool, err := backend.init(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return getStats(ctx, pool)
 }
 
 // Versions returns the versions of the storage backend.
@@ -1,8 +1,10 @@
 package postgres
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/netip"
+	"slices"
 	"strings"
 
 	"github.com/pomerium/pomerium/pkg/storage"
@@ -30,31 +32,91 @@ func addFilterExpressionToQuery(query *string, args *[]any, expr storage.FilterE
 	case storage.OrFilterExpression:
 		return compoundExpression(expr, "OR")
 	case storage.EqualsFilterExpression:
-		switch strings.Join(expr.Fields, ".") {
-		case "type":
-			*query += schemaName + "." + recordsTableName + ".type = " + fmt.Sprintf("$%d", len(*args)+1)
-			*args = append(*args, expr.Value)
-			return nil
-		case "id":
-			*query += schemaName + "." + recordsTableName + ".id = " + fmt.Sprintf("$%d", len(*args)+1)
-			*args = append(*args, expr.Value)
-			return nil
-		case "$index":
+		if slices.Equal(expr.Fields, []string{"$index"}) {
 			if isCIDR(expr.Value) {
-				*query += schemaName + "." + recordsTableName + ".index_cidr >>= " + fmt.Sprintf("$%d", len(*args)+1)
+				arg := fmt.Sprintf("$%d", len(*args)+1)
+				*query += "( " + schemaName + "." + recordsTableName + ".index_cidr >>= " + arg +
+					" OR EXISTS (SELECT 1 FROM " + schemaName + "." + recordCIDRsTableName +
+					" WHERE " + schemaName + "." + recordCIDRsTableName + ".type = " + schemaName + "." + recordsTableName + ".type" +
+					" AND " + schemaName + "." + recordCIDRsTableName + ".id = " + schemaName + "." + recordsTableName + ".id" +
+					" AND " + schemaName + "." + recordCIDRsTableName + ".cidr >>= " + arg + ") )"
 				*args = append(*args, expr.Value)
 			} else {
 				*query += " false "
 			}
 			return nil
-		default:
-			return fmt.Errorf("unsupported equals filter: %v", expr.Fields)
 		}
+		return addFieldComparisonToQuery(query, args, expr.Fields, "=", expr.Value)
+	case storage.NotEqualsFilterExpression:
+		return addFieldComparisonToQuery(query, args, expr.Fields, "<>", expr.Value)
+	case storage.PrefixFilterExpression:
+		column, ok := filterColumn(expr.Fields)
+		if !ok || column == "modified_at" {
+			return fmt.Errorf("unsupported prefix filter: %v", expr.Fields)
+		}
+		*query += "starts_with(" + schemaName + "." + recordsTableName + "." + column + ", " + fmt.Sprintf("$%d", len(*args)+1) + ")"
+		*args = append(*args, expr.Value)
+		return nil
+	case storage.ComparisonFilterExpression:
+		op, ok := comparisonOperators[expr.Operator]
+		if !ok {
+			return fmt.Errorf("unsupported comparison operator: %s", expr.Operator)
+		}
+		return addFieldComparisonToQuery(query, args, expr.Fields, op, expr.Value)
+	case storage.SearchFilterExpression:
+		// the "q" flag matches the query literally, and the @type of the
+		// record data is removed so that it isn't searched
+		path, err := json.Marshal(expr.Query)
+		if err != nil {
+			return fmt.Errorf("invalid search query: %w", err)
+		}
+		*query += "jsonb_path_exists(" + schemaName + "." + recordsTableName + ".data - '@type', " + fmt.Sprintf("$%d", len(*args)+1) + "::jsonpath)"
+		*args = append(*args, `strict $.** ? (@.type() == "string" && @ like_regex `+string(path)+` flag "iq")`)
+		return nil
 	default:
 		return fmt.Errorf("unsupported filter expression: %T", expr)
 	}
 }
 
+var comparisonOperators = map[storage.ComparisonOperator]string{
+	storage.ComparisonLessThan:           "<",
+	storage.ComparisonLessThanOrEqual:    "<=",
+	storage.ComparisonGreaterThan:        ">",
+	storage.ComparisonGreaterThanOrEqual: ">=",
+}
+
+func addFieldComparisonToQuery(query *string, args *[]any, fields []string, op, value string) error {
+	column, ok := filterColumn(fields)
+	if !ok {
+		return fmt.Errorf("unsupported filter field: %v", fields)
+	}
+
+	*query += schemaName + "." + recordsTableName + "." + column
+	switch {
+	case column == "modified_at":
+		*query += " " + op + " " + fmt.Sprintf("$%d", len(*args)+1) + "::timestamptz"
+	case op == "=" || op == "<>":
+		*query += " " + op + " " + fmt.Sprintf("$%d", len(*args)+1)
+	default:
+		// use byte ordering so comparisons match the other backends
+		*query += ` COLLATE "C" ` + op + " " + fmt.Sprintf("$%d", len(*args)+1)
+	}
+	*args = append(*args, value)
+	return nil
+}
+
+func filterColumn(fields []string) (string, bool) {
+	switch strings.Join(fields, ".") {
+	case "type":
+		return "type", true
+	case "id":
+		return "id", true
+	case "modified_at":
+		return "modified_at", true
+	}
+	return "", false
+}
+
 func isCIDR(value string) bool {
 	if _, err := netip.ParsePrefix(value); err == nil {
 		return true
@@ -33,6 +33,56 @@ func TestAddFilterExpressionToQuery(t *testing.T) {
 			Value:  "v3",
 		},
 	})
-	assert.Equal(t, "( ( pomerium.records.id = $1 OR  false  OR pomerium.records.index_cidr >>= $2 ) AND pomerium.records.type = $3 )", query)
+	assert.Equal(t, "( ( pomerium.records.id = $1 OR  false  OR ( pomerium.records.index_cidr >>= $2 OR EXISTS (SELECT 1 FROM pomerium.record_cidrs WHERE pomerium.record_cidrs.type = pomerium.records.type AND pomerium.record_cidrs.id = pomerium.records.id AND pomerium.record_cidrs.cidr >>= $2) ) ) AND pomerium.records.type = $3 )", query)
 	assert.Equal(t, []any{"v1", "10.0.0.0/8", "v3"}, args)
 }
+
+func TestAddFilterExpressionToQueryOperators(t *testing.T) {
+	t.Parallel()
+
+	query := ""
+	args := []any{}
+	err := addFilterExpressionToQuery(&query, &args, storage.AndFilterExpression{
+		storage.NotEqualsFilterExpression{
+			Fields: []string{"id"},
+			Value:  "v1",
+		},
+		storage.PrefixFilterExpression{
+			Fields: []string{"id"},
+			Value:  "v2",
+		},
+		storage.ComparisonFilterExpression{
+			Fields:   []string{"id"},
+			Operator: storage.ComparisonLessThan,
+			Value:    "v3",
+		},
+		storage.ComparisonFilterExpression{
+			Fields:   []string{"modified_at"},
+			Operator: storage.ComparisonGreaterThanOrEqual,
+			Value:    "2025-01-01T00:00:00.000000000Z",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "( pomerium.records.id <> $1 AND starts_with(pomerium.records.id, $2) AND "+
+		`pomerium.records.id COLLATE "C" < $3 AND pomerium.records.modified_at >= $4::timestamptz )`, query)
+	assert.Equal(t, []any{"v1", "v2", "v3", "2025-01-01T00:00:00.000000000Z"}, args)
+
+	err = addFilterExpressionToQuery(&query, &args, storage.PrefixFilterExpression{
+		Fields: []string{"modified_at"},
+		Value:  "2025",
+	})
+	assert.Error(t, err)
+}
+
+func TestAddFilterExpressionToQuerySearch(t *testing.T) {
+	t.Parallel()
+
+	var query string
+	var args []any
+	err := addFilterExpressionToQuery(&query, &args, storage.SearchFilterExpression{
+		Query: `a "b"`,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "jsonb_path_exists(pomerium.records.data - '@type', $1::jsonpath)", query)
+	assert.Equal(t, []any{`strict $.** ? (@.type() == "string" && @ like_regex "a \"b\"" flag "iq")`}, args)
+}
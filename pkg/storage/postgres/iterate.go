@@ -78,6 +78,7 @@ func (backend *Backend) iterateChangedRecords(
 func (backend *Backend) iterateLatestRecords(
 	ctx context.Context,
 	expr storage.FilterExpression,
+	lastRecordType, lastRecordID string,
 ) storage.RecordIterator {
 	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx, backend.iteratorCanceler.Context())
 	return func(yield func(*databroker.Record, error) bool) {
@@ -89,7 +90,6 @@ func (backend *Backend) iterateLatestRecords(
 			return
 		}
 
-		var lastRecordType, lastRecordID string
 		for {
 			records, err := listLatestRecordsAfter(ctx, pool, expr, lastRecordType, lastRecordID)
 			if err != nil {
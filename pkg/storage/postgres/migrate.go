@@ -192,6 +192,41 @@ var migrations = []func(context.Context, pgx.Tx) error{
 			return err
 		}
 
+		return nil
+	},
+	8: func(ctx context.Context, tx pgx.Tx) error {
+		// records may have multiple cidrs. The first is still stored in
+		// index_cidr, so that servers which haven't been upgraded yet keep
+		// working, and any others are stored in a separate table. Whenever a
+		// record is written the other cidrs are removed by a trigger, so writes
+		// from servers which don't know about the table don't leave stale cidrs
+		// behind.
+		for _, q := range []string{
+			`CREATE TABLE ` + schemaName + `.` + recordCIDRsTableName + ` (
+				type TEXT NOT NULL,
+				id TEXT NOT NULL,
+				cidr INET NOT NULL,
+
+				FOREIGN KEY (type, id) REFERENCES ` + schemaName + `.` + recordsTableName + ` (type, id) ON DELETE CASCADE
+			)`,
+			`CREATE INDEX ON ` + schemaName + `.` + recordCIDRsTableName + ` (type, id)`,
+			`CREATE INDEX ON ` + schemaName + `.` + recordCIDRsTableName + ` USING gist (cidr inet_ops)`,
+			`CREATE FUNCTION ` + schemaName + `.` + recordCIDRsTableName + `_delete() RETURNS TRIGGER AS $$
+			BEGIN
+				DELETE FROM ` + schemaName + `.` + recordCIDRsTableName + ` WHERE type=NEW.type AND id=NEW.id;
+				RETURN NULL;
+			END;
+			$$ LANGUAGE plpgsql`,
+			`CREATE TRIGGER ` + recordCIDRsTableName + `_delete
+			AFTER INSERT OR UPDATE ON ` + schemaName + `.` + recordsTableName + `
+			FOR EACH ROW EXECUTE FUNCTION ` + schemaName + `.` + recordCIDRsTableName + `_delete()`,
+		} {
+			_, err := tx.Exec(ctx, q)
+			if err != nil {
+				return err
+			}
+		}
+
 		return nil
 	},
 }
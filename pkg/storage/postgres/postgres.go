@@ -36,6 +36,7 @@ var (
 	schemaName              = "pomerium"
 	migrationInfoTableName  = "migration_info"
 	recordsTableName        = "records"
+	recordCIDRsTableName    = "record_cidrs"
 	recordChangesTableName  = "record_changes"
 	recordChangeNotifyName  = "pomerium_record_change"
 	recordOptionsTableName  = "record_options"
@@ -304,6 +305,42 @@ func listServices(ctx context.Context, q querier) ([]*registry.Service, error) {
 	return services, nil
 }
 
+func getStats(ctx context.Context, q querier) ([]storage.RecordTypeStats, error) {
+	query := `
+		SELECT type, COUNT(*), MIN(modified_at), MAX(modified_at), COALESCE(SUM(pg_column_size(data)), 0)
+		FROM ` + schemaName + `.` + recordsTableName + `
+		GROUP BY type
+		ORDER BY type ASC
+	`
+	rows, err := q.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []storage.RecordTypeStats
+	for rows.Next() {
+		var s storage.RecordTypeStats
+		var oldest, newest pgtype.Timestamptz
+		var count, size int64
+		err = rows.Scan(&s.RecordType, &count, &oldest, &newest, &size)
+		if err != nil {
+			return nil, fmt.Errorf("postgres: failed to scan row: %w", err)
+		}
+		s.Count = uint64(count)
+		s.OldestModifiedAt = oldest.Time
+		s.NewestModifiedAt = newest.Time
+		s.Size = uint64(size)
+		stats = append(stats, s)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("postgres: error iterating over rows: %w", err)
+	}
+
+	return stats, nil
+}
+
 func listTypes(ctx context.Context, q querier) ([]string, error) {
 	query := `
 		SELECT DISTINCT type
@@ -349,6 +386,38 @@ func maybeAcquireLease(ctx context.Context, q querier, leaseName, leaseID string
 	return leaseHolderID, err
 }
 
+func listLeases(ctx context.Context, q querier) ([]storage.Lease, error) {
+	now := timestamptzFromTimestamppb(timestamppb.Now())
+	rows, err := q.Query(ctx, `
+		SELECT name, id, expires_at
+		FROM `+schemaName+`.`+leasesTableName+`
+		WHERE expires_at >= $1
+		ORDER BY name
+	`, now)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	var leases []storage.Lease
+	for rows.Next() {
+		var lease storage.Lease
+		var expiresAt pgtype.Timestamptz
+		err = rows.Scan(&lease.Name, &lease.ID, &expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("postgres: failed to scan row: %w", err)
+		}
+		lease.ExpiresAt = expiresAt.Time
+		leases = append(leases, lease)
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("postgres: error iterating over rows: %w", err)
+	}
+
+	return leases, nil
+}
+
 func putRecordAndChange(ctx context.Context, q querier, record *databroker.Record) error {
 	data, err := jsonbFromAny(record.GetData())
 	if err != nil {
@@ -357,9 +426,10 @@ func putRecordAndChange(ctx context.Context, q querier, record *databroker.Recor
 
 	modifiedAt := timestamptzFromTimestamppb(record.GetModifiedAt())
 	deletedAt := timestamptzFromTimestamppb(record.GetDeletedAt())
+	indexCIDRs := storage.GetRecordIndexCIDRs(record.GetData())
 	indexCIDR := &pgtype.Text{Valid: false}
-	if cidr := storage.GetRecordIndexCIDR(record.GetData()); cidr != nil {
-		indexCIDR.String = cidr.String()
+	if len(indexCIDRs) > 0 {
+		indexCIDR.String = indexCIDRs[0].String()
 		indexCIDR.Valid = true
 	}
 
@@ -394,6 +464,18 @@ func putRecordAndChange(ctx context.Context, q querier, record *databroker.Recor
 		return fmt.Errorf("postgres: failed to execute query: %w", err)
 	}
 
+	// any other cidrs were removed by the trigger on the records table, so
+	// only the current ones need to be inserted
+	if record.GetDeletedAt() == nil && len(indexCIDRs) > 1 {
+		_, err = q.Exec(ctx, `
+			INSERT INTO `+schemaName+`.`+recordCIDRsTableName+` (type, id, cidr)
+			SELECT $1, $2, UNNEST($3::inet[])
+		`, record.GetType(), record.GetId(), indexCIDRs[1:])
+		if err != nil {
+			return fmt.Errorf("postgres: failed to insert record cidrs: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -425,6 +507,22 @@ func patchRecord(
 	return tx.Commit(ctx)
 }
 
+// putRecordAndChangeIfVersion puts a record if the current version of the
+// record matches the record's expected version. It should be called within a
+// transaction so that the record is locked until the transaction completes.
+func putRecordAndChangeIfVersion(ctx context.Context, q querier, record *databroker.Record) error {
+	current, err := getRecord(ctx, q, record.GetType(), record.GetId(), lockModeUpdate)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	if err := storage.CheckExpectedVersion(record, current); err != nil {
+		return err
+	}
+	record.ExpectedVersion = nil
+
+	return putRecordAndChange(ctx, q, record)
+}
+
 func putService(ctx context.Context, q querier, svc *registry.Service, expiresAt time.Time) error {
 	query := `
 		INSERT INTO ` + schemaName + `.` + servicesTableName + ` (kind, endpoint, expires_at)
@@ -23,7 +23,7 @@ func NewCachingQuerier(q Querier, cache Cache) Querier {
 }
 
 func (q *cachingQuerier) InvalidateCache(ctx context.Context, in *databroker.QueryRequest) {
-	key, err := q.getCacheKey(in)
+	key, err := getQueryCacheKey(in)
 	if err != nil {
 		return
 	}
@@ -52,14 +52,16 @@ func (q *cachingQuerier) Query(ctx context.Context, in *databroker.QueryRequest,
 
 func (*cachingQuerier) Stop() {}
 
-func (q *cachingQuerier) getCacheKey(in *databroker.QueryRequest) ([]byte, error) {
+// getQueryCacheKey returns the key used to cache the results of a query. The
+// minimum record version hint is not part of the key.
+func getQueryCacheKey(in *databroker.QueryRequest) ([]byte, error) {
 	in = proto.Clone(in).(*databroker.QueryRequest)
 	in.MinimumRecordVersionHint = nil
 	return MarshalQueryRequest(in)
 }
 
 func (q *cachingQuerier) query(ctx context.Context, in *databroker.QueryRequest, opts ...grpc.CallOption) (*databroker.QueryResponse, error) {
-	key, err := q.getCacheKey(in)
+	key, err := getQueryCacheKey(in)
 	if err != nil {
 		return nil, err
 	}
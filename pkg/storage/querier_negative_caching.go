@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+type negativeCachingQuerierEntry struct {
+	res    *databroker.QueryResponse
+	expiry time.Time
+}
+
+type negativeCachingQuerier struct {
+	q   Querier
+	ttl time.Duration
+
+	singleflight singleflight.Group
+
+	mu          sync.Mutex
+	notFound    map[string]negativeCachingQuerierEntry
+	nextCleanup time.Time
+}
+
+// NewNegativeCachingQuerier creates a new querier that deduplicates identical
+// concurrent queries and caches queries which return no records for the given
+// TTL. This protects the databroker from many requests for a missing record.
+func NewNegativeCachingQuerier(q Querier, ttl time.Duration) Querier {
+	return &negativeCachingQuerier{
+		q:        q,
+		ttl:      ttl,
+		notFound: make(map[string]negativeCachingQuerierEntry),
+	}
+}
+
+func (q *negativeCachingQuerier) InvalidateCache(ctx context.Context, in *databroker.QueryRequest) {
+	key, err := getQueryCacheKey(in)
+	if err == nil {
+		q.mu.Lock()
+		delete(q.notFound, string(key))
+		q.mu.Unlock()
+	}
+	q.q.InvalidateCache(ctx, in)
+}
+
+func (q *negativeCachingQuerier) Query(ctx context.Context, in *databroker.QueryRequest, opts ...grpc.CallOption) (*databroker.QueryResponse, error) {
+	key, err := getQueryCacheKey(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if res, ok := q.getNotFound(key, in); ok {
+		return res, nil
+	}
+
+	// the minimum record version hint is part of the singleflight key, so
+	// callers waiting on a newer version don't get an older result
+	singleflightKey, err := MarshalQueryRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := q.singleflight.DoChan(string(singleflightKey), func() (any, error) {
+		// the query is shared by every caller, so it shouldn't be canceled
+		// when the caller which started it goes away
+		res, err := q.q.Query(context.WithoutCancel(ctx), in, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if len(res.GetRecords()) == 0 {
+			q.setNotFound(key, res)
+		}
+		return res, nil
+	})
+	var r singleflight.Result
+	select {
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	case r = <-ch:
+	}
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	v := r.Val
+	// the response is shared by every caller, so return a copy
+	return proto.Clone(v.(*databroker.QueryResponse)).(*databroker.QueryResponse), nil
+}
+
+func (q *negativeCachingQuerier) Stop() {
+	q.q.Stop()
+}
+
+func (q *negativeCachingQuerier) getNotFound(key []byte, in *databroker.QueryRequest) (*databroker.QueryResponse, bool) {
+	q.mu.Lock()
+	entry, ok := q.notFound[string(key)]
+	q.mu.Unlock()
+
+	if !ok || time.Now().After(entry.expiry) {
+		return nil, false
+	}
+	// if the cached response is older than the minimum record version, the
+	// record may exist now
+	if in.MinimumRecordVersionHint != nil && entry.res.GetRecordVersion() < *in.MinimumRecordVersionHint {
+		return nil, false
+	}
+	return proto.Clone(entry.res).(*databroker.QueryResponse), true
+}
+
+func (q *negativeCachingQuerier) setNotFound(key []byte, res *databroker.QueryResponse) {
+	now := time.Now()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	// periodically remove any expired entries
+	if now.After(q.nextCleanup) {
+		for k, entry := range q.notFound {
+			if now.After(entry.expiry) {
+				delete(q.notFound, k)
+			}
+		}
+		q.nextCleanup = now.Add(q.ttl)
+	}
+
+	q.notFound[string(key)] = negativeCachingQuerierEntry{
+		res:    proto.Clone(res).(*databroker.QueryResponse),
+		expiry: now.Add(q.ttl),
+	}
+}
@@ -0,0 +1,159 @@
+package storage_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pomerium/pomerium/internal/testutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+type countingQuerier struct {
+	storage.Querier
+	count   atomic.Int64
+	release chan struct{}
+}
+
+func (q *countingQuerier) Query(ctx context.Context, in *databrokerpb.QueryRequest, opts ...grpc.CallOption) (*databrokerpb.QueryResponse, error) {
+	q.count.Add(1)
+	if q.release != nil {
+		<-q.release
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return q.Querier.Query(ctx, in, opts...)
+}
+
+func TestNegativeCachingQuerier(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.GetContext(t, time.Minute)
+	found := &databrokerpb.QueryRequest{Type: "t1", Limit: 1}
+	found.SetFilterByID("r1")
+	missing := &databrokerpb.QueryRequest{Type: "t1", Limit: 1}
+	missing.SetFilterByID("r2")
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		inner := &countingQuerier{Querier: storage.NewStaticQuerier(&databrokerpb.Record{Version: 1, Type: "t1", Id: "r1"})}
+		q := storage.NewNegativeCachingQuerier(inner, time.Hour)
+
+		for range 3 {
+			res, err := q.Query(ctx, found)
+			require.NoError(t, err)
+			assert.Len(t, res.GetRecords(), 1)
+		}
+		assert.Equal(t, int64(3), inner.count.Load(),
+			"should not cache found records")
+
+		inner.count.Store(0)
+		for range 3 {
+			res, err := q.Query(ctx, missing)
+			require.NoError(t, err)
+			assert.Empty(t, res.GetRecords())
+		}
+		assert.Equal(t, int64(1), inner.count.Load(),
+			"should cache not found results")
+
+		missingWithHint := proto.Clone(missing).(*databrokerpb.QueryRequest)
+		missingWithHint.MinimumRecordVersionHint = proto.Uint64(2)
+		_, err := q.Query(ctx, missingWithHint)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), inner.count.Load(),
+			"should ignore cached results older than the minimum record version")
+
+		q.InvalidateCache(ctx, missing)
+		_, err = q.Query(ctx, missing)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), inner.count.Load(),
+			"should query again after invalidation")
+	})
+	t.Run("expiry", func(t *testing.T) {
+		t.Parallel()
+
+		inner := &countingQuerier{Querier: storage.NewStaticQuerier()}
+		q := storage.NewNegativeCachingQuerier(inner, time.Millisecond)
+
+		_, err := q.Query(ctx, missing)
+		require.NoError(t, err)
+		time.Sleep(10 * time.Millisecond)
+		_, err = q.Query(ctx, missing)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), inner.count.Load(),
+			"should query again after the ttl")
+	})
+	t.Run("singleflight", func(t *testing.T) {
+		t.Parallel()
+
+		inner := &countingQuerier{
+			Querier: storage.NewStaticQuerier(&databrokerpb.Record{Version: 1, Type: "t1", Id: "r1"}),
+			release: make(chan struct{}),
+		}
+		q := storage.NewNegativeCachingQuerier(inner, time.Hour)
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res, err := q.Query(ctx, found)
+				if assert.NoError(t, err) {
+					assert.Len(t, res.GetRecords(), 1)
+				}
+			}()
+		}
+		assert.Eventually(t, func() bool { return inner.count.Load() == 1 },
+			time.Second*10, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		close(inner.release)
+		wg.Wait()
+
+		assert.Less(t, inner.count.Load(), int64(10),
+			"should deduplicate concurrent queries")
+	})
+	t.Run("canceled caller", func(t *testing.T) {
+		t.Parallel()
+
+		inner := &countingQuerier{
+			Querier: storage.NewStaticQuerier(&databrokerpb.Record{Version: 1, Type: "t1", Id: "r1"}),
+			release: make(chan struct{}),
+		}
+		q := storage.NewNegativeCachingQuerier(inner, time.Hour)
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		errc := make(chan error, 1)
+		go func() {
+			_, err := q.Query(cancelCtx, found)
+			errc <- err
+		}()
+		assert.Eventually(t, func() bool { return inner.count.Load() == 1 },
+			time.Second*10, time.Millisecond)
+
+		resc := make(chan *databrokerpb.QueryResponse, 1)
+		go func() {
+			res, err := q.Query(ctx, found)
+			assert.NoError(t, err)
+			resc <- res
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		cancel()
+		assert.ErrorIs(t, <-errc, context.Canceled,
+			"should return when the caller is canceled")
+
+		close(inner.release)
+		assert.Len(t, (<-resc).GetRecords(), 1,
+			"should not fail other callers when the first caller is canceled")
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/cockroachdb/pebble/v2"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,8 +21,11 @@ import (
 type syncQuerier struct {
 	client     databroker.DataBrokerServiceClient
 	recordType string
+	cache      *syncQuerierCache
 
-	cancel context.CancelFunc
+	cancel     context.CancelFunc
+	synced     chan struct{}
+	syncedOnce sync.Once
 
 	mu                   sync.RWMutex
 	ready                bool
@@ -31,25 +35,61 @@ type syncQuerier struct {
 	latestRecordVersion  uint64
 }
 
+// A SyncQuerierOption customizes a sync querier.
+type SyncQuerierOption func(q *syncQuerier)
+
+// WithSyncQuerierCache persists the synced records to a pebble database. The
+// records are loaded from the database on startup, so that queries can be
+// answered immediately while the sync catches up. The querier isn't considered
+// synced (see WaitForSync) until a full sync with the databroker completes.
+// Keys are stored under the given prefix.
+func WithSyncQuerierCache(db *pebble.DB, prefix []byte) SyncQuerierOption {
+	return func(q *syncQuerier) {
+		q.cache = &syncQuerierCache{db: db, prefix: prefix, recordType: q.recordType}
+	}
+}
+
 // NewSyncQuerier creates a new Querier backed by an in-memory record collection
 // filled via sync calls to the databroker.
 func NewSyncQuerier(
 	client databroker.DataBrokerServiceClient,
 	recordType string,
+	options ...SyncQuerierOption,
 ) Querier {
 	q := &syncQuerier{
 		client:     client,
 		recordType: recordType,
 		records:    NewRecordCollection(),
+		synced:     make(chan struct{}),
+	}
+	for _, option := range options {
+		option(q)
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	q.cancel = cancel
+	q.loadCache(ctx)
 	go q.run(ctx)
 
 	return q
 }
 
+// WaitForSync waits for the querier to complete its first full sync with the
+// databroker. Queriers which aren't backed by a sync return immediately.
+func WaitForSync(ctx context.Context, q Querier) error {
+	sq, ok := q.(*syncQuerier)
+	if !ok {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	case <-sq.synced:
+		return nil
+	}
+}
+
 func (q *syncQuerier) InvalidateCache(_ context.Context, req *databroker.QueryRequest) {
 	v := req.MinimumRecordVersionHint
 	if v == nil {
@@ -95,6 +135,38 @@ func (q *syncQuerier) canHandleQueryLocked(req *databroker.QueryRequest) bool {
 	return true
 }
 
+// loadCache loads the records from the cache, if one is configured. The cached
+// records are served until the first sync latest replaces them.
+func (q *syncQuerier) loadCache(ctx context.Context) {
+	if q.cache == nil {
+		return
+	}
+
+	_, recordVersion, records, err := q.cache.load()
+	if errors.Is(err, pebble.ErrNotFound) {
+		return
+	} else if err != nil {
+		log.Ctx(ctx).Error().
+			Err(err).
+			Str("record-type", q.recordType).
+			Msg("storage/sync-querier: error loading records from cache")
+		return
+	}
+
+	q.mu.Lock()
+	for _, record := range records {
+		q.records.Put(record)
+	}
+	q.latestRecordVersion = recordVersion
+	q.ready = true
+	log.Ctx(ctx).Info().
+		Str("record-type", q.recordType).
+		Int("record-count", q.records.Len()).
+		Uint64("latest-record-version", q.latestRecordVersion).
+		Msg("storage/sync-querier: loaded records from cache")
+	q.mu.Unlock()
+}
+
 func (q *syncQuerier) run(ctx context.Context) {
 	bo := backoff.WithContext(backoff.NewExponentialBackOff(backoff.WithMaxElapsedTime(0)), ctx)
 	_ = backoff.RetryNotify(func() error {
@@ -129,11 +201,10 @@ func (q *syncQuerier) syncLatest(ctx context.Context) error {
 		return fmt.Errorf("error starting sync latest stream: %w", err)
 	}
 
-	q.mu.Lock()
-	q.ready = false
-	q.records.Clear()
-	q.mu.Unlock()
-
+	// the records are collected separately so that any records loaded from
+	// the cache can still be served while the sync is in progress
+	latest := NewRecordCollection()
+	var versions *databroker.Versions
 	for {
 		res, err := stream.Recv()
 		if errors.Is(err, io.EOF) {
@@ -144,28 +215,39 @@ func (q *syncQuerier) syncLatest(ctx context.Context) error {
 
 		switch res := res.Response.(type) {
 		case *databroker.SyncLatestResponse_Record:
-			q.mu.Lock()
-			q.records.Put(res.Record)
-			q.mu.Unlock()
+			latest.Put(res.Record)
 		case *databroker.SyncLatestResponse_Versions:
-			q.mu.Lock()
-			q.serverVersion = res.Versions.ServerVersion
-			q.latestRecordVersion = res.Versions.LatestRecordVersion
-			q.mu.Unlock()
+			versions = res.Versions
 		default:
 			return fmt.Errorf("unknown message type from sync latest: %T", res)
 		}
 	}
 
 	q.mu.Lock()
+	q.records = latest
+	q.serverVersion = versions.GetServerVersion()
+	q.latestRecordVersion = versions.GetLatestRecordVersion()
 	log.Ctx(ctx).Info().
 		Str("record-type", q.recordType).
 		Int("record-count", q.records.Len()).
 		Uint64("latest-record-version", q.latestRecordVersion).
 		Msg("storage/sync-querier: synced latest records")
 	q.ready = true
+	serverVersion, latestRecordVersion, records := q.serverVersion, q.latestRecordVersion, q.records.All()
 	q.mu.Unlock()
 
+	q.syncedOnce.Do(func() { close(q.synced) })
+
+	if q.cache != nil {
+		err = q.cache.replace(serverVersion, latestRecordVersion, records)
+		if err != nil {
+			log.Ctx(ctx).Error().
+				Err(err).
+				Str("record-type", q.recordType).
+				Msg("storage/sync-querier: error saving records to cache")
+		}
+	}
+
 	return nil
 }
 
@@ -188,6 +270,8 @@ func (q *syncQuerier) sync(ctx context.Context) error {
 		if status.Code(err) == codes.Aborted {
 			// this indicates the server version changed, so we need to reset
 			q.mu.Lock()
+			q.ready = false
+			q.records.Clear()
 			q.serverVersion = 0
 			q.latestRecordVersion = 0
 			q.minimumRecordVersion = 0
@@ -200,6 +284,17 @@ func (q *syncQuerier) sync(ctx context.Context) error {
 		q.mu.Lock()
 		q.latestRecordVersion = max(q.latestRecordVersion, res.Record.Version)
 		q.records.Put(res.Record)
+		latestRecordVersion := q.latestRecordVersion
 		q.mu.Unlock()
+
+		if q.cache != nil {
+			err = q.cache.update(latestRecordVersion, res.Record)
+			if err != nil {
+				log.Ctx(ctx).Error().
+					Err(err).
+					Str("record-type", q.recordType).
+					Msg("storage/sync-querier: error saving record to cache")
+			}
+		}
 	}
 }
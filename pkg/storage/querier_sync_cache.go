@@ -0,0 +1,157 @@
+package storage
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/cockroachdb/pebble/v2"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/pebbleutil"
+)
+
+const (
+	syncQuerierCacheFieldServerVersion byte = 1
+	syncQuerierCacheFieldRecordVersion byte = 2
+	syncQuerierCacheFieldRecord        byte = 3
+)
+
+// A syncQuerierCache persists the records of a sync querier so that they can
+// be loaded on startup.
+//
+// Data is stored in a pebble database in this format:
+//
+//	{{prefix}}{{recordType}}0x00 0x01: the server version
+//	{{prefix}}{{recordType}}0x00 0x02: the latest record version
+//	{{prefix}}{{recordType}}0x00 0x03{{recordID}}: a databroker record
+//
+// Versions are big-endian encoded and records are protobuf encoded.
+type syncQuerierCache struct {
+	db         *pebble.DB
+	prefix     []byte
+	recordType string
+}
+
+// load returns the cached versions and records. If nothing has been cached
+// pebble.ErrNotFound is returned.
+func (c *syncQuerierCache) load() (serverVersion, recordVersion uint64, records []*databroker.Record, err error) {
+	serverVersion, err = c.getVersion(syncQuerierCacheFieldServerVersion)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	recordVersion, err = c.getVersion(syncQuerierCacheFieldRecordVersion)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	opts := new(pebble.IterOptions)
+	opts.LowerBound = c.key(syncQuerierCacheFieldRecord)
+	opts.UpperBound = pebbleutil.PrefixToUpperBound(opts.LowerBound)
+	for record, err := range pebbleutil.Iterate(c.db, opts, func(it *pebble.Iterator) (*databroker.Record, error) {
+		value, err := it.ValueAndErr()
+		if err != nil {
+			return nil, err
+		}
+		record := new(databroker.Record)
+		err = proto.Unmarshal(value, record)
+		if err != nil {
+			return nil, err
+		}
+		return record, nil
+	}) {
+		if err != nil {
+			return 0, 0, nil, fmt.Errorf("error reading cached record: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	return serverVersion, recordVersion, records, nil
+}
+
+// replace replaces all the cached data.
+func (c *syncQuerierCache) replace(serverVersion, recordVersion uint64, records []*databroker.Record) error {
+	batch := c.db.NewBatch()
+	defer batch.Close()
+
+	prefix := c.recordTypePrefix()
+	err := batch.DeleteRange(prefix, pebbleutil.PrefixToUpperBound(prefix), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		err = c.setRecord(batch, record)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = errors.Join(
+		c.setVersion(batch, syncQuerierCacheFieldServerVersion, serverVersion),
+		c.setVersion(batch, syncQuerierCacheFieldRecordVersion, recordVersion),
+	)
+	if err != nil {
+		return err
+	}
+
+	return batch.Commit(pebble.NoSync)
+}
+
+// update updates a single cached record and the latest record version.
+func (c *syncQuerierCache) update(recordVersion uint64, record *databroker.Record) error {
+	batch := c.db.NewBatch()
+	defer batch.Close()
+
+	var err error
+	if record.GetDeletedAt() != nil {
+		err = batch.Delete(c.key(syncQuerierCacheFieldRecord, []byte(record.GetId())...), nil)
+	} else {
+		err = c.setRecord(batch, record)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = c.setVersion(batch, syncQuerierCacheFieldRecordVersion, recordVersion)
+	if err != nil {
+		return err
+	}
+
+	return batch.Commit(pebble.NoSync)
+}
+
+func (c *syncQuerierCache) getVersion(field byte) (uint64, error) {
+	value, closer, err := c.db.Get(c.key(field))
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = closer.Close() }()
+
+	if len(value) != 8 {
+		return 0, fmt.Errorf("invalid cached version")
+	}
+	return binary.BigEndian.Uint64(value), nil
+}
+
+func (c *syncQuerierCache) setRecord(w pebble.Writer, record *databroker.Record) error {
+	value, err := proto.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return w.Set(c.key(syncQuerierCacheFieldRecord, []byte(record.GetId())...), value, nil)
+}
+
+func (c *syncQuerierCache) setVersion(w pebble.Writer, field byte, version uint64) error {
+	return w.Set(c.key(field), binary.BigEndian.AppendUint64(nil, version), nil)
+}
+
+func (c *syncQuerierCache) key(field byte, suffix ...byte) []byte {
+	return slices.Concat(c.recordTypePrefix(), []byte{field}, suffix)
+}
+
+func (c *syncQuerierCache) recordTypePrefix() []byte {
+	return slices.Concat(c.prefix, []byte(c.recordType), []byte{0x00})
+}
@@ -1,6 +1,7 @@
 package storage_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/pomerium/pomerium/internal/databroker"
 	"github.com/pomerium/pomerium/internal/testutil"
 	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/pebbleutil"
 	"github.com/pomerium/pomerium/pkg/protoutil"
 	"github.com/pomerium/pomerium/pkg/storage"
 )
@@ -125,6 +127,77 @@ func TestSyncQuerier(t *testing.T) {
 	}, time.Second*10, time.Millisecond*50, "should pick up changes after invalidation")
 }
 
+func TestSyncQuerierCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutil.GetContext(t, 10*time.Minute)
+
+	srv := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(srv.Stop)
+
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, srv)
+	})
+	t.Cleanup(func() { cc.Close() })
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+
+	// a databroker which doesn't implement any methods, so syncing never
+	// succeeds
+	unavailableCC := testutil.NewGRPCServer(t, func(_ *grpc.Server) {})
+	t.Cleanup(func() { unavailableCC.Close() })
+	unavailableClient := databrokerpb.NewDataBrokerServiceClient(unavailableCC)
+
+	db := pebbleutil.MustOpenMemory(nil)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err := client.Put(ctx, &databrokerpb.PutRequest{
+		Records: []*databrokerpb.Record{{Type: "t1", Id: "r1", Data: protoutil.ToAny("q1")}},
+	})
+	require.NoError(t, err)
+
+	queryIDs := func(q storage.Querier) ([]string, error) {
+		res, err := q.Query(ctx, &databrokerpb.QueryRequest{Type: "t1", Limit: 10})
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for _, record := range res.GetRecords() {
+			ids = append(ids, record.GetId())
+		}
+		return ids, nil
+	}
+
+	q1 := storage.NewSyncQuerier(client, "t1", storage.WithSyncQuerierCache(db, []byte("cache/")))
+	t.Cleanup(q1.Stop)
+	assert.NoError(t, storage.WaitForSync(ctx, q1))
+
+	q2 := storage.NewSyncQuerier(unavailableClient, "t1", storage.WithSyncQuerierCache(db, []byte("other/")))
+	t.Cleanup(q2.Stop)
+	_, err = queryIDs(q2)
+	assert.ErrorIs(t, err, storage.ErrUnavailable,
+		"should return unavailable when nothing is cached")
+
+	_, err = client.Put(ctx, &databrokerpb.PutRequest{
+		Records: []*databrokerpb.Record{{Type: "t1", Id: "r2", Data: protoutil.ToAny("q2")}},
+	})
+	require.NoError(t, err)
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		q3 := storage.NewSyncQuerier(unavailableClient, "t1", storage.WithSyncQuerierCache(db, []byte("cache/")))
+		defer q3.Stop()
+
+		ids, err := queryIDs(q3)
+		if assert.NoError(c, err) {
+			assert.ElementsMatch(c, []string{"r1", "r2"}, ids)
+		}
+
+		waitCtx, cancel := context.WithTimeout(ctx, time.Millisecond*10)
+		defer cancel()
+		assert.ErrorIs(c, storage.WaitForSync(waitCtx, q3), context.DeadlineExceeded,
+			"should not be synced when only the cache was loaded")
+	}, time.Second*10, time.Millisecond*50, "should load records from the cache")
+}
+
 func newStruct(t *testing.T, m map[string]any) *structpb.Struct {
 	t.Helper()
 	s, err := structpb.NewStruct(m)
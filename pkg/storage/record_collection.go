@@ -90,17 +90,44 @@ func (c *recordCollection) List(filter FilterExpression) ([]*databroker.Record,
 		return c.All(), nil
 	}
 
+	// expressions which can't use the id or cidr indexes are compiled and
+	// evaluated in a single scan
+	if !usesRecordCollectionIndex(filter) {
+		match, err := CompileFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+		return c.listMatching(match), nil
+	}
+
 	switch expr := filter.(type) {
 	case AndFilterExpression:
+		// only list the indexed expressions, the rest are used to filter the
+		// intersection
 		var rss [][]*databroker.Record
+		var rest AndFilterExpression
 		for _, e := range expr {
+			if !usesRecordCollectionIndex(e) {
+				rest = append(rest, e)
+				continue
+			}
 			rs, err := c.List(e)
 			if err != nil {
 				return nil, err
 			}
 			rss = append(rss, rs)
 		}
-		return intersection(rss), nil
+		l := intersection(rss)
+		if len(rest) > 0 {
+			match, err := CompileFilter(rest)
+			if err != nil {
+				return nil, err
+			}
+			l = slices.DeleteFunc(l, func(record *databroker.Record) bool {
+				return !match(record)
+			})
+		}
+		return l, nil
 	case OrFilterExpression:
 		var rss [][]*databroker.Record
 		for _, e := range expr {
@@ -127,12 +154,39 @@ func (c *recordCollection) List(filter FilterExpression) ([]*databroker.Record,
 				l = append(l, c.lookupAddr(addr)...)
 			}
 			return l, nil
-		default:
-			return nil, fmt.Errorf("unknown field: %s", strings.Join(expr.Fields, "."))
 		}
-	default:
-		return nil, fmt.Errorf("unknown expression type: %T", expr)
 	}
+	return nil, fmt.Errorf("unknown expression type: %T", filter)
+}
+
+// listMatching lists the records matching a compiled filter, in insertion
+// order.
+func (c *recordCollection) listMatching(match RecordMatcher) []*databroker.Record {
+	var l []*databroker.Record
+	for e := c.insertionOrder.Front(); e != nil; e = e.Next() {
+		node, ok := c.records[e.Value.(string)]
+		if ok && match(node.Record) {
+			l = append(l, node.Record)
+		}
+	}
+	return l
+}
+
+// usesRecordCollectionIndex returns true if the expression contains an id or
+// $index equals expression, which can be looked up in an index.
+func usesRecordCollectionIndex(expr FilterExpression) bool {
+	switch expr := expr.(type) {
+	case AndFilterExpression:
+		return slices.ContainsFunc(expr, usesRecordCollectionIndex)
+	case OrFilterExpression:
+		return slices.ContainsFunc(expr, usesRecordCollectionIndex)
+	case EqualsFilterExpression:
+		switch strings.Join(expr.Fields, ".") {
+		case "id", "$index":
+			return true
+		}
+	}
+	return false
 }
 
 func (c *recordCollection) Put(record *databroker.Record) {
@@ -150,8 +204,8 @@ func (c *recordCollection) Put(record *databroker.Record) {
 		Record:            record,
 		insertionOrderPtr: el,
 	}
-	if prefix := GetRecordIndexCIDR(record.GetData()); prefix != nil {
-		c.addIndex(*prefix, record.GetId())
+	for _, prefix := range GetRecordIndexCIDRs(record.GetData()) {
+		c.addIndex(prefix, record.GetId())
 	}
 }
 
@@ -198,8 +252,8 @@ func (c *recordCollection) delete(recordID string) {
 	}
 
 	// delete the record from the index if it's the current value stored there
-	if prefix := GetRecordIndexCIDR(node.GetData()); prefix != nil {
-		c.deleteIndex(*prefix, recordID)
+	for _, prefix := range GetRecordIndexCIDRs(node.GetData()) {
+		c.deleteIndex(prefix, recordID)
 	}
 
 	delete(c.records, recordID)
@@ -314,6 +368,20 @@ func QueryRecordCollections(
 		return nil, err
 	}
 
+	if query := req.GetQuery(); query != "" {
+		search := SearchFilterExpression{Query: query}
+		if filter == nil {
+			filter = search
+		} else {
+			filter = AndFilterExpression{filter, search}
+		}
+	}
+
+	orderBy, err := OrderByFromProto(req.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+
 	var cs []RecordCollection
 	if req.Type == "" {
 		for _, recordType := range slices.Sorted(maps.Keys(recordCollections)) {
@@ -336,16 +404,11 @@ func QueryRecordCollections(
 		if err != nil {
 			return nil, err
 		}
-
-		for _, record := range records {
-			if req.GetQuery() != "" && !MatchAny(record.GetData(), req.GetQuery()) {
-				continue
-			}
-
-			res.Records = append(res.Records, record)
-		}
+		res.Records = append(res.Records, records...)
 	}
 
+	SortRecords(res.Records, orderBy)
+
 	var total int
 	res.Records, total = databroker.ApplyOffsetAndLimit(
 		res.Records,
@@ -353,5 +416,11 @@ func QueryRecordCollections(
 		int(req.GetLimit()),
 	)
 	res.TotalCount = int64(total)
+
+	res.Records, err = MaskRecords(res.Records, req.GetFieldMask())
+	if err != nil {
+		return nil, err
+	}
+
 	return res, nil
 }
@@ -123,6 +123,44 @@ func TestRecordCollection(t *testing.T) {
 	assert.Empty(t, cmp.Diff([]*databroker.Record{r3}, rs, protocmp.Transform()))
 }
 
+func TestRecordCollectionMultipleCIDRs(t *testing.T) {
+	t.Parallel()
+
+	r1 := &databroker.Record{
+		Id: "r1",
+		Data: newStructAny(t, map[string]any{
+			"$index": map[string]any{
+				"cidr": []any{"10.0.0.0/8", "192.168.0.0/16"},
+			},
+		}),
+	}
+
+	c := storage.NewRecordCollection()
+	c.Put(r1)
+
+	for _, value := range []string{"10.1.2.3", "192.168.1.1", "192.168.1.0/24"} {
+		rs, err := c.List(storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: value})
+		assert.NoError(t, err)
+		assert.Empty(t, cmp.Diff([]*databroker.Record{r1}, rs, protocmp.Transform()),
+			"should match %s", value)
+	}
+
+	r1.Data = newStructAny(t, map[string]any{
+		"$index": map[string]any{
+			"cidr": []any{"10.0.0.0/8"},
+		},
+	})
+	c.Put(r1)
+
+	rs, err := c.List(storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "192.168.1.1"})
+	assert.NoError(t, err)
+	assert.Empty(t, rs, "should remove the old cidr from the index")
+
+	rs, err = c.List(storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "10.1.2.3"})
+	assert.NoError(t, err)
+	assert.Empty(t, cmp.Diff([]*databroker.Record{r1}, rs, protocmp.Transform()))
+}
+
 func newStructAny(t *testing.T, m map[string]any) *anypb.Any {
 	t.Helper()
 	s, err := structpb.NewStruct(m)
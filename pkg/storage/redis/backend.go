@@ -0,0 +1,536 @@
+// Package redis contains a storage Backend implemented with Redis.
+//
+// Records are stored in a hash per record type and every change is appended
+// to a stream, which is used to sync changes.
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/internal/log"
+	"github.com/pomerium/pomerium/pkg/contextutil"
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/health"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// Backend is a storage Backend implemented with Redis.
+type Backend struct {
+	cfg              *config
+	client           *goredis.Client
+	iteratorCanceler contextutil.Canceler
+
+	closeCtx context.Context
+	close    context.CancelFunc
+}
+
+// New creates a new Backend. The dsn is a redis:// or rediss:// URL.
+func New(ctx context.Context, dsn string, options ...Option) (*Backend, error) {
+	opts, err := goredis.ParseURL(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("redis: invalid connection string: %w", err)
+	}
+
+	backend := &Backend{
+		cfg:              getConfig(options...),
+		client:           goredis.NewClient(opts),
+		iteratorCanceler: contextutil.NewCanceler(),
+	}
+	backend.closeCtx, backend.close = context.WithCancel(ctx)
+
+	go backend.periodicallyPing()
+
+	return backend, nil
+}
+
+// Close closes the underlying redis client.
+func (backend *Backend) Close() error {
+	backend.close()
+	return backend.client.Close()
+}
+
+// Clean removes all changes before the given cutoff.
+func (backend *Backend) Clean(ctx context.Context, options storage.CleanOptions) error {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	var minVersion uint64
+	start := "-"
+	for {
+		msgs, err := backend.client.XRangeN(ctx, backend.key(changesKey), start, "+", recordBatchSize).Result()
+		if err != nil {
+			return fmt.Errorf("redis: error listing record changes: %w", err)
+		}
+
+		for _, msg := range msgs {
+			record, err := decodeChange(msg)
+			if err != nil {
+				return err
+			}
+			if !record.GetModifiedAt().AsTime().Before(options.RemoveRecordChangesBefore) {
+				return backend.removeChangesBefore(ctx, minVersion)
+			}
+			minVersion = record.GetVersion() + 1
+		}
+
+		if len(msgs) < recordBatchSize {
+			return backend.removeChangesBefore(ctx, minVersion)
+		}
+		start = formatStreamID(minVersion)
+	}
+}
+
+func (backend *Backend) removeChangesBefore(ctx context.Context, version uint64) error {
+	if version == 0 {
+		return nil
+	}
+	err := backend.client.XTrimMinID(ctx, backend.key(changesKey), formatStreamID(version)).Err()
+	if err != nil {
+		return fmt.Errorf("redis: error removing record changes: %w", err)
+	}
+	return nil
+}
+
+// Clear removes all records from the storage backend. Leases are kept.
+func (backend *Backend) Clear(ctx context.Context) error {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	recordTypes, err := backend.listTypes(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys := []string{
+		backend.key(recordVersionKey),
+		backend.key(changesKey),
+		backend.key(typesKey),
+		backend.key(optionsKey),
+		backend.key(checkpointKey),
+	}
+	for _, recordType := range recordTypes {
+		keys = append(keys, backend.recordsKey(recordType), backend.recordIDsKey(recordType))
+	}
+
+	_, err = backend.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		pipe.Set(ctx, backend.key(serverVersionKey), cryptutil.NewRandomUInt64(), 0)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("redis: error clearing records: %w", err)
+	}
+
+	backend.iteratorCanceler.Cancel(nil)
+	return nil
+}
+
+// Get gets a record.
+func (backend *Backend) Get(ctx context.Context, recordType, recordID string) (*databroker.Record, error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	return backend.getRecord(ctx, recordType, recordID)
+}
+
+// GetCheckpoint gets the latest checkpoint.
+func (backend *Backend) GetCheckpoint(ctx context.Context) (serverVersion, recordVersion uint64, err error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	values, err := backend.client.HMGet(ctx, backend.key(checkpointKey),
+		checkpointServerVersionField, checkpointRecordVersionField).Result()
+	if err != nil {
+		return 0, 0, fmt.Errorf("redis: error getting checkpoint: %w", err)
+	}
+
+	versions := make([]uint64, len(values))
+	for i, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		versions[i], err = strconv.ParseUint(str, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("redis: invalid checkpoint: %w", err)
+		}
+	}
+	return versions[0], versions[1], nil
+}
+
+// GetOptions returns the options for the given record type.
+func (backend *Backend) GetOptions(ctx context.Context, recordType string) (*databroker.Options, error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	return backend.getOptions(ctx, recordType)
+}
+
+func (backend *Backend) getOptions(ctx context.Context, recordType string) (*databroker.Options, error) {
+	options := new(databroker.Options)
+	value, err := backend.client.HGet(ctx, backend.key(optionsKey), recordType).Result()
+	if errors.Is(err, goredis.Nil) {
+		return options, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("redis: error getting options: %w", err)
+	}
+
+	err = proto.Unmarshal([]byte(value), options)
+	if err != nil {
+		return nil, fmt.Errorf("redis: error unmarshaling options: %w", err)
+	}
+	return options, nil
+}
+
+// Lease acquires or renews a lease.
+func (backend *Backend) Lease(ctx context.Context, leaseName, leaseID string, ttl time.Duration) (bool, error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	acquired, err := leaseScript.Run(ctx, backend.client,
+		[]string{backend.key(leaseKey, leaseName)},
+		leaseID, max(ttl.Milliseconds(), 0)).Int()
+	if err != nil {
+		return false, fmt.Errorf("redis: error acquiring lease: %w", err)
+	}
+	return acquired == 1, nil
+}
+
+// ListLeases lists all the unexpired leases, sorted by name.
+func (backend *Backend) ListLeases(ctx context.Context) ([]storage.Lease, error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	return backend.listLeases(ctx)
+}
+
+// ListTypes lists all the known record types.
+func (backend *Backend) ListTypes(ctx context.Context) ([]string, error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	return backend.listTypes(ctx)
+}
+
+// Put puts records into redis.
+func (backend *Backend) Put(ctx context.Context, records []*databroker.Record) (serverVersion uint64, err error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	serverVersion, err = backend.getServerVersion(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := timestamppb.Now()
+	for _, record := range records {
+		if record != nil {
+			record.ModifiedAt = now
+		}
+	}
+
+	err = backend.putRecords(ctx, records)
+	if err != nil {
+		return serverVersion, err
+	}
+
+	var recordTypes []string
+	for _, record := range records {
+		if !slices.Contains(recordTypes, record.GetType()) {
+			recordTypes = append(recordTypes, record.GetType())
+		}
+	}
+	for _, recordType := range recordTypes {
+		err = backend.enforceOptions(ctx, recordType)
+		if err != nil {
+			return serverVersion, err
+		}
+	}
+
+	return serverVersion, nil
+}
+
+// Patch updates the specified fields of existing records. Each record is
+// updated with an expected version so that concurrent patches aren't lost.
+func (backend *Backend) Patch(
+	ctx context.Context,
+	records []*databroker.Record,
+	fields *fieldmaskpb.FieldMask,
+) (serverVersion uint64, patchedRecords []*databroker.Record, err error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	serverVersion, err = backend.getServerVersion(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	patchedRecords = make([]*databroker.Record, 0, len(records))
+	for _, record := range records {
+		patched, err := backend.patchRecord(ctx, record, fields)
+		if storage.IsNotFound(err) {
+			// skip any record that does not currently exist
+			continue
+		} else if err != nil {
+			return serverVersion, patchedRecords, fmt.Errorf("redis: error patching record %q of type %q: %w",
+				record.GetId(), record.GetType(), err)
+		}
+		patchedRecords = append(patchedRecords, patched)
+	}
+
+	return serverVersion, patchedRecords, nil
+}
+
+func (backend *Backend) patchRecord(
+	ctx context.Context,
+	record *databroker.Record,
+	fields *fieldmaskpb.FieldMask,
+) (*databroker.Record, error) {
+	for {
+		existing, err := backend.getRecord(ctx, record.GetType(), record.GetId())
+		if err != nil {
+			return nil, err
+		}
+
+		patched := proto.CloneOf(record)
+		err = storage.PatchRecord(existing, patched, fields)
+		if err != nil {
+			return nil, err
+		}
+		patched.ModifiedAt = timestamppb.Now()
+		patched.ExpectedVersion = proto.Uint64(existing.GetVersion())
+
+		err = backend.putRecords(ctx, []*databroker.Record{patched})
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			// the record was changed concurrently, so try again
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		return patched, nil
+	}
+}
+
+// SetCheckpoint sets the latest checkpoint.
+func (backend *Backend) SetCheckpoint(ctx context.Context, serverVersion, recordVersion uint64) error {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	err := backend.client.HSet(ctx, backend.key(checkpointKey),
+		checkpointServerVersionField, strconv.FormatUint(serverVersion, 10),
+		checkpointRecordVersionField, strconv.FormatUint(recordVersion, 10)).Err()
+	if err != nil {
+		return fmt.Errorf("redis: error setting checkpoint: %w", err)
+	}
+	return nil
+}
+
+// SetOptions sets the options for the given record type.
+func (backend *Backend) SetOptions(ctx context.Context, recordType string, options *databroker.Options) error {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	if proto.Equal(options, new(databroker.Options)) {
+		err := backend.client.HDel(ctx, backend.key(optionsKey), recordType).Err()
+		if err != nil {
+			return fmt.Errorf("redis: error deleting options: %w", err)
+		}
+		return nil
+	}
+
+	data, err := proto.Marshal(options)
+	if err != nil {
+		return fmt.Errorf("redis: error marshaling options: %w", err)
+	}
+
+	err = backend.client.HSet(ctx, backend.key(optionsKey), recordType, data).Err()
+	if err != nil {
+		return fmt.Errorf("redis: error setting options: %w", err)
+	}
+
+	return backend.enforceOptions(ctx, recordType)
+}
+
+// enforceOptions deletes the least recently modified records of a type that
+// exceed its capacity.
+func (backend *Backend) enforceOptions(ctx context.Context, recordType string) error {
+	options, err := backend.getOptions(ctx, recordType)
+	if err != nil {
+		return err
+	}
+	if options.Capacity == nil {
+		return nil
+	}
+
+	records, err := backend.listRecords(ctx, recordType)
+	if err != nil {
+		return err
+	}
+	if uint64(len(records)) <= options.GetCapacity() {
+		return nil
+	}
+
+	slices.SortFunc(records, cmpVersion)
+	records = records[:uint64(len(records))-options.GetCapacity()]
+	now := timestamppb.Now()
+	for _, record := range records {
+		record.ModifiedAt = now
+		record.DeletedAt = now
+		// skip records that were changed concurrently
+		record.ExpectedVersion = proto.Uint64(record.GetVersion())
+		err = backend.putRecords(ctx, []*databroker.Record{record})
+		if err != nil && !errors.Is(err, storage.ErrVersionMismatch) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Sync syncs record changes after the specified version. If wait is set to
+// true the record iterator will continue to receive records until the
+// iterator or ctx is cancelled.
+func (backend *Backend) Sync(
+	ctx context.Context,
+	recordType string,
+	serverVersion, recordVersion uint64,
+	wait bool,
+) storage.RecordIterator {
+	return backend.iterateChangedRecords(ctx, recordType, serverVersion, recordVersion, wait)
+}
+
+// SyncLatest syncs the latest version of each record.
+func (backend *Backend) SyncLatest(
+	ctx context.Context,
+	recordType string,
+	filter storage.FilterExpression,
+) (serverVersion, recordVersion uint64, seq storage.RecordIterator, err error) {
+	return backend.SyncLatestAfter(ctx, recordType, filter, "", "")
+}
+
+// SyncLatestAfter syncs the latest version of each record that sorts after the
+// record with the given type and id.
+func (backend *Backend) SyncLatestAfter(
+	ctx context.Context,
+	recordType string,
+	filter storage.FilterExpression,
+	afterRecordType, afterRecordID string,
+) (serverVersion, recordVersion uint64, seq storage.RecordIterator, err error) {
+	callCtx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	serverVersion, err = backend.getServerVersion(callCtx)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	_, recordVersion, err = backend.getRecordVersionRange(callCtx)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	var after *databroker.Record
+	if afterRecordType != "" || afterRecordID != "" {
+		after = &databroker.Record{Type: afterRecordType, Id: afterRecordID}
+	}
+	return serverVersion, recordVersion, backend.iterateLatestRecords(ctx, recordType, filter, after), nil
+}
+
+// SyncLatestOrdered syncs the latest version of each record sorted by the
+// given order.
+func (backend *Backend) SyncLatestOrdered(
+	ctx context.Context,
+	recordType string,
+	filter storage.FilterExpression,
+	orderBy storage.OrderBy,
+) (serverVersion, recordVersion uint64, seq storage.RecordIterator, err error) {
+	serverVersion, recordVersion, seq, err = backend.SyncLatest(ctx, recordType, filter)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return serverVersion, recordVersion, storage.SortRecordIterator(seq, orderBy), nil
+}
+
+// Stats returns statistics for each record type.
+func (backend *Backend) Stats(ctx context.Context) ([]storage.RecordTypeStats, error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	recordTypes, err := backend.listTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats []storage.RecordTypeStats
+	for _, recordType := range recordTypes {
+		records, err := backend.listRecords(ctx, recordType)
+		if err != nil {
+			return nil, err
+		}
+
+		s := storage.RecordTypeStats{RecordType: recordType}
+		for _, record := range records {
+			s.Add(record, proto.Size(record))
+		}
+		if s.Count > 0 {
+			stats = append(stats, s)
+		}
+	}
+	return stats, nil
+}
+
+// Versions returns the versions of the storage backend.
+func (backend *Backend) Versions(ctx context.Context) (serverVersion, earliestRecordVersion, latestRecordVersion uint64, err error) {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx)
+	defer cancel(nil)
+
+	serverVersion, err = backend.getServerVersion(ctx)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	earliestRecordVersion, latestRecordVersion, err = backend.getRecordVersionRange(ctx)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return serverVersion, earliestRecordVersion, latestRecordVersion, nil
+}
+
+func (backend *Backend) periodicallyPing() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		err := backend.client.Ping(backend.closeCtx).Err()
+		if errors.Is(err, context.Canceled) {
+			return
+		} else if err != nil {
+			log.Ctx(backend.closeCtx).Error().Err(err).Msg("redis: error pinging server")
+			health.ReportError(health.StorageBackend, err, backend.healthAttrs()...)
+		} else {
+			health.ReportRunning(health.StorageBackend, backend.healthAttrs()...)
+		}
+
+		select {
+		case <-backend.closeCtx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (backend *Backend) healthAttrs() []health.Attr {
+	return []health.Attr{{Key: "backend", Value: "redis"}}
+}
@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/testutil"
+	"github.com/pomerium/pomerium/pkg/storage/storagetest"
+)
+
+func TestBackend(t *testing.T) {
+	t.Parallel()
+
+	if os.Getenv("GITHUB_ACTION") != "" && runtime.GOOS == "darwin" {
+		t.Skip("Github action can not run docker on MacOS")
+	}
+
+	testutil.WithTestRedis(t, func(dsn string) {
+		// each test uses its own key prefix since the redis server is shared
+		backend, err := New(t.Context(), dsn, WithKeyPrefix(uuid.NewString()))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = backend.Close() })
+
+		storagetest.TestBackend(t, backend)
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(t.Context(), "postgres://localhost:5432")
+	require.Error(t, err)
+}
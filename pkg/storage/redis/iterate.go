@@ -0,0 +1,191 @@
+package redis
+
+import (
+	"cmp"
+	"context"
+	"errors"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/pomerium/pomerium/pkg/contextutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+func (backend *Backend) iterateChangedRecords(
+	ctx context.Context,
+	recordType string,
+	serverVersion, afterRecordVersion uint64,
+	wait bool,
+) storage.RecordIterator {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx, backend.iteratorCanceler.Context())
+	return func(yield func(*databroker.Record, error) bool) {
+		defer cancel(nil)
+
+		currentServerVersion, err := backend.getServerVersion(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		} else if serverVersion != currentServerVersion {
+			yield(nil, storage.ErrInvalidServerVersion)
+			return
+		}
+
+		earliestRecordVersion, _, err := backend.getRecordVersionRange(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		} else if earliestRecordVersion > 0 && afterRecordVersion < (earliestRecordVersion-1) {
+			yield(nil, storage.ErrInvalidRecordVersion)
+			return
+		}
+
+		for {
+			msgs, err := backend.client.XRangeN(ctx, backend.key(changesKey),
+				"("+formatStreamID(afterRecordVersion), "+", recordBatchSize).Result()
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if len(msgs) > 0 {
+				for i, msg := range msgs {
+					record, err := decodeChange(msg)
+					if err != nil {
+						yield(nil, err)
+						return
+					}
+
+					// changes were removed after we started iterating
+					if i == 0 && record.GetVersion() != afterRecordVersion+1 {
+						yield(nil, storage.ErrInvalidRecordVersion)
+						return
+					}
+
+					afterRecordVersion = record.GetVersion()
+					if recordType != "" && record.GetType() != recordType {
+						continue
+					}
+					if !yield(record, nil) {
+						return
+					}
+				}
+				continue
+			}
+
+			if !wait {
+				return
+			}
+
+			err = backend.waitForChange(ctx, serverVersion, afterRecordVersion)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+		}
+	}
+}
+
+// waitForChange blocks until there is a change after the given record version.
+// The server version is re-checked periodically so that a Clear by another
+// backend sharing the same redis database is detected.
+func (backend *Backend) waitForChange(ctx context.Context, serverVersion, afterRecordVersion uint64) error {
+	for {
+		_, err := backend.client.XRead(ctx, &goredis.XReadArgs{
+			Streams: []string{backend.key(changesKey), formatStreamID(afterRecordVersion)},
+			Count:   1,
+			Block:   backend.cfg.syncBlockTimeout,
+		}).Result()
+		if err == nil {
+			return nil
+		} else if !errors.Is(err, goredis.Nil) {
+			if ctx.Err() != nil {
+				return context.Cause(ctx)
+			}
+			return err
+		}
+
+		currentServerVersion, err := backend.getServerVersion(ctx)
+		if err != nil {
+			return err
+		} else if serverVersion != currentServerVersion {
+			return storage.ErrInvalidServerVersion
+		}
+	}
+}
+
+// iterateLatestRecords iterates over the latest records. If after is set,
+// only records that sort after it are returned. Records are ordered by type
+// and id and are read in batches from the record ids sorted set.
+func (backend *Backend) iterateLatestRecords(
+	ctx context.Context,
+	recordType string,
+	expr storage.FilterExpression,
+	after *databroker.Record,
+) storage.RecordIterator {
+	ctx, cancel := contextutil.Merge(ctx, backend.closeCtx, backend.iteratorCanceler.Context())
+	return func(yield func(*databroker.Record, error) bool) {
+		defer cancel(nil)
+
+		match, err := storage.CompileFilter(expr)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		var recordTypes []string
+		if recordType == "" {
+			recordTypes, err = backend.listTypes(ctx)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+		} else {
+			recordTypes = []string{recordType}
+		}
+
+		for _, recordType := range recordTypes {
+			if after != nil && recordType < after.GetType() {
+				continue
+			}
+
+			var afterRecordID string
+			if after != nil && recordType == after.GetType() {
+				afterRecordID = after.GetId()
+			}
+
+			for {
+				records, done, err := backend.listRecordsAfter(ctx, recordType, afterRecordID)
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+
+				for _, record := range records {
+					afterRecordID = record.GetId()
+					if !match(record) {
+						continue
+					}
+					if !yield(record, nil) {
+						return
+					}
+				}
+
+				select {
+				case <-ctx.Done():
+					yield(nil, context.Cause(ctx))
+					return
+				default:
+				}
+
+				if done {
+					break
+				}
+			}
+		}
+	}
+}
+
+func cmpVersion(a, b *databroker.Record) int {
+	return cmp.Compare(a.GetVersion(), b.GetVersion())
+}
@@ -0,0 +1,44 @@
+package redis
+
+import (
+	"time"
+)
+
+const (
+	defaultKeyPrefix        = "pomerium"
+	defaultSyncBlockTimeout = time.Second
+)
+
+type config struct {
+	keyPrefix        string
+	syncBlockTimeout time.Duration
+}
+
+// Option customizes a Backend.
+type Option func(*config)
+
+// WithKeyPrefix sets the prefix used for all the redis keys. Multiple backends
+// can share a redis database by using different prefixes.
+func WithKeyPrefix(prefix string) Option {
+	return func(cfg *config) {
+		cfg.keyPrefix = prefix
+	}
+}
+
+// WithSyncBlockTimeout sets how long a waiting Sync blocks on the change stream
+// before checking whether the backend was cleared.
+func WithSyncBlockTimeout(timeout time.Duration) Option {
+	return func(cfg *config) {
+		cfg.syncBlockTimeout = timeout
+	}
+}
+
+func getConfig(options ...Option) *config {
+	cfg := new(config)
+	WithKeyPrefix(defaultKeyPrefix)(cfg)
+	WithSyncBlockTimeout(defaultSyncBlockTimeout)(cfg)
+	for _, o := range options {
+		o(cfg)
+	}
+	return cfg
+}
@@ -0,0 +1,425 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pomerium/pomerium/pkg/cryptutil"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/storage"
+)
+
+// Keys are wrapped in a hash tag so that they all map to the same slot and
+// scripts can use them together.
+//
+//	{prefix}:server_version   string, the server version
+//	{prefix}:record_version   string, the latest record version
+//	{prefix}:changes          stream, record changes with ids of {version}-0
+//	{prefix}:types            set, the known record types
+//	{prefix}:records:{type}   hash, record id => {version}:{record as proto}
+//	{prefix}:record_ids:{type} sorted set, record ids with a score of 0 so
+//	                          they can be paged in order with ZRANGEBYLEX
+//	{prefix}:options          hash, record type => options as proto
+//	{prefix}:checkpoint       hash, server_version and record_version
+//	{prefix}:lease:{name}     string, the lease id
+const (
+	serverVersionKey = "server_version"
+	recordVersionKey = "record_version"
+	changesKey       = "changes"
+	typesKey         = "types"
+	recordsKey       = "records"
+	recordIDsKey     = "record_ids"
+	optionsKey       = "options"
+	checkpointKey    = "checkpoint"
+	leaseKey         = "lease"
+
+	checkpointServerVersionField = "server_version"
+	checkpointRecordVersionField = "record_version"
+	changeRecordField            = "record"
+
+	recordBatchSize = 64
+
+	versionMismatchError = "VERSION_MISMATCH"
+)
+
+// putScript puts records. Expected versions are checked for every record before
+// any record is written. Each record gets the next record version, which is
+// also the id of its entry in the change stream, so record versions in the
+// stream are contiguous.
+//
+// KEYS[1] is the record version, KEYS[2] the change stream, KEYS[3] the set of
+// record types, KEYS[2+2i] the records hash and KEYS[3+2i] the record ids
+// sorted set for record i. ARGV holds the type, id, data, deleted flag and
+// expected version of each record.
+var putScript = goredis.NewScript(`
+local n = #ARGV / 5
+for i = 1, n do
+  local o = (i - 1) * 5
+  local expected = ARGV[o + 5]
+  if expected ~= '' then
+    local current = redis.call('HGET', KEYS[2 + 2 * i], ARGV[o + 2])
+    local version = '0'
+    if current then
+      version = string.match(current, '^(%d+):')
+    end
+    if version ~= expected then
+      return redis.error_reply('` + versionMismatchError + `')
+    end
+  end
+end
+
+local versions = {}
+for i = 1, n do
+  local o = (i - 1) * 5
+  local version = redis.call('INCR', KEYS[1])
+  if ARGV[o + 4] == '1' then
+    redis.call('HDEL', KEYS[2 + 2 * i], ARGV[o + 2])
+    redis.call('ZREM', KEYS[3 + 2 * i], ARGV[o + 2])
+  else
+    redis.call('HSET', KEYS[2 + 2 * i], ARGV[o + 2], version .. ':' .. ARGV[o + 3])
+    redis.call('ZADD', KEYS[3 + 2 * i], 0, ARGV[o + 2])
+    redis.call('SADD', KEYS[3], ARGV[o + 1])
+  end
+  redis.call('XADD', KEYS[2], version .. '-0', '` + changeRecordField + `', ARGV[o + 3])
+  versions[i] = version
+end
+return versions
+`)
+
+// leaseScript acquires, renews or releases a lease. It returns 1 if the lease
+// is held by the caller.
+//
+// KEYS[1] is the lease. ARGV[1] is the lease id and ARGV[2] the ttl in
+// milliseconds. A ttl of 0 releases the lease.
+var leaseScript = goredis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+local ttl = tonumber(ARGV[2])
+if not current then
+  if ttl > 0 then
+    redis.call('SET', KEYS[1], ARGV[1], 'PX', ttl)
+  end
+  return 1
+end
+if current ~= ARGV[1] then
+  return 0
+end
+if ttl <= 0 then
+  redis.call('DEL', KEYS[1])
+  return 0
+end
+redis.call('PEXPIRE', KEYS[1], ttl)
+return 1
+`)
+
+func (backend *Backend) key(parts ...string) string {
+	return "{" + backend.cfg.keyPrefix + "}:" + strings.Join(parts, ":")
+}
+
+func (backend *Backend) recordsKey(recordType string) string {
+	return backend.key(recordsKey, recordType)
+}
+
+func (backend *Backend) recordIDsKey(recordType string) string {
+	return backend.key(recordIDsKey, recordType)
+}
+
+func isVersionMismatch(err error) bool {
+	var redisErr goredis.Error
+	return errors.As(err, &redisErr) && strings.Contains(redisErr.Error(), versionMismatchError)
+}
+
+// encodeRecord encodes a record without its version, which is stored
+// separately.
+func encodeRecord(record *databroker.Record) ([]byte, error) {
+	record = proto.CloneOf(record)
+	record.Version = 0
+	record.ExpectedVersion = nil
+	return proto.Marshal(record)
+}
+
+func decodeRecord(version uint64, data string) (*databroker.Record, error) {
+	record := new(databroker.Record)
+	err := proto.Unmarshal([]byte(data), record)
+	if err != nil {
+		return nil, fmt.Errorf("redis: error unmarshaling record: %w", err)
+	}
+	record.Version = version
+	return record, nil
+}
+
+// decodeRecordValue decodes a value from a records hash.
+func decodeRecordValue(value string) (*databroker.Record, error) {
+	rawVersion, data, ok := strings.Cut(value, ":")
+	if !ok {
+		return nil, fmt.Errorf("redis: invalid record value")
+	}
+	version, err := strconv.ParseUint(rawVersion, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("redis: invalid record version: %w", err)
+	}
+	return decodeRecord(version, data)
+}
+
+// decodeChange decodes an entry from the change stream.
+func decodeChange(msg goredis.XMessage) (*databroker.Record, error) {
+	version, err := parseStreamID(msg.ID)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := msg.Values[changeRecordField].(string)
+	if !ok {
+		return nil, fmt.Errorf("redis: missing record in change %s", msg.ID)
+	}
+	return decodeRecord(version, data)
+}
+
+func formatStreamID(version uint64) string {
+	return strconv.FormatUint(version, 10) + "-0"
+}
+
+func parseStreamID(id string) (uint64, error) {
+	rawVersion, _, _ := strings.Cut(id, "-")
+	version, err := strconv.ParseUint(rawVersion, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("redis: invalid change id %s: %w", id, err)
+	}
+	return version, nil
+}
+
+func parseUint64(value string, err error) (uint64, error) {
+	if errors.Is(err, goredis.Nil) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
+
+// getServerVersion returns the server version, creating it if it doesn't exist.
+func (backend *Backend) getServerVersion(ctx context.Context) (uint64, error) {
+	serverVersion, err := parseUint64(backend.client.Get(ctx, backend.key(serverVersionKey)).Result())
+	if err != nil {
+		return 0, fmt.Errorf("redis: error getting server version: %w", err)
+	} else if serverVersion != 0 {
+		return serverVersion, nil
+	}
+
+	err = backend.client.SetNX(ctx, backend.key(serverVersionKey), cryptutil.NewRandomUInt64(), 0).Err()
+	if err != nil {
+		return 0, fmt.Errorf("redis: error setting server version: %w", err)
+	}
+
+	serverVersion, err = parseUint64(backend.client.Get(ctx, backend.key(serverVersionKey)).Result())
+	if err != nil {
+		return 0, fmt.Errorf("redis: error getting server version: %w", err)
+	}
+	return serverVersion, nil
+}
+
+// getRecordVersionRange returns the earliest and latest record versions in the
+// change stream.
+func (backend *Backend) getRecordVersionRange(ctx context.Context) (earliest, latest uint64, err error) {
+	latest, err = parseUint64(backend.client.Get(ctx, backend.key(recordVersionKey)).Result())
+	if err != nil {
+		return 0, 0, fmt.Errorf("redis: error getting record version: %w", err)
+	}
+
+	msgs, err := backend.client.XRangeN(ctx, backend.key(changesKey), "-", "+", 1).Result()
+	if err != nil {
+		return 0, 0, fmt.Errorf("redis: error getting earliest record change: %w", err)
+	}
+	if len(msgs) == 0 {
+		// every change was removed
+		if latest > 0 {
+			earliest = latest + 1
+		}
+		return earliest, latest, nil
+	}
+
+	earliest, err = parseStreamID(msgs[0].ID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return earliest, latest, nil
+}
+
+func (backend *Backend) getRecord(ctx context.Context, recordType, recordID string) (*databroker.Record, error) {
+	value, err := backend.client.HGet(ctx, backend.recordsKey(recordType), recordID).Result()
+	if errors.Is(err, goredis.Nil) {
+		return nil, storage.ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("redis: error getting record: %w", err)
+	}
+	return decodeRecordValue(value)
+}
+
+// listRecords lists all the records of a type, sorted by id.
+func (backend *Backend) listRecords(ctx context.Context, recordType string) ([]*databroker.Record, error) {
+	values, err := backend.client.HGetAll(ctx, backend.recordsKey(recordType)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis: error listing records: %w", err)
+	}
+
+	records := make([]*databroker.Record, 0, len(values))
+	for _, value := range values {
+		record, err := decodeRecordValue(value)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	slices.SortFunc(records, func(a, b *databroker.Record) int {
+		return strings.Compare(a.GetId(), b.GetId())
+	})
+	return records, nil
+}
+
+// listRecordsAfter lists up to recordBatchSize records of a type whose ids sort
+// after the given id. An empty id starts from the beginning.
+func (backend *Backend) listRecordsAfter(ctx context.Context, recordType, afterRecordID string) (records []*databroker.Record, done bool, err error) {
+	minID := "-"
+	if afterRecordID != "" {
+		minID = "(" + afterRecordID
+	}
+	ids, err := backend.client.ZRangeByLex(ctx, backend.recordIDsKey(recordType), &goredis.ZRangeBy{
+		Min:   minID,
+		Max:   "+",
+		Count: recordBatchSize,
+	}).Result()
+	if err != nil {
+		return nil, false, fmt.Errorf("redis: error listing record ids: %w", err)
+	}
+	done = len(ids) < recordBatchSize
+	if len(ids) == 0 {
+		return nil, done, nil
+	}
+
+	values, err := backend.client.HMGet(ctx, backend.recordsKey(recordType), ids...).Result()
+	if err != nil {
+		return nil, false, fmt.Errorf("redis: error listing records: %w", err)
+	}
+
+	records = make([]*databroker.Record, 0, len(values))
+	for _, value := range values {
+		// the record may have been deleted after its id was listed
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		record, err := decodeRecordValue(str)
+		if err != nil {
+			return nil, false, err
+		}
+		records = append(records, record)
+	}
+	return records, done, nil
+}
+
+func (backend *Backend) listLeases(ctx context.Context) ([]storage.Lease, error) {
+	prefix := backend.key(leaseKey, "")
+	var names []string
+	it := backend.client.Scan(ctx, 0, prefix+"*", 0).Iterator()
+	for it.Next(ctx) {
+		names = append(names, strings.TrimPrefix(it.Val(), prefix))
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("redis: error scanning leases: %w", err)
+	}
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	ids := make([]*goredis.StringCmd, len(names))
+	ttls := make([]*goredis.DurationCmd, len(names))
+	_, err := backend.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for i, name := range names {
+			ids[i] = pipe.Get(ctx, backend.key(leaseKey, name))
+			ttls[i] = pipe.PTTL(ctx, backend.key(leaseKey, name))
+		}
+		return nil
+	})
+	// a lease may expire between the scan and the get
+	if err != nil && !errors.Is(err, goredis.Nil) {
+		return nil, fmt.Errorf("redis: error reading leases: %w", err)
+	}
+
+	now := time.Now()
+	var leases []storage.Lease
+	for i, name := range names {
+		id, err := ids[i].Result()
+		if errors.Is(err, goredis.Nil) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("redis: error reading lease: %w", err)
+		}
+		ttl := ttls[i].Val()
+		if ttl <= 0 {
+			continue
+		}
+		leases = append(leases, storage.Lease{Name: name, ID: id, ExpiresAt: now.Add(ttl)})
+	}
+	return leases, nil
+}
+
+func (backend *Backend) listTypes(ctx context.Context) ([]string, error) {
+	recordTypes, err := backend.client.SMembers(ctx, backend.key(typesKey)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis: error listing record types: %w", err)
+	}
+	slices.Sort(recordTypes)
+	return recordTypes, nil
+}
+
+// putRecords puts records and sets their versions.
+func (backend *Backend) putRecords(ctx context.Context, records []*databroker.Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	keys := []string{backend.key(recordVersionKey), backend.key(changesKey), backend.key(typesKey)}
+	args := make([]any, 0, len(records)*5)
+	for _, record := range records {
+		if record == nil {
+			return fmt.Errorf("records cannot be nil")
+		}
+
+		data, err := encodeRecord(record)
+		if err != nil {
+			return fmt.Errorf("redis: error marshaling record: %w", err)
+		}
+
+		deleted := "0"
+		if record.GetDeletedAt() != nil {
+			deleted = "1"
+		}
+		var expectedVersion string
+		if record.ExpectedVersion != nil {
+			expectedVersion = strconv.FormatUint(record.GetExpectedVersion(), 10)
+		}
+
+		keys = append(keys, backend.recordsKey(record.GetType()), backend.recordIDsKey(record.GetType()))
+		args = append(args, record.GetType(), record.GetId(), data, deleted, expectedVersion)
+	}
+
+	versions, err := putScript.Run(ctx, backend.client, keys, args...).Int64Slice()
+	if isVersionMismatch(err) {
+		return storage.ErrVersionMismatch
+	} else if err != nil {
+		return fmt.Errorf("redis: error putting records: %w", err)
+	} else if len(versions) != len(records) {
+		return fmt.Errorf("redis: expected %d record versions, got %d", len(records), len(versions))
+	}
+
+	for i, record := range records {
+		record.Version = uint64(versions[i])
+		record.ExpectedVersion = nil
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+)
+
+// TokenizeSearchText splits text into lower-cased search tokens. A token is a
+// run of letters and digits.
+func TokenizeSearchText(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// MatchSearch returns true if the record matches a search query. The query is
+// matched case-insensitively against every string field of the record data.
+func MatchSearch(record *databroker.Record, query string) bool {
+	if query == "" {
+		return true
+	}
+	return MatchAny(record.GetData(), strings.ToLower(query))
+}
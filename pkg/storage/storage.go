@@ -23,8 +23,27 @@ var (
 	ErrStreamDone           = errors.New("record stream done")
 	ErrInvalidServerVersion = status.Error(codes.Aborted, "invalid server version")
 	ErrInvalidRecordVersion = status.Error(codes.Aborted, "invalid record version")
+	ErrVersionMismatch      = status.Error(codes.Aborted, "record version mismatch")
 )
 
+// CheckExpectedVersion returns ErrVersionMismatch if the record has an expected
+// version that doesn't match the version of the current record. A record that
+// doesn't exist or was deleted has a version of 0.
+func CheckExpectedVersion(record, current *databroker.Record) error {
+	if record.ExpectedVersion == nil {
+		return nil
+	}
+
+	var currentVersion uint64
+	if current != nil && current.GetDeletedAt() == nil {
+		currentVersion = current.GetVersion()
+	}
+	if currentVersion != record.GetExpectedVersion() {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
 // Backend is the interface required for a storage backend.
 type Backend interface {
 	// Close closes the backend.
@@ -41,9 +60,12 @@ type Backend interface {
 	GetOptions(ctx context.Context, recordType string) (*databroker.Options, error)
 	// Lease acquires a lease, or renews an existing one. If the lease is acquired true is returned.
 	Lease(ctx context.Context, leaseName, leaseID string, ttl time.Duration) (bool, error)
+	// ListLeases lists all the unexpired leases, sorted by name.
+	ListLeases(ctx context.Context) ([]Lease, error)
 	// ListTypes lists all the known record types.
 	ListTypes(ctx context.Context) ([]string, error)
-	// Put is used to insert or update records.
+	// Put is used to insert or update records. If any record has an expected
+	// version that doesn't match, ErrVersionMismatch is returned.
 	Put(ctx context.Context, records []*databroker.Record) (serverVersion uint64, err error)
 	// Patch is used to update specific fields of existing records.
 	Patch(ctx context.Context, records []*databroker.Record, fields *fieldmaskpb.FieldMask) (serverVersion uint64, patchedRecords []*databroker.Record, err error)
@@ -57,10 +79,50 @@ type Backend interface {
 	Sync(ctx context.Context, recordType string, serverVersion, recordVersion uint64, wait bool) RecordIterator
 	// SyncLatest syncs all the records.
 	SyncLatest(ctx context.Context, recordType string, filter FilterExpression) (serverVersion, recordVersion uint64, seq RecordIterator, err error)
+	// SyncLatestAfter syncs all the records that sort after the record with
+	// the given type and id. Records are ordered by type and then by id.
+	SyncLatestAfter(ctx context.Context, recordType string, filter FilterExpression, afterRecordType, afterRecordID string) (serverVersion, recordVersion uint64, seq RecordIterator, err error)
+	// SyncLatestOrdered syncs all the records sorted by the given order.
+	SyncLatestOrdered(ctx context.Context, recordType string, filter FilterExpression, orderBy OrderBy) (serverVersion, recordVersion uint64, seq RecordIterator, err error)
+	// Stats returns statistics for each record type, sorted by record type.
+	Stats(ctx context.Context) ([]RecordTypeStats, error)
 	// Versions returns versions from the storage backend.
 	Versions(ctx context.Context) (serverVersion, earliestRecordVersion, latestRecordVersion uint64, err error)
 }
 
+// A Lease is a distributed mutex lease.
+type Lease struct {
+	Name      string
+	ID        string
+	ExpiresAt time.Time
+}
+
+// RecordTypeStats are the statistics for the records of a single type.
+type RecordTypeStats struct {
+	RecordType string
+	// Count is the number of records.
+	Count uint64
+	// OldestModifiedAt is the modified at timestamp of the least recently modified record.
+	OldestModifiedAt time.Time
+	// NewestModifiedAt is the modified at timestamp of the most recently modified record.
+	NewestModifiedAt time.Time
+	// Size is the total size of the records in bytes.
+	Size uint64
+}
+
+// Add adds a record to the stats.
+func (stats *RecordTypeStats) Add(record *databroker.Record, size int) {
+	modifiedAt := record.GetModifiedAt().AsTime()
+	if stats.Count == 0 || modifiedAt.Before(stats.OldestModifiedAt) {
+		stats.OldestModifiedAt = modifiedAt
+	}
+	if stats.Count == 0 || modifiedAt.After(stats.NewestModifiedAt) {
+		stats.NewestModifiedAt = modifiedAt
+	}
+	stats.Count++
+	stats.Size += uint64(size)
+}
+
 // CleanOptions are the options used for cleaning the storage backend.
 type CleanOptions struct {
 	RemoveRecordChangesBefore time.Time
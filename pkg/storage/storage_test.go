@@ -40,3 +40,11 @@ func TestMatchAny(t *testing.T) {
 	assert.True(t, MatchAny(data, "email"))
 	assert.False(t, MatchAny(data, "nope"))
 }
+
+func TestTokenizeSearchText(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"alice", "example", "com"}, TokenizeSearchText("Alice@Example.com"))
+	assert.Equal(t, []string{"héllo", "42"}, TokenizeSearchText(" HÉLLO, 42! "))
+	assert.Empty(t, TokenizeSearchText("@-."))
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"iter"
 	"runtime"
+	"slices"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -216,6 +218,48 @@ func TestBackend(t *testing.T, backend storage.Backend) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("put expected version", func(t *testing.T) {
+		_, err := backend.Put(ctx, []*databroker.Record{
+			{Type: "expected-version-test", Id: "r1", Data: protoutil.NewAnyString("v1"), ExpectedVersion: proto.Uint64(0)},
+		})
+		require.NoError(t, err, "should create a missing record")
+
+		_, err = backend.Put(ctx, []*databroker.Record{
+			{Type: "expected-version-test", Id: "r1", Data: protoutil.NewAnyString("v2"), ExpectedVersion: proto.Uint64(0)},
+		})
+		assert.ErrorIs(t, err, storage.ErrVersionMismatch, "should not overwrite an existing record")
+
+		current, err := backend.Get(ctx, "expected-version-test", "r1")
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff(protoutil.NewAnyString("v1"), current.GetData(), protocmp.Transform()))
+		assert.Nil(t, current.ExpectedVersion)
+
+		_, err = backend.Put(ctx, []*databroker.Record{
+			{Type: "expected-version-test", Id: "r1", Data: protoutil.NewAnyString("v3"), ExpectedVersion: proto.Uint64(current.GetVersion() + 1)},
+		})
+		assert.ErrorIs(t, err, storage.ErrVersionMismatch, "should not update with a stale version")
+
+		_, err = backend.Put(ctx, []*databroker.Record{
+			{Type: "expected-version-test", Id: "r1", Data: protoutil.NewAnyString("v4"), ExpectedVersion: proto.Uint64(current.GetVersion())},
+		})
+		require.NoError(t, err, "should update with the current version")
+
+		current, err = backend.Get(ctx, "expected-version-test", "r1")
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Diff(protoutil.NewAnyString("v4"), current.GetData(), protocmp.Transform()))
+	})
+
+	t.Run("put is atomic", func(t *testing.T) {
+		_, err := backend.Put(ctx, []*databroker.Record{
+			{Type: "atomic-put-test", Id: "r1", Data: protoutil.NewAnyString("v1")},
+			{Type: "atomic-put-test", Id: "r2", Data: protoutil.NewAnyString("v1"), ExpectedVersion: proto.Uint64(1)},
+		})
+		assert.ErrorIs(t, err, storage.ErrVersionMismatch)
+
+		_, err = backend.Get(ctx, "atomic-put-test", "r1")
+		assert.ErrorIs(t, err, storage.ErrNotFound, "should not save any records if one fails")
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		serverVersion, err := backend.Put(ctx, []*databroker.Record{{
 			Type:      "test-1",
@@ -268,6 +312,22 @@ func TestBackend(t *testing.T, backend storage.Backend) {
 		acquired, err = backend.Lease(ctx, "lease-test", "client-2", time.Second)
 		assert.NoError(t, err)
 		assert.False(t, acquired)
+
+		// release the lease
+		_, err = backend.Lease(ctx, "lease-test", "client-1", 0)
+		assert.NoError(t, err)
+
+		acquired, err = backend.Lease(ctx, "lease-test", "client-2", time.Second)
+		assert.NoError(t, err)
+		assert.True(t, acquired, "should acquire a released lease")
+
+		leases, err := backend.ListLeases(ctx)
+		assert.NoError(t, err)
+		idx := slices.IndexFunc(leases, func(l storage.Lease) bool { return l.Name == "lease-test" })
+		if assert.GreaterOrEqual(t, idx, 0, "should list the lease") {
+			assert.Equal(t, "client-2", leases[idx].ID)
+			assert.WithinDuration(t, time.Now().Add(time.Second), leases[idx].ExpiresAt, time.Second)
+		}
 	})
 
 	t.Run("latest", func(t *testing.T) {
@@ -294,6 +354,85 @@ func TestBackend(t *testing.T, backend storage.Backend) {
 		for i := 0; i < 100; i++ {
 			assert.Equal(t, 1, count[fmt.Sprint(i)])
 		}
+
+		_, _, seq, err = backend.SyncLatestAfter(ctx, "latest-test", nil, "", "")
+		require.NoError(t, err)
+		records, err := iterutil.CollectWithError(seq)
+		require.NoError(t, err)
+		assert.Len(t, records, 100)
+		assert.True(t, slices.IsSortedFunc(records, func(a, b *databroker.Record) int {
+			return strings.Compare(a.GetId(), b.GetId())
+		}), "should return records sorted by id across batches")
+	})
+
+	t.Run("latest after", func(t *testing.T) {
+		_, err := backend.Put(ctx, []*databroker.Record{
+			{Type: "latest-after-test", Id: "c", Data: protoutil.NewAnyString("c")},
+			{Type: "latest-after-test", Id: "a", Data: protoutil.NewAnyString("a")},
+			{Type: "latest-after-test", Id: "b", Data: protoutil.NewAnyString("b")},
+		})
+		require.NoError(t, err)
+
+		var ids []string
+		afterRecordType, afterRecordID := "", ""
+		for {
+			_, _, seq, err := backend.SyncLatestAfter(ctx, "latest-after-test", nil, afterRecordType, afterRecordID)
+			require.NoError(t, err)
+
+			next, stop := iter.Pull2(seq)
+			record, err, ok := next()
+			stop()
+			require.NoError(t, err)
+			if !ok {
+				break
+			}
+			ids = append(ids, record.GetId())
+			afterRecordType, afterRecordID = record.GetType(), record.GetId()
+		}
+		assert.Equal(t, []string{"a", "b", "c"}, ids)
+
+		_, _, seq, err := backend.SyncLatestAfter(ctx, "latest-after-test", storage.EqualsFilterExpression{
+			Fields: []string{"id"},
+			Value:  "a",
+		}, "latest-after-test", "a")
+		require.NoError(t, err)
+		records, err := iterutil.CollectWithError(seq)
+		require.NoError(t, err)
+		assert.Empty(t, records, "should not return records before the cursor")
+	})
+
+	t.Run("latest ordered", func(t *testing.T) {
+		for _, id := range []string{"b", "c", "a"} {
+			_, err := backend.Put(ctx, []*databroker.Record{{
+				Type: "latest-ordered-test",
+				Id:   id,
+				Data: protoutil.NewAny(protoutil.NewStructMap(map[string]*structpb.Value{
+					"name": protoutil.NewStructString(strings.ToUpper(id)),
+				})),
+			}})
+			require.NoError(t, err)
+		}
+
+		collectIDs := func(orderBy storage.OrderBy) []string {
+			_, _, seq, err := backend.SyncLatestOrdered(ctx, "latest-ordered-test", nil, orderBy)
+			require.NoError(t, err)
+			records, err := iterutil.CollectWithError(seq)
+			require.NoError(t, err)
+			var ids []string
+			for _, record := range records {
+				ids = append(ids, record.GetId())
+			}
+			return ids
+		}
+
+		assert.Equal(t, []string{"b", "c", "a"},
+			collectIDs(storage.OrderBy{{Fields: []string{"modified_at"}}}))
+		assert.Equal(t, []string{"a", "c", "b"},
+			collectIDs(storage.OrderBy{{Fields: []string{"modified_at"}, Descending: true}}))
+		assert.Equal(t, []string{"a", "b", "c"},
+			collectIDs(storage.OrderBy{{Fields: []string{"name"}}}))
+		assert.Equal(t, []string{"c", "b", "a"},
+			collectIDs(storage.OrderBy{{Fields: []string{"id"}, Descending: true}}))
 	})
 
 	t.Run("changed", func(t *testing.T) {
@@ -335,7 +474,10 @@ func TestBackend(t *testing.T, backend storage.Backend) {
 	t.Run("list types", func(t *testing.T) {
 		types, err := backend.ListTypes(ctx)
 		assert.NoError(t, err)
-		assert.Equal(t, []string{"capacity-test", "latest-test", "sync-test", "test-1"}, types)
+		assert.Equal(t, []string{
+			"capacity-test", "expected-version-test", "latest-after-test", "latest-ordered-test",
+			"latest-test", "sync-test", "test-1",
+		}, types)
 	})
 
 	t.Run("patch", func(t *testing.T) {
@@ -411,6 +553,28 @@ func TestBackend(t *testing.T, backend storage.Backend) {
 		}
 	})
 
+	t.Run("stats", func(t *testing.T) {
+		_, err := backend.Put(ctx, []*databroker.Record{
+			{Type: "stats-test", Id: "r1", Data: protoutil.NewAnyString("v1")},
+			{Type: "stats-test", Id: "r2", Data: protoutil.NewAnyString("v2")},
+		})
+		require.NoError(t, err)
+
+		stats, err := backend.Stats(ctx)
+		require.NoError(t, err)
+		assert.True(t, slices.IsSortedFunc(stats, func(a, b storage.RecordTypeStats) int {
+			return strings.Compare(a.RecordType, b.RecordType)
+		}), "should sort stats by record type")
+
+		idx := slices.IndexFunc(stats, func(s storage.RecordTypeStats) bool { return s.RecordType == "stats-test" })
+		if assert.GreaterOrEqual(t, idx, 0) {
+			assert.Equal(t, uint64(2), stats[idx].Count)
+			assert.Greater(t, stats[idx].Size, uint64(0))
+			assert.False(t, stats[idx].OldestModifiedAt.IsZero())
+			assert.False(t, stats[idx].NewestModifiedAt.Before(stats[idx].OldestModifiedAt))
+		}
+	})
+
 	t.Run("versions", func(t *testing.T) {
 		serverVersion, _, latestRecordVersion, err := backend.Versions(ctx)
 		require.NoError(t, err)
@@ -538,6 +702,67 @@ func TestFilter(t *testing.T, backend storage.Backend) {
 		},
 		syncLatest("filter-test-2", storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "192.168.0.1"}),
 		"should filter by record type and index")
+	assert.Equal(t,
+		[][2]string{
+			{"filter-test-2", "id-2"},
+			{"filter-test-2", "id-3"},
+		},
+		syncLatest("filter-test-2", storage.NotEqualsFilterExpression{Fields: []string{"id"}, Value: "id-1"}),
+		"should filter by id not equal")
+	assert.Equal(t,
+		[][2]string{
+			{"filter-test-1", "id-1"},
+			{"filter-test-1", "id-2"},
+			{"filter-test-1", "id-3"},
+		},
+		syncLatest("", storage.PrefixFilterExpression{Fields: []string{"type"}, Value: "filter-test-1"}),
+		"should filter by type prefix")
+	assert.Equal(t,
+		[][2]string{
+			{"filter-test-3", "id-2"},
+			{"filter-test-3", "id-3"},
+		},
+		syncLatest("filter-test-3", storage.ComparisonFilterExpression{
+			Fields:   []string{"id"},
+			Operator: storage.ComparisonGreaterThan,
+			Value:    "id-1",
+		}),
+		"should filter by id greater than")
+	assert.Equal(t,
+		[][2]string{
+			{"filter-test-1", "id-2"},
+			{"filter-test-2", "id-2"},
+			{"filter-test-3", "id-2"},
+		},
+		syncLatest("", storage.SearchFilterExpression{Query: "D-2"}),
+		"should search the record data")
+
+	withCIDRs, err := structpb.NewStruct(map[string]any{
+		"$index": map[string]any{
+			"cidr": []any{"10.0.0.0/8", "172.16.0.0/12"},
+		},
+	})
+	require.NoError(t, err)
+	_, err = backend.Put(t.Context(), []*databroker.Record{
+		{Type: "filter-test-4", Id: "id-1", Data: protoutil.NewAny(withCIDRs)},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[][2]string{
+			{"filter-test-4", "id-1"},
+		},
+		syncLatest("filter-test-4", storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "172.16.0.1"}),
+		"should filter by any of the index cidrs")
+	assert.Equal(t,
+		[][2]string{
+			{"filter-test-4", "id-1"},
+		},
+		syncLatest("filter-test-4", storage.OrFilterExpression{
+			storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "192.168.0.1"},
+			storage.EqualsFilterExpression{Fields: []string{"$index"}, Value: "10.1.0.0/16"},
+		}),
+		"should filter by multiple index values")
 }
 
 func TestSyncOldRecords(t *testing.T, backend storage.Backend) {
@@ -32,6 +32,11 @@ func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
 }
 
 func toMetadata(ctx context.Context) context.Context {
+	// don't overwrite an existing request id, for example when forwarding a request
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(headerName)) > 0 {
+		return ctx
+	}
+
 	requestID := FromContext(ctx)
 	if requestID == "" {
 		requestID = New()
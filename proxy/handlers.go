@@ -76,6 +76,16 @@ func (p *Proxy) registerDashboardHandlers(r *mux.Router, opts *config.Options) *
 					return nil
 				}
 				return p.routesPortalJSON(w, r)
+			// token api handler exchanges the current session for a short-lived route scoped token
+			case endpoints.PathPomeriumAPIToken:
+				if r.Method != http.MethodPost {
+					http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+					return nil
+				}
+				return p.APIToken(w, r)
+			// verify api handler checks the session for external proxies using forward auth
+			case endpoints.PathPomeriumAPIVerify:
+				return p.ForwardAuth(w, r)
 			}
 			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
 			return nil
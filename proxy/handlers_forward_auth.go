@@ -0,0 +1,173 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"google.golang.org/grpc/codes"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/config/envoyconfig"
+	"github.com/pomerium/pomerium/internal/httputil"
+)
+
+// headers used by external proxies to describe the original request
+const (
+	headerXOriginalURL     = "X-Original-URL"
+	headerXOriginalMethod  = "X-Original-Method"
+	headerXForwardedMethod = "X-Forwarded-Method"
+	headerXForwardedProto  = "X-Forwarded-Proto"
+	headerXForwardedHost   = "X-Forwarded-Host"
+	headerXForwardedURI    = "X-Forwarded-Uri"
+)
+
+// ForwardAuth verifies a request made by an external proxy (nginx
+// auth_request, traefik forwardAuth, etc). The session cookie or bearer token
+// must be valid and the policy of the route matching the original url must
+// allow the request. It responds with a 200 and the headers set by the
+// authorize service if the request is allowed, a 401 if the session is invalid and a 403 if the policy
+// denies the request.
+func (p *Proxy) ForwardAuth(w http.ResponseWriter, r *http.Request) error {
+	state := p.state.Load()
+	options := p.currentConfig.Load().Options
+
+	originalURL := getForwardAuthOriginalURL(r)
+	if originalURL == "" {
+		return httputil.NewError(http.StatusBadRequest, errors.New("original url is required"))
+	}
+	policy, err := getPolicyForURL(options, originalURL)
+	if err != nil {
+		return httputil.NewError(http.StatusForbidden, err)
+	}
+	routeID, err := policy.RouteID()
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	// the session is checked against the identity provider of the original
+	// url rather than the verify endpoint
+	originalRequest, err := newForwardAuthOriginalRequest(r, originalURL)
+	if err != nil {
+		return httputil.NewError(http.StatusBadRequest, err)
+	}
+	h, err := state.sessionStore.LoadSessionHandleAndCheckIDP(originalRequest.Clone(r.Context()))
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+
+	// route scoped tokens are only valid for requests to one of their routes
+	err = h.ValidateForRoute(routeID)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+
+	s, _, err := p.getSession(r.Context(), h.ID)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, fmt.Errorf("error retrieving session: %w", err))
+	}
+	err = s.Validate()
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+
+	res, err := p.checkForwardAuthPolicy(r.Context(), originalRequest, policy, routeID)
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, fmt.Errorf("error evaluating policy: %w", err))
+	} else if res == nil {
+		return httputil.NewError(http.StatusForbidden, errors.New("request denied by policy"))
+	}
+
+	// the headers set by authorize include the signed jwt assertion, so the
+	// external proxy can pass along the same identity headers envoy would
+	w.Header().Set("Cache-Control", "no-store")
+	for _, hdr := range res.GetHeaders() {
+		w.Header().Set(hdr.GetHeader().GetKey(), hdr.GetHeader().GetValue())
+	}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// checkForwardAuthPolicy runs the original request through the authorize
+// service, the same way envoy does for requests to the route. It returns nil
+// if the request is denied.
+func (p *Proxy) checkForwardAuthPolicy(
+	ctx context.Context,
+	r *http.Request,
+	policy *config.Policy,
+	routeID string,
+) (*envoy_service_auth_v3.OkHttpResponse, error) {
+	headers := make(map[string]string, len(r.Header))
+	for k, vs := range r.Header {
+		headers[strings.ToLower(k)] = strings.Join(vs, ",")
+	}
+
+	res, err := p.state.Load().authorizeClient.Check(ctx, &envoy_service_auth_v3.CheckRequest{
+		Attributes: &envoy_service_auth_v3.AttributeContext{
+			Request: &envoy_service_auth_v3.AttributeContext_Request{
+				Http: &envoy_service_auth_v3.AttributeContext_HttpRequest{
+					Method:  r.Method,
+					Headers: headers,
+					Path:    r.URL.RequestURI(),
+					Host:    r.URL.Host,
+					Scheme:  r.URL.Scheme,
+				},
+			},
+			ContextExtensions: envoyconfig.MakeExtAuthzContextExtensions(false, routeID, policy.Checksum()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.GetStatus().GetCode() != int32(codes.OK) {
+		return nil, nil
+	}
+	return res.GetOkResponse(), nil
+}
+
+// newForwardAuthOriginalRequest returns a copy of the forward auth request
+// for the original url and method.
+func newForwardAuthOriginalRequest(r *http.Request, originalURL string) (*http.Request, error) {
+	u, err := url.Parse(originalURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid original url %s: %w", originalURL, err)
+	}
+
+	method := r.Header.Get(headerXOriginalMethod)
+	if method == "" {
+		method = r.Header.Get(headerXForwardedMethod)
+	}
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	originalRequest := r.Clone(r.Context())
+	originalRequest.Method = method
+	originalRequest.URL = u
+	originalRequest.Host = u.Host
+	originalRequest.RequestURI = u.RequestURI()
+	return originalRequest, nil
+}
+
+// getForwardAuthOriginalURL returns the url of the request being authorized by
+// the external proxy. nginx sets X-Original-URL, traefik sets the
+// X-Forwarded-* headers.
+func getForwardAuthOriginalURL(r *http.Request) string {
+	if rawURL := r.Header.Get(headerXOriginalURL); rawURL != "" {
+		return rawURL
+	}
+
+	host := r.Header.Get(headerXForwardedHost)
+	if host == "" {
+		return ""
+	}
+	scheme := r.Header.Get(headerXForwardedProto)
+	if scheme == "" {
+		scheme = "https"
+	}
+	return scheme + "://" + host + r.Header.Get(headerXForwardedURI)
+}
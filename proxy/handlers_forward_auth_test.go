@@ -0,0 +1,163 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/config/envoyconfig"
+	"github.com/pomerium/pomerium/internal/databroker"
+	"github.com/pomerium/pomerium/internal/sessions"
+	"github.com/pomerium/pomerium/internal/testutil"
+	databrokerpb "github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+)
+
+// pathAuthorizationClient denies requests to paths starting with /denied and
+// sets identity headers on allowed requests.
+type pathAuthorizationClient struct{}
+
+func (pathAuthorizationClient) Check(_ context.Context, in *envoy_service_auth_v3.CheckRequest, _ ...grpc.CallOption) (*envoy_service_auth_v3.CheckResponse, error) {
+	if strings.HasPrefix(in.GetAttributes().GetRequest().GetHttp().GetPath(), "/denied") ||
+		envoyconfig.ExtAuthzContextExtensionsRouteID(in.GetAttributes().GetContextExtensions()) == "" {
+		return &envoy_service_auth_v3.CheckResponse{Status: &status.Status{Code: int32(codes.PermissionDenied)}}, nil
+	}
+	return &envoy_service_auth_v3.CheckResponse{
+		Status: &status.Status{Code: int32(codes.OK)},
+		HttpResponse: &envoy_service_auth_v3.CheckResponse_OkResponse{
+			OkResponse: &envoy_service_auth_v3.OkHttpResponse{
+				Headers: []*envoy_config_core_v3.HeaderValueOption{
+					{Header: &envoy_config_core_v3.HeaderValue{Key: "x-pomerium-jwt-assertion", Value: "JWT"}},
+					{Header: &envoy_config_core_v3.HeaderValue{Key: "x-pomerium-claim-email", Value: "user@example.com"}},
+				},
+			},
+		},
+	}, nil
+}
+
+func TestProxy_ForwardAuth(t *testing.T) {
+	t.Parallel()
+
+	srv := databroker.NewBackendServer(noop.NewTracerProvider())
+	t.Cleanup(srv.Stop)
+
+	cc := testutil.NewGRPCServer(t, func(s *grpc.Server) {
+		databrokerpb.RegisterDataBrokerServiceServer(s, srv)
+	})
+	t.Cleanup(func() { cc.Close() })
+
+	client := databrokerpb.NewDataBrokerServiceClient(cc)
+
+	opts := testOptions(t)
+	proxy, err := New(t.Context(), &config.Config{Options: opts})
+	require.NoError(t, err)
+	proxy.state.Load().dataBrokerClient = client
+	proxy.state.Load().authorizeClient = pathAuthorizationClient{}
+
+	routeID, err := opts.Policies[0].RouteID()
+	require.NoError(t, err)
+
+	require.NoError(t, databrokerpb.PutMulti(t.Context(), client,
+		makeRecord(&session.Session{
+			Id:        "FORWARD-AUTH-S1",
+			UserId:    "FORWARD-AUTH-U1",
+			ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+		}),
+		makeRecord(&session.Session{
+			Id:        "FORWARD-AUTH-S2",
+			UserId:    "FORWARD-AUTH-U1",
+			ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour)),
+		})))
+
+	serve := func(h *sessions.Handle, headers map[string]string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "https://corp.example.example/.pomerium/api/v1/verify", nil)
+		if h != nil {
+			r.Header.Set("Authorization", "Bearer Pomerium-"+encodeSessionHandle(t, opts, h))
+		}
+		for k, v := range headers {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		proxy.ServeHTTP(w, r)
+		return w
+	}
+
+	original := map[string]string{"X-Original-URL": "https://corp.example.example/some/path"}
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(&sessions.Handle{ID: "FORWARD-AUTH-S1"}, original)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, "JWT", w.Header().Get("X-Pomerium-Jwt-Assertion"))
+		assert.Equal(t, "user@example.com", w.Header().Get("X-Pomerium-Claim-Email"))
+	})
+	t.Run("no session", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(nil, original)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+	t.Run("unknown session", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(&sessions.Handle{ID: "FORWARD-AUTH-UNKNOWN"}, original)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+	t.Run("expired session", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(&sessions.Handle{ID: "FORWARD-AUTH-S2"}, original)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+	t.Run("scoped token", func(t *testing.T) {
+		t.Parallel()
+
+		h := &sessions.Handle{ID: "FORWARD-AUTH-S1", RouteIDs: []string{routeID}}
+
+		w := serve(h, map[string]string{"X-Original-URL": "https://corp.example.example/some/path"})
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = serve(h, map[string]string{
+			"X-Forwarded-Proto": "https",
+			"X-Forwarded-Host":  "corp.example.example",
+			"X-Forwarded-Uri":   "/some/path",
+		})
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		w = serve(&sessions.Handle{ID: "FORWARD-AUTH-S1", RouteIDs: []string{"other-route"}}, original)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+	t.Run("missing original url", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(&sessions.Handle{ID: "FORWARD-AUTH-S1"}, nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("unknown route", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(&sessions.Handle{ID: "FORWARD-AUTH-S1"}, map[string]string{"X-Original-URL": "https://unknown.example.example"})
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+	t.Run("denied by policy", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(&sessions.Handle{ID: "FORWARD-AUTH-S1"}, map[string]string{"X-Original-URL": "https://corp.example.example/denied"})
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net"
 	"net/http"
+	"net/url"
 	"sync"
 
 	"github.com/pomerium/pomerium/config"
@@ -15,11 +17,14 @@ import (
 	"github.com/pomerium/pomerium/ui"
 )
 
+// queryTag is the query parameter used to filter portal routes by tag.
+const queryTag = "tag"
+
 func (p *Proxy) routesPortalHTML(w http.ResponseWriter, r *http.Request) error {
 	u := p.getUserInfoData(r)
 	rs := p.getPortalRoutes(r.Context(), u)
 	m := u.ToJSON()
-	m["routes"] = rs
+	addPortalRoutes(m, rs, r.URL.Query()[queryTag])
 	return ui.ServePage(w, r, "Routes", "Routes Portal", m)
 }
 
@@ -27,7 +32,7 @@ func (p *Proxy) routesPortalJSON(w http.ResponseWriter, r *http.Request) error {
 	u := p.getUserInfoData(r)
 	rs := p.getPortalRoutes(r.Context(), u)
 	m := map[string]any{}
-	m["routes"] = rs
+	addPortalRoutes(m, rs, r.URL.Query()[queryTag])
 
 	b, err := json.Marshal(m)
 	if err != nil {
@@ -40,6 +45,19 @@ func (p *Proxy) routesPortalJSON(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
 
+// addPortalRoutes adds the routes matching the selected tags to the portal
+// data, along with their groups and the tags available to filter by.
+func addPortalRoutes(m map[string]any, routes []portal.Route, selectedTags []string) {
+	portal.SortRoutes(routes)
+	filtered := portal.FilterRoutesByTags(routes, selectedTags)
+	m["routes"] = filtered
+	m["groups"] = portal.GroupRoutes(filtered)
+	m["tags"] = portal.AllTags(routes)
+	if len(selectedTags) > 0 {
+		m["selected_tags"] = selectedTags
+	}
+}
+
 func (p *Proxy) getPortalRoutes(ctx context.Context, u handlers.UserInfoData) []portal.Route {
 	options := p.currentConfig.Load().Options
 	pu := p.getPortalUser(u)
@@ -75,9 +93,42 @@ func (p *Proxy) getPortalRoutes(ctx context.Context, u handlers.UserInfoData) []
 		}()
 	}
 	wg.Wait()
+
+	clusters, err := p.healthProvider.GetClusterHealth(ctx)
+	if err != nil {
+		log.Ctx(ctx).Debug().Err(err).Msg("error retrieving upstream health for routes")
+	}
+	for i := range portalRoutes {
+		portalRoutes[i].Health = portal.RouteHealth(clusters, portalRoutes[i].ID)
+	}
+
 	return portalRoutes
 }
 
+// getEnvoyClustersURL returns the url of the envoy clusters admin endpoint,
+// which is only reachable through the debug listener.
+func (p *Proxy) getEnvoyClustersURL() string {
+	debugAddress := p.currentConfig.Load().Options.DebugAddress
+	if !debugAddress.IsValid() {
+		return ""
+	}
+
+	host, port, err := net.SplitHostPort(debugAddress.String)
+	if err != nil {
+		return ""
+	}
+	if ip := net.ParseIP(host); host == "" || ip != nil && ip.IsUnspecified() {
+		host = "localhost"
+	}
+
+	return (&url.URL{
+		Scheme:   "http",
+		Host:     net.JoinHostPort(host, port),
+		Path:     "/envoy/clusters",
+		RawQuery: "format=json",
+	}).String()
+}
+
 func (p *Proxy) getPortalUser(u handlers.UserInfoData) portal.User {
 	pu := portal.User{}
 	pu.SessionID = u.Session.GetId()
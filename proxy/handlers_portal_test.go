@@ -1,6 +1,7 @@
 package proxy
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -26,27 +27,79 @@ func TestProxy_routesPortalJSON(t *testing.T) {
 		From:                             "https://from.example.com",
 		To:                               to,
 		AllowPublicUnauthenticatedAccess: true,
+	}, config.Policy{
+		ID:                               "route-db",
+		Name:                             "db",
+		From:                             "https://db.example.com",
+		To:                               to,
+		AllowPublicUnauthenticatedAccess: true,
+		Category:                         "databases",
+		Tags:                             []string{"prod"},
+		PortalOrder:                      -1,
 	})
 	proxy, err := New(ctx, cfg)
 	require.NoError(t, err)
 
-	r := httptest.NewRequest(http.MethodGet, "/.pomerium/api/v1/routes", nil)
-	w := httptest.NewRecorder()
-
 	router := httputil.NewRouter()
 	router = proxy.registerDashboardHandlers(router, cfg.Options)
-	router.ServeHTTP(w, r)
-
-	assert.Equal(t, http.StatusOK, w.Code)
-	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
-	assert.JSONEq(t, `{"routes":[
-		{
-			"id": "1013c6be524d7fbd",
-			"name": "public",
-			"from": "https://from.example.com",
-			"type": "http",
-			"description": "PUBLIC ROUTE",
-			"logo_url": "https://logo.example.com"
+
+	t.Run("all", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/.pomerium/api/v1/routes", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{
+			"routes":[
+				{
+					"id": "route-db",
+					"name": "db",
+					"from": "https://db.example.com",
+					"type": "http",
+					"description": "",
+					"logo_url": "",
+					"category": "databases",
+					"tags": ["prod"],
+					"order": -1
+				},
+				{
+					"id": "1013c6be524d7fbd",
+					"name": "public",
+					"from": "https://from.example.com",
+					"type": "http",
+					"description": "PUBLIC ROUTE",
+					"logo_url": "https://logo.example.com"
+				}
+			],
+			"groups": [
+				{"category": "databases", "route_ids": ["route-db"]},
+				{"category": "", "route_ids": ["1013c6be524d7fbd"]}
+			],
+			"tags": ["prod"]
+		}`, w.Body.String())
+	})
+	t.Run("filtered by tag", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/.pomerium/api/v1/routes?tag=prod", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var res struct {
+			Routes []struct {
+				Name string `json:"name"`
+			} `json:"routes"`
+			Tags         []string `json:"tags"`
+			SelectedTags []string `json:"selected_tags"`
 		}
-	]}`, w.Body.String())
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Len(t, res.Routes, 1)
+		assert.Equal(t, "db", res.Routes[0].Name)
+		assert.Equal(t, []string{"prod"}, res.Tags)
+		assert.Equal(t, []string{"prod"}, res.SelectedTags)
+	})
 }
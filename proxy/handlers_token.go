@@ -0,0 +1,127 @@
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/httputil"
+	"github.com/pomerium/pomerium/internal/urlutil"
+)
+
+const (
+	defaultAPITokenTTL = 5 * time.Minute
+	maxAPITokenTTL     = time.Hour
+
+	maxAPITokenRequestSize = 1 << 20
+)
+
+type apiTokenRequest struct {
+	// Routes are the URLs of the routes the token can be used for.
+	Routes []string `json:"routes"`
+	// TTL is how long the token is valid for, as a duration string.
+	TTL string `json:"ttl,omitempty"`
+}
+
+type apiTokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// APIToken exchanges the current session for a short-lived token that can only
+// be used for the requested routes. The token is sent to pomerium as a bearer
+// token: `Authorization: Bearer Pomerium-<token>`.
+func (p *Proxy) APIToken(w http.ResponseWriter, r *http.Request) error {
+	state := p.state.Load()
+	options := p.currentConfig.Load().Options
+
+	h, err := state.sessionStore.LoadSessionHandleAndCheckIDP(r)
+	if err != nil {
+		return httputil.NewError(http.StatusUnauthorized, err)
+	}
+	// scoped tokens can't be used to extend or widen themselves
+	if h.IsScoped() {
+		return httputil.NewError(http.StatusForbidden, errors.New("scoped tokens cannot issue new tokens"))
+	}
+
+	var req apiTokenRequest
+	err = json.NewDecoder(io.LimitReader(r.Body, maxAPITokenRequestSize)).Decode(&req)
+	if err != nil {
+		return httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid token request: %w", err))
+	}
+	if len(req.Routes) == 0 {
+		return httputil.NewError(http.StatusBadRequest, errors.New("at least one route is required"))
+	}
+
+	ttl := defaultAPITokenTTL
+	if req.TTL != "" {
+		ttl, err = time.ParseDuration(req.TTL)
+		if err != nil || ttl <= 0 {
+			return httputil.NewError(http.StatusBadRequest, fmt.Errorf("invalid ttl: %s", req.TTL))
+		}
+		ttl = min(ttl, maxAPITokenTTL)
+	}
+
+	routeIDs := make([]string, 0, len(req.Routes))
+	for _, rawURL := range req.Routes {
+		routeID, err := getRouteIDForURL(options, rawURL)
+		if err != nil {
+			return httputil.NewError(http.StatusBadRequest, err)
+		}
+		routeIDs = append(routeIDs, routeID)
+	}
+
+	now := time.Now()
+	token := *h
+	token.IssuedAt = jwt.NewNumericDate(now)
+	token.Expiry = jwt.NewNumericDate(now.Add(ttl))
+	token.RouteIDs = routeIDs
+	rawJWT, err := state.sessionStore.EncodeSessionHandle(&token)
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	b, err := json.Marshal(apiTokenResponse{
+		Token:     rawJWT,
+		ExpiresAt: token.Expiry.Time().UTC(),
+	})
+	if err != nil {
+		return httputil.NewError(http.StatusInternalServerError, err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+	return nil
+}
+
+// getRouteIDForURL returns the id of the first route matching the given URL.
+func getRouteIDForURL(options *config.Options, rawURL string) (string, error) {
+	policy, err := getPolicyForURL(options, rawURL)
+	if err != nil {
+		return "", err
+	}
+	return policy.RouteID()
+}
+
+// getPolicyForURL returns the first policy matching the given URL.
+func getPolicyForURL(options *config.Options, rawURL string) (*config.Policy, error) {
+	u, err := urlutil.ParseAndValidateURL(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid route url %s: %w", rawURL, err)
+	}
+
+	for policy := range options.GetAllPolicies() {
+		if policy.Matches(u, options.IsRuntimeFlagSet(config.RuntimeFlagMatchAnyIncomingPort)) {
+			return policy, nil
+		}
+	}
+	return nil, fmt.Errorf("no route found for %s", rawURL)
+}
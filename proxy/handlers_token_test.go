@@ -0,0 +1,109 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/config"
+	"github.com/pomerium/pomerium/internal/encoding/jws"
+	"github.com/pomerium/pomerium/internal/sessions"
+)
+
+func TestProxy_APIToken(t *testing.T) {
+	t.Parallel()
+
+	opts := testOptions(t)
+	proxy, err := New(t.Context(), &config.Config{Options: opts})
+	require.NoError(t, err)
+
+	routeID, err := opts.Policies[0].RouteID()
+	require.NoError(t, err)
+
+	serve := func(method, body string, h *sessions.Handle) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "https://corp.example.example/.pomerium/api/v1/token", strings.NewReader(body))
+		if h != nil {
+			r.Header.Set("Authorization", "Bearer Pomerium-"+encodeSessionHandle(t, opts, h))
+		}
+		w := httptest.NewRecorder()
+		proxy.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(http.MethodPost, `{"routes":["https://corp.example.example"],"ttl":"10m"}`, &sessions.Handle{ID: "S1"})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+		var res apiTokenResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), res.ExpiresAt, time.Minute)
+
+		sharedKey, err := opts.GetSharedKey()
+		require.NoError(t, err)
+		decoder, err := jws.NewHS256Signer(sharedKey)
+		require.NoError(t, err)
+
+		var h sessions.Handle
+		require.NoError(t, decoder.Unmarshal([]byte(res.Token), &h))
+		assert.Equal(t, "S1", h.ID)
+		assert.Equal(t, []string{routeID}, h.RouteIDs)
+		assert.Equal(t, jwt.NewNumericDate(res.ExpiresAt), h.Expiry)
+	})
+	t.Run("ttl is capped", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(http.MethodPost, `{"routes":["https://corp.example.example"],"ttl":"24h"}`, &sessions.Handle{ID: "S1"})
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var res apiTokenResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.WithinDuration(t, time.Now().Add(maxAPITokenTTL), res.ExpiresAt, time.Minute)
+	})
+	t.Run("method not allowed", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(http.MethodGet, "", &sessions.Handle{ID: "S1"})
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+	t.Run("no session", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(http.MethodPost, `{"routes":["https://corp.example.example"]}`, nil)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+	t.Run("scoped session", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(http.MethodPost, `{"routes":["https://corp.example.example"]}`,
+			&sessions.Handle{ID: "S1", RouteIDs: []string{routeID}})
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+	t.Run("unknown route", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(http.MethodPost, `{"routes":["https://unknown.example.example"]}`, &sessions.Handle{ID: "S1"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("no routes", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(http.MethodPost, `{}`, &sessions.Handle{ID: "S1"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("invalid ttl", func(t *testing.T) {
+		t.Parallel()
+
+		w := serve(http.MethodPost, `{"routes":["https://corp.example.example"],"ttl":"-1m"}`, &sessions.Handle{ID: "S1"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
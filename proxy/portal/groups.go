@@ -0,0 +1,83 @@
+package portal
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// A RouteGroup is a set of routes shown together in the routes portal.
+type RouteGroup struct {
+	Category string   `json:"category"`
+	RouteIDs []string `json:"route_ids"`
+}
+
+// SortRoutes sorts routes by their order and then by their name.
+func SortRoutes(routes []Route) {
+	slices.SortStableFunc(routes, func(a, b Route) int {
+		return cmp.Or(
+			cmp.Compare(a.Order, b.Order),
+			cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)),
+		)
+	})
+}
+
+// GroupRoutes groups routes by their category. Groups are returned in the
+// order their first route appears, with uncategorized routes last.
+func GroupRoutes(routes []Route) []RouteGroup {
+	groups := []RouteGroup{}
+	var uncategorized []string
+	lookup := map[string]int{}
+	for _, route := range routes {
+		if route.Category == "" {
+			uncategorized = append(uncategorized, route.ID)
+			continue
+		}
+
+		idx, ok := lookup[route.Category]
+		if !ok {
+			idx = len(groups)
+			lookup[route.Category] = idx
+			groups = append(groups, RouteGroup{Category: route.Category})
+		}
+		groups[idx].RouteIDs = append(groups[idx].RouteIDs, route.ID)
+	}
+	if len(uncategorized) > 0 {
+		groups = append(groups, RouteGroup{RouteIDs: uncategorized})
+	}
+	return groups
+}
+
+// FilterRoutesByTags returns the routes which have all of the given tags.
+func FilterRoutesByTags(routes []Route, tags []string) []Route {
+	if len(tags) == 0 {
+		return routes
+	}
+
+	filtered := []Route{}
+	for _, route := range routes {
+		if hasAllTags(route, tags) {
+			filtered = append(filtered, route)
+		}
+	}
+	return filtered
+}
+
+// AllTags returns the sorted, distinct tags of the given routes.
+func AllTags(routes []Route) []string {
+	tags := []string{}
+	for _, route := range routes {
+		tags = append(tags, route.Tags...)
+	}
+	slices.Sort(tags)
+	return slices.Compact(tags)
+}
+
+func hasAllTags(route Route, tags []string) bool {
+	for _, tag := range tags {
+		if !slices.Contains(route.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package portal_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pomerium/pomerium/proxy/portal"
+)
+
+func TestSortRoutes(t *testing.T) {
+	t.Parallel()
+
+	routes := []portal.Route{
+		{ID: "1", Name: "b"},
+		{ID: "2", Name: "a"},
+		{ID: "3", Name: "z", Order: -1},
+		{ID: "4", Name: "C"},
+		{ID: "5", Name: "y", Order: 10},
+	}
+	portal.SortRoutes(routes)
+
+	var ids []string
+	for _, r := range routes {
+		ids = append(ids, r.ID)
+	}
+	assert.Equal(t, []string{"3", "2", "1", "4", "5"}, ids)
+}
+
+func TestGroupRoutes(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, portal.GroupRoutes(nil))
+	assert.Equal(t, []portal.RouteGroup{
+		{Category: "databases", RouteIDs: []string{"1", "3"}},
+		{Category: "apps", RouteIDs: []string{"2"}},
+		{RouteIDs: []string{"4", "5"}},
+	}, portal.GroupRoutes([]portal.Route{
+		{ID: "1", Category: "databases"},
+		{ID: "2", Category: "apps"},
+		{ID: "3", Category: "databases"},
+		{ID: "4"},
+		{ID: "5"},
+	}))
+}
+
+func TestFilterRoutesByTags(t *testing.T) {
+	t.Parallel()
+
+	routes := []portal.Route{
+		{ID: "1", Tags: []string{"prod", "db"}},
+		{ID: "2", Tags: []string{"dev", "db"}},
+		{ID: "3"},
+	}
+
+	assert.Equal(t, routes, portal.FilterRoutesByTags(routes, nil))
+	assert.Equal(t, routes[:2], portal.FilterRoutesByTags(routes, []string{"db"}))
+	assert.Equal(t, routes[1:2], portal.FilterRoutesByTags(routes, []string{"db", "dev"}))
+	assert.Empty(t, portal.FilterRoutesByTags(routes, []string{"staging"}))
+}
+
+func TestAllTags(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"db", "dev", "prod"}, portal.AllTags([]portal.Route{
+		{ID: "1", Tags: []string{"prod", "db"}},
+		{ID: "2", Tags: []string{"dev", "db"}},
+		{ID: "3"},
+	}))
+}
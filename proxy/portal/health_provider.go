@@ -0,0 +1,171 @@
+package portal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// A Health is the health of a route's upstream.
+type Health string
+
+// health values
+const (
+	HealthHealthy   Health = "healthy"
+	HealthDegraded  Health = "degraded"
+	HealthUnhealthy Health = "unhealthy"
+)
+
+// A HealthProvider gets the health of envoy clusters.
+type HealthProvider interface {
+	GetClusterHealth(ctx context.Context) (map[string]Health, error)
+}
+
+// RouteHealth returns the health of the cluster for the given route. Clusters
+// are named after the route id, optionally prefixed with a custom name.
+func RouteHealth(clusters map[string]Health, routeID string) Health {
+	if health, ok := clusters["route-"+routeID]; ok {
+		return health
+	}
+	for name, health := range clusters {
+		if strings.HasSuffix(name, "-"+routeID) {
+			return health
+		}
+	}
+	return ""
+}
+
+type envoyAdminHealthProvider struct {
+	client  *http.Client
+	getURL  func() string
+	ttl     time.Duration
+	timeout time.Duration
+
+	mu       sync.Mutex
+	clusters map[string]Health
+	err      error
+	expiry   time.Time
+}
+
+// NewEnvoyAdminHealthProvider creates a new HealthProvider which gets cluster
+// health from the envoy admin clusters endpoint at the url returned by getURL.
+// If getURL returns an empty string no health is returned.
+func NewEnvoyAdminHealthProvider(getURL func() string) HealthProvider {
+	return &envoyAdminHealthProvider{
+		client:  http.DefaultClient,
+		getURL:  getURL,
+		ttl:     10 * time.Second,
+		timeout: time.Second,
+	}
+}
+
+func (p *envoyAdminHealthProvider) GetClusterHealth(ctx context.Context) (map[string]Health, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// if we have valid cached clusters or an error, return them
+	if p.expiry.After(time.Now()) {
+		return p.clusters, p.err
+	}
+
+	p.clusters, p.err = p.fetchClusterHealth(ctx)
+	p.expiry = time.Now().Add(p.ttl)
+	return p.clusters, p.err
+}
+
+func (p *envoyAdminHealthProvider) fetchClusterHealth(ctx context.Context) (map[string]Health, error) {
+	rawURL := p.getURL()
+	if rawURL == "" {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("portal: error creating envoy clusters request: %w", err)
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("portal: error retrieving envoy clusters: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("portal: unexpected status code retrieving envoy clusters: %d", res.StatusCode)
+	}
+
+	bs, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("portal: error reading envoy clusters: %w", err)
+	}
+
+	var clusters envoy_admin_v3.Clusters
+	err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(bs, &clusters)
+	if err != nil {
+		return nil, fmt.Errorf("portal: error unmarshaling envoy clusters: %w", err)
+	}
+
+	m := make(map[string]Health, len(clusters.GetClusterStatuses()))
+	for _, cluster := range clusters.GetClusterStatuses() {
+		m[cluster.GetName()] = getClusterHealth(cluster)
+	}
+	return m, nil
+}
+
+// getClusterHealth returns healthy if all of the cluster's hosts are healthy,
+// unhealthy if none of them are, and degraded otherwise.
+func getClusterHealth(cluster *envoy_admin_v3.ClusterStatus) Health {
+	var healthy, degraded int
+	for _, host := range cluster.GetHostStatuses() {
+		switch getHostHealth(host.GetHealthStatus()) {
+		case HealthHealthy:
+			healthy++
+		case HealthDegraded:
+			degraded++
+		}
+	}
+
+	total := len(cluster.GetHostStatuses())
+	switch {
+	case total > 0 && healthy == total:
+		return HealthHealthy
+	case healthy+degraded > 0:
+		return HealthDegraded
+	default:
+		return HealthUnhealthy
+	}
+}
+
+func getHostHealth(status *envoy_admin_v3.HostHealthStatus) Health {
+	if status.GetFailedActiveHealthCheck() ||
+		status.GetFailedOutlierCheck() ||
+		status.GetExcludedViaImmediateHcFail() ||
+		status.GetActiveHcTimeout() {
+		return HealthUnhealthy
+	}
+
+	switch status.GetEdsHealthStatus() {
+	case envoy_config_core_v3.HealthStatus_UNKNOWN,
+		envoy_config_core_v3.HealthStatus_HEALTHY:
+	case envoy_config_core_v3.HealthStatus_DEGRADED:
+		return HealthDegraded
+	default:
+		return HealthUnhealthy
+	}
+
+	if status.GetFailedActiveDegradedCheck() {
+		return HealthDegraded
+	}
+	return HealthHealthy
+}
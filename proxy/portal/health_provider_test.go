@@ -0,0 +1,90 @@
+package portal
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pomerium/pomerium/internal/testutil"
+)
+
+func TestEnvoyAdminHealthProvider(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "json", r.URL.Query().Get("format"))
+		io.WriteString(w, `{
+			"cluster_statuses": [
+				{
+					"name": "route-r1",
+					"host_statuses": [
+						{ "health_status": { "eds_health_status": "HEALTHY" } },
+						{ "health_status": {} }
+					]
+				},
+				{
+					"name": "custom-name-r2",
+					"host_statuses": [
+						{ "health_status": { "eds_health_status": "HEALTHY" } },
+						{ "health_status": { "failed_outlier_check": true } }
+					]
+				},
+				{
+					"name": "route-r3",
+					"host_statuses": [
+						{ "health_status": { "failed_active_health_check": true } }
+					]
+				},
+				{
+					"name": "route-r4",
+					"host_statuses": [
+						{ "health_status": { "eds_health_status": "DEGRADED" } }
+					]
+				},
+				{
+					"name": "route-r5",
+					"unknown_field": true
+				}
+			]
+		}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx := testutil.GetContext(t, time.Minute)
+	p := NewEnvoyAdminHealthProvider(func() string { return srv.URL + "/envoy/clusters?format=json" })
+	clusters, err := p.GetClusterHealth(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, HealthHealthy, RouteHealth(clusters, "r1"))
+	assert.Equal(t, HealthDegraded, RouteHealth(clusters, "r2"))
+	assert.Equal(t, HealthUnhealthy, RouteHealth(clusters, "r3"))
+	assert.Equal(t, HealthDegraded, RouteHealth(clusters, "r4"))
+	assert.Equal(t, HealthUnhealthy, RouteHealth(clusters, "r5"))
+	assert.Equal(t, Health(""), RouteHealth(clusters, "r6"))
+
+	_, err = p.GetClusterHealth(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load(), "should cache cluster health")
+}
+
+func TestEnvoyAdminHealthProvider_Error(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx := testutil.GetContext(t, time.Minute)
+	p := NewEnvoyAdminHealthProvider(func() string { return srv.URL })
+	_, err := p.GetClusterHealth(ctx)
+	assert.Error(t, err)
+}
@@ -11,13 +11,17 @@ import (
 
 // A Route is a portal route.
 type Route struct {
-	ID             string `json:"id"`
-	Name           string `json:"name"`
-	Type           string `json:"type"`
-	From           string `json:"from"`
-	Description    string `json:"description"`
-	ConnectCommand string `json:"connect_command,omitempty"`
-	LogoURL        string `json:"logo_url"`
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Type           string   `json:"type"`
+	From           string   `json:"from"`
+	Description    string   `json:"description"`
+	ConnectCommand string   `json:"connect_command,omitempty"`
+	LogoURL        string   `json:"logo_url"`
+	Category       string   `json:"category,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+	Order          int      `json:"order,omitempty"`
+	Health         Health   `json:"health,omitempty"`
 }
 
 // RoutesFromConfigRoutes converts config routes into portal routes.
@@ -56,6 +60,9 @@ func RoutesFromConfigRoutes(routes []*config.Policy) []Route {
 		}
 		pr.Description = route.Description
 		pr.LogoURL = route.LogoURL
+		pr.Category = route.Category
+		pr.Tags = route.Tags
+		pr.Order = route.PortalOrder
 		prs[i] = pr
 	}
 	// generate names if they're empty
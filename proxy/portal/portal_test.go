@@ -28,6 +28,9 @@ func TestRouteFromConfigRoute(t *testing.T) {
 			From:        "https://from.example.com",
 			Description: "ROUTE #1",
 			LogoURL:     "https://logo.example.com",
+			Category:    "apps",
+			Tags:        []string{"prod"},
+			Order:       1,
 		},
 		{
 			ID:   "15fa6bb41b1f0bd2",
@@ -62,6 +65,9 @@ func TestRouteFromConfigRoute(t *testing.T) {
 			To:          to1,
 			Description: "ROUTE #1",
 			LogoURL:     "https://logo.example.com",
+			Category:    "apps",
+			Tags:        []string{"prod"},
+			PortalOrder: 1,
 		},
 		{
 			From: "https://from.example.com",
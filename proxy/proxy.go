@@ -22,6 +22,9 @@ import (
 	"github.com/pomerium/pomerium/internal/telemetry/metrics"
 	"github.com/pomerium/pomerium/pkg/cryptutil"
 	"github.com/pomerium/pomerium/pkg/grpc"
+	"github.com/pomerium/pomerium/pkg/grpc/databroker"
+	"github.com/pomerium/pomerium/pkg/grpc/session"
+	"github.com/pomerium/pomerium/pkg/grpcutil"
 	"github.com/pomerium/pomerium/pkg/storage"
 	"github.com/pomerium/pomerium/pkg/telemetry/trace"
 	"github.com/pomerium/pomerium/proxy/portal"
@@ -58,6 +61,7 @@ type Proxy struct {
 	webauthn         *webauthn.Handler
 	tracerProvider   oteltrace.TracerProvider
 	logoProvider     portal.LogoProvider
+	healthProvider   portal.HealthProvider
 	mcp              atomic.Pointer[mcp.Handler]
 	outboundGrpcConn *grpc.CachedOutboundGRPClientConn
 }
@@ -67,7 +71,7 @@ type Proxy struct {
 func New(ctx context.Context, cfg *config.Config) (*Proxy, error) {
 	tracerProvider := trace.NewTracerProvider(ctx, "Proxy")
 	outboundGrpcConn := &grpc.CachedOutboundGRPClientConn{}
-	state, err := newProxyStateFromConfig(ctx, tracerProvider, cfg, outboundGrpcConn)
+	state, err := newProxyStateFromConfig(ctx, nil, tracerProvider, cfg, outboundGrpcConn)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +81,7 @@ func New(ctx context.Context, cfg *config.Config) (*Proxy, error) {
 		logoProvider:     portal.NewLogoProvider(),
 		outboundGrpcConn: outboundGrpcConn,
 	}
+	p.healthProvider = portal.NewEnvoyAdminHealthProvider(p.getEnvoyClustersURL)
 	p.state.Store(state)
 	p.currentConfig.Store(&config.Config{Options: config.NewDefaultOptions()})
 	p.currentRouter.Store(httputil.NewRouter())
@@ -90,6 +95,17 @@ func New(ctx context.Context, cfg *config.Config) (*Proxy, error) {
 	p.OnConfigChange(ctx, cfg)
 	p.webauthn = webauthn.New(p.getWebauthnState)
 
+	// drop revoked sessions from the cache as soon as they're revoked
+	go func() {
+		_ = storage.NewCacheInvalidator(ctx, "proxy/sessions",
+			session.RevocationRecordType,
+			grpcutil.GetTypeURL(new(session.Session)),
+			func() databroker.DataBrokerServiceClient { return p.state.Load().dataBrokerClient },
+			p.withQuerier,
+			databroker.WithSyncerTracerProvider(tracerProvider),
+		).Run(ctx)
+	}()
+
 	metrics.AddPolicyCountCallback("pomerium-proxy", func() int64 {
 		return int64(p.currentConfig.Load().Options.NumPolicies())
 	})
@@ -121,7 +137,7 @@ func (p *Proxy) OnConfigChange(ctx context.Context, cfg *config.Config) {
 	if err := p.setHandlers(ctx, cfg.Options); err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("proxy: failed to update proxy handlers from configuration settings")
 	}
-	if state, err := newProxyStateFromConfig(ctx, p.tracerProvider, cfg, p.outboundGrpcConn); err != nil {
+	if state, err := newProxyStateFromConfig(ctx, p.state.Load(), p.tracerProvider, cfg, p.outboundGrpcConn); err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("proxy: failed to update proxy state from configuration settings")
 	} else {
 		p.state.Store(state)
@@ -154,13 +170,15 @@ func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 func (p *Proxy) querierMiddleware(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
-		ctx = storage.WithQuerier(ctx, storage.NewCachingQuerier(
-			storage.NewQuerier(p.state.Load().dataBrokerClient),
-			storage.GlobalCache,
-		))
-		r = r.WithContext(ctx)
+		r = r.WithContext(p.withQuerier(r.Context()))
 
 		h.ServeHTTP(w, r)
 	})
 }
+
+func (p *Proxy) withQuerier(ctx context.Context) context.Context {
+	return storage.WithQuerier(ctx, storage.NewCachingQuerier(
+		storage.NewQuerier(p.state.Load().dataBrokerClient),
+		storage.GlobalCache,
+	))
+}
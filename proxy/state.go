@@ -5,12 +5,14 @@ import (
 	"net/http"
 	"net/url"
 
+	envoy_service_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	oteltrace "go.opentelemetry.io/otel/trace"
 	googlegrpc "google.golang.org/grpc"
 
 	"github.com/pomerium/pomerium/config"
 	"github.com/pomerium/pomerium/internal/authenticateflow"
+	"github.com/pomerium/pomerium/internal/sessions/serverside"
 	"github.com/pomerium/pomerium/pkg/endpoints"
 	"github.com/pomerium/pomerium/pkg/grpc"
 	"github.com/pomerium/pomerium/pkg/grpc/databroker"
@@ -29,13 +31,16 @@ type proxyState struct {
 
 	sharedKey                           []byte
 	sessionStore                        *config.SessionStore
+	dataBrokerClientConnection          *googlegrpc.ClientConn
 	dataBrokerClient                    databroker.DataBrokerServiceClient
+	authorizeClient                     envoy_service_auth_v3.AuthorizationClient
+	serverSideSessionQuerier            storage.Querier
 	programmaticRedirectDomainWhitelist []string
 	authenticateFlow                    authenticateFlow
 	incomingIDPTokenSessionCreator      config.IncomingIDPTokenSessionCreator
 }
 
-func newProxyStateFromConfig(ctx context.Context, tracerProvider oteltrace.TracerProvider, cfg *config.Config, outboundGrpcConn *grpc.CachedOutboundGRPClientConn) (*proxyState, error) {
+func newProxyStateFromConfig(ctx context.Context, previousState *proxyState, tracerProvider oteltrace.TracerProvider, cfg *config.Config, outboundGrpcConn *grpc.CachedOutboundGRPClientConn) (*proxyState, error) {
 	err := ValidateOptions(cfg.Options)
 	if err != nil {
 		return nil, err
@@ -55,11 +60,6 @@ func newProxyStateFromConfig(ctx context.Context, tracerProvider oteltrace.Trace
 		return nil, err
 	}
 
-	state.sessionStore, err = config.NewSessionStore(cfg.Options)
-	if err != nil {
-		return nil, err
-	}
-
 	dataBrokerConn, err := outboundGrpcConn.Get(ctx, &grpc.OutboundOptions{
 		OutboundPort:   cfg.OutboundPort,
 		InstallationID: cfg.Options.InstallationID,
@@ -69,13 +69,35 @@ func newProxyStateFromConfig(ctx context.Context, tracerProvider oteltrace.Trace
 	if err != nil {
 		return nil, err
 	}
+	state.dataBrokerClientConnection = dataBrokerConn
 	state.dataBrokerClient = databroker.NewDataBrokerServiceClient(dataBrokerConn)
+	// the outbound listener also routes requests to the authorize service
+	state.authorizeClient = envoy_service_auth_v3.NewAuthorizationClient(dataBrokerConn)
+
+	// the sync querier is kept across config changes as long as the databroker
+	// connection doesn't change
+	if previousState != nil && previousState.serverSideSessionQuerier != nil {
+		if previousState.dataBrokerClientConnection == dataBrokerConn && cfg.Options.UseServerSideSessions() {
+			state.serverSideSessionQuerier = previousState.serverSideSessionQuerier
+		} else {
+			previousState.serverSideSessionQuerier.Stop()
+		}
+	}
+	if cfg.Options.UseServerSideSessions() && state.serverSideSessionQuerier == nil {
+		state.serverSideSessionQuerier = storage.NewSyncQuerier(state.dataBrokerClient, serverside.RecordType)
+	}
+
+	state.sessionStore, err = config.NewSessionStore(cfg.Options,
+		config.WithSessionStoreDataBroker(state.dataBrokerClient, state.serverSideSessionQuerier))
+	if err != nil {
+		return nil, err
+	}
 
 	state.programmaticRedirectDomainWhitelist = cfg.Options.ProgrammaticRedirectDomainWhitelist
 
 	if cfg.Options.UseStatelessAuthenticateFlow() {
 		state.authenticateFlow, err = authenticateflow.NewStateless(ctx, tracerProvider,
-			cfg, state.sessionStore, nil, nil, nil, outboundGrpcConn)
+			cfg, state.sessionStore, nil, nil, nil, nil, outboundGrpcConn)
 	} else {
 		state.authenticateFlow, err = authenticateflow.NewStateful(ctx, tracerProvider, cfg, state.sessionStore, outboundGrpcConn)
 	}
@@ -5,20 +5,53 @@ import {
   CardActionArea,
   CardContent,
   CardHeader,
+  Chip,
   Grid,
   IconButton,
   Paper,
   Snackbar,
   Stack,
+  Tooltip,
   Typography,
 } from "@mui/material";
 import React, { FC, useState } from "react";
 import { Clipboard, Link } from "react-feather";
 
-import { Route, RoutesPageData } from "../types";
+import { Route, RouteGroup, RoutesPageData } from "../types";
 import Section from "./Section";
 import SidebarPage from "./SidebarPage";
 
+const healthColors = {
+  healthy: "success.main",
+  degraded: "warning.main",
+  unhealthy: "error.main",
+};
+
+type RouteHealthProps = {
+  health: Route["health"];
+};
+const RouteHealth: FC<RouteHealthProps> = ({ health }) => {
+  if (!health) {
+    return <></>;
+  }
+
+  return (
+    <Tooltip title={health}>
+      <Box
+        component="span"
+        sx={{
+          display: "inline-block",
+          width: 8,
+          height: 8,
+          borderRadius: "50%",
+          marginRight: 1,
+          backgroundColor: healthColors[health],
+        }}
+      />
+    </Tooltip>
+  );
+};
+
 type RouteCardProps = {
   route: Route;
 };
@@ -87,6 +120,7 @@ const RouteCard: FC<RouteCardProps> = ({ route }) => {
                 wordBreak: "break-all",
               }}
             >
+              <RouteHealth health={route.health} />
               {route.name}
             </Box>
           }
@@ -144,31 +178,108 @@ const RoutesSection: FC<RoutesSectionProps> = ({ type, title, allRoutes }) => {
   );
 };
 
+type RoutesGroupSectionProps = {
+  group: RouteGroup;
+  allRoutes: Route[];
+};
+const RoutesGroupSection: FC<RoutesGroupSectionProps> = ({
+  group,
+  allRoutes,
+}) => {
+  const routes = group.route_ids
+    .map((id) => allRoutes.find((r) => r.id === id))
+    .filter((r): r is Route => !!r);
+  if (routes.length === 0) {
+    return <></>;
+  }
+
+  return (
+    <Section title={group.category || "Other Routes"}>
+      <Grid container spacing={2} justifyContent="center">
+        {routes.map((r) => (
+          <Grid key={r.id} item sx={{ width: 300 }}>
+            <RouteCard route={r} />
+          </Grid>
+        ))}
+      </Grid>
+    </Section>
+  );
+};
+
+type TagFilterProps = {
+  tags: string[];
+  selectedTags: string[];
+};
+const TagFilter: FC<TagFilterProps> = ({ tags, selectedTags }) => {
+  const tagURL = (tag: string) => {
+    const params = new URLSearchParams(window.location.search);
+    const selected = params.getAll("tag");
+    params.delete("tag");
+    const next = selected.includes(tag)
+      ? selected.filter((t) => t !== tag)
+      : [...selected, tag];
+    next.forEach((t) => params.append("tag", t));
+    const query = params.toString();
+    return window.location.pathname + (query ? `?${query}` : "");
+  };
+
+  return (
+    <Stack direction="row" spacing={1} useFlexGap flexWrap="wrap">
+      {tags.map((tag) => (
+        <Chip
+          key={tag}
+          label={tag}
+          component="a"
+          href={tagURL(tag)}
+          clickable
+          color={selectedTags.includes(tag) ? "primary" : "default"}
+          variant={selectedTags.includes(tag) ? "filled" : "outlined"}
+        />
+      ))}
+    </Stack>
+  );
+};
+
 type RoutesPageProps = {
   data: RoutesPageData;
 };
 const RoutesPage: FC<RoutesPageProps> = ({ data }) => {
+  const hasCategories = data?.groups?.some((g) => g.category !== "");
+
   return (
     <SidebarPage>
       <Stack spacing={2}>
+        {data?.tags?.length > 0 && (
+          <TagFilter tags={data.tags} selectedTags={data.selected_tags || []} />
+        )}
         {data?.routes?.length > 0 ? (
-          <>
-            <RoutesSection
-              type={"http"}
-              title={"HTTP Routes"}
-              allRoutes={data.routes}
-            />
-            <RoutesSection
-              type={"tcp"}
-              title={"TCP Routes"}
-              allRoutes={data.routes}
-            />
-            <RoutesSection
-              type={"udp"}
-              title={"UDP Routes"}
-              allRoutes={data.routes}
-            />
-          </>
+          hasCategories ? (
+            data.groups.map((g) => (
+              <RoutesGroupSection
+                key={g.category}
+                group={g}
+                allRoutes={data.routes}
+              />
+            ))
+          ) : (
+            <>
+              <RoutesSection
+                type={"http"}
+                title={"HTTP Routes"}
+                allRoutes={data.routes}
+              />
+              <RoutesSection
+                type={"tcp"}
+                title={"TCP Routes"}
+                allRoutes={data.routes}
+              />
+              <RoutesSection
+                type={"udp"}
+                title={"UDP Routes"}
+                allRoutes={data.routes}
+              />
+            </>
+          )
         ) : (
           <Paper sx={{ padding: 3 }}>
             <Typography>No accessible routes found</Typography>
@@ -115,12 +115,24 @@ export type Route = {
   connect_command?: string;
   description: string;
   logo_url: string;
+  category?: string;
+  tags?: string[];
+  order?: number;
+  health?: "healthy" | "degraded" | "unhealthy";
+};
+
+export type RouteGroup = {
+  category: string;
+  route_ids: string[];
 };
 
 export type RoutesPageData = BasePageData &
   UserInfoData & {
     page: "Routes";
     routes: Route[];
+    groups?: RouteGroup[];
+    tags?: string[];
+    selected_tags?: string[];
   };
 
 export type SignOutConfirmPageData = BasePageData & {